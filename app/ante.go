@@ -0,0 +1,62 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// NewAnteHandler returns an AnteHandler that runs default auth ante handler
+// and rejects txs carrying more side msgs than can be voted on in a single side-tx.
+func NewAnteHandler(
+	ak ante.AccountKeeper, bankKeeper authtypes.BankKeeper,
+	sigGasConsumer ante.SignatureVerificationGasConsumer,
+	signModeHandler signing.SignModeHandler,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewRejectExtensionOptionsDecorator(),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		NewSideMsgsDecorator(),
+		ante.TxTimeoutHeightDecorator{},
+		ante.NewValidateMemoDecorator(ak),
+		ante.NewConsumeGasForTxSizeDecorator(ak),
+		ante.NewRejectFeeGranterDecorator(),
+		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(ak),
+		ante.NewDeductFeeDecorator(ak, bankKeeper),
+		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		ante.NewSigVerificationDecorator(ak, signModeHandler),
+		ante.NewIncrementSequenceDecorator(ak),
+	)
+}
+
+// SideMsgsDecorator rejects txs with more than MaxSideTxMsgs side msgs
+type SideMsgsDecorator struct{}
+
+// NewSideMsgsDecorator creates new side msgs decorator
+func NewSideMsgsDecorator() SideMsgsDecorator {
+	return SideMsgsDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator
+func (SideMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	count := 0
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := IsSideMsg(msg); ok {
+			count++
+		}
+	}
+
+	if count > types.MaxSideTxMsgs {
+		return ctx, sdkerrors.Wrapf(hmCommon.ErrTooManySideMsgs, "side msgs: %d, max: %d", count, types.MaxSideTxMsgs)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -47,15 +47,10 @@ import (
 	// unnamed import of statik for swagger UI support
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
 
-	// "github.com/cosmos/cosmos-sdk/x/slashing"
-	// slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
-	// slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
-
 	hmparams "github.com/maticnetwork/heimdall/app/params"
 	"github.com/maticnetwork/heimdall/helper"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/types/common"
-	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	hmmodule "github.com/maticnetwork/heimdall/types/module"
 	"github.com/maticnetwork/heimdall/x/chainmanager"
 	chainKeeper "github.com/maticnetwork/heimdall/x/chainmanager/keeper"
@@ -72,6 +67,9 @@ import (
 	"github.com/maticnetwork/heimdall/x/sidechannel"
 	sidechannelkeeper "github.com/maticnetwork/heimdall/x/sidechannel/keeper"
 	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
+	"github.com/maticnetwork/heimdall/x/slashing"
+	slashingkeeper "github.com/maticnetwork/heimdall/x/slashing/keeper"
+	slashingtypes "github.com/maticnetwork/heimdall/x/slashing/types"
 	"github.com/maticnetwork/heimdall/x/staking"
 	stakingkeeper "github.com/maticnetwork/heimdall/x/staking/keeper"
 	stakingtypes "github.com/maticnetwork/heimdall/x/staking/types"
@@ -108,6 +106,7 @@ var (
 		topup.AppModuleBasic{},
 		clerk.AppModuleBasic{},
 		bor.AppModuleBasic{},
+		slashing.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -136,6 +135,12 @@ type HeimdallApp struct {
 
 	invCheckPeriod uint
 
+	// module invariants, asserted every invCheckPeriod blocks
+	InvariantRegistry InvariantRegistry
+
+	// module store migrations, run at first begin block after upgrade
+	StoreMigrations StoreMigrations
+
 	// keys to access the substores
 	keys  map[string]*sdk.KVStoreKey
 	tkeys map[string]*sdk.TransientStoreKey
@@ -152,6 +157,7 @@ type HeimdallApp struct {
 	CheckpointKeeper  checkpointkeeper.Keeper
 	TopupKeeper       topupkeeper.Keeper
 	BorKeeper         borkeeper.Keeper
+	SlashingKeeper    slashingkeeper.Keeper
 
 	// side router
 	sideRouter hmtypes.SideRouter
@@ -213,7 +219,7 @@ func NewHeimdallApp(
 		stakingtypes.StoreKey,
 		checkpointtypes.StoreKey,
 		// distrtypes.StoreKey,
-		// slashingtypes.StoreKey,
+		slashingtypes.StoreKey,
 		govtypes.StoreKey,
 		paramstypes.StoreKey,
 		topuptypes.StoreKey,
@@ -271,11 +277,6 @@ func NewHeimdallApp(
 		app.BlockedAddrs(),
 	)
 
-	app.SidechannelKeeper = sidechannelkeeper.NewKeeper(
-		appCodec,
-		keys[sidechanneltypes.StoreKey],
-	)
-
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey], // target store
@@ -284,6 +285,14 @@ func NewHeimdallApp(
 		app.BankKeeper,
 		moduleCommunicator,
 	)
+
+	app.SidechannelKeeper = sidechannelkeeper.NewKeeper(
+		appCodec,
+		keys[sidechanneltypes.StoreKey],
+		app.GetSubspace(sidechanneltypes.ModuleName),
+		&app.StakingKeeper,
+	)
+
 	app.CheckpointKeeper = checkpointkeeper.NewKeeper(
 		appCodec,
 		keys[checkpointtypes.StoreKey], // target store
@@ -293,6 +302,14 @@ func NewHeimdallApp(
 		moduleCommunicator,
 	)
 
+	app.SlashingKeeper = slashingkeeper.NewKeeper(
+		appCodec,
+		keys[slashingtypes.StoreKey], // target store
+		app.GetSubspace(slashingtypes.ModuleName),
+		app.StakingKeeper,
+		app.ChainKeeper,
+	)
+
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler)
 
@@ -319,6 +336,7 @@ func NewHeimdallApp(
 		app.ChainKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.CheckpointKeeper,
 	)
 
 	app.BorKeeper = borkeeper.NewKeeper(
@@ -363,6 +381,7 @@ func NewHeimdallApp(
 		checkpoint.NewAppModule(appCodec, app.CheckpointKeeper, &app.caller),
 		bor.NewAppModule(appCodec, app.BorKeeper, &app.caller),
 		topup.NewAppModule(appCodec, app.TopupKeeper, &app.caller),
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, &app.caller),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -372,16 +391,13 @@ func NewHeimdallApp(
 	app.mm.SetOrderBeginBlockers(
 		sidechanneltypes.ModuleName,
 		stakingtypes.ModuleName,
+		slashingtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		sidechanneltypes.ModuleName,
 		stakingtypes.ModuleName,
 		govtypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(
-		stakingtypes.ModuleName,
-		govtypes.ModuleName,
-	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -394,6 +410,7 @@ func NewHeimdallApp(
 		sidechanneltypes.ModuleName,
 		chainmanagerTypes.ModuleName,
 		stakingtypes.ModuleName,
+		slashingtypes.ModuleName,
 		checkpointtypes.ModuleName,
 		clerktypes.ModuleName,
 		genutiltypes.ModuleName,
@@ -402,7 +419,22 @@ func NewHeimdallApp(
 		topuptypes.ModuleName,
 	)
 
-	// app.mm.RegisterInvariants(&app.CrisisKeeper)
+	app.mm.RegisterInvariants(&app.InvariantRegistry)
+	app.StoreMigrations.Register(keys[bortypes.StoreKey], bortypes.ConsensusVersion, map[uint64]StoreMigration{
+		1: func(ctx sdk.Context) error {
+			_, err := app.BorKeeper.MigrateSpans(ctx)
+			return err
+		},
+	})
+	app.StoreMigrations.Register(keys[topuptypes.StoreKey], topuptypes.ConsensusVersion, map[uint64]StoreMigration{
+		1: app.TopupKeeper.MigrateDividendAccountHistory,
+	})
+	app.StoreMigrations.Register(keys[stakingtypes.StoreKey], stakingtypes.ConsensusVersion, map[uint64]StoreMigration{
+		1: app.StakingKeeper.MigratePendingValidatorUpdates,
+	})
+	app.StoreMigrations.Register(keys[checkpointtypes.StoreKey], checkpointtypes.ConsensusVersion, map[uint64]StoreMigration{
+		1: app.CheckpointKeeper.MigrateCheckpointBlockIndex,
+	})
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.mm.RegisterServices(module.NewConfigurator(app.MsgServiceRouter(), app.GRPCQueryRouter()))
 
@@ -449,7 +481,7 @@ func NewHeimdallApp(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(
-		ante.NewAnteHandler(
+		NewAnteHandler(
 			app.AccountKeeper,
 			app.BankKeeper,
 			ante.DefaultSigVerificationGasConsumer,
@@ -503,6 +535,9 @@ func (app *HeimdallApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock
 		)
 	}
 
+	// migrate module stores written by previous version, only first block of process checks store versions
+	app.StoreMigrations.RunMigrations(ctx)
+
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -522,57 +557,17 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		// remove block proposer
 		app.ChainKeeper.RemoveBlockProposer(ctx)
 	}
-	var tmValUpdates []abci.ValidatorUpdate
-
-	// --- Start update to new validators
-	currentValidatorSet := app.StakingKeeper.GetValidatorSet(ctx)
-	allValidators := app.StakingKeeper.GetAllValidators(ctx)
-	ackCount := app.CheckpointKeeper.GetACKCount(ctx)
 
-	// get validator updates
-	setUpdates := helper.GetUpdatedValidators(
-		currentValidatorSet, // pointer to current validator set -- UpdateValidators will modify it
-		allValidators,       // All validators
-		ackCount,            // ack count
-	)
+	// end block, staking module applies staged validator updates
+	res := app.mm.EndBlock(ctx, req)
 
-	if len(setUpdates) > 0 {
-		// create new validator set
-		if err := currentValidatorSet.UpdateWithChangeSet(setUpdates); err != nil {
-			// return with nothing
-			logger.Error("Unable to update current validator set", "Error", err)
-			return abci.ResponseEndBlock{}
-		}
-
-		// increment proposer priority
-		currentValidatorSet.IncrementProposerPriority(1)
-
-		// validator set change
-		logger.Debug("[ENDBLOCK] Updated current validator set", "proposer", currentValidatorSet.GetProposer())
-
-		// save set in store
-		if err := app.StakingKeeper.UpdateValidatorSetInStore(ctx, currentValidatorSet); err != nil {
-			// return with nothing
-			logger.Error("Unable to update current validator set in state", "Error", err)
-			return abci.ResponseEndBlock{}
-		}
-
-		// convert updates from map to array
-		for _, v := range setUpdates {
-			tmValUpdates = append(tmValUpdates, abci.ValidatorUpdate{
-				Power:  int64(v.VotingPower),
-				PubKey: hmCommonTypes.NewPubKeyFromHex(v.PubKey).TMProtoCryptoPubKey(),
-			})
-		}
+	// assert invariants on updated state
+	if app.invCheckPeriod != 0 && ctx.BlockHeight()%int64(app.invCheckPeriod) == 0 {
+		app.InvariantRegistry.AssertInvariants(ctx)
 	}
 
-	// end block
-	app.mm.EndBlock(ctx, req)
-
 	// send validator updates to peppermint
-	return abci.ResponseEndBlock{
-		ValidatorUpdates: tmValUpdates,
-	}
+	return res
 }
 
 // InitChainer application update at chain initialization
@@ -593,6 +588,7 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 
 	// Init genesis
 	app.mm.InitGenesis(ctx, app.AppCodec(), genesisState)
+	app.StoreMigrations.InitVersions(ctx)
 
 	// get staking state
 	stakingState := stakingtypes.GetGenesisStateFromAppState(app.AppCodec(), genesisState)
@@ -783,6 +779,7 @@ func initParamsKeeper(appCodec codec.BinaryMarshaler, legacyAmino *codec.LegacyA
 	paramsKeeper.Subspace(bortypes.ModuleName)
 	paramsKeeper.Subspace(clerktypes.ModuleName)
 	paramsKeeper.Subspace(topuptypes.ModuleName)
+	paramsKeeper.Subspace(slashingtypes.ModuleName)
 
 	return paramsKeeper
 }
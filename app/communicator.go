@@ -26,6 +26,11 @@ func (d ModuleCommunicator) GetAllDividendAccounts(ctx sdk.Context) []*types.Div
 	return d.App.TopupKeeper.GetAllDividendAccounts(ctx)
 }
 
+// PruneDividendAccountHistory removes dividend account history of topup module older than height
+func (d ModuleCommunicator) PruneDividendAccountHistory(ctx sdk.Context, height int64) {
+	d.App.TopupKeeper.PruneDividendAccountHistory(ctx, height)
+}
+
 // GetValidatorFromValID get validator from validator id
 func (d ModuleCommunicator) GetValidatorFromValID(ctx sdk.Context, valID types.ValidatorID) (validator types.Validator, ok bool) {
 	return d.App.StakingKeeper.GetValidatorFromValID(ctx, valID)
@@ -33,7 +38,5 @@ func (d ModuleCommunicator) GetValidatorFromValID(ctx sdk.Context, valID types.V
 
 // CreateValidatorSigningInfo creates ValidatorSigningInfo used by slashing module
 func (d ModuleCommunicator) CreateValidatorSigningInfo(ctx sdk.Context, valID types.ValidatorID, valSigningInfo types.ValidatorSigningInfo) {
-	// todo: enable after adding slashing keeper
-	//d.App.SlashingKeeper.SetValidatorSigningInfo(ctx, valID, valSigningInfo)
-	//return
+	d.App.SlashingKeeper.SetValidatorSigningInfo(ctx, valID, valSigningInfo)
 }
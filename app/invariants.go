@@ -0,0 +1,72 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvariantRoute is an invariant registered by a module
+type InvariantRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// FullRoute returns module scoped name of invariant
+func (r InvariantRoute) FullRoute() string {
+	return r.ModuleName + "/" + r.Route
+}
+
+// BrokenInvariant is an invariant found broken with its message
+type BrokenInvariant struct {
+	Route   string
+	Message string
+}
+
+// InvariantRegistry keeps invariants registered by modules, asserted crisis-style
+type InvariantRegistry struct {
+	routes []InvariantRoute
+}
+
+var _ sdk.InvariantRegistry = (*InvariantRegistry)(nil)
+
+// RegisterRoute registers invariant of module
+func (r *InvariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	r.routes = append(r.routes, InvariantRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	})
+}
+
+// Routes returns registered invariants in registration order
+func (r *InvariantRegistry) Routes() []InvariantRoute {
+	return r.routes
+}
+
+// CheckInvariants runs all registered invariants and returns broken ones
+func (r *InvariantRegistry) CheckInvariants(ctx sdk.Context) []BrokenInvariant {
+	var broken []BrokenInvariant
+	for _, route := range r.routes {
+		if res, stop := route.Invar(ctx); stop {
+			broken = append(broken, BrokenInvariant{Route: route.FullRoute(), Message: res})
+		}
+	}
+
+	return broken
+}
+
+// AssertInvariants runs all registered invariants and halts the chain by panicking if any is broken
+func (r *InvariantRegistry) AssertInvariants(ctx sdk.Context) {
+	logger.Info("Asserting invariants", "count", len(r.routes), "height", ctx.BlockHeight())
+
+	if broken := r.CheckInvariants(ctx); len(broken) > 0 {
+		var msg string
+		for _, invariant := range broken {
+			msg += invariant.Message
+		}
+
+		panic(fmt.Errorf("invariant broken: %s", msg))
+	}
+}
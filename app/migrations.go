@@ -0,0 +1,88 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreVersionKey is key to version of store layout in every module store registering migrations
+var StoreVersionKey = []byte("StoreVersion")
+
+// StoreMigration migrates module store from one version of its layout to the next
+type StoreMigration func(ctx sdk.Context) error
+
+type storeMigrations struct {
+	storeKey   sdk.StoreKey
+	version    uint64
+	migrations map[uint64]StoreMigration
+}
+
+// StoreMigrations keeps store migrations registered by modules.
+// Stores are migrated once, at first block processed after upgrade, and set to current version at init chain.
+type StoreMigrations struct {
+	stores   []storeMigrations
+	migrated bool
+}
+
+// Register registers migrations of module store to its current version, keyed by version they migrate from
+func (m *StoreMigrations) Register(storeKey sdk.StoreKey, version uint64, migrations map[uint64]StoreMigration) {
+	m.stores = append(m.stores, storeMigrations{
+		storeKey:   storeKey,
+		version:    version,
+		migrations: migrations,
+	})
+}
+
+// InitVersions sets registered stores to their current version, genesis state is always stored in current layout
+func (m *StoreMigrations) InitVersions(ctx sdk.Context) {
+	for _, store := range m.stores {
+		SetStoreVersion(ctx, store.storeKey, store.version)
+	}
+	m.migrated = true
+}
+
+// RunMigrations migrates registered stores to their current version in registration order.
+// It does nothing after first call and halts the chain by panicking if any migration fails.
+func (m *StoreMigrations) RunMigrations(ctx sdk.Context) {
+	if m.migrated {
+		return
+	}
+	m.migrated = true
+
+	for _, store := range m.stores {
+		fromVersion := GetStoreVersion(ctx, store.storeKey)
+		if fromVersion >= store.version {
+			continue
+		}
+
+		for version := fromVersion; version < store.version; version++ {
+			migration, ok := store.migrations[version]
+			if !ok {
+				panic(fmt.Errorf("store migration of %s from version %d not registered", store.storeKey.Name(), version))
+			}
+
+			if err := migration(ctx); err != nil {
+				panic(fmt.Errorf("store migration of %s from version %d failed: %w", store.storeKey.Name(), version, err))
+			}
+		}
+
+		SetStoreVersion(ctx, store.storeKey, store.version)
+		ctx.Logger().Info("Migrated store", "store", store.storeKey.Name(), "fromVersion", fromVersion, "toVersion", store.version)
+	}
+}
+
+// GetStoreVersion returns version of store layout, store written before versioning was introduced is at version 1
+func GetStoreVersion(ctx sdk.Context, storeKey sdk.StoreKey) uint64 {
+	bz := ctx.KVStore(storeKey).Get(StoreVersionKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetStoreVersion sets version of store layout
+func SetStoreVersion(ctx sdk.Context, storeKey sdk.StoreKey, version uint64) {
+	ctx.KVStore(storeKey).Set(StoreVersionKey, sdk.Uint64ToBigEndian(version))
+}
@@ -0,0 +1,81 @@
+package app_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	bortypes "github.com/maticnetwork/heimdall/x/bor/types"
+	checkpointtypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+	stakingtypes "github.com/maticnetwork/heimdall/x/staking/types"
+	topuptypes "github.com/maticnetwork/heimdall/x/topup/types"
+)
+
+func TestStoreMigrations(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{})
+	key := happ.GetKey(bortypes.StoreKey)
+
+	// store written before versioning was introduced
+	ctx.KVStore(key).Delete(app.StoreVersionKey)
+	require.Equal(t, uint64(1), app.GetStoreVersion(ctx, key))
+
+	var migrated []uint64
+	migration := func(version uint64) app.StoreMigration {
+		return func(ctx sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}
+	}
+
+	migrations := app.StoreMigrations{}
+	migrations.Register(key, 3, map[uint64]app.StoreMigration{1: migration(1), 2: migration(2)})
+
+	// store is migrated through every version once
+	migrations.RunMigrations(ctx)
+	require.Equal(t, []uint64{1, 2}, migrated)
+	require.Equal(t, uint64(3), app.GetStoreVersion(ctx, key))
+
+	migrations.RunMigrations(ctx)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// migrated store is not migrated again after restart
+	migrations = app.StoreMigrations{}
+	migrations.Register(key, 3, map[uint64]app.StoreMigration{1: migration(1), 2: migration(2)})
+	migrations.RunMigrations(ctx)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// failed migration halts the chain
+	app.SetStoreVersion(ctx, key, 2)
+	migrations = app.StoreMigrations{}
+	migrations.Register(key, 3, map[uint64]app.StoreMigration{2: func(ctx sdk.Context) error { return errors.New("failed") }})
+	require.Panics(t, func() { migrations.RunMigrations(ctx) })
+
+	// missing migration halts the chain
+	app.SetStoreVersion(ctx, key, 1)
+	migrations = app.StoreMigrations{}
+	migrations.Register(key, 3, map[uint64]app.StoreMigration{2: migration(2)})
+	require.Panics(t, func() { migrations.RunMigrations(ctx) })
+
+	// store initialized at init chain is at current version
+	migrations = app.StoreMigrations{}
+	migrations.Register(key, 3, map[uint64]app.StoreMigration{1: migration(1), 2: migration(2)})
+	migrations.InitVersions(ctx)
+	require.Equal(t, uint64(3), app.GetStoreVersion(ctx, key))
+	migrations.RunMigrations(ctx)
+	require.Equal(t, []uint64{1, 2}, migrated)
+}
+
+func TestInitChainStoreVersions(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{})
+
+	require.Equal(t, bortypes.ConsensusVersion, app.GetStoreVersion(ctx, happ.GetKey(bortypes.StoreKey)))
+	require.Equal(t, topuptypes.ConsensusVersion, app.GetStoreVersion(ctx, happ.GetKey(topuptypes.StoreKey)))
+	require.Equal(t, stakingtypes.ConsensusVersion, app.GetStoreVersion(ctx, happ.GetKey(stakingtypes.StoreKey)))
+	require.Equal(t, checkpointtypes.ConsensusVersion, app.GetStoreVersion(ctx, happ.GetKey(checkpointtypes.StoreKey)))
+}
@@ -10,10 +10,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
+	ethcrypto "github.com/maticnetwork/bor/crypto"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/maticnetwork/heimdall/types"
+	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
 )
 
 // PostDeliverTxHandler runs after deliver tx handler
@@ -40,16 +43,29 @@ func (app *HeimdallApp) PostDeliverTxHandler(ctx sdk.Context, tx sdk.Tx, result
 // BeginSideBlocker runs before side block
 func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginSideBlock) (res abci.ResponseBeginSideBlock) {
 	height := ctx.BlockHeader().Height
-	if height <= 2 {
+
+	// fetch thresholds and delay
+	params := app.SidechannelKeeper.GetParams(ctx)
+	if height <= int64(params.SideBlockDelay) {
 		return
 	}
 
-	targetHeight := uint64(height - 2) // sidechannel takes 2 blocks to process
+	targetHeight := uint64(height) - params.SideBlockDelay // sidechannel takes `SideBlockDelay` blocks to process
+
+	// heights up to last processed one are done, heights left behind by lowered delay are processed now
+	fromHeight := targetHeight
+	if lastHeight, ok := app.SidechannelKeeper.GetLastSideBlockHeight(ctx); ok {
+		fromHeight = lastHeight + 1
+	}
+
+	if fromHeight > targetHeight {
+		return
+	}
 
 	// get logger
 	logger := app.Logger()
 
-	logger.Debug("[sidechannel] Processing side block", "height", height, "targetHeight", targetHeight)
+	logger.Debug("[sidechannel] Processing side block", "height", height, "fromHeight", fromHeight, "targetHeight", targetHeight)
 
 	// get all validators
 	// begin-block stores validators and end-block removes validators for each height - check sidechannel module.go
@@ -58,12 +74,46 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 		return
 	}
 
+	// get empty events
+	events := sdk.EmptyEvents()
+
+	for processHeight := fromHeight; processHeight <= targetHeight; processHeight++ {
+		events = events.AppendEvents(app.processSideBlock(ctx, req, params, height, processHeight, validators))
+	}
+
+	app.SidechannelKeeper.SetLastSideBlockHeight(ctx, targetHeight)
+
+	// set event to response
+	res.Events = events.ToABCIEvents()
+
+	return res
+}
+
+// processSideBlock tallies votes of side-txs included at target height and executes them,
+// side-txs without votes are executed with `skip`
+func (app *HeimdallApp) processSideBlock(
+	ctx sdk.Context,
+	req abci.RequestBeginSideBlock,
+	params sidechanneltypes.Params,
+	height int64,
+	targetHeight uint64,
+	validators []*abci.Validator,
+) sdk.Events {
+	logger := app.Logger()
+
 	// calculate power
 	var totalPower int64
 	for _, v := range validators {
 		totalPower = totalPower + v.Power
 	}
 
+	// record vote tallies only if retention window is set
+	recordTally := params.VoteRetentionBlocks > 0
+
+	// required power to approve or reject side-tx
+	approvalPower := params.ApprovalPower(totalPower)
+	rejectionPower := params.RejectionPower(totalPower)
+
 	// get empty events
 	events := sdk.EmptyEvents()
 
@@ -75,6 +125,14 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 			// remove tx to avoid duplicate execution
 			app.SidechannelKeeper.RemoveTx(ctx, targetHeight, txHash)
 
+			// side-tx with multiple side msgs is tallied and executed per msg
+			if sideMsgs := app.getTxSideMsgs(tx); len(sideMsgs) > 1 {
+				events = events.AppendEvents(
+					app.processMultiMsgSideTx(ctx, params, height, targetHeight, validators, totalPower, tx, sideMsgs, sideTxResult.Sigs),
+				)
+				continue
+			}
+
 			usedValidator := make(map[int]bool)
 
 			// signed power
@@ -83,39 +141,67 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 			signedPower[tmprototypes.SideTxResultType_SKIP] = 0
 			signedPower[tmprototypes.SideTxResultType_NO] = 0
 
+			votes := make([]sidechanneltypes.SideTxVote, 0, len(sideTxResult.Sigs))
+
 			for _, sigObj := range sideTxResult.Sigs {
 				// get validator by sig address
-				if i := getValidatorIndexByAddress(sigObj.Address, validators); i != -1 {
+				if i := app.getVoterIndex(ctx, sigObj.Address, validators); i != -1 {
 					// check if validator already voted on tx
 					if _, ok := usedValidator[i]; !ok {
 						signedPower[sigObj.Result] = signedPower[sigObj.Result] + validators[i].Power
 						usedValidator[i] = true
+
+						votes = append(votes, sidechanneltypes.SideTxVote{
+							Address: validators[i].Address,
+							Power:   validators[i].Power,
+							Result:  sigObj.Result,
+						})
 					}
 				}
 			}
 
 			var result *sdk.Result
 			var rerr error
+			var txResult tmprototypes.SideTxResultType
 
 			// check vote majority
-			if signedPower[tmprototypes.SideTxResultType_YES] >= (totalPower*2/3 + 1) {
+			if signedPower[tmprototypes.SideTxResultType_YES] >= approvalPower {
 				// approved
 				logger.Debug("[sidechannel] Approved side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
 				// execute tx with `yes`
-				result, rerr = app.runTx(ctx, tx, tmprototypes.SideTxResultType_YES)
-			} else if signedPower[tmprototypes.SideTxResultType_NO] >= (totalPower*2/3 + 1) {
+				txResult = tmprototypes.SideTxResultType_YES
+			} else if signedPower[tmprototypes.SideTxResultType_NO] >= rejectionPower {
 				// rejected
 				logger.Debug("[sidechannel] Rejected side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
 				// execute tx with `no`
-				result, rerr = app.runTx(ctx, tx, tmprototypes.SideTxResultType_NO)
+				txResult = tmprototypes.SideTxResultType_NO
 			} else {
 				// skipped
 				logger.Debug("[sidechannel] Skipped side-tx", "txHash", hex.EncodeToString(tx.Hash()))
 
 				// execute tx with `skip`
-				result, rerr = app.runTx(ctx, tx, tmprototypes.SideTxResultType_SKIP)
+				txResult = tmprototypes.SideTxResultType_SKIP
+			}
+
+			result, rerr = app.runTx(ctx, tx, txResult)
+
+			// track validator participation in side-tx
+			events = events.AppendEvents(app.handleSideTxParticipation(ctx, validators, votes, txResult))
+
+			if recordTally {
+				app.setSideTxTally(ctx, &sidechanneltypes.SideTxTally{
+					Height:         targetHeight,
+					ExecutedHeight: uint64(height),
+					TxHash:         tx.Hash(),
+					TotalPower:     totalPower,
+					YesPower:       signedPower[tmprototypes.SideTxResultType_YES],
+					NoPower:        signedPower[tmprototypes.SideTxResultType_NO],
+					SkipPower:      signedPower[tmprototypes.SideTxResultType_SKIP],
+					Result:         txResult,
+					Votes:          votes,
+				})
 			}
 
 			if rerr != nil {
@@ -144,6 +230,21 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 
 		// execute tx with `skip`
 		result, serr := app.runTx(ctx, tx, tmprototypes.SideTxResultType_SKIP)
+
+		// no votes received for tx, every validator missed it
+		events = events.AppendEvents(app.handleSideTxParticipation(ctx, validators, nil, tmprototypes.SideTxResultType_SKIP))
+
+		// no votes received for tx
+		if recordTally {
+			app.setSideTxTally(ctx, &sidechanneltypes.SideTxTally{
+				Height:         targetHeight,
+				ExecutedHeight: uint64(height),
+				TxHash:         tx.Hash(),
+				TotalPower:     totalPower,
+				Result:         tmprototypes.SideTxResultType_SKIP,
+			})
+		}
+
 		if serr != nil {
 			logger.Error("[sidechannel] Error while processing skipped side-tx in beginside block", "txHash", hex.EncodeToString(tx.Hash()))
 		} else {
@@ -152,14 +253,16 @@ func (app *HeimdallApp) BeginSideBlocker(ctx sdk.Context, req abci.RequestBeginS
 		}
 	}
 
-	// set event to response
-	res.Events = events.ToABCIEvents()
-
-	return res
+	return events
 }
 
 // DeliverSideTxHandler runs for each side tx
 func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abci.RequestDeliverSideTx) (res abci.ResponseDeliverSideTx) {
+	// side-tx with multiple side msgs is voted per msg
+	if sideMsgs := app.getSideMsgs(tx.GetMsgs()); len(sideMsgs) > 1 {
+		return app.deliverMultiMsgSideTx(ctx, req, sideMsgs)
+	}
+
 	var code uint32
 	var codespace string
 
@@ -210,6 +313,189 @@ func (app *HeimdallApp) DeliverSideTxHandler(ctx sdk.Context, tx sdk.Tx, req abc
 	}
 }
 
+// deliverMultiMsgSideTx runs side-tx handler of each side msg in order and votes on the longest run of msgs
+// from the first one with same result, remaining msgs are skipped.
+// Side-tx data holds number of voted msgs and hash of side sign bytes of all msgs.
+func (app *HeimdallApp) deliverMultiMsgSideTx(ctx sdk.Context, req abci.RequestDeliverSideTx, sideMsgs []sdk.Msg) abci.ResponseDeliverSideTx {
+	result := tmprototypes.SideTxResultType_SKIP
+	voted := 0
+
+	signBytes := make([][]byte, len(sideMsgs))
+	for i, msg := range sideMsgs {
+		sideMsg, _ := IsSideMsg(msg)
+		signBytes[i] = sideMsg.GetSideSignBytes()
+
+		// run of voted msgs ended, rest of the msgs are skipped
+		if voted < i {
+			continue
+		}
+
+		// Create a new context based off of the existing context with a cache wrapped multi-store (for state-less execution)
+		runMsgCtx, _ := app.cacheTxContext(ctx, req.Tx)
+		// execute side-tx handler
+		msgResult := app.sideRouter.GetRoute(msg.Route()).SideTxHandler(runMsgCtx, msg)
+
+		// failed or skipped msg ends the run
+		if msgResult.Code != abci.CodeTypeOK || msgResult.Result == tmprototypes.SideTxResultType_SKIP {
+			continue
+		}
+
+		// msg with result different from first msg ends the run
+		if i > 0 && msgResult.Result != result {
+			continue
+		}
+
+		result = msgResult.Result
+		voted++
+	}
+
+	return abci.ResponseDeliverSideTx{
+		Data:   types.GetMultiSideSignBytes(voted, types.HashMultiSideSignBytes(signBytes)),
+		Result: result,
+	}
+}
+
+// processMultiMsgSideTx tallies per-msg votes of multi-msg side-tx and executes each side msg with its own result
+func (app *HeimdallApp) processMultiMsgSideTx(
+	ctx sdk.Context,
+	params sidechanneltypes.Params,
+	height int64,
+	targetHeight uint64,
+	validators []*abci.Validator,
+	totalPower int64,
+	tx tmtypes.Tx,
+	sideMsgs []sdk.Msg,
+	sigs []tmprototypes.SideTxResponse,
+) sdk.Events {
+	logger := app.Logger()
+	events := sdk.EmptyEvents()
+
+	// hash of side sign bytes of all msgs, signed by every vote
+	signBytes := make([][]byte, len(sideMsgs))
+	for i, msg := range sideMsgs {
+		sideMsg, _ := IsSideMsg(msg)
+		signBytes[i] = sideMsg.GetSideSignBytes()
+	}
+	signBytesHash := types.HashMultiSideSignBytes(signBytes)
+
+	// signed power of side-tx
+	signedPower := make(map[tmprototypes.SideTxResultType]int64)
+
+	// power of votes on first k msgs by result, indexed by k
+	votedPower := map[tmprototypes.SideTxResultType][]int64{
+		tmprototypes.SideTxResultType_YES: make([]int64, len(sideMsgs)+1),
+		tmprototypes.SideTxResultType_NO:  make([]int64, len(sideMsgs)+1),
+	}
+
+	usedValidator := make(map[int]bool)
+	votes := make([]sidechanneltypes.SideTxVote, 0, len(sigs))
+	msgVotes := make([][]sidechanneltypes.SideTxVote, len(sideMsgs))
+
+	for _, sigObj := range sigs {
+		// get validator by sig address
+		i := app.getVoterIndex(ctx, sigObj.Address, validators)
+		if i == -1 || usedValidator[i] {
+			continue
+		}
+
+		// find number of msgs validator voted on
+		voted, ok := matchMultiSideTxVote(sigObj, len(sideMsgs), signBytesHash)
+		if !ok {
+			logger.Debug("[sidechannel] Invalid multi-msg side-tx sig", "txHash", hex.EncodeToString(tx.Hash()), "address", hex.EncodeToString(sigObj.Address))
+			continue
+		}
+
+		usedValidator[i] = true
+		signedPower[sigObj.Result] = signedPower[sigObj.Result] + validators[i].Power
+		if _, ok := votedPower[sigObj.Result]; ok {
+			votedPower[sigObj.Result][voted] = votedPower[sigObj.Result][voted] + validators[i].Power
+		}
+
+		msgResults := types.GetMultiSideMsgResults(sigObj.Result, voted, len(sideMsgs))
+		votes = append(votes, sidechanneltypes.SideTxVote{
+			Address:    validators[i].Address,
+			Power:      validators[i].Power,
+			Result:     sigObj.Result,
+			MsgResults: msgResults,
+		})
+
+		for j, msgResult := range msgResults {
+			msgVotes[j] = append(msgVotes[j], sidechanneltypes.SideTxVote{
+				Address: validators[i].Address,
+				Power:   validators[i].Power,
+				Result:  msgResult,
+			})
+		}
+	}
+
+	// signed power of each msg, msg is covered by votes on more msgs than its index
+	msgSignedPower := make([]map[tmprototypes.SideTxResultType]int64, len(sideMsgs))
+	for result, power := range votedPower {
+		var covered int64
+		for k := len(sideMsgs); k > 0; k-- {
+			covered = covered + power[k]
+
+			if msgSignedPower[k-1] == nil {
+				msgSignedPower[k-1] = make(map[tmprototypes.SideTxResultType]int64)
+			}
+			msgSignedPower[k-1][result] = covered
+		}
+	}
+
+	// required power to approve or reject msg
+	approvalPower := params.ApprovalPower(totalPower)
+	rejectionPower := params.RejectionPower(totalPower)
+
+	msgResults := make([]tmprototypes.SideTxResultType, len(sideMsgs))
+	for i, msg := range sideMsgs {
+		// check vote majority of msg
+		if msgSignedPower[i][tmprototypes.SideTxResultType_YES] >= approvalPower {
+			msgResults[i] = tmprototypes.SideTxResultType_YES
+		} else if msgSignedPower[i][tmprototypes.SideTxResultType_NO] >= rejectionPower {
+			msgResults[i] = tmprototypes.SideTxResultType_NO
+		} else {
+			msgResults[i] = tmprototypes.SideTxResultType_SKIP
+		}
+
+		logger.Debug("[sidechannel] Processing side msg", "txHash", hex.EncodeToString(tx.Hash()), "msgIndex", i, "result", msgResults[i])
+
+		// execute msg on its own, failed msg doesn't revert other msgs
+		result, err := app.runTxMsgs(ctx, tx, []sdk.Msg{msg}, msgResults[i])
+		if err != nil {
+			logger.Error("[sidechannel] Error while processing side msg in begin side block",
+				"txHash", hex.EncodeToString(tx.Hash()),
+				"msgIndex", i,
+				"yesVotes", msgSignedPower[i][tmprototypes.SideTxResultType_YES],
+				"noVotes", msgSignedPower[i][tmprototypes.SideTxResultType_NO],
+				"err", err,
+			)
+		} else {
+			// add events
+			events = events.AppendEvents(result.GetEvents())
+		}
+
+		// track validator participation in side msg
+		events = events.AppendEvents(app.handleSideTxParticipation(ctx, validators, msgVotes[i], msgResults[i]))
+	}
+
+	if params.VoteRetentionBlocks > 0 {
+		app.setSideTxTally(ctx, &sidechanneltypes.SideTxTally{
+			Height:         targetHeight,
+			ExecutedHeight: uint64(height),
+			TxHash:         tx.Hash(),
+			TotalPower:     totalPower,
+			YesPower:       signedPower[tmprototypes.SideTxResultType_YES],
+			NoPower:        signedPower[tmprototypes.SideTxResultType_NO],
+			SkipPower:      signedPower[tmprototypes.SideTxResultType_SKIP],
+			Result:         aggregateSideTxResult(msgResults),
+			Votes:          votes,
+			MsgResults:     msgResults,
+		})
+	}
+
+	return events
+}
+
 //
 // Internal functions
 //
@@ -221,6 +507,11 @@ func (app *HeimdallApp) runTx(ctx sdk.Context, txBytes []byte, sideTxResult tmpr
 		return
 	}
 
+	return app.runTxMsgs(ctx, txBytes, tx.GetMsgs(), sideTxResult)
+}
+
+// runTxMsgs executes msgs of tx, state is updated only if all msgs pass
+func (app *HeimdallApp) runTxMsgs(ctx sdk.Context, txBytes []byte, msgs []sdk.Msg, sideTxResult tmprototypes.SideTxResultType) (result *sdk.Result, err error) {
 	// recover if runMsgs fails
 	defer func() {
 		if r := recover(); r != nil {
@@ -237,7 +528,7 @@ func (app *HeimdallApp) runTx(ctx sdk.Context, txBytes []byte, sideTxResult tmpr
 	// Create a new context based off of the existing context with a cache wrapped
 	// multi-store in case message processing fails.
 	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
-	result, err = app.runMsgs(runMsgCtx, tx.GetMsgs(), sideTxResult)
+	result, err = app.runMsgs(runMsgCtx, msgs, sideTxResult)
 	// only update state if all messages pass
 	if err == nil {
 		msCache.Write()
@@ -320,6 +611,112 @@ func IsSideMsg(msg sdk.Msg) (types.SideTxMsg, bool) {
 	return nil, false
 }
 
+func (app *HeimdallApp) setSideTxTally(ctx sdk.Context, tally *sidechanneltypes.SideTxTally) {
+	if err := app.SidechannelKeeper.SetSideTxTally(ctx, tally); err != nil {
+		app.Logger().Error("[sidechannel] Error storing side-tx tally", "txHash", hex.EncodeToString(tally.TxHash), "err", err)
+	}
+}
+
+// handleSideTxParticipation records validator participation and returns emitted events
+func (app *HeimdallApp) handleSideTxParticipation(
+	ctx sdk.Context,
+	validators []*abci.Validator,
+	votes []sidechanneltypes.SideTxVote,
+	result tmprototypes.SideTxResultType,
+) sdk.Events {
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.SidechannelKeeper.HandleSideTxParticipation(ctx, validators, votes, result)
+
+	return ctx.EventManager().Events()
+}
+
+// getTxSideMsgs decodes tx and returns its side msgs, nil if tx can't be decoded
+func (app *HeimdallApp) getTxSideMsgs(txBytes []byte) []sdk.Msg {
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return nil
+	}
+
+	return app.getSideMsgs(tx.GetMsgs())
+}
+
+// getSideMsgs returns side msgs which have both side-tx and post-tx handlers
+func (app *HeimdallApp) getSideMsgs(msgs []sdk.Msg) []sdk.Msg {
+	sideMsgs := make([]sdk.Msg, 0, len(msgs))
+	for _, msg := range msgs {
+		if _, isSideTxMsg := IsSideMsg(msg); !isSideTxMsg {
+			continue
+		}
+
+		handlers := app.sideRouter.GetRoute(msg.Route())
+		if handlers != nil && handlers.SideTxHandler != nil && handlers.PostTxHandler != nil {
+			sideMsgs = append(sideMsgs, msg)
+		}
+	}
+
+	return sideMsgs
+}
+
+// aggregateSideTxResult returns side-tx result for per-msg results:
+// yes if any msg is approved, no if any msg is rejected and skip otherwise
+func aggregateSideTxResult(results []tmprototypes.SideTxResultType) tmprototypes.SideTxResultType {
+	result := tmprototypes.SideTxResultType_SKIP
+	for _, r := range results {
+		if r == tmprototypes.SideTxResultType_YES {
+			return r
+		}
+
+		if r == tmprototypes.SideTxResultType_NO {
+			result = r
+		}
+	}
+
+	return result
+}
+
+// matchMultiSideTxVote returns number of msgs of multi-msg side-tx validator voted on with side-tx result,
+// false if sig matches none
+func matchMultiSideTxVote(sigObj tmprototypes.SideTxResponse, count int, signBytesHash []byte) (int, bool) {
+	if len(sigObj.Sig) != 65 {
+		return 0, false
+	}
+
+	for voted := count; voted >= 0; voted-- {
+		data := tmtypes.SignTxResultBytes(&tmprototypes.SideTxResultWithData{
+			Result: &tmprototypes.SideTxResult{Result: sigObj.Result},
+			Data:   types.GetMultiSideSignBytes(voted, signBytesHash),
+		})
+
+		pubkey, err := ethcrypto.SigToPub(ethcrypto.Keccak256(data), sigObj.Sig)
+		if err == nil && bytes.Equal(ethcrypto.PubkeyToAddress(*pubkey).Bytes(), sigObj.Address) {
+			return voted, true
+		}
+	}
+
+	return 0, false
+}
+
+// getVoterIndex returns index of validator which signed side-tx vote with address.
+// Signer rotated out within overlap window is attributed to validator with same validator ID.
+func (app *HeimdallApp) getVoterIndex(ctx sdk.Context, address []byte, validators []*abci.Validator) int {
+	if i := getValidatorIndexByAddress(address, validators); i != -1 {
+		return i
+	}
+
+	valID, ok := app.StakingKeeper.GetAcceptedSignerValidatorID(ctx, address)
+	if !ok {
+		return -1
+	}
+
+	for i, v := range validators {
+		if validator, err := app.StakingKeeper.GetValidatorInfo(ctx, v.Address); err == nil && validator.ID == valID {
+			return i
+		}
+	}
+
+	return -1
+}
+
 func getValidatorIndexByAddress(address []byte, validators []*abci.Validator) int {
 	for i, v := range validators {
 		if bytes.Equal(address, v.Address) {
@@ -8,23 +8,29 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store"
 	testdata "github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/maticnetwork/heimdall/app"
+	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/testutil"
 	hmtestdata "github.com/maticnetwork/heimdall/testutil/testdata"
 	hmtypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/x/gov/types"
 	sidechannelkeeper "github.com/maticnetwork/heimdall/x/sidechannel/keeper"
+	sidechanneltypes "github.com/maticnetwork/heimdall/x/sidechannel/types"
 )
 
 var testTxStateData1 = []byte("test-tx-state1")
@@ -83,6 +89,26 @@ func (suite *SideTxProcessorTestSuite) getTx() (tmtypes.Tx, sdk.Tx) {
 	return txBytes, tx
 }
 
+// getMultiMsgTx returns tx with side msg for each dog name
+func (suite *SideTxProcessorTestSuite) getMultiMsgTx(names ...string) (tmtypes.Tx, sdk.Tx) {
+	t, encodingConfig := suite.T(), suite.encodingConfig
+
+	msgs := make([]sdk.Msg, 0, len(names))
+	for _, name := range names {
+		msgs = append(msgs, hmtestdata.NewServiceSideMsgCreateDog(&hmtestdata.SideMsgCreateDog{Dog: &hmtestdata.Dog{Name: name}}))
+	}
+
+	txBuilder := encodingConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBytes, err := encodingConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	tx, err := encodingConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	return txBytes, tx
+}
+
 //
 // Test cases
 //
@@ -274,6 +300,82 @@ func (suite *SideTxProcessorTestSuite) TestDeliverSideTxHandler() {
 	})
 }
 
+func (suite *SideTxProcessorTestSuite) TestDeliverSideTxHandlerMultiMsg() {
+	t, ctx, happ := suite.T(), suite.ctx, suite.happ
+
+	_, tx := suite.getMultiMsgTx("Spot", "Rex", "Bad")
+
+	// dog name decides side-tx result
+	router := hmtypes.NewSideRouter()
+	router.AddRoute(tx.GetMsgs()[0].Route(), &hmtypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			switch getDogName(msg) {
+			case "Spot":
+				return abci.ResponseDeliverSideTx{Result: tmproto.SideTxResultType_YES}
+			case "Rex":
+				return abci.ResponseDeliverSideTx{Result: tmproto.SideTxResultType_NO}
+			default:
+				return abci.ResponseDeliverSideTx{Code: uint32(1)}
+			}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmproto.SideTxResultType) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		},
+	})
+	happ.SetSideRouter(router)
+
+	testCases := []struct {
+		names  []string
+		result tmproto.SideTxResultType
+		voted  int
+	}{
+		{[]string{"Spot", "Spot", "Rex", "Spot"}, tmproto.SideTxResultType_YES, 2},
+		{[]string{"Rex", "Bad", "Rex"}, tmproto.SideTxResultType_NO, 1},
+		{[]string{"Bad", "Spot"}, tmproto.SideTxResultType_SKIP, 0},
+	}
+
+	for _, tc := range testCases {
+		txBytes, tx := suite.getMultiMsgTx(tc.names...)
+		res := happ.DeliverSideTxHandler(ctx, tx, abci.RequestDeliverSideTx{Tx: txBytes})
+		require.Equal(t, abci.CodeTypeOK, res.Code)
+		require.Equal(t, tc.result, res.Result)
+
+		// data holds number of voted msgs and hash of sign bytes of all msgs
+		signBytes := make([][]byte, len(tc.names))
+		for i, msg := range tx.GetMsgs() {
+			sideMsg, _ := app.IsSideMsg(msg)
+			signBytes[i] = sideMsg.GetSideSignBytes()
+		}
+
+		voted, signBytesHash, err := hmtypes.ParseMultiSideSignBytes(res.Data)
+		require.NoError(t, err)
+		require.Equal(t, tc.voted, voted)
+		require.Equal(t, hmtypes.HashMultiSideSignBytes(signBytes), signBytesHash)
+	}
+}
+
+func (suite *SideTxProcessorTestSuite) TestAnteHandlerSideMsgs() {
+	t, ctx := suite.T(), suite.ctx
+
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+
+	names := make([]string, hmtypes.MaxSideTxMsgs)
+	for i := range names {
+		names[i] = "Spot"
+	}
+
+	_, tx := suite.getMultiMsgTx(names...)
+	_, err := app.NewSideMsgsDecorator().AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+
+	// too many side msgs
+	_, tx = suite.getMultiMsgTx(append(names, "Spot")...)
+	_, err = app.NewSideMsgsDecorator().AnteHandle(ctx, tx, false, next)
+	require.True(t, hmCommon.ErrTooManySideMsgs.Is(err))
+}
+
 func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 	t, keeper, ctx, happ := suite.T(), suite.keeper, suite.ctx, suite.happ
 
@@ -329,6 +431,8 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 				router.AddRoute(msg.Route(), handler)
 				happ.SetSideRouter(router)
 
+				// process same height again
+				keeper.SetLastSideBlockHeight(ctx, height-3)
 				keeper.SetTx(ctx, height-2, txBytes) // set tx in the store for process
 				res = happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{
 					SideTxResults: []tmproto.SideTxResponses{
@@ -361,11 +465,29 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 		}
 
 		t.Run("SkipWithoutPowerCalculation", func(t *testing.T) {
+			// participation before processing, validators already voted in earlier blocks
+			participation1, _ := keeper.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr1))
+
 			// skip without any power calculation
+			// process same height again
+			keeper.SetLastSideBlockHeight(ctx, height-3)
 			keeper.SetTx(ctx, height-2, txBytes)
 			res = happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{})
 			require.Equal(t, 0, len(res.Events), "It should have no event with validators")
 			require.Nil(t, keeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin block")
+
+			// tally without votes is recorded
+			tally := keeper.GetSideTxTally(ctx, txHash)
+			require.NotNil(t, tally)
+			require.Equal(t, tmproto.SideTxResultType_SKIP, tally.Result)
+			require.Equal(t, int64(100), tally.TotalPower)
+			require.Empty(t, tally.Votes)
+
+			// expired tx without votes is missed by every validator
+			participation, found := keeper.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr1))
+			require.True(t, found)
+			require.Equal(t, participation1.MissedCount+1, participation.MissedCount)
+			require.Equal(t, participation1.VotedCount, participation.VotedCount)
 		})
 	})
 
@@ -449,6 +571,12 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 			router.AddRoute(msg.Route(), handler)
 			happ.SetSideRouter(router)
 
+			// participation before processing, validators already voted in earlier blocks
+			participation1, _ := keeper.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr1))
+			participation4, _ := keeper.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr4))
+
+			// process same height again
+			keeper.SetLastSideBlockHeight(ctx, height-3)
 			keeper.SetTx(ctx, height-2, txBytes) // set tx in the store for process
 			res := happ.BeginSideBlocker(ctx, req)
 			require.Equal(t, 2, len(res.Events), "It should include correct emitted events")
@@ -456,6 +584,34 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 
 			// check if it saved the data
 			require.Equal(t, 1, len(keeper.GetTxs(ctx, 700)), "It should save state correctly after successful post-tx execution")
+
+			// check vote tally
+			tally := keeper.GetSideTxTally(ctx, txHash)
+			require.NotNil(t, tally)
+			require.Equal(t, height-2, tally.Height)
+			require.Equal(t, height, tally.ExecutedHeight)
+			require.Equal(t, int64(100), tally.TotalPower)
+			require.Equal(t, int64(70), tally.YesPower)
+			require.Equal(t, int64(30), tally.NoPower)
+			require.Equal(t, int64(0), tally.SkipPower)
+			require.Equal(t, tmproto.SideTxResultType_YES, tally.Result)
+			require.Equal(t, []sidechanneltypes.SideTxVote{
+				{Address: addr1, Power: 10, Result: tmproto.SideTxResultType_NO},
+				{Address: addr2, Power: 20, Result: tmproto.SideTxResultType_NO},
+				{Address: addr3, Power: 30, Result: tmproto.SideTxResultType_YES},
+				{Address: addr4, Power: 40, Result: tmproto.SideTxResultType_YES},
+			}, tally.Votes)
+			require.Len(t, keeper.GetSideTxTallies(ctx, height-2), 1)
+
+			// check participation, validators voting `no` disagreed with result
+			participation, found := keeper.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr1))
+			require.True(t, found)
+			require.Equal(t, participation1.VotedCount+1, participation.VotedCount)
+			require.Equal(t, participation1.DisagreedCount+1, participation.DisagreedCount)
+			participation, found = keeper.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr4))
+			require.True(t, found)
+			require.Equal(t, participation4.VotedCount+1, participation.VotedCount)
+			require.Equal(t, participation4.DisagreedCount, participation.DisagreedCount)
 		}
 
 		// shouldn't save state on failed execution of post-tx handler
@@ -482,6 +638,8 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 			router.AddRoute(msg.Route(), handler)
 			happ.SetSideRouter(router)
 
+			// process same height again
+			keeper.SetLastSideBlockHeight(ctx, height-3)
 			keeper.SetTx(ctx, height-2, txBytes) // set tx in the store for process
 			res := happ.BeginSideBlocker(ctx, req)
 			require.Equal(t, 0, len(res.Events), "It should have 0 events")
@@ -514,6 +672,8 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 			router.AddRoute(msg.Route(), handler)
 			happ.SetSideRouter(router)
 
+			// process same height again
+			keeper.SetLastSideBlockHeight(ctx, height-3)
 			keeper.SetTx(ctx, height-2, txBytes) // set tx in the store for process
 			res := happ.BeginSideBlocker(ctx, req)
 			require.Equal(t, 0, len(res.Events), "It should have 0 events")
@@ -525,6 +685,387 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 	})
 }
 
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerParams() {
+	t, keeper, ctx, happ := suite.T(), suite.keeper, suite.ctx, suite.happ
+
+	txBytes, tx := suite.getTx()
+	msg := tx.GetMsgs()[0]
+	txHash := tmtypes.Tx(txBytes).Hash()
+
+	var height uint64 = 20
+	ctx = ctx.WithBlockHeight(int64(height))
+
+	addr1 := []byte("hello-1")
+	addr2 := []byte("hello-2")
+	addr3 := []byte("hello-3")
+	err := keeper.SetValidators(ctx, height, []*abci.Validator{
+		{Address: addr1, Power: 10},
+		{Address: addr2, Power: 20},
+		{Address: addr3, Power: 30},
+	})
+	require.Nil(t, err, "It should throw no error while setting validators")
+
+	var executedResult tmproto.SideTxResultType
+	router := hmtypes.NewSideRouter()
+	router.AddRoute(msg.Route(), &hmtypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmproto.SideTxResultType) (*sdk.Result, error) {
+			executedResult = sideTxResult
+			return &sdk.Result{}, nil
+		},
+	})
+	happ.SetSideRouter(router)
+
+	// yes power 40 out of 60
+	req := abci.RequestBeginSideBlock{
+		SideTxResults: []tmproto.SideTxResponses{
+			{
+				TxHash: txHash,
+				Sigs: []tmproto.SideTxResponse{
+					{Result: tmproto.SideTxResultType_YES, Address: addr1},
+					{Result: tmproto.SideTxResultType_NO, Address: addr2},
+					{Result: tmproto.SideTxResultType_YES, Address: addr3},
+				},
+			},
+		},
+	}
+
+	// not enough power with default 2/3 threshold
+	keeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, req)
+	require.Equal(t, tmproto.SideTxResultType_SKIP, executedResult)
+
+	// approved with 1/2 threshold and side-tx included one block earlier
+	params := keeper.GetParams(ctx)
+	params.ApprovalThreshold = sdk.NewDecWithPrec(5, 1)
+	params.SideBlockDelay = 1
+	keeper.SetParams(ctx, params)
+
+	keeper.SetTx(ctx, height-1, txBytes)
+	happ.BeginSideBlocker(ctx, req)
+	require.Equal(t, tmproto.SideTxResultType_YES, executedResult)
+	require.Nil(t, keeper.GetTx(ctx, height-1, txHash), "Tx should not be present in store after begin block")
+
+	// no-op below side block delay
+	params.SideBlockDelay = 30
+	keeper.SetParams(ctx, params)
+
+	keeper.SetTx(ctx, 0, txBytes)
+	res := happ.BeginSideBlocker(ctx, req)
+	require.Equal(t, 0, len(res.Events))
+	require.NotNil(t, keeper.GetTx(ctx, 0, txHash), "Tx should be left in store")
+}
+
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerDelayChange() {
+	t, keeper, ctx, happ := suite.T(), suite.keeper, suite.ctx, suite.happ
+
+	txBytes1, tx := suite.getTx()
+	txBytes2, _ := suite.getMultiMsgTx("Spot", "Rex")
+	txHash1 := tmtypes.Tx(txBytes1).Hash()
+	txHash2 := tmtypes.Tx(txBytes2).Hash()
+
+	addr1 := []byte("hello-1")
+	validators := []*abci.Validator{{Address: addr1, Power: 10}}
+
+	var executed []tmproto.SideTxResultType
+	router := hmtypes.NewSideRouter()
+	router.AddRoute(tx.GetMsgs()[0].Route(), &hmtypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmproto.SideTxResultType) (*sdk.Result, error) {
+			executed = append(executed, sideTxResult)
+			return &sdk.Result{}, nil
+		},
+	})
+	happ.SetSideRouter(router)
+
+	beginSideBlock := func(height uint64, req abci.RequestBeginSideBlock) {
+		ctx = ctx.WithBlockHeight(int64(height))
+		require.NoError(t, keeper.SetValidators(ctx, height, validators))
+		happ.BeginSideBlocker(ctx, req)
+	}
+
+	// side-txs included at height 8 are processed at height 10
+	beginSideBlock(10, abci.RequestBeginSideBlock{})
+	lastHeight, ok := keeper.GetLastSideBlockHeight(ctx)
+	require.True(t, ok)
+	require.Equal(t, uint64(8), lastHeight)
+
+	keeper.SetTx(ctx, 9, txBytes1)
+	keeper.SetTx(ctx, 10, txBytes2)
+
+	// lowered delay, side-txs included at height 9 are not left behind
+	params := keeper.GetParams(ctx)
+	params.SideBlockDelay = 1
+	keeper.SetParams(ctx, params)
+
+	beginSideBlock(11, abci.RequestBeginSideBlock{
+		SideTxResults: []tmproto.SideTxResponses{
+			{TxHash: txHash2, Sigs: []tmproto.SideTxResponse{{Result: tmproto.SideTxResultType_YES, Address: addr1}}},
+		},
+	})
+	require.Nil(t, keeper.GetTx(ctx, 9, txHash1), "Tx should not be present in store after begin block")
+	require.Nil(t, keeper.GetTx(ctx, 10, txHash2), "Tx should not be present in store after begin block")
+	require.Equal(t, tmproto.SideTxResultType_SKIP, keeper.GetSideTxTally(ctx, txHash1).Result)
+	require.Equal(t, uint64(9), keeper.GetSideTxTally(ctx, txHash1).Height)
+	require.Equal(t, uint64(10), keeper.GetSideTxTally(ctx, txHash2).Height)
+	require.Len(t, executed, 3)
+
+	// raised delay, processed heights are not processed again
+	params.SideBlockDelay = 3
+	keeper.SetParams(ctx, params)
+
+	keeper.SetTx(ctx, 11, txBytes1)
+	beginSideBlock(12, abci.RequestBeginSideBlock{})
+	beginSideBlock(13, abci.RequestBeginSideBlock{})
+	require.NotNil(t, keeper.GetTx(ctx, 11, txHash1), "Tx should be left in store")
+	require.Len(t, executed, 3)
+
+	beginSideBlock(14, abci.RequestBeginSideBlock{})
+	require.Nil(t, keeper.GetTx(ctx, 11, txHash1), "Tx should not be present in store after begin block")
+	require.Len(t, executed, 4)
+}
+
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerMultiMsg() {
+	t, keeper, ctx, happ := suite.T(), suite.keeper, suite.ctx, suite.happ
+
+	var height uint64 = 20
+	ctx = ctx.WithBlockHeight(int64(height))
+
+	txBytes, tx := suite.getMultiMsgTx("Spot", "Rex", "Bad")
+	txHash := txBytes.Hash()
+
+	// validators with signing keys
+	privKeys := make([]secp256k1.PrivKey, 4)
+	validators := make([]*abci.Validator, 4)
+	for i := range privKeys {
+		privKeys[i] = secp256k1.GenPrivKey()
+		validators[i] = &abci.Validator{Address: privKeys[i].PubKey().Address(), Power: int64(10 * (i + 1))}
+	}
+	require.NoError(t, keeper.SetValidators(ctx, height, validators))
+
+	// side sign bytes of msgs
+	signBytes := make([][]byte, len(tx.GetMsgs()))
+	for i, msg := range tx.GetMsgs() {
+		sideMsg, _ := app.IsSideMsg(msg)
+		signBytes[i] = sideMsg.GetSideSignBytes()
+	}
+
+	// sign vote on first voted msgs of side-tx with validator key
+	yes, no, skip := tmproto.SideTxResultType_YES, tmproto.SideTxResultType_NO, tmproto.SideTxResultType_SKIP
+	sign := func(signer int, validator int, result tmproto.SideTxResultType, voted int) tmproto.SideTxResponse {
+		sideTxResult := tmproto.SideTxResultWithData{
+			Result: &tmproto.SideTxResult{TxHash: txHash, Result: result},
+			Data:   hmtypes.GetMultiSideSignBytes(voted, hmtypes.HashMultiSideSignBytes(signBytes)),
+		}
+		sig, err := privKeys[signer].Sign(tmtypes.SignTxResultBytes(&sideTxResult))
+		require.NoError(t, err)
+
+		return tmproto.SideTxResponse{Result: result, Sig: sig, Address: validators[validator].Address}
+	}
+
+	req := abci.RequestBeginSideBlock{
+		SideTxResults: []tmproto.SideTxResponses{
+			{
+				TxHash: txHash,
+				Sigs: []tmproto.SideTxResponse{
+					sign(0, 0, yes, 3),
+					sign(1, 1, no, 2),
+					sign(0, 2, yes, 3), // signed with key of another validator
+					sign(2, 2, yes, 1),
+					sign(3, 3, yes, 2),
+					sign(3, 3, yes, 3), // second vote of validator
+				},
+			},
+		},
+	}
+
+	// post-tx handler fails for rejected msg
+	executed := make(map[string]tmproto.SideTxResultType)
+	router := hmtypes.NewSideRouter()
+	router.AddRoute(tx.GetMsgs()[0].Route(), &hmtypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmproto.SideTxResultType) (*sdk.Result, error) {
+			name := getDogName(msg)
+			executed[name] = sideTxResult
+			keeper.SetTx(ctx, 700, []byte(name))
+
+			if sideTxResult != tmproto.SideTxResultType_YES {
+				return nil, errors.New("Side-tx not approved")
+			}
+
+			return &sdk.Result{}, nil
+		},
+	})
+	happ.SetSideRouter(router)
+
+	keeper.SetTx(ctx, height-2, txBytes)
+	happ.BeginSideBlocker(ctx, req)
+	require.Nil(t, keeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin block")
+
+	// each msg is executed with its own result
+	require.Equal(t, map[string]tmproto.SideTxResultType{"Spot": yes, "Rex": skip, "Bad": skip}, executed)
+
+	// failed msgs don't revert approved msg
+	require.Equal(t, tmtypes.Txs{tmtypes.Tx("Spot")}, keeper.GetTxs(ctx, 700))
+
+	// vote with invalid sig and second vote of validator are ignored
+	tally := keeper.GetSideTxTally(ctx, txHash)
+	require.NotNil(t, tally)
+	require.Equal(t, yes, tally.Result)
+	require.Equal(t, []tmproto.SideTxResultType{yes, skip, skip}, tally.MsgResults)
+	require.Equal(t, int64(80), tally.YesPower)
+	require.Equal(t, int64(20), tally.NoPower)
+	require.Len(t, tally.Votes, 4)
+	require.Equal(t, validators[1].Address, tally.Votes[1].Address)
+	require.Equal(t, []tmproto.SideTxResultType{no, no, skip}, tally.Votes[1].MsgResults)
+	require.Equal(t, []tmproto.SideTxResultType{yes, skip, skip}, tally.Votes[2].MsgResults)
+	require.Equal(t, validators[3].Address, tally.Votes[3].Address)
+	require.Equal(t, []tmproto.SideTxResultType{yes, yes, skip}, tally.Votes[3].MsgResults)
+}
+
+func (suite *SideTxProcessorTestSuite) TestBeginSideBlockerRotatedSigner() {
+	t := suite.T()
+
+	// app with staking keeper resolving rotated signers
+	initApp := app.Setup(false)
+	ctx := initApp.BaseApp.NewContext(false, tmproto.Header{})
+	happ := &app.HeimdallApp{
+		SidechannelKeeper: initApp.SidechannelKeeper,
+		StakingKeeper:     initApp.StakingKeeper,
+		BaseApp:           baseapp.NewBaseApp("test", testutil.Logger(t), nil, suite.encodingConfig.TxDecoder()),
+	}
+	happ.SetTxDecoder(suite.encodingConfig.TxDecoder())
+	keeper, stakingKeeper := happ.SidechannelKeeper, happ.StakingKeeper
+
+	router := hmtypes.NewSideRouter()
+	router.AddRoute(hmtestdata.NewServiceSideMsgCreateDog(&hmtestdata.SideMsgCreateDog{}).Route(), &hmtypes.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+			return abci.ResponseDeliverSideTx{}
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmproto.SideTxResultType) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		},
+	})
+	happ.SetSideRouter(router)
+
+	params := stakingKeeper.GetParams(ctx)
+	params.SignerOverlapBlocks = 5
+	stakingKeeper.SetParams(ctx, params)
+
+	// old and new signer key of rotating validator and key of another validator
+	privKeys := make([]secp256k1.PrivKey, 3)
+	addresses := make([][]byte, 3)
+	for i := range privKeys {
+		privKeys[i] = secp256k1.GenPrivKey()
+		addresses[i] = privKeys[i].PubKey().Address().Bytes()
+	}
+
+	validators := make([]*hmtypes.Validator, 2)
+	for i, key := range []int{0, 2} {
+		validators[i] = hmtypes.NewValidator(
+			hmtypes.NewValidatorID(uint64(i+1)), 0, 0, 1, 10,
+			hmCommonTypes.NewPubKey(privKeys[key].PubKey().Bytes()),
+			sdk.AccAddress(addresses[key]),
+		)
+		require.NoError(t, stakingKeeper.AddValidator(ctx, *validators[i]))
+	}
+	require.NoError(t, stakingKeeper.UpdateValidatorSetInStore(ctx, hmtypes.NewValidatorSet(validators)))
+
+	// rotate signer of first validator, signer swap is applied at height 10
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, stakingKeeper.RotateSignerKey(ctx, *validators[0]))
+	require.NoError(t, stakingKeeper.UpdateSigner(ctx, sdk.AccAddress(addresses[1]), hmCommonTypes.NewPubKey(privKeys[1].PubKey().Bytes()), sdk.AccAddress(addresses[0])))
+	for _, address := range addresses[:2] {
+		validator, err := stakingKeeper.GetValidatorInfo(ctx, address)
+		require.NoError(t, err)
+		require.NoError(t, stakingKeeper.StageValidatorUpdate(ctx, validator, stakingKeeper.ModuleCommunicator.GetACKCount(ctx)+1))
+	}
+	_, err := stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	// tendermint validators after signer swap
+	abciValidators := []*abci.Validator{
+		{Address: addresses[1], Power: 10},
+		{Address: addresses[2], Power: 10},
+	}
+
+	yes := tmproto.SideTxResultType_YES
+	process := func(height uint64, txBytes tmtypes.Tx, tx sdk.Tx, signers ...int) *sidechanneltypes.SideTxTally {
+		ctx := ctx.WithBlockHeight(int64(height))
+		txHash := txBytes.Hash()
+
+		signBytes := make([][]byte, len(tx.GetMsgs()))
+		for i, msg := range tx.GetMsgs() {
+			sideMsg, _ := app.IsSideMsg(msg)
+			signBytes[i] = sideMsg.GetSideSignBytes()
+		}
+
+		// votes on all msgs of side-tx
+		sigs := make([]tmproto.SideTxResponse, 0, len(signers))
+		for _, signer := range signers {
+			data := signBytes[0]
+			if len(signBytes) > 1 {
+				data = hmtypes.GetMultiSideSignBytes(len(signBytes), hmtypes.HashMultiSideSignBytes(signBytes))
+			}
+
+			sig, err := privKeys[signer].Sign(tmtypes.SignTxResultBytes(&tmproto.SideTxResultWithData{
+				Result: &tmproto.SideTxResult{TxHash: txHash, Result: yes},
+				Data:   data,
+			}))
+			require.NoError(t, err)
+
+			sigs = append(sigs, tmproto.SideTxResponse{Result: yes, Sig: sig, Address: addresses[signer]})
+		}
+
+		require.NoError(t, keeper.SetValidators(ctx, height, abciValidators))
+		// process same height again
+		keeper.SetLastSideBlockHeight(ctx, height-3)
+		keeper.SetTx(ctx, height-2, txBytes)
+		happ.BeginSideBlocker(ctx, abci.RequestBeginSideBlock{
+			SideTxResults: []tmproto.SideTxResponses{{TxHash: txHash, Sigs: sigs}},
+		})
+		require.Nil(t, keeper.GetTx(ctx, height-2, txHash), "Tx should not be present in store after begin block")
+
+		return keeper.GetSideTxTally(ctx, txHash)
+	}
+
+	for name, getTx := range map[string]func() (tmtypes.Tx, sdk.Tx){
+		"single msg": func() (tmtypes.Tx, sdk.Tx) { return suite.getTx() },
+		"multi msg":  func() (tmtypes.Tx, sdk.Tx) { return suite.getMultiMsgTx("Spot", "Rex") },
+	} {
+		suite.Run(name, func() {
+			txBytes, tx := getTx()
+
+			// old signer vote is attributed to validator within overlap window
+			tally := process(12, txBytes, tx, 0)
+			require.NotNil(t, tally)
+			require.Equal(t, int64(10), tally.YesPower)
+			require.Len(t, tally.Votes, 1)
+			require.Equal(t, addresses[1], tally.Votes[0].Address)
+
+			// old and new signer votes are counted once
+			tally = process(13, txBytes, tx, 0, 1)
+			require.NotNil(t, tally)
+			require.Equal(t, int64(10), tally.YesPower)
+			require.Len(t, tally.Votes, 1)
+			require.Equal(t, addresses[1], tally.Votes[0].Address)
+
+			// old signer is rejected once overlap window ends
+			tally = process(17, txBytes, tx, 0, 2)
+			require.NotNil(t, tally)
+			require.Equal(t, int64(10), tally.YesPower)
+			require.Len(t, tally.Votes, 1)
+			require.Equal(t, addresses[2], tally.Votes[0].Address)
+		})
+	}
+}
+
 //
 // Internal setup keeper
 //
@@ -532,11 +1073,48 @@ func (suite *SideTxProcessorTestSuite) TestBeginSideBlocker() {
 func setupKeeper(t *testing.T) (sdk.Context, sidechannelkeeper.Keeper) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, sidechannelkeeper.NewKeeper(types.ModuleCdc, key)
+	subspace := paramtypes.NewSubspace(types.ModuleCdc, codec.NewLegacyAmino(), paramsKey, paramsTKey, sidechanneltypes.ModuleName)
+	k := sidechannelkeeper.NewKeeper(types.ModuleCdc, key, subspace, testutil.FakeStakingKeeper{})
+	k.SetParams(ctx, sidechanneltypes.DefaultParams())
+	return ctx, k
+}
+
+func getDogName(msg sdk.Msg) string {
+	return msg.(sdk.ServiceMsg).Request.(*hmtestdata.SideMsgCreateDog).Dog.Name
+}
+
+func TestEndBlockerPrunesSideTxTallies(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := happ.SidechannelKeeper
+
+	params := keeper.GetParams(ctx)
+	params.VoteRetentionBlocks = 5
+	keeper.SetParams(ctx, params)
+
+	for height := uint64(1); height <= 3; height++ {
+		err := keeper.SetSideTxTally(ctx, &sidechanneltypes.SideTxTally{Height: height, ExecutedHeight: height + 2, TxHash: []byte{byte(height)}})
+		require.NoError(t, err)
+	}
+
+	// tallies within retention window are kept
+	happ.EndBlocker(ctx.WithBlockHeight(7), abci.RequestEndBlock{Height: 7})
+	require.Nil(t, keeper.GetSideTxTally(ctx, []byte{1}))
+	require.NotNil(t, keeper.GetSideTxTally(ctx, []byte{2}))
+	require.NotNil(t, keeper.GetSideTxTally(ctx, []byte{3}))
+
+	// tallies past retention window are pruned
+	happ.EndBlocker(ctx.WithBlockHeight(9), abci.RequestEndBlock{Height: 9})
+	require.Nil(t, keeper.GetSideTxTally(ctx, []byte{2}))
+	require.Nil(t, keeper.GetSideTxTally(ctx, []byte{3}))
 }
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	rootchainCursor = "rootchain"
+	heimdallCursor  = "heimdall"
+)
+
+// cursorKeys maps listener name to its cursor key in bridge db
+var cursorKeys = map[string]string{
+	rootchainCursor: util.RootchainLastBlockKey,
+	heimdallCursor:  util.HeimdallLastBlockKey,
+}
+
+// cursorCmd groups commands to manage listener cursors
+var cursorCmd = &cobra.Command{
+	Use:   "cursor",
+	Short: "Inspect or move listener cursors (bridge must be stopped)",
+	Long: `Inspect or move last processed block of rootchain and heimdall listeners.
+Listeners resume from cursor + 1, so moving cursor back re-processes events.
+Bridge must be stopped as it holds lock on bridge db.`,
+}
+
+var cursorShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show listener cursors",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		db := util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))
+		defer util.CloseBridgeDBInstance()
+
+		for _, name := range []string{rootchainCursor, heimdallCursor} {
+			block, found, err := util.GetLastBlock(db, cursorKeys[name])
+			if err != nil {
+				return err
+			}
+
+			if found {
+				fmt.Printf("%s: %d\n", name, block)
+			} else {
+				fmt.Printf("%s: not set\n", name)
+			}
+		}
+
+		return nil
+	},
+}
+
+var cursorSetCmd = &cobra.Command{
+	Use:   "set [rootchain|heimdall] [block]",
+	Short: "Set last processed block of listener",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		block, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		db := util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))
+		defer util.CloseBridgeDBInstance()
+
+		if err := setCursor(db, args[0], block); err != nil {
+			return err
+		}
+
+		fmt.Printf("%s: %d\n", args[0], block)
+
+		return nil
+	},
+}
+
+var cursorRewindCmd = &cobra.Command{
+	Use:   "rewind [rootchain|heimdall] [blocks]",
+	Short: "Move listener cursor back by number of blocks",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		blocks, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		db := util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))
+		defer util.CloseBridgeDBInstance()
+
+		block, err := rewindCursor(db, args[0], blocks)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s: %d\n", args[0], block)
+
+		return nil
+	},
+}
+
+var cursorResetCmd = &cobra.Command{
+	Use:   "reset [rootchain|heimdall]",
+	Short: "Delete listener cursor",
+	Long: `Delete listener cursor. Rootchain listener restarts from latest confirmed block,
+heimdall listener restarts from first block.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		key, err := getCursorKey(args[0])
+		if err != nil {
+			return err
+		}
+
+		db := util.GetBridgeDBInstance(viper.GetString(bridgeDBFlag))
+		defer util.CloseBridgeDBInstance()
+
+		if err := util.DeleteLastBlock(db, key); err != nil {
+			return err
+		}
+
+		fmt.Printf("%s: not set\n", args[0])
+
+		return nil
+	},
+}
+
+func getCursorKey(name string) (string, error) {
+	key, ok := cursorKeys[name]
+	if !ok {
+		return "", fmt.Errorf("unknown cursor %s, expected %s or %s", name, rootchainCursor, heimdallCursor)
+	}
+
+	return key, nil
+}
+
+// setCursor sets last processed block of listener
+func setCursor(db *leveldb.DB, name string, block uint64) error {
+	key, err := getCursorKey(name)
+	if err != nil {
+		return err
+	}
+
+	return util.SetLastBlock(db, key, block)
+}
+
+// rewindCursor moves last processed block of listener back by given blocks
+func rewindCursor(db *leveldb.DB, name string, blocks uint64) (uint64, error) {
+	key, err := getCursorKey(name)
+	if err != nil {
+		return 0, err
+	}
+
+	block, found, err := util.GetLastBlock(db, key)
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, errors.New("cursor is not set")
+	}
+
+	if blocks > block {
+		blocks = block
+	}
+	block -= blocks
+
+	return block, util.SetLastBlock(db, key, block)
+}
+
+func init() {
+	cursorCmd.AddCommand(
+		cursorShowCmd,
+		cursorSetCmd,
+		cursorRewindCmd,
+		cursorResetCmd,
+	)
+	rootCmd.AddCommand(cursorCmd)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+func TestCursor(t *testing.T) {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	// rewind requires cursor
+	_, err = rewindCursor(db, rootchainCursor, 10)
+	require.Error(t, err)
+
+	// unknown cursor
+	require.Error(t, setCursor(db, "bor", 10))
+
+	require.NoError(t, setCursor(db, rootchainCursor, 100))
+	require.NoError(t, setCursor(db, heimdallCursor, 5))
+
+	block, err := rewindCursor(db, rootchainCursor, 40)
+	require.NoError(t, err)
+	require.Equal(t, uint64(60), block)
+
+	// rewind does not go below zero
+	block, err = rewindCursor(db, heimdallCursor, 40)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), block)
+
+	block, found, err := util.GetLastBlock(db, util.RootchainLastBlockKey)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(60), block)
+
+	require.NoError(t, util.DeleteLastBlock(db, util.RootchainLastBlockKey))
+	_, found, err = util.GetLastBlock(db, util.RootchainLastBlockKey)
+	require.NoError(t, err)
+	require.False(t, found)
+}
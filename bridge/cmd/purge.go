@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/streadway/amqp"
 )
 
@@ -19,6 +21,17 @@ var purgeCmd = &cobra.Command{
 
 func purgeQueue() {
 	var logger = helper.Logger.With("module", "bridge/cmd/")
+
+	if helper.GetConfig().QueueBackend == queue.LevelDBBackendType {
+		defer util.CloseBridgeDBInstance()
+
+		if err := queue.PurgeLevelDBTasks(util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag))); err != nil {
+			logger.Error("purgeQueue | PurgeLevelDBTasks", "Error", err)
+		}
+
+		return
+	}
+
 	dialer := helper.GetConfig().AmqpURL
 
 	// amqp dialer
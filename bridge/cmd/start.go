@@ -22,6 +22,7 @@ import (
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/bridge/setu/listener"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/spf13/cobra"
@@ -33,6 +34,7 @@ import (
 const (
 	waitDuration = 1 * time.Minute
 	logLevel     = "log_level"
+	statusAddr   = "status-addr"
 )
 
 // GetStartCmd returns the start command to start bridge
@@ -47,7 +49,7 @@ func GetStartCmd() *cobra.Command {
 			// encoding
 			encoding := app.MakeEncodingConfig()
 			// queue connector & http client
-			_queueConnector := queue.NewQueueConnector(helper.GetConfig().AmqpURL)
+			_queueConnector := queue.NewQueueConnector(helper.GetConfig().QueueBackend, helper.GetConfig().AmqpURL)
 			_queueConnector.StartWorker()
 
 			_httpClient, _ := httpClient.New(helper.GetConfig().TendermintRPCUrl, "/websocket")
@@ -80,6 +82,13 @@ func GetStartCmd() *cobra.Command {
 				processor.NewProcessorService(cliCtx, _queueConnector, _httpClient, _txBroadcaster, _paramsContext),
 			)
 
+			// status server, disabled when address is empty
+			var _statusServer *status.Server
+			if addr := viper.GetString(statusAddr); addr != "" {
+				_statusServer = status.NewServer(addr, status.GetRecorder())
+				_statusServer.Start()
+			}
+
 			// sync group
 			var wg sync.WaitGroup
 
@@ -97,6 +106,17 @@ func GetStartCmd() *cobra.Command {
 						}
 					}
 
+					// stop tx broadcaster
+					_txBroadcaster.Stop()
+
+					// stop queue worker
+					_queueConnector.Stop()
+
+					// stop status server
+					if _statusServer != nil {
+						_statusServer.Stop()
+					}
+
 					// stop http client
 					if err := _httpClient.Stop(); err != nil {
 						logger.Error("GetStartCmd | _httpClient.Stop", "Error", err)
@@ -159,6 +179,11 @@ func GetStartCmd() *cobra.Command {
 		logger.Error("GetStartCmd | BindPFlag | only", "Error", err)
 	}
 
+	startCmd.Flags().String(statusAddr, "", "address to serve bridge status (/status) and prometheus metrics (/metrics), disabled if empty")
+	if err := viper.BindPFlag(statusAddr, startCmd.Flags().Lookup(statusAddr)); err != nil {
+		logger.Error("GetStartCmd | BindPFlag | "+statusAddr, "Error", err)
+	}
+
 	startCmd.Flags().String(cli.HomeFlag, app.DefaultNodeHome, "node's home directory")
 	if err := viper.BindPFlag(cli.HomeFlag, startCmd.Flags().Lookup(cli.HomeFlag)); err != nil {
 		logger.Error("GetStartCmd | BindPFlag | "+cli.HomeFlag, "Error", err)
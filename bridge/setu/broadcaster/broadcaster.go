@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
@@ -29,8 +30,16 @@ type TxBroadcaster struct {
 
 	cliCtx client.Context
 
-	heimdallMutex sync.Mutex
-	maticMutex    sync.Mutex
+	heimdallMutex  sync.Mutex
+	maticMutex     sync.Mutex
+	rootchainMutex sync.Mutex
+
+	rootchainTxManager   *rootchainTxManager
+	rootchainWatcherOnce sync.Once
+
+	// ctx is cancelled when broadcaster is stopped
+	ctx    context.Context
+	cancel context.CancelFunc
 
 	lastSeqNo uint64
 	accNum    uint64
@@ -46,20 +55,36 @@ func NewTxBroadcaster(cliCtx client.Context, cdc codec.Marshaler, flagSet *pflag
 		panic("Error connecting to rest-server, please start server before bridge.")
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+
 	txBroadcaster := TxBroadcaster{
 		logger:    util.Logger().With("module", "txBroadcaster"),
+		ctx:       ctx,
+		cancel:    cancel,
 		cliCtx:    cliCtx,
 		lastSeqNo: account.GetSequence(),
 		accNum:    account.GetAccountNumber(),
 		flagSet:   flagSet,
 	}
 
+	status.SetHeimdallSequence(txBroadcaster.lastSeqNo)
+
 	return &txBroadcaster
 }
 
+// Stop stops background routines of broadcaster
+func (tb *TxBroadcaster) Stop() {
+	tb.cancel()
+}
+
 //
 // BroadcastToHeimdall broadcast to heimdall
 func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
+	return tb.BroadcastMsgsToHeimdall([]sdk.Msg{msg})
+}
+
+// BroadcastMsgsToHeimdall broadcasts msgs to heimdall in single tx
+func (tb *TxBroadcaster) BroadcastMsgsToHeimdall(msgs []sdk.Msg) error {
 	tb.heimdallMutex.Lock()
 	defer tb.heimdallMutex.Unlock()
 	//chain id
@@ -73,7 +98,7 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 		WithTxConfig(tb.cliCtx.TxConfig).
 		WithAccountRetriever(tb.cliCtx.AccountRetriever)
 
-	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txf, []sdk.Msg{msg})
+	txResponse, err := helper.BuildAndBroadcastMsgs(tb.cliCtx, txf, msgs)
 	if err != nil {
 		tb.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
 		// current address
@@ -88,6 +113,7 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 
 		// update seqNo for safety
 		tb.lastSeqNo = account.GetSequence()
+		status.SetHeimdallSequence(tb.lastSeqNo)
 
 		return err
 	}
@@ -96,6 +122,8 @@ func (tb *TxBroadcaster) BroadcastToHeimdall(msg sdk.Msg) error {
 	tb.logger.Debug("Tx successful on heimdall", "txResponse", txResponse)
 	// increment account sequence
 	tb.lastSeqNo += 1
+	status.SetHeimdallSequence(tb.lastSeqNo)
+
 	return nil
 }
 
@@ -136,6 +164,3 @@ func (tb *TxBroadcaster) BroadcastToMatic(msg bor.CallMsg) error {
 
 	return nil
 }
-
-// BroadcastToRootchain broadcast to rootchain
-func (tb *TxBroadcaster) BroadcastToRootchain() {}
@@ -0,0 +1,499 @@
+package broadcaster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+	leveldbUtil "github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	bor "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	// RootchainTxPrefix is key prefix of rootchain txs stored in bridge db
+	RootchainTxPrefix = "rootchain-tx-"
+
+	// rootchain tx status
+	RootchainTxPending = "pending"
+	RootchainTxSuccess = "success"
+	RootchainTxFailed  = "failed"
+	RootchainTxDropped = "dropped"
+
+	defaultRootchainPollInterval = 15 * time.Second
+	defaultRootchainStuckTimeout = 3 * time.Minute
+	defaultGasBumpPercent        = 20
+	defaultMaxGasBumps           = 5
+)
+
+// RootchainTx represents transaction sent to rootchain by bridge
+type RootchainTx struct {
+	Nonce       uint64         `json:"nonce"`
+	Hash        common.Hash    `json:"hash"`
+	Hashes      []common.Hash  `json:"hashes"` // all broadcasted hashes, including replaced ones
+	From        common.Address `json:"from"`
+	To          common.Address `json:"to"`
+	Data        hexutil.Bytes  `json:"data"`
+	Value       *big.Int       `json:"value"`
+	GasLimit    uint64         `json:"gasLimit"`
+	GasPrice    *big.Int       `json:"gasPrice"`
+	Bumps       int            `json:"bumps"`
+	Status      string         `json:"status"`
+	SentAt      time.Time      `json:"sentAt"`
+	BlockNumber uint64         `json:"blockNumber"`
+}
+
+// rootchainClient is subset of eth client used to broadcast and watch rootchain txs
+type rootchainClient interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	EstimateGas(ctx context.Context, msg bor.CallMsg) (uint64, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// rootchainTxManager tracks local nonce and pending rootchain txs.
+// All methods must be called with TxBroadcaster.rootchainMutex held.
+type rootchainTxManager struct {
+	logger        log.Logger
+	client        rootchainClient
+	storageClient *leveldb.DB
+
+	from   common.Address
+	signTx func(tx *types.Transaction) (*types.Transaction, error)
+
+	nonce       uint64
+	nonceSynced bool
+	pending     map[uint64]*RootchainTx
+
+	stuckTimeout    time.Duration
+	gasBumpPercent  int64
+	maxGasBumps     int
+	maxGasPrice     *big.Int
+	defaultGasLimit uint64
+}
+
+// BroadcastToRootchain signs and broadcasts tx to rootchain and keeps watching it until inclusion.
+// Stuck txs are replaced with same nonce and bumped gas price.
+func (tb *TxBroadcaster) BroadcastToRootchain(msg bor.CallMsg) (common.Hash, error) {
+	tb.rootchainMutex.Lock()
+	defer tb.rootchainMutex.Unlock()
+
+	manager, err := tb.getRootchainTxManager()
+	if err != nil {
+		tb.logger.Error("Error initializing rootchain tx manager", "error", err)
+		return common.Hash{}, err
+	}
+
+	hash, err := manager.send(msg)
+	status.SetRootchainNonce(manager.nonce)
+
+	return hash, err
+}
+
+// GetRootchainTxs returns recorded rootchain txs
+func (tb *TxBroadcaster) GetRootchainTxs() ([]RootchainTx, error) {
+	tb.rootchainMutex.Lock()
+	defer tb.rootchainMutex.Unlock()
+
+	manager, err := tb.getRootchainTxManager()
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.records()
+}
+
+// getRootchainTxManager lazily creates rootchain tx manager and starts watcher
+func (tb *TxBroadcaster) getRootchainTxManager() (*rootchainTxManager, error) {
+	if tb.rootchainTxManager != nil {
+		return tb.rootchainTxManager, nil
+	}
+
+	ecdsaPrivateKey, err := crypto.ToECDSA(helper.GetPrivKey()[:])
+	if err != nil {
+		return nil, err
+	}
+
+	mainClient := helper.GetMainClient()
+
+	chainID, err := mainClient.ChainID(tb.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// replay protected signer for rootchain
+	signer := types.NewEIP155Signer(chainID)
+
+	from := crypto.PubkeyToAddress(ecdsaPrivateKey.PublicKey)
+	signTx := func(tx *types.Transaction) (*types.Transaction, error) {
+		return types.SignTx(tx, signer, ecdsaPrivateKey)
+	}
+
+	manager := newRootchainTxManager(
+		tb.logger,
+		mainClient,
+		util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)),
+		from,
+		signTx,
+	)
+
+	if err := manager.loadPending(); err != nil {
+		return nil, err
+	}
+
+	tb.rootchainTxManager = manager
+	tb.startRootchainWatcher(defaultRootchainPollInterval)
+
+	return manager, nil
+}
+
+// startRootchainWatcher periodically checks pending rootchain txs until broadcaster is stopped
+func (tb *TxBroadcaster) startRootchainWatcher(interval time.Duration) {
+	tb.rootchainWatcherOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-tb.ctx.Done():
+					tb.logger.Info("Stopping rootchain tx watcher")
+					return
+				case <-ticker.C:
+					tb.checkRootchainTxs()
+				}
+			}
+		}()
+	})
+}
+
+// checkRootchainTxs checks inclusion of pending rootchain txs and replaces stuck ones
+func (tb *TxBroadcaster) checkRootchainTxs() {
+	tb.rootchainMutex.Lock()
+	defer tb.rootchainMutex.Unlock()
+
+	if tb.rootchainTxManager == nil {
+		return
+	}
+
+	tb.rootchainTxManager.checkPending()
+}
+
+func newRootchainTxManager(
+	logger log.Logger,
+	client rootchainClient,
+	storageClient *leveldb.DB,
+	from common.Address,
+	signTx func(tx *types.Transaction) (*types.Transaction, error),
+) *rootchainTxManager {
+	var maxGasPrice *big.Int
+	if helper.GetConfig().MainchainMaxGasPrice > 0 {
+		maxGasPrice = new(big.Int).SetUint64(helper.GetConfig().MainchainMaxGasPrice)
+	}
+
+	return &rootchainTxManager{
+		logger:          logger.With("chain", "rootchain"),
+		client:          client,
+		storageClient:   storageClient,
+		from:            from,
+		signTx:          signTx,
+		pending:         make(map[uint64]*RootchainTx),
+		stuckTimeout:    defaultRootchainStuckTimeout,
+		gasBumpPercent:  defaultGasBumpPercent,
+		maxGasBumps:     defaultMaxGasBumps,
+		maxGasPrice:     maxGasPrice,
+		defaultGasLimit: helper.GetConfig().MainchainGasLimit,
+	}
+}
+
+// send creates, signs and broadcasts tx with next local nonce
+func (m *rootchainTxManager) send(msg bor.CallMsg) (common.Hash, error) {
+	if msg.To == nil {
+		return common.Hash{}, errors.New("rootchain tx without recipient")
+	}
+
+	ctx := context.Background()
+
+	if err := m.syncNonce(ctx); err != nil {
+		m.logger.Error("Error fetching nonce", "error", err)
+		return common.Hash{}, err
+	}
+
+	gasPrice, err := m.client.SuggestGasPrice(ctx)
+	if err != nil {
+		m.logger.Error("Error fetching gas price", "error", err)
+		return common.Hash{}, err
+	}
+	gasPrice = m.capGasPrice(gasPrice)
+
+	gasLimit := msg.Gas
+	if gasLimit == 0 {
+		msg.From = m.from
+		if gasLimit, err = m.client.EstimateGas(ctx, msg); err != nil {
+			m.logger.Info("Error estimating gas, setting custom gaslimit", "gaslimit", m.defaultGasLimit, "error", err)
+			gasLimit = m.defaultGasLimit
+		}
+	}
+
+	value := msg.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	record := &RootchainTx{
+		Nonce:    m.nonce,
+		From:     m.from,
+		To:       *msg.To,
+		Data:     msg.Data,
+		Value:    value,
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+		Status:   RootchainTxPending,
+	}
+
+	hash, err := m.broadcast(ctx, record)
+	if err != nil {
+		// resync nonce from chain on next submission
+		m.nonceSynced = false
+		m.logger.Error("Error while broadcasting the transaction to rootchain", "nonce", record.Nonce, "error", err)
+		return common.Hash{}, err
+	}
+
+	m.logger.Info("Tx sent on rootchain", "txHash", hash, "nonce", record.Nonce, "gasPrice", gasPrice, "gasLimit", gasLimit)
+
+	m.nonce++
+	m.pending[record.Nonce] = record
+	m.save(record)
+
+	return hash, nil
+}
+
+// syncNonce moves local nonce to pending nonce on chain if it is ahead or local nonce is stale
+func (m *rootchainTxManager) syncNonce(ctx context.Context) error {
+	pendingNonce, err := m.client.PendingNonceAt(ctx, m.from)
+	if err != nil {
+		return err
+	}
+
+	if !m.nonceSynced || pendingNonce > m.nonce {
+		m.nonce = pendingNonce
+		m.nonceSynced = true
+	}
+
+	// txs dropped from mempool keep their nonce, watcher re-broadcasts them
+	for m.pending[m.nonce] != nil {
+		m.nonce++
+	}
+
+	return nil
+}
+
+// broadcast signs record with its current gas price and sends it
+func (m *rootchainTxManager) broadcast(ctx context.Context, record *RootchainTx) (common.Hash, error) {
+	rawTx := types.NewTransaction(record.Nonce, record.To, record.Value, record.GasLimit, record.GasPrice, record.Data)
+
+	signedTx, err := m.signTx(rawTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := m.client.SendTransaction(ctx, signedTx); err != nil {
+		return common.Hash{}, err
+	}
+
+	record.Hash = signedTx.Hash()
+	record.Hashes = append(record.Hashes, record.Hash)
+	record.SentAt = time.Now()
+
+	return record.Hash, nil
+}
+
+// checkPending updates status of pending txs and replaces stuck ones
+func (m *rootchainTxManager) checkPending() {
+	if len(m.pending) == 0 {
+		return
+	}
+
+	ctx := context.Background()
+
+	confirmedNonce, err := m.client.NonceAt(ctx, m.from, nil)
+	if err != nil {
+		m.logger.Error("Error fetching confirmed nonce", "error", err)
+		return
+	}
+
+	nonces := make([]uint64, 0, len(m.pending))
+	for nonce := range m.pending {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	for _, nonce := range nonces {
+		record := m.pending[nonce]
+
+		if receipt := m.findReceipt(ctx, record); receipt != nil {
+			record.Hash = receipt.TxHash
+			record.BlockNumber = receipt.BlockNumber.Uint64()
+			record.Status = RootchainTxSuccess
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				record.Status = RootchainTxFailed
+			}
+
+			m.logger.Info("Rootchain tx included", "txHash", record.Hash, "nonce", nonce, "status", record.Status, "blockNumber", record.BlockNumber)
+			m.finalize(record)
+
+			continue
+		}
+
+		// nonce is used by some other tx
+		if nonce < confirmedNonce {
+			record.Status = RootchainTxDropped
+			m.logger.Info("Rootchain tx dropped, nonce already used", "txHash", record.Hash, "nonce", nonce)
+			m.finalize(record)
+
+			continue
+		}
+
+		if time.Since(record.SentAt) >= m.stuckTimeout {
+			m.bump(ctx, record)
+		}
+	}
+}
+
+// findReceipt returns receipt of any broadcasted version of record
+func (m *rootchainTxManager) findReceipt(ctx context.Context, record *RootchainTx) *types.Receipt {
+	for i := len(record.Hashes) - 1; i >= 0; i-- {
+		receipt, err := m.client.TransactionReceipt(ctx, record.Hashes[i])
+		if err == nil && receipt != nil {
+			return receipt
+		}
+	}
+
+	return nil
+}
+
+// bump replaces stuck tx with same nonce and higher gas price
+func (m *rootchainTxManager) bump(ctx context.Context, record *RootchainTx) {
+	if record.Bumps >= m.maxGasBumps {
+		m.logger.Error("Rootchain tx stuck, max gas bumps reached", "txHash", record.Hash, "nonce", record.Nonce, "gasPrice", record.GasPrice)
+		return
+	}
+
+	gasPrice := new(big.Int).Mul(record.GasPrice, big.NewInt(100+m.gasBumpPercent))
+	gasPrice.Div(gasPrice, big.NewInt(100))
+
+	// use network price if it moved higher than bumped price
+	if suggested, err := m.client.SuggestGasPrice(ctx); err == nil && suggested.Cmp(gasPrice) > 0 {
+		gasPrice = suggested
+	}
+
+	gasPrice = m.capGasPrice(gasPrice)
+	if gasPrice.Cmp(record.GasPrice) <= 0 {
+		m.logger.Error("Rootchain tx stuck, gas price already at max", "txHash", record.Hash, "nonce", record.Nonce, "gasPrice", record.GasPrice)
+		return
+	}
+
+	oldHash, oldGasPrice := record.Hash, record.GasPrice
+	record.GasPrice = gasPrice
+
+	if _, err := m.broadcast(ctx, record); err != nil {
+		record.GasPrice = oldGasPrice
+		m.logger.Error("Error replacing stuck rootchain tx", "txHash", oldHash, "nonce", record.Nonce, "error", err)
+		return
+	}
+
+	record.Bumps++
+	m.logger.Info("Replaced stuck rootchain tx", "oldTxHash", oldHash, "txHash", record.Hash, "nonce", record.Nonce, "gasPrice", gasPrice, "bumps", record.Bumps)
+	m.save(record)
+}
+
+func (m *rootchainTxManager) capGasPrice(gasPrice *big.Int) *big.Int {
+	if m.maxGasPrice != nil && gasPrice.Cmp(m.maxGasPrice) > 0 {
+		return new(big.Int).Set(m.maxGasPrice)
+	}
+
+	return gasPrice
+}
+
+func (m *rootchainTxManager) finalize(record *RootchainTx) {
+	delete(m.pending, record.Nonce)
+	m.save(record)
+}
+
+// save records tx in bridge db
+func (m *rootchainTxManager) save(record *RootchainTx) {
+	if m.storageClient == nil {
+		return
+	}
+
+	value, err := json.Marshal(record)
+	if err != nil {
+		m.logger.Error("Error marshalling rootchain tx", "error", err)
+		return
+	}
+
+	if err := m.storageClient.Put(rootchainTxKey(record.From, record.Nonce), value, nil); err != nil {
+		m.logger.Error("Error storing rootchain tx", "nonce", record.Nonce, "error", err)
+	}
+}
+
+// records returns all rootchain txs stored in bridge db
+func (m *rootchainTxManager) records() ([]RootchainTx, error) {
+	var result []RootchainTx
+	if m.storageClient == nil {
+		return result, nil
+	}
+
+	iter := m.storageClient.NewIterator(leveldbUtil.BytesPrefix([]byte(RootchainTxPrefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var record RootchainTx
+		if err := json.Unmarshal(iter.Value(), &record); err != nil {
+			return nil, err
+		}
+
+		result = append(result, record)
+	}
+
+	return result, iter.Error()
+}
+
+// loadPending resumes watching pending txs of this signer recorded before restart
+func (m *rootchainTxManager) loadPending() error {
+	records, err := m.records()
+	if err != nil {
+		return err
+	}
+
+	for i := range records {
+		record := records[i]
+		if record.Status == RootchainTxPending && record.From == m.from {
+			m.pending[record.Nonce] = &record
+		}
+	}
+
+	return nil
+}
+
+// rootchainTxKey returns bridge db key of rootchain tx, nonces are unique per sender only
+func rootchainTxKey(from common.Address, nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s-%020d", RootchainTxPrefix, from.Hex(), nonce))
+}
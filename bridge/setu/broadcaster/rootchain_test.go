@@ -0,0 +1,226 @@
+package broadcaster
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/tendermint/tendermint/libs/log"
+
+	bor "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+)
+
+// mockRootchainClient simulates rootchain node used by rootchain tx manager
+type mockRootchainClient struct {
+	pendingNonce   uint64
+	confirmedNonce uint64
+	gasPrice       *big.Int
+	sent           []*types.Transaction
+	receipts       map[common.Hash]*types.Receipt
+	sendErr        error
+}
+
+func (c *mockRootchainClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return c.pendingNonce, nil
+}
+
+func (c *mockRootchainClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return c.confirmedNonce, nil
+}
+
+func (c *mockRootchainClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return new(big.Int).Set(c.gasPrice), nil
+}
+
+func (c *mockRootchainClient) EstimateGas(ctx context.Context, msg bor.CallMsg) (uint64, error) {
+	return 0, errors.New("execution reverted")
+}
+
+func (c *mockRootchainClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if c.sendErr != nil {
+		return c.sendErr
+	}
+
+	c.sent = append(c.sent, tx)
+
+	return nil
+}
+
+func (c *mockRootchainClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if receipt, ok := c.receipts[txHash]; ok {
+		return receipt, nil
+	}
+
+	return nil, errors.New("not found")
+}
+
+func newTestRootchainTxManager(t *testing.T, client *mockRootchainClient) *rootchainTxManager {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	manager := newRootchainTxManager(
+		log.NewNopLogger(),
+		client,
+		db,
+		crypto.PubkeyToAddress(key.PublicKey),
+		func(tx *types.Transaction) (*types.Transaction, error) {
+			return types.SignTx(tx, types.NewEIP155Signer(big.NewInt(5)), key)
+		},
+	)
+	manager.defaultGasLimit = 1000000
+
+	return manager
+}
+
+func TestRootchainTxManagerNonce(t *testing.T) {
+	t.Parallel()
+
+	client := &mockRootchainClient{pendingNonce: 5, gasPrice: big.NewInt(100)}
+	manager := newTestRootchainTxManager(t, client)
+	to := common.HexToAddress("0x1")
+
+	// local nonce is used for consecutive txs while node still reports old pending nonce
+	for i := 0; i < 3; i++ {
+		_, err := manager.send(bor.CallMsg{To: &to, Data: []byte{byte(i)}})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, client.sent, 3)
+	for i, tx := range client.sent {
+		require.Equal(t, uint64(5+i), tx.Nonce())
+		require.Equal(t, uint64(1000000), tx.Gas(), "custom gas limit should be used when estimation fails")
+	}
+
+	// failed broadcast resyncs nonce from chain, skipping tracked txs
+	client.sendErr = errors.New("nonce too low")
+	_, err := manager.send(bor.CallMsg{To: &to})
+	require.Error(t, err)
+
+	client.sendErr = nil
+	_, err = manager.send(bor.CallMsg{To: &to})
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), client.sent[3].Nonce())
+
+	records, err := manager.records()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+}
+
+func TestRootchainTxManagerGasBump(t *testing.T) {
+	t.Parallel()
+
+	client := &mockRootchainClient{gasPrice: big.NewInt(100), receipts: make(map[common.Hash]*types.Receipt)}
+	manager := newTestRootchainTxManager(t, client)
+	manager.maxGasPrice = big.NewInt(130)
+	to := common.HexToAddress("0x1")
+
+	hash, err := manager.send(bor.CallMsg{To: &to})
+	require.NoError(t, err)
+
+	// not stuck yet
+	manager.checkPending()
+	require.Len(t, client.sent, 1)
+
+	// stuck tx is replaced with same nonce and bumped gas price
+	manager.pending[0].SentAt = time.Now().Add(-manager.stuckTimeout)
+	manager.checkPending()
+	require.Len(t, client.sent, 2)
+	require.Equal(t, uint64(0), client.sent[1].Nonce())
+	require.Equal(t, big.NewInt(120), client.sent[1].GasPrice())
+
+	// bumped price is capped by max gas price
+	manager.pending[0].SentAt = time.Now().Add(-manager.stuckTimeout)
+	manager.checkPending()
+	require.Len(t, client.sent, 3)
+	require.Equal(t, big.NewInt(130), client.sent[2].GasPrice())
+
+	// no more bumps above max gas price
+	manager.pending[0].SentAt = time.Now().Add(-manager.stuckTimeout)
+	manager.checkPending()
+	require.Len(t, client.sent, 3)
+
+	// inclusion of replaced version is detected as well
+	client.receipts[hash] = &types.Receipt{TxHash: hash, BlockNumber: big.NewInt(10), Status: types.ReceiptStatusSuccessful}
+	client.confirmedNonce = 1
+	manager.checkPending()
+	require.Empty(t, manager.pending)
+
+	records, err := manager.records()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, RootchainTxSuccess, records[0].Status)
+	require.Equal(t, hash, records[0].Hash)
+	require.Equal(t, uint64(10), records[0].BlockNumber)
+	require.Equal(t, 2, records[0].Bumps)
+	require.Len(t, records[0].Hashes, 3)
+}
+
+func TestRootchainTxManagerDroppedAndRestart(t *testing.T) {
+	t.Parallel()
+
+	client := &mockRootchainClient{gasPrice: big.NewInt(100), receipts: make(map[common.Hash]*types.Receipt)}
+	manager := newTestRootchainTxManager(t, client)
+	to := common.HexToAddress("0x1")
+
+	_, err := manager.send(bor.CallMsg{To: &to})
+	require.NoError(t, err)
+	_, err = manager.send(bor.CallMsg{To: &to})
+	require.NoError(t, err)
+
+	// pending txs are resumed after restart
+	restarted := newRootchainTxManager(log.NewNopLogger(), client, manager.storageClient, manager.from, manager.signTx)
+	require.NoError(t, restarted.loadPending())
+	require.Len(t, restarted.pending, 2)
+
+	// first nonce is used by other tx
+	client.confirmedNonce = 1
+	restarted.checkPending()
+	require.Len(t, restarted.pending, 1)
+
+	records, err := restarted.records()
+	require.NoError(t, err)
+	require.Equal(t, RootchainTxDropped, records[0].Status)
+	require.Equal(t, RootchainTxPending, records[1].Status)
+}
+
+func TestRootchainTxManagerRecordsPerSender(t *testing.T) {
+	t.Parallel()
+
+	client := &mockRootchainClient{gasPrice: big.NewInt(100)}
+	manager := newTestRootchainTxManager(t, client)
+	to := common.HexToAddress("0x1")
+
+	_, err := manager.send(bor.CallMsg{To: &to})
+	require.NoError(t, err)
+
+	// other signer sharing bridge db uses same nonce
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other := newRootchainTxManager(log.NewNopLogger(), client, manager.storageClient, crypto.PubkeyToAddress(key.PublicKey), manager.signTx)
+	other.defaultGasLimit = manager.defaultGasLimit
+
+	_, err = other.send(bor.CallMsg{To: &to})
+	require.NoError(t, err)
+
+	records, err := manager.records()
+	require.NoError(t, err)
+	require.Len(t, records, 2, "records of different senders with same nonce must not overwrite each other")
+
+	// only own pending txs are resumed
+	restarted := newRootchainTxManager(log.NewNopLogger(), client, manager.storageClient, manager.from, manager.signTx)
+	require.NoError(t, restarted.loadPending())
+	require.Len(t, restarted.pending, 1)
+	require.Equal(t, manager.from, restarted.pending[0].From)
+}
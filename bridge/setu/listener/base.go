@@ -13,6 +13,7 @@ import (
 	"github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/heimdall/bridge/setu/queue"
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
@@ -140,6 +141,7 @@ func (bl *BaseListener) StartHeaderProcess(ctx context.Context) {
 	for {
 		select {
 		case newHeader := <-bl.HeaderChannel:
+			status.SetListenerSeenBlock(bl.name, newHeader.Number.Uint64())
 			bl.impl.ProcessHeader(newHeader)
 		case <-ctx.Done():
 			bl.Logger.Info("Header process stopped")
@@ -3,21 +3,18 @@ package listener
 import (
 	"context"
 	"encoding/json"
-	"strconv"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/helper"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
-	//slashingTypes "github.com/maticnetwork/heimdall/x/slashing/types"
-)
-
-const (
-	heimdallLastBlockKey = "heimdall-last-block" // storage key
+	slashingTypes "github.com/maticnetwork/heimdall/x/slashing/types"
 )
 
 // HeimdallListener - Listens to and process events from heimdall
@@ -77,18 +74,24 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 			if err != nil {
 				hl.Logger.Error("Error fetching fromBlock and toBlock...skipping events query", "error", err)
 			} else if fromBlock < toBlock {
+				status.SetListenerSeenBlock(hl.name, toBlock)
 
 				hl.Logger.Info("Fetching new events between", "fromBlock", fromBlock, "toBlock", toBlock)
 
-				// Querying and processing Begin events
+				// Querying and processing Begin events, cursor moves only after events of block are enqueued
 				for i := fromBlock; i <= toBlock; i++ {
-					events, err := helper.GetBeginBlockEvents(hl.httpClient, int64(i))
-					if err != nil {
-						hl.Logger.Error("Error fetching begin block events", "error", err)
+					if err := hl.processBlockEvents(int64(i)); err != nil {
+						hl.Logger.Error("Error processing begin block events, will retry from last processed block", "height", i, "error", err)
+						break
 					}
-					for _, event := range events {
-						hl.ProcessBlockEvent(sdk.StringifyEvent(event), int64(i))
+
+					// set last block to storage
+					if err := util.SetLastBlock(hl.storageClient, util.HeimdallLastBlockKey, i); err != nil {
+						hl.Logger.Error("hl.storageClient.Put", "Error", err)
+						break
 					}
+
+					status.SetListenerProcessedBlock(hl.name, i)
 				}
 
 				// Querying and processing tx Events. Below for loop is kept for future purpose to process events from tx
@@ -126,10 +129,6 @@ func (hl *HeimdallListener) StartPolling(ctx context.Context, pollInterval time.
 						}
 					}
 				} */
-				// set last block to storage
-				if err := hl.storageClient.Put([]byte(heimdallLastBlockKey), []byte(strconv.FormatUint(toBlock, 10)), nil); err != nil {
-					hl.Logger.Error("hl.storageClient.Put", "Error", err)
-				}
 			}
 
 		case <-ctx.Done():
@@ -154,52 +153,62 @@ func (hl *HeimdallListener) fetchFromAndToBlock() (uint64, uint64, error) {
 	toBlock = uint64(nodeStatus.SyncInfo.LatestBlockHeight)
 
 	// fromBlock - get last block from storage
-	hasLastBlock, err := hl.storageClient.Has([]byte(heimdallLastBlockKey), nil)
+	lastBlock, hasLastBlock, err := util.GetLastBlock(hl.storageClient, util.HeimdallLastBlockKey)
 	if err != nil {
-		hl.Logger.Error("Error while fetching "+heimdallLastBlockKey+" from storage", "error", err)
+		hl.Logger.Info("Error while fetching last block from storage", "error", err)
+		toBlock = 0
+		return fromBlock, toBlock, err
 	}
 
 	if hasLastBlock {
-		lastBlockBytes, err := hl.storageClient.Get([]byte(heimdallLastBlockKey), nil)
-		if err != nil {
-			hl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
-			return fromBlock, toBlock, err
-		}
+		hl.Logger.Debug("Got last block from bridge storage", "lastBlock", lastBlock)
+		fromBlock = lastBlock + 1
+	}
+
+	return fromBlock, toBlock, nil
+}
 
-		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			hl.Logger.Debug("Got last block from bridge storage", "lastBlock", result)
-			fromBlock = result + 1
-		} else {
-			hl.Logger.Info("Error parsing last block bytes from storage", "error", err)
-			toBlock = 0
-			return fromBlock, toBlock, err
+// processBlockEvents - fetches and processes begin block events of given height
+func (hl *HeimdallListener) processBlockEvents(height int64) error {
+	events, err := helper.GetBeginBlockEvents(hl.httpClient, height)
+	if err != nil {
+		hl.Logger.Error("Error fetching begin block events", "error", err)
+		return err
+	}
+
+	for _, event := range events {
+		if err := hl.ProcessBlockEvent(sdk.StringifyEvent(event), height); err != nil {
+			return err
 		}
 	}
-	return fromBlock, toBlock, err
+
+	return nil
 }
 
 // ProcessBlockEvent - process Blockevents (BeginBlock, EndBlock events) from heimdall.
-func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight int64) {
+func (hl *HeimdallListener) ProcessBlockEvent(event sdk.StringEvent, blockHeight int64) error {
 	hl.Logger.Info("Received block event from Heimdall", "eventType", event.Type)
 	eventBytes, err := json.Marshal(event)
 	if err != nil {
 		hl.Logger.Error("Error while parsing block event", "error", err, "eventType", event.Type)
-		return
+		return nil
 	}
 
 	switch event.Type {
 	case checkpointTypes.EventTypeCheckpoint:
-		hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
-	//case slashingTypes.EventTypeSlashLimit:
-	//	hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
-	//case slashingTypes.EventTypeTickConfirm:
-	//	hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight)
+		return hl.sendBlockTask("sendCheckpointToRootchain", eventBytes, blockHeight)
+	case slashingTypes.EventTypeSlashLimit:
+		return hl.sendBlockTask("sendTickToHeimdall", eventBytes, blockHeight)
+	case slashingTypes.EventTypeTickConfirm:
+		return hl.sendBlockTask("sendTickToRootchain", eventBytes, blockHeight)
 	default:
 		hl.Logger.Debug("BlockEvent Type mismatch", "eventType", event.Type)
 	}
+
+	return nil
 }
 
-func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, blockHeight int64) {
+func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, blockHeight int64) error {
 	// create machinery task
 	signature := &tasks.Signature{
 		Name: taskName,
@@ -217,8 +226,10 @@ func (hl *HeimdallListener) sendBlockTask(taskName string, eventBytes []byte, bl
 	signature.RetryCount = 3
 	hl.Logger.Info("Sending block level task", "taskName", taskName, "currentTime", time.Now(), "blockHeight", blockHeight)
 	// send task
-	_, err := hl.queueConnector.Server.SendTask(signature)
+	err := hl.queueConnector.SendTask(signature)
 	if err != nil {
 		hl.Logger.Error("Error sending block level task", "taskName", taskName, "blockHeight", blockHeight, "error", err)
 	}
+
+	return err
 }
@@ -2,6 +2,7 @@ package listener
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/RichardKnop/machinery/v1/tasks"
@@ -15,6 +16,10 @@ import (
 type MaticChainListener struct {
 	BaseListener
 	cacheLastSpan *hmTypes.Span
+
+	// sprint duration is cached by header goroutines
+	sprintDurationMutex sync.Mutex
+	cacheSprintDuration uint64
 }
 
 // NewMaticChainListener - constructor func
@@ -67,6 +72,9 @@ func (ml *MaticChainListener) ProcessHeader(newHeader *types.Header) {
 	// check and send span task
 	go ml.checkAndSendSpanTask(newHeader)
 
+	// check and send missed sprint task
+	go ml.checkAndSendMissedSprintTask(newHeader)
+
 	// Marshall header block and publish to queue
 	headerBytes, err := newHeader.MarshalJSON()
 	if err != nil {
@@ -110,6 +118,43 @@ func (ml *MaticChainListener) checkAndSendSpanTask(newHeader *types.Header) {
 	}
 }
 
+// checkAndSendMissedSprintTask sends sprint start headers to check if in-turn producer missed the sprint
+func (ml *MaticChainListener) checkAndSendMissedSprintTask(newHeader *types.Header) {
+	sprintDuration, err := ml.getSprintDuration()
+	if err != nil {
+		ml.Logger.Error("Error fetching bor params", "error", err)
+		return
+	}
+
+	if sprintDuration == 0 || newHeader.Number.Uint64()%sprintDuration != 0 {
+		return
+	}
+
+	// Marshall header block and publish to queue
+	headerBytes, err := newHeader.MarshalJSON()
+	if err != nil {
+		ml.Logger.Error("Error marshalling header block", "error", err)
+		return
+	}
+	ml.sendTaskWithDelay("sendMissedSprintToHeimdall", headerBytes, 0)
+}
+
+// getSprintDuration returns cached sprint duration, fetching it from heimdall on first use
+func (ml *MaticChainListener) getSprintDuration() (uint64, error) {
+	ml.sprintDurationMutex.Lock()
+	defer ml.sprintDurationMutex.Unlock()
+
+	if ml.cacheSprintDuration == 0 {
+		params, err := util.GetBorParams(ml.cliCtx)
+		if err != nil {
+			return 0, err
+		}
+		ml.cacheSprintDuration = params.Sprint
+	}
+
+	return ml.cacheSprintDuration, nil
+}
+
 func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []byte, delay time.Duration) {
 	// create machinery task
 	signature := &tasks.Signature{
@@ -127,7 +172,7 @@ func (ml *MaticChainListener) sendTaskWithDelay(taskName string, headerBytes []b
 	eta := time.Now().Add(delay)
 	signature.ETA = &eta
 	ml.Logger.Debug("Sending task", "taskname", taskName, "currentTime", time.Now(), "delayTime", eta)
-	_, err := ml.queueConnector.Server.SendTask(signature)
+	err := ml.queueConnector.SendTask(signature)
 	if err != nil {
 		ml.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"math/big"
-	"strconv"
 	"time"
 
 	chainmanagerTypes "github.com/maticnetwork/heimdall/x/chainmanager/types"
@@ -15,9 +14,11 @@ import (
 	"github.com/maticnetwork/bor/accounts/abi"
 	ethCommon "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // RootChainListenerContext root chain listener context
@@ -34,10 +35,6 @@ type RootChainListener struct {
 	stakingInfoAbi *abi.ABI
 }
 
-const (
-	lastRootBlockKey = "rootchain-last-block" // storage key
-)
-
 // NewRootChainListener - constructor func
 func NewRootChainListener() *RootChainListener {
 	contractCaller, err := helper.NewContractCaller()
@@ -99,54 +96,56 @@ func (rl *RootChainListener) ProcessHeader(newHeader *types.Header) {
 		return
 	}
 	requiredConfirmations := rootchainContext.ChainmanagerParams.MainchainTxConfirmations
-	latestNumber := newHeader.Number
-
-	// confirmation
-	confirmationBlocks := big.NewInt(0).SetUint64(requiredConfirmations)
 
-	if latestNumber.Cmp(confirmationBlocks) <= 0 {
-		rl.Logger.Error("Block number less than Confirmations required", "blockNumber", latestNumber.Uint64, "confirmationsRequired", confirmationBlocks.Uint64)
+	if newHeader.Number.Uint64() <= requiredConfirmations {
+		rl.Logger.Error("Block number less than Confirmations required", "blockNumber", newHeader.Number.Uint64(), "confirmationsRequired", requiredConfirmations)
 		return
 	}
-	latestNumber = latestNumber.Sub(latestNumber, confirmationBlocks)
+
+	// latest confirmed block
+	toBlock := newHeader.Number.Uint64() - requiredConfirmations
 
 	// default fromBlock
-	fromBlock := latestNumber
+	fromBlock := toBlock
 
 	// get last block from storage
-	hasLastBlock, _ := rl.storageClient.Has([]byte(lastRootBlockKey), nil)
+	lastBlock, hasLastBlock, err := util.GetLastBlock(rl.storageClient, util.RootchainLastBlockKey)
+	if err != nil {
+		rl.Logger.Info("Error while fetching last block from storage", "error", err)
+		return
+	}
+
 	if hasLastBlock {
-		lastBlockBytes, err := rl.storageClient.Get([]byte(lastRootBlockKey), nil)
-		if err != nil {
-			rl.Logger.Info("Error while fetching last block bytes from storage", "error", err)
+		rl.Logger.Debug("Got last block from bridge storage", "lastBlock", lastBlock)
+		if lastBlock >= toBlock {
 			return
 		}
-		rl.Logger.Debug("Got last block from bridge storage", "lastBlock", string(lastBlockBytes))
-		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			if result >= newHeader.Number.Uint64() {
-				return
-			}
-			fromBlock = big.NewInt(0).SetUint64(result + 1)
-		}
+		fromBlock = lastBlock + 1
 	}
 
-	// to block
-	toBlock := latestNumber
+	// backfill in bounded chunks, cursor moves only after events of chunk are enqueued
+	for chunkStart := fromBlock; chunkStart <= toBlock; chunkStart += util.RootchainMaxBlockRange {
+		chunkEnd := chunkStart + util.RootchainMaxBlockRange - 1
+		if chunkEnd > toBlock {
+			chunkEnd = toBlock
+		}
 
-	if toBlock.Cmp(fromBlock) == -1 {
-		fromBlock = toBlock
-	}
+		if err := rl.queryAndBroadcastEvents(rootchainContext, new(big.Int).SetUint64(chunkStart), new(big.Int).SetUint64(chunkEnd)); err != nil {
+			rl.Logger.Error("Error processing rootchain events, will retry from last processed block", "fromBlock", chunkStart, "toBlock", chunkEnd, "error", err)
+			return
+		}
 
-	// set last block to storage
-	if err := rl.storageClient.Put([]byte(lastRootBlockKey), []byte(toBlock.String()), nil); err != nil {
-		rl.Logger.Error("rl.storageClient.Put", "Error", err)
-	}
+		// set last block to storage
+		if err := util.SetLastBlock(rl.storageClient, util.RootchainLastBlockKey, chunkEnd); err != nil {
+			rl.Logger.Error("rl.storageClient.Put", "Error", err)
+			return
+		}
 
-	// query events
-	rl.queryAndBroadcastEvents(rootchainContext, fromBlock, toBlock)
+		status.SetListenerProcessedBlock(rl.name, chunkEnd)
+	}
 }
 
-func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) {
+func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChainListenerContext, fromBlock *big.Int, toBlock *big.Int) error {
 	rl.Logger.Info("Query rootchain event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
 	// current public key
@@ -166,110 +165,163 @@ func (rl *RootChainListener) queryAndBroadcastEvents(rootchainContext *RootChain
 	logs, err := rl.contractConnector.MainChainClient.FilterLogs(context.Background(), query)
 	if err != nil {
 		rl.Logger.Error("Error while filtering logs", "error", err)
-		return
+		return err
 	} else if len(logs) > 0 {
 		rl.Logger.Debug("New logs found", "numberOfLogs", len(logs))
 	}
 
+	// state synced logs are sent to heimdall in batches
+	var stateSyncedLogs []types.Log
+
 	// process filtered log
 	for _, vLog := range logs {
 		topic := vLog.Topics[0].Bytes()
 		for _, abiObject := range rl.abis {
 			selectedEvent := helper.EventByID(abiObject, topic)
-			logBytes, _ := json.Marshal(vLog)
-			if selectedEvent != nil {
-				rl.Logger.Debug("ReceivedEvent", "eventname", selectedEvent.Name)
-				switch selectedEvent.Name {
-				case "NewHeaderBlock":
-					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-				case "Staked":
-					event := new(stakinginfo.StakinginfoStaked)
-					if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
-						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
-					}
-					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
-						// topup has to be processed first before validator join. so adding delay.
-						delay := util.TaskDelayBetweenEachVal
-						rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						// topup has to be processed first before validator join. so adding delay.
-						delay = delay + util.TaskDelayBetweenEachVal
-						rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-
-				case "StakeUpdate":
-					event := new(stakinginfo.StakinginfoStakeUpdate)
-					if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
-						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
-					}
-					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-
-				case "SignerChange":
-					event := new(stakinginfo.StakinginfoSignerChange)
-					if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
-						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
-					}
-					if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
-						rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-
-				case "UnstakeInit":
-					event := new(stakinginfo.StakinginfoUnstakeInit)
-					if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
-						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
-					}
-					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-
-				case "StateSynced":
-					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-
-				case "TopUpFee":
-					event := new(stakinginfo.StakinginfoTopUpFee)
-					if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
-						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
-					}
-					if bytes.Equal(event.User.Bytes(), helper.GetAddress()) {
-						rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-
-				case "Slashed":
-					if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-
-				case "UnJailed":
-					event := new(stakinginfo.StakinginfoUnJailed)
-					if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
-						rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
-					}
-					if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
-						rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, 0)
-					} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
-						rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, delay)
-					}
-				}
+			if selectedEvent == nil {
+				continue
+			}
+
+			if selectedEvent.Name == "StateSynced" {
+				stateSyncedLogs = append(stateSyncedLogs, vLog)
+				continue
 			}
+
+			if err := rl.handleLog(vLog, selectedEvent, pubkeyBytes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return rl.sendStateSyncedBatches(stateSyncedLogs)
+}
+
+// sendStateSyncedBatches sends task for state synced logs in batches of at most MaxSideTxMsgs logs
+func (rl *RootChainListener) sendStateSyncedBatches(logs []types.Log) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx)
+	if !isCurrentValidator {
+		return nil
+	}
+
+	for start := 0; start < len(logs); start += hmTypes.MaxSideTxMsgs {
+		end := start + hmTypes.MaxSideTxMsgs
+		if end > len(logs) {
+			end = len(logs)
+		}
+
+		logsBytes, err := json.Marshal(logs[start:end])
+		if err != nil {
+			rl.Logger.Error("Error while marshalling state synced logs", "error", err)
+			return err
+		}
+
+		if err := rl.sendTaskWithDelay("sendStateSyncedBatchToHeimdall", "StateSynced", logsBytes, delay); err != nil {
+			return err
 		}
 	}
+
+	return nil
 }
 
-func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration) {
+// handleLog sends task for rootchain event log
+func (rl *RootChainListener) handleLog(vLog types.Log, selectedEvent *abi.Event, pubkeyBytes []byte) error {
+	logBytes, _ := json.Marshal(vLog)
+
+	rl.Logger.Debug("ReceivedEvent", "eventname", selectedEvent.Name)
+	switch selectedEvent.Name {
+	case "NewHeaderBlock":
+		if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			return rl.sendTaskWithDelay("sendCheckpointAckToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+	case "Staked":
+		event := new(stakinginfo.StakinginfoStaked)
+		if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
+			rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
+		}
+		if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
+			// topup has to be processed first before validator join. so adding delay.
+			delay := util.TaskDelayBetweenEachVal
+			return rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
+		} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			// topup has to be processed first before validator join. so adding delay.
+			delay = delay + util.TaskDelayBetweenEachVal
+			return rl.sendTaskWithDelay("sendValidatorJoinToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+
+	case "StakeUpdate":
+		event := new(stakinginfo.StakinginfoStakeUpdate)
+		if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
+			rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
+		}
+		if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
+			return rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, 0)
+		} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			return rl.sendTaskWithDelay("sendStakeUpdateToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+
+	case "SignerChange":
+		event := new(stakinginfo.StakinginfoSignerChange)
+		if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
+			rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
+		}
+		if bytes.Equal(event.SignerPubkey, pubkeyBytes) {
+			return rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, 0)
+		} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			return rl.sendTaskWithDelay("sendSignerChangeToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+
+	case "UnstakeInit":
+		event := new(stakinginfo.StakinginfoUnstakeInit)
+		if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
+			rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
+		}
+		if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
+			return rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, 0)
+		} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			return rl.sendTaskWithDelay("sendUnstakeInitToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+
+	case "StateSynced":
+		if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			return rl.sendTaskWithDelay("sendStateSyncedToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+
+	case "TopUpFee":
+		event := new(stakinginfo.StakinginfoTopUpFee)
+		if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
+			rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
+		}
+		if bytes.Equal(event.User.Bytes(), helper.GetAddress()) {
+			return rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, 0)
+		} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			return rl.sendTaskWithDelay("sendTopUpFeeToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+
+	case "Slashed":
+		if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			return rl.sendTaskWithDelay("sendTickAckToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+
+	case "UnJailed":
+		event := new(stakinginfo.StakinginfoUnJailed)
+		if err := helper.UnpackLog(rl.stakingInfoAbi, event, selectedEvent.Name, &vLog); err != nil {
+			rl.Logger.Error("Error while parsing event", "name", selectedEvent.Name, "error", err)
+		}
+		if util.IsEventSender(rl.cliCtx, event.ValidatorId.Uint64()) {
+			return rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, 0)
+		} else if isCurrentValidator, delay := util.CalculateTaskDelay(rl.cliCtx); isCurrentValidator {
+			return rl.sendTaskWithDelay("sendUnjailToHeimdall", selectedEvent.Name, logBytes, delay)
+		}
+	}
+
+	return nil
+}
+
+func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string, logBytes []byte, delay time.Duration) error {
 	signature := &tasks.Signature{
 		Name: taskName,
 		Args: []tasks.Arg{
@@ -289,10 +341,12 @@ func (rl *RootChainListener) sendTaskWithDelay(taskName string, eventName string
 	eta := time.Now().Add(delay)
 	signature.ETA = &eta
 	rl.Logger.Info("Sending task", "taskName", taskName, "currentTime", time.Now(), "delayTime", eta)
-	_, err := rl.queueConnector.Server.SendTask(signature)
+	err := rl.queueConnector.SendTask(signature)
 	if err != nil {
 		rl.Logger.Error("Error sending task", "taskName", taskName, "error", err)
 	}
+
+	return err
 }
 
 //
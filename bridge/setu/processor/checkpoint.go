@@ -18,9 +18,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	bor "github.com/maticnetwork/bor"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
 	"github.com/maticnetwork/heimdall/helper"
@@ -40,6 +42,9 @@ type CheckpointProcessor struct {
 
 	// Rootchain abi
 	rootchainAbi *abi.ABI
+
+	// checkpoint length policy
+	lengthPolicy CheckpointLengthPolicy
 }
 
 // Result represents single req result
@@ -51,6 +56,7 @@ type Result struct {
 func NewCheckpointProcessor(rootchainAbi *abi.ABI) *CheckpointProcessor {
 	checkpointProcessor := &CheckpointProcessor{
 		rootchainAbi: rootchainAbi,
+		lengthPolicy: staticCheckpointLengthPolicy{},
 	}
 	return checkpointProcessor
 }
@@ -69,13 +75,13 @@ func (cp *CheckpointProcessor) Start() error {
 // RegisterTasks - Registers checkpoint related tasks with machinery
 func (cp *CheckpointProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering checkpoint tasks")
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToHeimdall", cp.sendCheckpointToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToHeimdall", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointToRootchain", cp.sendCheckpointToRootchain); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointToRootchain", "error", err)
 	}
-	if err := cp.queueConnector.Server.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendCheckpointAckToHeimdall", cp.sendCheckpointAckToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendCheckpointAckToHeimdall", "error", err)
 	}
 }
@@ -108,6 +114,8 @@ func (cp *CheckpointProcessor) sendCheckpointToHeimdall(headerBlockStr string) (
 	}
 
 	cp.Logger.Info("Processing new header", "headerNumber", header.Number)
+	cp.lengthPolicy.ObserveChildHeader(&header)
+
 	var isProposer bool
 	if isProposer, err = util.IsProposer(cp.cliCtx); err != nil {
 		cp.Logger.Error("Error checking isProposer in HeaderBlock handler", "error", err)
@@ -309,6 +317,10 @@ func (cp *CheckpointProcessor) handleCheckpointNoAck() {
 		return
 	}
 
+	if lastCreatedAt != 0 {
+		status.SetLastCheckpointTime(time.Unix(lastCreatedAt, 0))
+	}
+
 	isNoAckRequired, count := cp.checkIfNoAckIsRequired(params, lastCreatedAt)
 	if isNoAckRequired {
 		var isProposer bool
@@ -365,11 +377,14 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(params util.Params, latest
 		start = start + 1
 	}
 
+	// checkpoint length from policy, within avg/max checkpoint length bounds
+	checkpointLength := cp.lengthPolicy.CheckpointLength(checkpointParams)
+
 	// get diff
 	diff := latestChildBlock - start + 1
 	// process if diff > 0 (positive)
 	if diff > 0 {
-		expectedDiff := diff - diff%checkpointParams.AvgCheckpointLength
+		expectedDiff := diff - diff%checkpointLength
 		if expectedDiff > 0 {
 			expectedDiff = expectedDiff - 1
 		}
@@ -381,13 +396,14 @@ func (cp *CheckpointProcessor) nextExpectedCheckpoint(params util.Params, latest
 		end = expectedDiff + start
 		cp.Logger.Debug("Calculating checkpoint eligibility",
 			"latest", latestChildBlock,
+			"checkpointLength", checkpointLength,
 			"start", start,
 			"end", end,
 		)
 	}
 
 	// Handle when block producers go down
-	if end == 0 || end == start || (0 < diff && diff < checkpointParams.AvgCheckpointLength) {
+	if end == 0 || end == start || (0 < diff && diff < checkpointLength) {
 		cp.Logger.Debug("Fetching last header block to calculate time")
 
 		currentTime := time.Now().UTC().Unix()
@@ -515,17 +531,20 @@ func (cp *CheckpointProcessor) createAndSendCheckpointToRootchain(params util.Pa
 		chainParams := params.ChainmanagerParams.ChainParams
 		// root chain address
 		rootChainAddress := common.HexToAddress(chainParams.RootChainAddress)
-		// root chain instance
-		rootChainInstance, err := cp.contractConnector.GetRootChainInstance(rootChainAddress)
+		// submit checkpoint data
+		data, err := cp.contractConnector.RootChainABI.Pack("submitCheckpoint", sideTxData, sigs)
 		if err != nil {
-			cp.Logger.Info("Error while creating rootchain instance", "error", err)
+			cp.Logger.Error("Unable to pack tx for submitCheckpoint", "error", err)
 			return err
 		}
 
-		if err := cp.contractConnector.SendCheckpoint(sideTxData, sigs, rootChainAddress, rootChainInstance); err != nil {
+		rootchainTxHash, err := cp.txBroadcaster.BroadcastToRootchain(bor.CallMsg{To: &rootChainAddress, Data: data})
+		if err != nil {
 			cp.Logger.Info("Error submitting checkpoint to rootchain", "error", err)
 			return err
 		}
+
+		cp.Logger.Info("Submitted new checkpoint to rootchain successfully", "txHash", rootchainTxHash.String())
 	}
 
 	return nil
@@ -588,6 +607,10 @@ func (cp *CheckpointProcessor) getLastNoAckTime() uint64 {
 		return 0
 	}
 
+	if noackObject.LastNoAck != 0 {
+		status.SetLastNoAckTime(time.Unix(int64(noackObject.LastNoAck), 0))
+	}
+
 	return noackObject.LastNoAck
 }
 
@@ -0,0 +1,170 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	bor "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+)
+
+const (
+	// StaticCheckpointLengthPolicy always uses avg checkpoint length param
+	StaticCheckpointLengthPolicy = "static"
+	// AdaptiveCheckpointLengthPolicy picks length from rootchain gas price and child chain throughput
+	AdaptiveCheckpointLengthPolicy = "adaptive"
+
+	// number of recent samples kept by adaptive policy
+	adaptiveSampleWindow = 20
+)
+
+// CheckpointLengthPolicy picks checkpoint length used to form next checkpoint
+type CheckpointLengthPolicy interface {
+	// ObserveChildHeader records child chain header seen by checkpoint processor
+	ObserveChildHeader(header *types.Header)
+	// CheckpointLength returns checkpoint length within checkpoint params bounds
+	CheckpointLength(params *checkpointTypes.Params) uint64
+}
+
+// NewCheckpointLengthPolicy creates checkpoint length policy of given type
+func NewCheckpointLengthPolicy(policyType string, gasPricer bor.GasPricer, logger log.Logger) (CheckpointLengthPolicy, error) {
+	switch policyType {
+	case "", StaticCheckpointLengthPolicy:
+		return staticCheckpointLengthPolicy{}, nil
+	case AdaptiveCheckpointLengthPolicy:
+		return newAdaptiveCheckpointLengthPolicy(gasPricer, logger), nil
+	default:
+		return nil, fmt.Errorf("unknown checkpoint length policy %s", policyType)
+	}
+}
+
+//
+// Static policy
+//
+
+type staticCheckpointLengthPolicy struct{}
+
+func (staticCheckpointLengthPolicy) ObserveChildHeader(*types.Header) {}
+
+func (staticCheckpointLengthPolicy) CheckpointLength(params *checkpointTypes.Params) uint64 {
+	return params.AvgCheckpointLength
+}
+
+//
+// Adaptive policy
+//
+
+// adaptiveCheckpointLengthPolicy scales avg checkpoint length up (bounded by max checkpoint length)
+// - by ratio of current rootchain gas price to its recent average, so gas spikes send fewer, larger checkpoints
+// - by upto 2x as child chain gets quiet, measured by gas utilisation of recent child blocks
+type adaptiveCheckpointLengthPolicy struct {
+	mu sync.Mutex
+
+	gasPricer bor.GasPricer
+	logger    log.Logger
+
+	gasPrices    []*big.Int
+	utilisations []float64
+}
+
+func newAdaptiveCheckpointLengthPolicy(gasPricer bor.GasPricer, logger log.Logger) *adaptiveCheckpointLengthPolicy {
+	return &adaptiveCheckpointLengthPolicy{
+		gasPricer: gasPricer,
+		logger:    logger,
+	}
+}
+
+// ObserveChildHeader records gas utilisation of child block
+func (p *adaptiveCheckpointLengthPolicy) ObserveChildHeader(header *types.Header) {
+	if header == nil || header.GasLimit == 0 {
+		return
+	}
+
+	utilisation := float64(header.GasUsed) / float64(header.GasLimit)
+	if utilisation > 1 {
+		utilisation = 1
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.utilisations = append(p.utilisations, utilisation)
+	if len(p.utilisations) > adaptiveSampleWindow {
+		p.utilisations = p.utilisations[1:]
+	}
+}
+
+// CheckpointLength samples rootchain gas price and returns adapted checkpoint length
+func (p *adaptiveCheckpointLengthPolicy) CheckpointLength(params *checkpointTypes.Params) uint64 {
+	var gasPrice *big.Int
+	if p.gasPricer != nil {
+		var err error
+		if gasPrice, err = p.gasPricer.SuggestGasPrice(context.Background()); err != nil {
+			p.logger.Error("Unable to fetch rootchain gas price, ignoring gas factor", "error", err)
+			gasPrice = nil
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	gasFactor := 1.0
+	if gasPrice != nil && gasPrice.Sign() > 0 {
+		if avgGasPrice := averageGasPrice(p.gasPrices); avgGasPrice != nil && avgGasPrice.Sign() > 0 {
+			ratio, _ := new(big.Float).Quo(new(big.Float).SetInt(gasPrice), new(big.Float).SetInt(avgGasPrice)).Float64()
+			if ratio > gasFactor {
+				gasFactor = ratio
+			}
+		}
+
+		p.gasPrices = append(p.gasPrices, gasPrice)
+		if len(p.gasPrices) > adaptiveSampleWindow {
+			p.gasPrices = p.gasPrices[1:]
+		}
+	}
+
+	throughputFactor := 1.0
+	if len(p.utilisations) > 0 {
+		var total float64
+		for _, utilisation := range p.utilisations {
+			total += utilisation
+		}
+		throughputFactor = 2 - total/float64(len(p.utilisations))
+	}
+
+	length := float64(params.AvgCheckpointLength) * gasFactor * throughputFactor
+	result := params.MaxCheckpointLength
+	if length < float64(params.MaxCheckpointLength) {
+		result = uint64(length)
+	}
+	if result < params.AvgCheckpointLength {
+		result = params.AvgCheckpointLength
+	}
+
+	p.logger.Debug("Adaptive checkpoint length",
+		"gasPrice", gasPrice,
+		"gasFactor", gasFactor,
+		"throughputFactor", throughputFactor,
+		"length", result,
+	)
+
+	return result
+}
+
+func averageGasPrice(gasPrices []*big.Int) *big.Int {
+	if len(gasPrices) == 0 {
+		return nil
+	}
+
+	total := big.NewInt(0)
+	for _, gasPrice := range gasPrices {
+		total.Add(total, gasPrice)
+	}
+
+	return total.Div(total, big.NewInt(int64(len(gasPrices))))
+}
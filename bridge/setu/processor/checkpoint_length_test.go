@@ -0,0 +1,72 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+)
+
+type fakeGasPricer struct {
+	gasPrice *big.Int
+}
+
+func (f *fakeGasPricer) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return f.gasPrice, nil
+}
+
+func TestNewCheckpointLengthPolicy(t *testing.T) {
+	policy, err := NewCheckpointLengthPolicy("", nil, log.NewNopLogger())
+	require.NoError(t, err)
+	require.IsType(t, staticCheckpointLengthPolicy{}, policy)
+
+	policy, err = NewCheckpointLengthPolicy(AdaptiveCheckpointLengthPolicy, nil, log.NewNopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &adaptiveCheckpointLengthPolicy{}, policy)
+
+	_, err = NewCheckpointLengthPolicy("unknown", nil, log.NewNopLogger())
+	require.Error(t, err)
+}
+
+func TestStaticCheckpointLengthPolicy(t *testing.T) {
+	params := &checkpointTypes.Params{AvgCheckpointLength: 256, MaxCheckpointLength: 1024}
+
+	policy := staticCheckpointLengthPolicy{}
+	policy.ObserveChildHeader(&types.Header{GasLimit: 100})
+	require.Equal(t, uint64(256), policy.CheckpointLength(params))
+}
+
+func TestAdaptiveCheckpointLengthPolicy(t *testing.T) {
+	params := &checkpointTypes.Params{AvgCheckpointLength: 256, MaxCheckpointLength: 1024}
+	gasPricer := &fakeGasPricer{gasPrice: big.NewInt(100)}
+	policy := newAdaptiveCheckpointLengthPolicy(gasPricer, log.NewNopLogger())
+
+	// no history yet
+	require.Equal(t, uint64(256), policy.CheckpointLength(params))
+
+	// busy child chain, gas price at average
+	policy.ObserveChildHeader(&types.Header{GasUsed: 100, GasLimit: 100})
+	require.Equal(t, uint64(256), policy.CheckpointLength(params))
+
+	// gas spike doubles length
+	gasPricer.gasPrice = big.NewInt(200)
+	require.Equal(t, uint64(512), policy.CheckpointLength(params))
+
+	// gas drop never shrinks below avg length
+	gasPricer.gasPrice = big.NewInt(10)
+	require.Equal(t, uint64(256), policy.CheckpointLength(params))
+
+	// quiet child chain: average utilisation 0.5 -> 1.5x
+	gasPricer.gasPrice = big.NewInt(1)
+	policy.ObserveChildHeader(&types.Header{GasUsed: 0, GasLimit: 100})
+	require.Equal(t, uint64(384), policy.CheckpointLength(params))
+
+	// capped with max checkpoint length
+	gasPricer.gasPrice = big.NewInt(100000)
+	require.Equal(t, uint64(1024), policy.CheckpointLength(params))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
@@ -37,9 +38,12 @@ func (cp *ClerkProcessor) Start() error {
 // RegisterTasks - Registers clerk related tasks with machinery
 func (cp *ClerkProcessor) RegisterTasks() {
 	cp.Logger.Info("Registering clerk tasks")
-	if err := cp.queueConnector.Server.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
+	if err := cp.queueConnector.RegisterTask("sendStateSyncedToHeimdall", cp.sendStateSyncedToHeimdall); err != nil {
 		cp.Logger.Error("RegisterTasks | sendStateSyncedToHeimdall", "error", err)
 	}
+	if err := cp.queueConnector.RegisterTask("sendStateSyncedBatchToHeimdall", cp.sendStateSyncedBatchToHeimdall); err != nil {
+		cp.Logger.Error("RegisterTasks | sendStateSyncedBatchToHeimdall", "error", err)
+	}
 }
 
 // HandleStateSyncEvent - handle state sync event from rootchain
@@ -57,57 +61,100 @@ func (cp *ClerkProcessor) sendStateSyncedToHeimdall(eventName string, logBytes s
 		return err
 	}
 
-	chainParams := params.ChainmanagerParams.ChainParams
+	msg := cp.createEventRecordMsg(eventName, vLog, params.ChainmanagerParams.ChainParams.BorChainID)
+	if msg == nil {
+		return nil
+	}
+
+	// return broadcast to heimdall
+	if err := cp.txBroadcaster.BroadcastToHeimdall(msg); err != nil {
+		cp.Logger.Error("Error while broadcasting clerk Record to heimdall", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// sendStateSyncedBatchToHeimdall - handle batch of state sync events from rootchain
+// and broadcast records which are not processed yet in single transaction to heimdall
+func (cp *ClerkProcessor) sendStateSyncedBatchToHeimdall(eventName string, logsBytes string) error {
+	var vLogs []types.Log
+	if err := json.Unmarshal([]byte(logsBytes), &vLogs); err != nil {
+		cp.Logger.Error("Error while unmarshalling events from rootchain", "error", err)
+		return err
+	}
+
+	params, err := cp.paramsContext.GetParams()
+	if err != nil {
+		return err
+	}
+
+	msgs := make([]sdk.Msg, 0, len(vLogs))
+	for _, vLog := range vLogs {
+		if msg := cp.createEventRecordMsg(eventName, vLog, params.ChainmanagerParams.ChainParams.BorChainID); msg != nil {
+			msgs = append(msgs, msg)
+		}
+	}
 
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	// return broadcast to heimdall
+	if err := cp.txBroadcaster.BroadcastMsgsToHeimdall(msgs); err != nil {
+		cp.Logger.Error("Error while broadcasting clerk Records to heimdall", "records", len(msgs), "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// createEventRecordMsg returns event record msg for state synced log, nil if log is invalid or already processed
+func (cp *ClerkProcessor) createEventRecordMsg(eventName string, vLog types.Log, borChainID string) sdk.Msg {
 	event := new(statesender.StatesenderStateSynced)
 	if err := helper.UnpackLog(cp.stateSenderAbi, event, eventName, &vLog); err != nil {
 		cp.Logger.Error("Error while parsing event", "name", eventName, "error", err)
-	} else {
-		if isOld, _ := cp.isOldTx(cp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
-			cp.Logger.Info("Ignoring task to send deposit to heimdall as already processed",
-				"event", eventName,
-				"id", event.Id,
-				"contract", event.ContractAddress,
-				"data", hex.EncodeToString(event.Data),
-				"borChainId", chainParams.BorChainID,
-				"txHash", hmCommonTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				"logIndex", uint64(vLog.Index),
-				"blockNumber", vLog.BlockNumber,
-			)
-			return nil
-		}
+		return nil
+	}
 
-		cp.Logger.Debug(
-			"⬜ New event found",
+	if isOld, _ := cp.isOldTx(cp.cliCtx, vLog.TxHash.String(), uint64(vLog.Index)); isOld {
+		cp.Logger.Info("Ignoring task to send deposit to heimdall as already processed",
 			"event", eventName,
 			"id", event.Id,
 			"contract", event.ContractAddress,
 			"data", hex.EncodeToString(event.Data),
-			"borChainId", chainParams.BorChainID,
+			"borChainId", borChainID,
 			"txHash", hmCommonTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 			"logIndex", uint64(vLog.Index),
 			"blockNumber", vLog.BlockNumber,
 		)
-
-		msg := clerkTypes.NewMsgEventRecord(
-			helper.GetAddress(),
-			hmCommonTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-			uint64(vLog.Index),
-			vLog.BlockNumber,
-			event.Id.Uint64(),
-			event.ContractAddress.Bytes(),
-			event.Data,
-			chainParams.BorChainID,
-		)
-
-		// return broadcast to heimdall
-		if err := cp.txBroadcaster.BroadcastToHeimdall(&msg); err != nil {
-			cp.Logger.Error("Error while broadcasting clerk Record to heimdall", "error", err)
-			return err
-		}
+		return nil
 	}
 
-	return nil
+	cp.Logger.Debug(
+		"⬜ New event found",
+		"event", eventName,
+		"id", event.Id,
+		"contract", event.ContractAddress,
+		"data", hex.EncodeToString(event.Data),
+		"borChainId", borChainID,
+		"txHash", hmCommonTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		"logIndex", uint64(vLog.Index),
+		"blockNumber", vLog.BlockNumber,
+	)
+
+	msg := clerkTypes.NewMsgEventRecord(
+		helper.GetAddress(),
+		hmCommonTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+		event.Id.Uint64(),
+		event.ContractAddress.Bytes(),
+		event.Data,
+		borChainID,
+	)
+
+	return &msg
 }
 
 // isOldTx  checks if tx is already processed or not
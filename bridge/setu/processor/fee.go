@@ -39,7 +39,7 @@ func (fp *FeeProcessor) Start() error {
 // RegisterTasks - Registers clerk related tasks with machinery
 func (fp *FeeProcessor) RegisterTasks() {
 	fp.Logger.Info("Registering fee related tasks")
-	if err := fp.queueConnector.Server.RegisterTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
+	if err := fp.queueConnector.RegisterTask("sendTopUpFeeToHeimdall", fp.sendTopUpFeeToHeimdall); err != nil {
 		fp.Logger.Error("RegisterTasks | sendTopUpFeeToHeimdall", "error", err)
 	}
 }
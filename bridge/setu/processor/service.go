@@ -55,6 +55,11 @@ func NewProcessorService(
 	// initialize checkpoint processor
 	checkpointProcessor := NewCheckpointProcessor(&contractCaller.RootChainABI)
 	checkpointProcessor.BaseProcessor = *NewBaseProcessor(cliCtx, queueConnector, httpClient, txBroadcaster, paramsContext, "checkpoint", checkpointProcessor)
+	checkpointLengthPolicy, err := NewCheckpointLengthPolicy(helper.GetConfig().CheckpointLengthPolicy, contractCaller.MainChainClient, checkpointProcessor.Logger)
+	if err != nil {
+		panic(err)
+	}
+	checkpointProcessor.lengthPolicy = checkpointLengthPolicy
 
 	// initialize fee processor
 	feeProcessor := NewFeeProcessor(&contractCaller.StakingInfoABI)
@@ -73,8 +78,8 @@ func NewProcessorService(
 	spanProcessor.BaseProcessor = *NewBaseProcessor(cliCtx, queueConnector, httpClient, txBroadcaster, paramsContext, "span", spanProcessor)
 
 	// initialize slashing processor
-	//slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
-	//slashingProcessor.BaseProcessor = *NewBaseProcessor(cliCtx, queueConnector, httpClient, txBroadcaster, paramsContext, "slashing", slashingProcessor)
+	slashingProcessor := NewSlashingProcessor(&contractCaller.StakingInfoABI)
+	slashingProcessor.BaseProcessor = *NewBaseProcessor(cliCtx, queueConnector, httpClient, txBroadcaster, paramsContext, "slashing", slashingProcessor)
 
 	//
 	// Select processors
@@ -105,8 +110,8 @@ func NewProcessorService(
 				processorService.processors = append(processorService.processors, feeProcessor)
 			case "span":
 				processorService.processors = append(processorService.processors, spanProcessor)
-				//case "slashing":
-				//	processorService.processors = append(processorService.processors, nil)
+			case "slashing":
+				processorService.processors = append(processorService.processors, slashingProcessor)
 			}
 		}
 	}
@@ -25,9 +25,12 @@ func (sp *SpanProcessor) Start() error {
 // RegisterTasks
 func (sp *SpanProcessor) RegisterTasks() {
 	sp.Logger.Info("Registering span tasks")
-	if err := sp.queueConnector.Server.RegisterTask("sendSpanToHeimdall", sp.sendSpanToHeimdall); err != nil {
+	if err := sp.queueConnector.RegisterTask("sendSpanToHeimdall", sp.sendSpanToHeimdall); err != nil {
 		sp.Logger.Error("RegisterTasks | sendSpanToHeimdall", "error", err)
 	}
+	if err := sp.queueConnector.RegisterTask("sendMissedSprintToHeimdall", sp.sendMissedSprintToHeimdall); err != nil {
+		sp.Logger.Error("RegisterTasks | sendMissedSprintToHeimdall", "error", err)
+	}
 }
 
 // HandleSendSpanTask - handle send span task
@@ -87,6 +90,102 @@ func (sp *SpanProcessor) sendSpanToHeimdall(headerBlockStr string) error {
 	return nil
 }
 
+// sendMissedSprintToHeimdall - handle missed sprint task
+// 1. check if in-turn producer missed sprint started by header
+// 2. report missed sprint, or replace producer once it missed enough sprints in span
+func (sp *SpanProcessor) sendMissedSprintToHeimdall(headerBlockStr string) error {
+	var header = types.Header{}
+	if err := header.UnmarshalJSON([]byte(headerBlockStr)); err != nil {
+		sp.Logger.Error("Error while unmarshalling the header block", "error", err)
+		return err
+	}
+
+	// only current proposer reports missed sprints
+	isProposer, err := util.IsProposer(sp.cliCtx)
+	if err != nil || !isProposer {
+		return err
+	}
+
+	// fetch span covering header, last span may already be the next one
+	span, err := util.GetLastSpan(sp.cliCtx)
+	if err != nil || span == nil {
+		sp.Logger.Error("Error while fetching last span", "error", err)
+		return err
+	}
+	if header.Number.Uint64() < span.StartBlock && span.ID > 0 {
+		if span, err = util.GetSpan(sp.cliCtx, span.ID-1); err != nil {
+			return err
+		}
+	}
+	if header.Number.Uint64() < span.StartBlock || header.Number.Uint64() > span.EndBlock {
+		sp.Logger.Debug("No span found for sprint", "sprintStartBlock", header.Number)
+		return nil
+	}
+
+	// producers of span at sprint, earlier replacements included
+	producers, err := util.GetSpanProducers(sp.cliCtx, span.ID, header.Number.Uint64())
+	if err != nil {
+		return err
+	}
+
+	producerID, missed, err := borTypes.GetMissedSprintProducer(&header, producers)
+	if err != nil {
+		sp.Logger.Error("Error while finding in-turn producer of sprint", "sprintStartBlock", header.Number, "error", err)
+		return err
+	}
+	if !missed {
+		return nil
+	}
+
+	params, err := util.GetBorParams(sp.cliCtx)
+	if err != nil {
+		return err
+	}
+	downtimes, err := util.GetProducerDowntimes(sp.cliCtx, span.ID)
+	if err != nil {
+		return err
+	}
+
+	var missedSprints uint64
+	for _, downtime := range downtimes {
+		if downtime.ValidatorId == producerID.Uint64() {
+			missedSprints = downtime.MissedSprints
+		}
+	}
+
+	if params.MissedSprintsThreshold > 0 && missedSprints >= params.MissedSprintsThreshold {
+		// replacement takes over from next sprint after current child block
+		childBlock, err := sp.contractConnector.GetMaticChainBlock(nil)
+		if err != nil {
+			sp.Logger.Error("Error while fetching current child block", "error", err)
+			return err
+		}
+		startBlock := (childBlock.Number.Uint64()/params.Sprint + 1) * params.Sprint
+		if startBlock > span.EndBlock {
+			sp.Logger.Debug("No sprint left in span to replace producer", "spanId", span.ID, "producerId", producerID)
+			return nil
+		}
+
+		sp.Logger.Info("✅ Replacing producer", "spanId", span.ID, "producerId", producerID, "missedSprints", missedSprints, "startBlock", startBlock)
+		msg := borTypes.NewMsgReplaceProducer(helper.GetAddressStr(), span.ID, producerID.Uint64(), startBlock, span.BorChainId)
+		if err := sp.txBroadcaster.BroadcastToHeimdall(&msg); err != nil {
+			sp.Logger.Error("Error while broadcasting replace producer to heimdall", "spanId", span.ID, "producerId", producerID, "error", err)
+			return err
+		}
+
+		return nil
+	}
+
+	sp.Logger.Info("✅ Reporting missed sprint", "spanId", span.ID, "sprintStartBlock", header.Number, "producerId", producerID)
+	msg := borTypes.NewMsgReportMissedSprint(helper.GetAddressStr(), span.ID, header.Number.Uint64(), producerID.Uint64(), span.BorChainId)
+	if err := sp.txBroadcaster.BroadcastToHeimdall(&msg); err != nil {
+		sp.Logger.Error("Error while broadcasting missed sprint to heimdall", "spanId", span.ID, "sprintStartBlock", header.Number, "error", err)
+		return err
+	}
+
+	return nil
+}
+
 // fetchNextSpanSeed - fetches seed for next span
 func (sp *SpanProcessor) fetchNextSpanSeed() (nextSpanSeed common.Hash, err error) {
 	sp.Logger.Debug("Sending Rest call to Get Seed for next span")
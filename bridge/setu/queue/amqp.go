@@ -0,0 +1,138 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/RichardKnop/machinery/v1"
+	"github.com/RichardKnop/machinery/v1/config"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/streadway/amqp"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+// AMQPBackend runs tasks with machinery using an AMQP broker
+type AMQPBackend struct {
+	logger log.Logger
+	server *machinery.Server
+	worker *machinery.Worker
+}
+
+var _ Backend = (*AMQPBackend)(nil)
+
+// NewAMQPBackend creates machinery backed queue
+func NewAMQPBackend(dialer string) *AMQPBackend {
+	// amqp dialer
+	_, err := amqp.Dial(dialer)
+	if err != nil {
+		panic(err)
+	}
+
+	var cnf = &config.Config{
+		Broker:        dialer,
+		DefaultQueue:  QueueName,
+		ResultBackend: dialer,
+		AMQP: &config.AMQPConfig{
+			Exchange:     "machinery_exchange",
+			ExchangeType: "direct",
+			BindingKey:   "machinery_task",
+		},
+	}
+
+	server, err := machinery.NewServer(cnf)
+	if err != nil {
+		fmt.Printf("error while starting the new server %v error ", err)
+	}
+
+	return &AMQPBackend{
+		logger: util.Logger().With("module", "AMQPBackend"),
+		server: server,
+	}
+}
+
+// RegisterTask registers task with machinery server
+func (b *AMQPBackend) RegisterTask(name string, taskFunc interface{}) error {
+	if err := tasks.ValidateTask(taskFunc); err != nil {
+		return err
+	}
+
+	return b.server.RegisterTask(name, withTaskStatus(name, taskFunc))
+}
+
+// SendTask publishes task to AMQP broker
+func (b *AMQPBackend) SendTask(signature *tasks.Signature) error {
+	_, err := b.server.SendTask(signature)
+	return err
+}
+
+// StartWorker starts machinery worker
+func (b *AMQPBackend) StartWorker() {
+	b.worker = b.server.NewWorker("invoke-processor", 10)
+	b.logger.Info("Starting machinery worker")
+	errors := make(chan error)
+	b.worker.LaunchAsync(errors)
+}
+
+// Stop stops machinery worker
+func (b *AMQPBackend) Stop() {
+	if b.worker != nil {
+		b.worker.Quit()
+	}
+}
+
+// withTaskStatus wraps task function to record its outcome. Wrapper takes context as first
+// argument so that machinery passes task signature, which tells whether task will be retried.
+func withTaskStatus(name string, taskFunc interface{}) interface{} {
+	fn := reflect.ValueOf(taskFunc)
+	fnType := fn.Type()
+	contextType := reflect.TypeOf((*context.Context)(nil)).Elem()
+
+	hasContext := fnType.NumIn() > 0 && tasks.IsContextType(fnType.In(0))
+
+	in := make([]reflect.Type, 0, fnType.NumIn()+1)
+	if !hasContext {
+		in = append(in, contextType)
+	}
+	for i := 0; i < fnType.NumIn(); i++ {
+		in = append(in, fnType.In(i))
+	}
+
+	out := make([]reflect.Type, fnType.NumOut())
+	for i := range out {
+		out[i] = fnType.Out(i)
+	}
+
+	wrapperType := reflect.FuncOf(in, out, fnType.IsVariadic())
+
+	return reflect.MakeFunc(wrapperType, func(args []reflect.Value) []reflect.Value {
+		signature := tasks.SignatureFromContext(args[0].Interface().(context.Context))
+
+		callArgs := args
+		if !hasContext {
+			callArgs = args[1:]
+		}
+
+		var results []reflect.Value
+		if fnType.IsVariadic() {
+			results = fn.CallSlice(callArgs)
+		} else {
+			results = fn.Call(callArgs)
+		}
+
+		err, _ := results[len(results)-1].Interface().(error)
+		switch {
+		case err == nil:
+			status.IncTask(name, status.TaskSucceeded)
+		case signature != nil && signature.RetryCount > 0:
+			status.IncTask(name, status.TaskRetried)
+		default:
+			status.IncTask(name, status.TaskFailed)
+		}
+
+		return results
+	}).Interface()
+}
@@ -0,0 +1,46 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
+)
+
+func TestWithTaskStatus(t *testing.T) {
+	name := "testAMQPTaskStatus"
+	fail := true
+	taskFunc := func(eventBytes string, blockHeight int64) error {
+		require.Equal(t, "event", eventBytes)
+		require.Equal(t, int64(10), blockHeight)
+
+		if fail {
+			return errors.New("failed")
+		}
+		return nil
+	}
+
+	wrapped := withTaskStatus(name, taskFunc)
+	require.NoError(t, tasks.ValidateTask(wrapped))
+
+	call := func(retryCount int) {
+		signature := newTestSignature(name, "event", 10)
+		signature.RetryCount = retryCount
+
+		task, err := tasks.NewWithSignature(wrapped, signature)
+		require.NoError(t, err)
+
+		_, _ = task.Call()
+	}
+
+	// machinery retries while retry count is left
+	call(3)
+	call(0)
+	fail = false
+	call(3)
+
+	require.Equal(t, status.TaskStatus{Succeeded: 1, Failed: 1, Retried: 1}, status.GetRecorder().Snapshot().Tasks[name])
+}
@@ -3,62 +3,95 @@ package queue
 import (
 	"fmt"
 
-	"github.com/streadway/amqp"
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
 
-	"github.com/RichardKnop/machinery/v1"
-	"github.com/RichardKnop/machinery/v1/config"
-
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
 	"github.com/maticnetwork/heimdall/bridge/setu/util"
 )
 
-type QueueConnector struct {
-	logger log.Logger
-	Server *machinery.Server
-}
-
 const (
 	// machinery task queue
 	QueueName = "machinery_tasks"
+
+	// AMQPBackendType uses machinery on top of an external AMQP broker
+	AMQPBackendType = "amqp"
+	// LevelDBBackendType uses embedded queue persisted in bridge db
+	LevelDBBackendType = "leveldb"
 )
 
-func NewQueueConnector(dialer string) *QueueConnector {
-	// amqp dialer
-	_, err := amqp.Dial(dialer)
-	if err != nil {
-		panic(err)
-	}
+// Backend represents task queue implementation used to pass tasks from listeners to processors
+type Backend interface {
+	RegisterTask(name string, taskFunc interface{}) error
+	SendTask(signature *tasks.Signature) error
+	StartWorker()
+	Stop()
+}
 
-	var cnf = &config.Config{
-		Broker:        dialer,
-		DefaultQueue:  QueueName,
-		ResultBackend: dialer,
-		AMQP: &config.AMQPConfig{
-			Exchange:     "machinery_exchange",
-			ExchangeType: "direct",
-			BindingKey:   "machinery_task",
-		},
-	}
+type QueueConnector struct {
+	logger  log.Logger
+	backend Backend
+}
 
-	server, err := machinery.NewServer(cnf)
-	if err != nil {
-		fmt.Printf("error while starting the new server %v error ", err)
+// NewQueueConnector creates queue connector with selected backend type
+func NewQueueConnector(backendType string, dialer string) *QueueConnector {
+	var backend Backend
+
+	switch backendType {
+	case "", AMQPBackendType:
+		backend = NewAMQPBackend(dialer)
+	case LevelDBBackendType:
+		backend = NewLevelDBBackend(util.GetBridgeDBInstance(viper.GetString(util.BridgeDBFlag)))
+	default:
+		panic(fmt.Sprintf("unknown queue backend %s", backendType))
 	}
 
+	return NewQueueConnectorWithBackend(backend)
+}
+
+// NewQueueConnectorWithBackend creates queue connector using given backend
+func NewQueueConnectorWithBackend(backend Backend) *QueueConnector {
 	// queue connector
 	connector := QueueConnector{
-		logger: util.Logger().With("module", "QueueConnector"),
-		Server: server,
+		logger:  util.Logger().With("module", "QueueConnector"),
+		backend: backend,
 	}
 
 	// connector
 	return &connector
 }
 
+// RegisterTask - registers task handler with name
+func (qc *QueueConnector) RegisterTask(name string, taskFunc interface{}) error {
+	if err := qc.backend.RegisterTask(name, taskFunc); err != nil {
+		return err
+	}
+
+	status.RegisterTask(name)
+
+	return nil
+}
+
+// SendTask - sends task to queue
+func (qc *QueueConnector) SendTask(signature *tasks.Signature) error {
+	if err := qc.backend.SendTask(signature); err != nil {
+		return err
+	}
+
+	status.IncTask(signature.Name, status.TaskQueued)
+
+	return nil
+}
+
 // StartWorker - starts worker to process registered tasks
 func (qc *QueueConnector) StartWorker() {
-	worker := qc.Server.NewWorker("invoke-processor", 10)
-	qc.logger.Info("Starting machinery worker")
-	errors := make(chan error)
-	worker.LaunchAsync(errors)
+	qc.logger.Info("Starting queue worker")
+	qc.backend.StartWorker()
+}
+
+// Stop - stops worker
+func (qc *QueueConnector) Stop() {
+	qc.logger.Info("Stopping queue worker")
+	qc.backend.Stop()
 }
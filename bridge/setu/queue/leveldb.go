@@ -0,0 +1,356 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/pborman/uuid"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/status"
+	bridgeUtil "github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	// LevelDBTaskPrefix is key prefix of pending tasks stored in bridge db
+	LevelDBTaskPrefix = "queue-task-"
+
+	// key layout: prefix | zero padded eta (unix nano) | "-" | task uuid
+	etaKeyLength = 20
+
+	defaultWorkerConcurrency = 10
+	defaultPollInterval      = 1 * time.Second
+	defaultRetryDelay        = 5 * time.Second
+	maxRetryDelay            = 5 * time.Minute
+)
+
+// storedTask is persisted representation of a queued task
+type storedTask struct {
+	Signature *tasks.Signature `json:"signature"`
+	Attempts  int              `json:"attempts"`
+}
+
+// LevelDBBackend is an embedded durable task queue persisted in bridge db.
+// Tasks are ordered by ETA and only removed after they are processed,
+// so pending tasks survive restarts and are delivered at least once.
+type LevelDBBackend struct {
+	logger log.Logger
+	db     *leveldb.DB
+
+	concurrency  int
+	pollInterval time.Duration
+	retryDelay   time.Duration
+
+	mu       sync.Mutex
+	handlers map[string]interface{}
+	inFlight map[string]bool
+
+	wake     chan struct{}
+	quit     chan struct{}
+	started  bool
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+var _ Backend = (*LevelDBBackend)(nil)
+
+// NewLevelDBBackend creates embedded queue on top of given leveldb instance
+func NewLevelDBBackend(db *leveldb.DB) *LevelDBBackend {
+	return &LevelDBBackend{
+		logger:       bridgeUtil.Logger().With("module", "LevelDBBackend"),
+		db:           db,
+		concurrency:  defaultWorkerConcurrency,
+		pollInterval: defaultPollInterval,
+		retryDelay:   defaultRetryDelay,
+		handlers:     make(map[string]interface{}),
+		inFlight:     make(map[string]bool),
+		wake:         make(chan struct{}, 1),
+		quit:         make(chan struct{}),
+	}
+}
+
+// RegisterTask registers task handler with name
+func (b *LevelDBBackend) RegisterTask(name string, taskFunc interface{}) error {
+	if err := tasks.ValidateTask(taskFunc); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.handlers[name] = taskFunc
+	b.mu.Unlock()
+
+	// tasks persisted before registration may be due now
+	b.notify()
+
+	return nil
+}
+
+// SendTask persists task in bridge db
+func (b *LevelDBBackend) SendTask(signature *tasks.Signature) error {
+	if signature.UUID == "" {
+		signature.UUID = fmt.Sprintf("task_%v", uuid.New())
+	}
+
+	eta := time.Now()
+	if signature.ETA != nil {
+		eta = *signature.ETA
+	}
+
+	if err := b.putTask(nil, eta, &storedTask{Signature: signature}); err != nil {
+		return err
+	}
+
+	b.notify()
+
+	return nil
+}
+
+// StartWorker starts polling for due tasks
+func (b *LevelDBBackend) StartWorker() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.started {
+		return
+	}
+	b.started = true
+
+	b.wg.Add(1)
+	go b.run()
+}
+
+// Stop stops worker and waits for in-flight tasks
+func (b *LevelDBBackend) Stop() {
+	b.stopOnce.Do(func() {
+		close(b.quit)
+	})
+	b.wg.Wait()
+}
+
+// PendingTasks returns number of tasks stored in queue
+func (b *LevelDBBackend) PendingTasks() int {
+	count := 0
+	iter := b.db.NewIterator(util.BytesPrefix([]byte(LevelDBTaskPrefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		count++
+	}
+
+	return count
+}
+
+// PurgeLevelDBTasks removes all pending tasks from bridge db
+func PurgeLevelDBTasks(db *leveldb.DB) error {
+	batch := new(leveldb.Batch)
+	iter := db.NewIterator(util.BytesPrefix([]byte(LevelDBTaskPrefix)), nil)
+
+	for iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	iter.Release()
+
+	if err := iter.Error(); err != nil {
+		return err
+	}
+
+	return db.Write(batch, nil)
+}
+
+func (b *LevelDBBackend) run() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+
+	slots := make(chan struct{}, b.concurrency)
+
+	for {
+		b.dispatchDueTasks(slots)
+
+		select {
+		case <-b.quit:
+			return
+		case <-ticker.C:
+		case <-b.wake:
+		}
+	}
+}
+
+// dispatchDueTasks starts processing of all tasks with ETA in past, bounded by free worker slots
+func (b *LevelDBBackend) dispatchDueTasks(slots chan struct{}) {
+	now := []byte(LevelDBTaskPrefix + etaKey(time.Now()))
+
+	iter := b.db.NewIterator(util.BytesPrefix([]byte(LevelDBTaskPrefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+
+		// tasks are ordered by eta
+		if bytes.Compare(key[:len(now)], now) > 0 {
+			return
+		}
+
+		var task storedTask
+		decoder := json.NewDecoder(bytes.NewReader(iter.Value()))
+		decoder.UseNumber()
+		if err := decoder.Decode(&task); err != nil || task.Signature == nil {
+			b.logger.Error("Error decoding queued task, dropping", "key", string(key), "error", err)
+			if err := b.db.Delete(key, nil); err != nil {
+				b.logger.Error("Error deleting queued task", "key", string(key), "error", err)
+			}
+			continue
+		}
+
+		b.mu.Lock()
+		taskFunc, registered := b.handlers[task.Signature.Name]
+		busy := b.inFlight[string(key)]
+		b.mu.Unlock()
+
+		// keep task until handler is registered
+		if !registered || busy {
+			continue
+		}
+
+		// iterator works on a snapshot, task might be completed by a worker meanwhile
+		if exists, err := b.db.Has(key, nil); err != nil || !exists {
+			continue
+		}
+
+		select {
+		case slots <- struct{}{}:
+		default:
+			// all workers are busy
+			return
+		}
+
+		b.mu.Lock()
+		b.inFlight[string(key)] = true
+		b.mu.Unlock()
+
+		b.wg.Add(1)
+		go func(key []byte, task storedTask) {
+			defer func() {
+				b.mu.Lock()
+				delete(b.inFlight, string(key))
+				b.mu.Unlock()
+
+				<-slots
+				b.wg.Done()
+				b.notify()
+			}()
+
+			b.process(key, taskFunc, &task)
+		}(key, task)
+	}
+}
+
+// process invokes task and removes, reschedules or drops it based on result
+func (b *LevelDBBackend) process(key []byte, taskFunc interface{}, task *storedTask) {
+	signature := task.Signature
+
+	err := invokeTask(taskFunc, signature)
+	if err == nil {
+		status.IncTask(signature.Name, status.TaskSucceeded)
+
+		if err := b.db.Delete(key, nil); err != nil {
+			b.logger.Error("Error deleting processed task", "taskName", signature.Name, "error", err)
+		}
+		return
+	}
+
+	if task.Attempts >= signature.RetryCount {
+		status.IncTask(signature.Name, status.TaskFailed)
+
+		b.logger.Error("Task failed, no retries left", "taskName", signature.Name, "uuid", signature.UUID, "attempts", task.Attempts+1, "error", err)
+		if err := b.db.Delete(key, nil); err != nil {
+			b.logger.Error("Error deleting failed task", "taskName", signature.Name, "error", err)
+		}
+		return
+	}
+
+	delay := b.retryBackoff(task.Attempts)
+	task.Attempts++
+
+	status.IncTask(signature.Name, status.TaskRetried)
+
+	b.logger.Info("Task failed, retrying later", "taskName", signature.Name, "uuid", signature.UUID, "attempt", task.Attempts, "retryIn", delay, "error", err)
+
+	if err := b.putTask(key, time.Now().Add(delay), task); err != nil {
+		b.logger.Error("Error rescheduling task", "taskName", signature.Name, "error", err)
+	}
+}
+
+// retryBackoff returns exponential delay for given attempt
+func (b *LevelDBBackend) retryBackoff(attempts int) time.Duration {
+	delay := b.retryDelay
+	for i := 0; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	return delay
+}
+
+// putTask stores task with given eta, atomically replacing old key if present
+func (b *LevelDBBackend) putTask(oldKey []byte, eta time.Time, task *storedTask) error {
+	eta = eta.UTC()
+	task.Signature.ETA = &eta
+
+	value, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+
+	batch := new(leveldb.Batch)
+	if oldKey != nil {
+		batch.Delete(oldKey)
+	}
+	batch.Put([]byte(LevelDBTaskPrefix+etaKey(eta)+"-"+task.Signature.UUID), value)
+
+	return b.db.Write(batch, nil)
+}
+
+func (b *LevelDBBackend) notify() {
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+}
+
+// invokeTask calls task function with signature args
+func invokeTask(taskFunc interface{}, signature *tasks.Signature) error {
+	task, err := tasks.NewWithSignature(taskFunc, signature)
+	if err != nil {
+		return err
+	}
+
+	_, err = task.Call()
+
+	return err
+}
+
+func etaKey(eta time.Time) string {
+	nano := eta.UnixNano()
+	if nano < 0 {
+		nano = 0
+	}
+
+	key := strconv.FormatInt(nano, 10)
+	for len(key) < etaKeyLength {
+		key = "0" + key
+	}
+
+	return key
+}
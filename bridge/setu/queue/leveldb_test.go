@@ -0,0 +1,178 @@
+package queue
+
+import (
+	"errors"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RichardKnop/machinery/v1/tasks"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func TestMain(m *testing.M) {
+	// bridge logger reads log level from viper
+	viper.Set("log_level", "info")
+
+	os.Exit(m.Run())
+}
+
+func newTestDB(t *testing.T) *leveldb.DB {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func newTestBackend(db *leveldb.DB) *LevelDBBackend {
+	backend := NewLevelDBBackend(db)
+	backend.pollInterval = 10 * time.Millisecond
+	backend.retryDelay = 10 * time.Millisecond
+
+	return backend
+}
+
+func newTestSignature(name string, eventBytes string, blockHeight int64) *tasks.Signature {
+	return &tasks.Signature{
+		Name: name,
+		Args: []tasks.Arg{
+			{Type: "string", Value: eventBytes},
+			{Type: "int64", Value: blockHeight},
+		},
+		RetryCount: 3,
+	}
+}
+
+func TestLevelDBBackendSendTask(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	backend := newTestBackend(db)
+	defer backend.Stop()
+
+	received := make(chan int64, 1)
+	require.NoError(t, backend.RegisterTask("sendBlockTask", func(eventBytes string, blockHeight int64) error {
+		require.Equal(t, "event", eventBytes)
+		received <- blockHeight
+		return nil
+	}))
+	backend.StartWorker()
+
+	require.NoError(t, backend.SendTask(newTestSignature("sendBlockTask", "event", 1<<40)))
+
+	select {
+	case blockHeight := <-received:
+		require.Equal(t, int64(1<<40), blockHeight)
+	case <-time.After(5 * time.Second):
+		t.Fatal("task was not processed")
+	}
+
+	require.Eventually(t, func() bool { return backend.PendingTasks() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestLevelDBBackendDelayedTask(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	backend := newTestBackend(db)
+	defer backend.Stop()
+
+	var processedAt atomic.Value
+	require.NoError(t, backend.RegisterTask("sendDelayedTask", func(eventBytes string, blockHeight int64) error {
+		processedAt.Store(time.Now())
+		return nil
+	}))
+	backend.StartWorker()
+
+	delay := 300 * time.Millisecond
+	signature := newTestSignature("sendDelayedTask", "event", 1)
+	eta := time.Now().Add(delay)
+	signature.ETA = &eta
+	require.NoError(t, backend.SendTask(signature))
+
+	require.Eventually(t, func() bool { return processedAt.Load() != nil }, 5*time.Second, 10*time.Millisecond)
+	require.False(t, processedAt.Load().(time.Time).Before(eta), "task processed before eta")
+}
+
+func TestLevelDBBackendRetry(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	backend := newTestBackend(db)
+	defer backend.Stop()
+
+	var calls int32
+	require.NoError(t, backend.RegisterTask("sendFailingTask", func(eventBytes string, blockHeight int64) error {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return errors.New("failed")
+		}
+		return nil
+	}))
+	backend.StartWorker()
+
+	require.NoError(t, backend.SendTask(newTestSignature("sendFailingTask", "event", 1)))
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&calls) == 3 && backend.PendingTasks() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// task without retries left is dropped
+	atomic.StoreInt32(&calls, -10)
+	require.NoError(t, backend.SendTask(newTestSignature("sendFailingTask", "event", 1)))
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&calls) == -6 && backend.PendingTasks() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestLevelDBBackendRestart(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+
+	// send task without any worker, simulating shutdown before processing
+	backend := newTestBackend(db)
+	require.NoError(t, backend.SendTask(newTestSignature("sendPersistedTask", "event", 7)))
+	backend.Stop()
+	require.Equal(t, 1, backend.PendingTasks())
+
+	// new backend picks up persisted task, even if worker starts before registration
+	restarted := newTestBackend(db)
+	defer restarted.Stop()
+	restarted.StartWorker()
+
+	received := make(chan int64, 1)
+	require.NoError(t, restarted.RegisterTask("sendPersistedTask", func(eventBytes string, blockHeight int64) error {
+		received <- blockHeight
+		return nil
+	}))
+
+	select {
+	case blockHeight := <-received:
+		require.Equal(t, int64(7), blockHeight)
+	case <-time.After(5 * time.Second):
+		t.Fatal("persisted task was not processed")
+	}
+
+	require.Eventually(t, func() bool { return restarted.PendingTasks() == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestPurgeLevelDBTasks(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t)
+	backend := newTestBackend(db)
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, backend.SendTask(newTestSignature("sendTask", "event", int64(i))))
+	}
+	require.Equal(t, 3, backend.PendingTasks())
+
+	require.NoError(t, PurgeLevelDBTasks(db))
+	require.Equal(t, 0, backend.PendingTasks())
+}
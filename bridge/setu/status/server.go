@@ -0,0 +1,82 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/setu/util"
+)
+
+const (
+	// StatusPath serves bridge status as JSON
+	StatusPath = "/status"
+	// MetricsPath serves bridge status as prometheus metrics
+	MetricsPath = "/metrics"
+
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server serves bridge status over HTTP
+type Server struct {
+	logger   log.Logger
+	recorder *Recorder
+	server   *http.Server
+}
+
+// NewServer creates status server listening on given address
+func NewServer(addr string, recorder *Recorder) *Server {
+	s := &Server{
+		logger:   util.Logger().With("module", "status"),
+		recorder: recorder,
+	}
+
+	s.server = &http.Server{
+		Addr:    addr,
+		Handler: s.Handler(),
+	}
+
+	return s
+}
+
+// Handler returns http handler serving status and metrics
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(StatusPath, s.handleStatus)
+	mux.Handle(MetricsPath, promhttp.HandlerFor(s.recorder.Registry(), promhttp.HandlerOpts{}))
+
+	return mux
+}
+
+// Start starts serving in background
+func (s *Server) Start() {
+	s.logger.Info("Starting status server", "addr", s.server.Addr)
+
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("Status server stopped", "error", err)
+		}
+	}()
+}
+
+// Stop gracefully shuts down server
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.logger.Error("Error stopping status server", "error", err)
+	}
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(s.recorder.Snapshot()); err != nil {
+		s.logger.Error("Error encoding status", "error", err)
+	}
+}
@@ -0,0 +1,331 @@
+package status
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// metrics namespace
+	namespace = "heimdall_bridge"
+
+	// task states
+	TaskQueued    = "queued"
+	TaskSucceeded = "succeeded"
+	TaskFailed    = "failed"
+	TaskRetried   = "retried"
+)
+
+// ListenerStatus represents block heights seen and processed by listener
+type ListenerStatus struct {
+	LastSeenBlock      uint64    `json:"last_seen_block"`
+	LastSeenAt         time.Time `json:"last_seen_at"`
+	LastProcessedBlock uint64    `json:"last_processed_block"`
+	LastProcessedAt    time.Time `json:"last_processed_at"`
+}
+
+// TaskStatus represents counters of task
+type TaskStatus struct {
+	Queued    uint64 `json:"queued"`
+	Succeeded uint64 `json:"succeeded"`
+	Failed    uint64 `json:"failed"`
+	Retried   uint64 `json:"retried"`
+}
+
+// BroadcasterStatus represents broadcaster sequence numbers
+type BroadcasterStatus struct {
+	HeimdallSequence uint64 `json:"heimdall_sequence"`
+	RootchainNonce   uint64 `json:"rootchain_nonce"`
+}
+
+// CheckpointStatus represents last checkpoint and no-ack times
+type CheckpointStatus struct {
+	LastCheckpointTime time.Time `json:"last_checkpoint_time"`
+	LastNoAckTime      time.Time `json:"last_no_ack_time"`
+}
+
+// Status represents bridge status
+type Status struct {
+	Listeners   map[string]ListenerStatus `json:"listeners"`
+	Tasks       map[string]TaskStatus     `json:"tasks"`
+	Broadcaster BroadcasterStatus         `json:"broadcaster"`
+	Checkpoint  CheckpointStatus          `json:"checkpoint"`
+}
+
+// Recorder collects bridge status and exposes it as prometheus metrics
+type Recorder struct {
+	mu     sync.RWMutex
+	status Status
+
+	registry *prometheus.Registry
+
+	listenerSeenBlock      *prometheus.GaugeVec
+	listenerSeenTime       *prometheus.GaugeVec
+	listenerProcessedBlock *prometheus.GaugeVec
+	listenerProcessedTime  *prometheus.GaugeVec
+	tasks                  *prometheus.CounterVec
+	heimdallSequence       prometheus.Gauge
+	rootchainNonce         prometheus.Gauge
+	lastCheckpointTime     prometheus.Gauge
+	lastNoAckTime          prometheus.Gauge
+}
+
+// NewRecorder creates recorder with its own prometheus registry
+func NewRecorder() *Recorder {
+	r := &Recorder{
+		status: Status{
+			Listeners: make(map[string]ListenerStatus),
+			Tasks:     make(map[string]TaskStatus),
+		},
+		registry: prometheus.NewRegistry(),
+		listenerSeenBlock: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "listener_last_seen_block",
+			Help:      "Latest block seen by listener",
+		}, []string{"listener"}),
+		listenerSeenTime: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "listener_last_seen_timestamp_seconds",
+			Help:      "Unix time when listener last saw a block",
+		}, []string{"listener"}),
+		listenerProcessedBlock: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "listener_last_processed_block",
+			Help:      "Last block processed by listener",
+		}, []string{"listener"}),
+		listenerProcessedTime: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "listener_last_processed_timestamp_seconds",
+			Help:      "Unix time when listener last processed a block",
+		}, []string{"listener"}),
+		tasks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "tasks_total",
+			Help:      "Number of tasks by task name and state",
+		}, []string{"task", "state"}),
+		heimdallSequence: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "broadcaster_heimdall_sequence",
+			Help:      "Next account sequence used by heimdall broadcaster",
+		}),
+		rootchainNonce: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "broadcaster_rootchain_nonce",
+			Help:      "Next nonce used by rootchain broadcaster",
+		}),
+		lastCheckpointTime: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_checkpoint_timestamp_seconds",
+			Help:      "Unix time of last checkpoint on rootchain",
+		}),
+		lastNoAckTime: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_no_ack_timestamp_seconds",
+			Help:      "Unix time of last checkpoint no-ack on heimdall",
+		}),
+	}
+
+	r.registry.MustRegister(
+		r.listenerSeenBlock,
+		r.listenerSeenTime,
+		r.listenerProcessedBlock,
+		r.listenerProcessedTime,
+		r.tasks,
+		r.heimdallSequence,
+		r.rootchainNonce,
+		r.lastCheckpointTime,
+		r.lastNoAckTime,
+	)
+
+	return r
+}
+
+// Registry returns prometheus registry of recorder
+func (r *Recorder) Registry() *prometheus.Registry {
+	return r.registry
+}
+
+// SetListenerSeenBlock records latest block seen by listener
+func (r *Recorder) SetListenerSeenBlock(listener string, block uint64) {
+	now := time.Now()
+
+	r.mu.Lock()
+	s := r.status.Listeners[listener]
+	s.LastSeenBlock = block
+	s.LastSeenAt = now
+	r.status.Listeners[listener] = s
+	r.mu.Unlock()
+
+	r.listenerSeenBlock.WithLabelValues(listener).Set(float64(block))
+	r.listenerSeenTime.WithLabelValues(listener).Set(float64(now.Unix()))
+}
+
+// SetListenerProcessedBlock records last block processed by listener
+func (r *Recorder) SetListenerProcessedBlock(listener string, block uint64) {
+	now := time.Now()
+
+	r.mu.Lock()
+	s := r.status.Listeners[listener]
+	s.LastProcessedBlock = block
+	s.LastProcessedAt = now
+	r.status.Listeners[listener] = s
+	r.mu.Unlock()
+
+	r.listenerProcessedBlock.WithLabelValues(listener).Set(float64(block))
+	r.listenerProcessedTime.WithLabelValues(listener).Set(float64(now.Unix()))
+}
+
+// RegisterTask initialises counters of task so that it is reported before first use
+func (r *Recorder) RegisterTask(name string) {
+	r.mu.Lock()
+	if _, ok := r.status.Tasks[name]; !ok {
+		r.status.Tasks[name] = TaskStatus{}
+	}
+	r.mu.Unlock()
+
+	for _, state := range []string{TaskQueued, TaskSucceeded, TaskFailed, TaskRetried} {
+		r.tasks.WithLabelValues(name, state)
+	}
+}
+
+// IncTask increments counter of task for given state
+func (r *Recorder) IncTask(name string, state string) {
+	r.mu.Lock()
+	s := r.status.Tasks[name]
+	switch state {
+	case TaskQueued:
+		s.Queued++
+	case TaskSucceeded:
+		s.Succeeded++
+	case TaskFailed:
+		s.Failed++
+	case TaskRetried:
+		s.Retried++
+	default:
+		r.mu.Unlock()
+		return
+	}
+	r.status.Tasks[name] = s
+	r.mu.Unlock()
+
+	r.tasks.WithLabelValues(name, state).Inc()
+}
+
+// SetHeimdallSequence records next account sequence of heimdall broadcaster
+func (r *Recorder) SetHeimdallSequence(seq uint64) {
+	r.mu.Lock()
+	r.status.Broadcaster.HeimdallSequence = seq
+	r.mu.Unlock()
+
+	r.heimdallSequence.Set(float64(seq))
+}
+
+// SetRootchainNonce records next nonce of rootchain broadcaster
+func (r *Recorder) SetRootchainNonce(nonce uint64) {
+	r.mu.Lock()
+	r.status.Broadcaster.RootchainNonce = nonce
+	r.mu.Unlock()
+
+	r.rootchainNonce.Set(float64(nonce))
+}
+
+// SetLastCheckpointTime records time of last checkpoint on rootchain
+func (r *Recorder) SetLastCheckpointTime(t time.Time) {
+	r.mu.Lock()
+	r.status.Checkpoint.LastCheckpointTime = t
+	r.mu.Unlock()
+
+	r.lastCheckpointTime.Set(float64(t.Unix()))
+}
+
+// SetLastNoAckTime records time of last checkpoint no-ack
+func (r *Recorder) SetLastNoAckTime(t time.Time) {
+	r.mu.Lock()
+	r.status.Checkpoint.LastNoAckTime = t
+	r.mu.Unlock()
+
+	r.lastNoAckTime.Set(float64(t.Unix()))
+}
+
+// Snapshot returns copy of current status
+func (r *Recorder) Snapshot() Status {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	snapshot := Status{
+		Listeners:   make(map[string]ListenerStatus, len(r.status.Listeners)),
+		Tasks:       make(map[string]TaskStatus, len(r.status.Tasks)),
+		Broadcaster: r.status.Broadcaster,
+		Checkpoint:  r.status.Checkpoint,
+	}
+
+	for name, s := range r.status.Listeners {
+		snapshot.Listeners[name] = s
+	}
+
+	for name, s := range r.status.Tasks {
+		snapshot.Tasks[name] = s
+	}
+
+	return snapshot
+}
+
+//
+// Global recorder used by bridge services
+//
+
+var (
+	recorder     *Recorder
+	recorderOnce sync.Once
+)
+
+// GetRecorder returns global recorder
+func GetRecorder() *Recorder {
+	recorderOnce.Do(func() {
+		recorder = NewRecorder()
+	})
+
+	return recorder
+}
+
+// SetListenerSeenBlock records latest block seen by listener on global recorder
+func SetListenerSeenBlock(listener string, block uint64) {
+	GetRecorder().SetListenerSeenBlock(listener, block)
+}
+
+// SetListenerProcessedBlock records last block processed by listener on global recorder
+func SetListenerProcessedBlock(listener string, block uint64) {
+	GetRecorder().SetListenerProcessedBlock(listener, block)
+}
+
+// RegisterTask initialises counters of task on global recorder
+func RegisterTask(name string) {
+	GetRecorder().RegisterTask(name)
+}
+
+// IncTask increments counter of task on global recorder
+func IncTask(name string, state string) {
+	GetRecorder().IncTask(name, state)
+}
+
+// SetHeimdallSequence records heimdall broadcaster sequence on global recorder
+func SetHeimdallSequence(seq uint64) {
+	GetRecorder().SetHeimdallSequence(seq)
+}
+
+// SetRootchainNonce records rootchain broadcaster nonce on global recorder
+func SetRootchainNonce(nonce uint64) {
+	GetRecorder().SetRootchainNonce(nonce)
+}
+
+// SetLastCheckpointTime records last checkpoint time on global recorder
+func SetLastCheckpointTime(t time.Time) {
+	GetRecorder().SetLastCheckpointTime(t)
+}
+
+// SetLastNoAckTime records last no-ack time on global recorder
+func SetLastNoAckTime(t time.Time) {
+	GetRecorder().SetLastNoAckTime(t)
+}
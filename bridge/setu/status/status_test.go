@@ -0,0 +1,99 @@
+package status
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	viper.Set("log_level", "info")
+	os.Exit(m.Run())
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	r := NewRecorder()
+
+	r.RegisterTask("sendCheckpointToHeimdall")
+	r.IncTask("sendCheckpointToHeimdall", TaskQueued)
+	r.IncTask("sendCheckpointToHeimdall", TaskQueued)
+	r.IncTask("sendCheckpointToHeimdall", TaskRetried)
+	r.IncTask("sendCheckpointToHeimdall", TaskSucceeded)
+	r.IncTask("sendCheckpointToHeimdall", TaskFailed)
+	r.IncTask("sendCheckpointToHeimdall", "unknown")
+
+	r.SetListenerSeenBlock("rootchain", 120)
+	r.SetListenerProcessedBlock("rootchain", 100)
+	r.SetHeimdallSequence(7)
+	r.SetRootchainNonce(3)
+	r.SetLastCheckpointTime(time.Unix(1000, 0))
+	r.SetLastNoAckTime(time.Unix(2000, 0))
+
+	s := r.Snapshot()
+	require.Equal(t, TaskStatus{Queued: 2, Succeeded: 1, Failed: 1, Retried: 1}, s.Tasks["sendCheckpointToHeimdall"])
+	require.Equal(t, uint64(120), s.Listeners["rootchain"].LastSeenBlock)
+	require.Equal(t, uint64(100), s.Listeners["rootchain"].LastProcessedBlock)
+	require.Equal(t, BroadcasterStatus{HeimdallSequence: 7, RootchainNonce: 3}, s.Broadcaster)
+	require.Equal(t, int64(1000), s.Checkpoint.LastCheckpointTime.Unix())
+	require.Equal(t, int64(2000), s.Checkpoint.LastNoAckTime.Unix())
+
+	// snapshot is not affected by later updates
+	r.IncTask("sendCheckpointToHeimdall", TaskQueued)
+	require.Equal(t, uint64(2), s.Tasks["sendCheckpointToHeimdall"].Queued)
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	r := NewRecorder()
+	r.RegisterTask("sendSpanToHeimdall")
+	r.RegisterTask("sendCheckpointToHeimdall")
+	r.IncTask("sendCheckpointToHeimdall", TaskSucceeded)
+	r.SetListenerSeenBlock("heimdall", 42)
+	r.SetHeimdallSequence(11)
+
+	server := httptest.NewServer(NewServer("", r).Handler())
+	defer server.Close()
+
+	// json status
+	resp, err := http.Get(server.URL + StatusPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var s Status
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&s))
+	require.Equal(t, uint64(42), s.Listeners["heimdall"].LastSeenBlock)
+	require.Equal(t, uint64(1), s.Tasks["sendCheckpointToHeimdall"].Succeeded)
+	require.Contains(t, s.Tasks, "sendSpanToHeimdall")
+	require.Equal(t, uint64(11), s.Broadcaster.HeimdallSequence)
+
+	// prometheus metrics, registered tasks are reported before first use
+	resp, err = http.Get(server.URL + MetricsPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	metrics := string(body)
+	for _, line := range []string{
+		`heimdall_bridge_listener_last_seen_block{listener="heimdall"} 42`,
+		`heimdall_bridge_tasks_total{state="succeeded",task="sendCheckpointToHeimdall"} 1`,
+		`heimdall_bridge_tasks_total{state="queued",task="sendSpanToHeimdall"} 0`,
+		`heimdall_bridge_broadcaster_heimdall_sequence 11`,
+	} {
+		require.True(t, strings.Contains(metrics, line), "missing metric %s", line)
+	}
+}
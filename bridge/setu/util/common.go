@@ -49,18 +49,22 @@ const (
 	LatestSpanURL          = "/heimdall/bor/v1beta1/latest-span"
 	NextSpanInfoURL        = "/heimdall/bor/v1beta1/prepare-next-span"
 	NextSpanSeedURL        = "/heimdall/bor/v1beta1/next-span-seed"
+	BorParamsURL           = "/heimdall/bor/v1beta1/params"
+	SpanURL                = "/heimdall/bor/v1beta1/span/%v"
+	ProducerDowntimeURL    = "/heimdall/bor/v1beta1/producer-downtime/%v"
+	SpanProducersURL       = "/heimdall/bor/v1beta1/span-producers/%v/%v"
 	DividendAccountRootURL = "/heimdall/topup/v1beta1/dividend-account-root"
 	ValidatorURL           = "/heimdall/staking/v1beta1/validator/%v"
 	CurrentValidatorSetURL = "/heimdall/staking/v1beta1/validator-set"
 	StakingTxStatusURL     = "/heimdall/staking/v1beta1/isoldtx"
 	TopupTxStatusURL       = "/heimdall/topup/v1beta1/isoldtx"
 	ClerkTxStatusURL       = "/heimdall/clerk/v1beta1/isoldtx"
-	// Todo: once slashing enabled uncomment this
-	//LatestSlashInfoBytesURL = "/slashing/latest_slash_info_bytes"
-	//TickSlashInfoListURL    = "/slashing/tick_slash_infos"
-	//SlashingTxStatusURL     = "/slashing/isoldtx"
-	//SlashingTickCountURL    = "/slashing/tick-count"
-	//
+
+	LatestSlashInfoBytesURL = "/heimdall/slashing/v1beta1/latest-slash-info-bytes"
+	TickSlashInfoListURL    = "/heimdall/slashing/v1beta1/tick-slash-infos"
+	SlashingTxStatusURL     = "/heimdall/slashing/v1beta1/isoldtx"
+	SlashingTickCountURL    = "/heimdall/slashing/v1beta1/tick-count"
+
 	//TransactionTimeout      = 1 * time.Minute
 	CommitTimeout           = 2 * time.Minute
 	BlockInterval           = 6 * time.Second
@@ -452,3 +456,71 @@ func GetLastSpan(cliCtx client.Context) (*types.Span, error) {
 	}
 	return lastSpan.Span, nil
 }
+
+// GetBorParams return bor params
+func GetBorParams(cliCtx client.Context) (*borTypes.QueryParamsResponse, error) {
+	response, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(BorParamsURL))
+	if err != nil {
+		logger.Error("Error fetching bor params", "err", err)
+		return nil, err
+	}
+
+	var params borTypes.QueryParamsResponse
+	if err := jsonpb.UnmarshalString(string(response), &params); err != nil {
+		logger.Error("Error unmarshalling bor params", "url", BorParamsURL, "err", err)
+		return nil, err
+	}
+
+	return &params, nil
+}
+
+// GetSpan returns span with id
+func GetSpan(cliCtx client.Context, id uint64) (*types.Span, error) {
+	result, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(fmt.Sprintf(SpanURL, id)))
+	if err != nil {
+		logger.Error("Error while fetching span", "spanId", id)
+		return nil, err
+	}
+
+	var span borTypes.QuerySpanResponse
+	if err = jsonpb.UnmarshalString(string(result), &span); err != nil {
+		logger.Error("Error unmarshalling span", "error", err)
+		return nil, err
+	}
+
+	return span.Span, nil
+}
+
+// GetProducerDowntimes returns sprints missed by producers of span
+func GetProducerDowntimes(cliCtx client.Context, spanID uint64) ([]borTypes.ProducerDowntime, error) {
+	result, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(fmt.Sprintf(ProducerDowntimeURL, spanID)))
+	if err != nil {
+		logger.Error("Error while fetching producer downtime", "spanId", spanID)
+		return nil, err
+	}
+
+	var downtime borTypes.QueryProducerDowntimeResponse
+	if err = jsonpb.UnmarshalString(string(result), &downtime); err != nil {
+		logger.Error("Error unmarshalling producer downtime", "error", err)
+		return nil, err
+	}
+
+	return downtime.Downtimes, nil
+}
+
+// GetSpanProducers returns producers of span at block, with producer replacements applied
+func GetSpanProducers(cliCtx client.Context, spanID uint64, block uint64) ([]types.Validator, error) {
+	result, err := helper.FetchFromAPI(helper.GetHeimdallServerEndpoint(fmt.Sprintf(SpanProducersURL, spanID, block)))
+	if err != nil {
+		logger.Error("Error while fetching span producers", "spanId", spanID, "block", block)
+		return nil, err
+	}
+
+	var producers borTypes.QuerySpanProducersResponse
+	if err = jsonpb.UnmarshalString(string(result), &producers); err != nil {
+		logger.Error("Error unmarshalling span producers", "error", err)
+		return nil, err
+	}
+
+	return producers.Producers, nil
+}
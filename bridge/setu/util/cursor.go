@@ -0,0 +1,44 @@
+package util
+
+import (
+	"strconv"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+const (
+	// RootchainLastBlockKey stores last rootchain block processed by rootchain listener
+	RootchainLastBlockKey = "rootchain-last-block"
+	// HeimdallLastBlockKey stores last heimdall block processed by heimdall listener
+	HeimdallLastBlockKey = "heimdall-last-block"
+
+	// RootchainMaxBlockRange is max number of blocks queried in single rootchain logs filter
+	RootchainMaxBlockRange = uint64(1000)
+)
+
+// GetLastBlock returns last processed block stored under cursor key
+func GetLastBlock(db *leveldb.DB, key string) (uint64, bool, error) {
+	value, err := db.Get([]byte(key), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	block, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return block, true, nil
+}
+
+// SetLastBlock stores last processed block under cursor key
+func SetLastBlock(db *leveldb.DB, key string, block uint64) error {
+	return db.Put([]byte(key), []byte(strconv.FormatUint(block, 10)), nil)
+}
+
+// DeleteLastBlock removes cursor key, listener starts from its default block afterwards
+func DeleteLastBlock(db *leveldb.DB, key string) error {
+	return db.Delete([]byte(key), nil)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"path"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// checkInvariantsCmd runs module invariants against local state
+func checkInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Check module invariants against local state offline",
+		Long: fmt.Sprintf(`Run all registered module invariants against latest state in node data dir and report broken ones.
+Stop the node before running it. Exits with error if any invariant is broken.
+
+Example:
+$ %s check-invariants --home ~/.heimdalld
+`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			home := viper.GetString(cli.HomeFlag)
+
+			db, err := sdk.NewLevelDB("application", path.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			happ := app.NewHeimdallApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, app.MakeEncodingConfig())
+			ctx := happ.NewContext(true, tmproto.Header{Height: happ.LastBlockHeight()})
+
+			broken := writeInvariantsReport(cmd.OutOrStdout(), ctx, &happ.InvariantRegistry)
+			if broken > 0 {
+				return fmt.Errorf("%d invariants broken at height %d", broken, happ.LastBlockHeight())
+			}
+
+			cmd.PrintErrln("All invariants hold at height", happ.LastBlockHeight())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "node's home directory")
+	return cmd
+}
+
+// writeInvariantsReport runs invariants in registry and writes status of each to w, returning number of broken invariants
+func writeInvariantsReport(w io.Writer, ctx sdk.Context, registry *app.InvariantRegistry) int {
+	broken := 0
+	for _, route := range registry.Routes() {
+		res, stop := route.Invar(ctx)
+		if !stop {
+			fmt.Fprintf(w, "OK      %s\n", route.FullRoute())
+			continue
+		}
+
+		broken++
+		fmt.Fprintf(w, "BROKEN  %s\n%s", route.FullRoute(), res)
+	}
+
+	return broken
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+)
+
+// initDataDir commits default genesis state to application db in home data dir
+func initDataDir(t *testing.T, home string) {
+	db, err := sdk.NewLevelDB("application", path.Join(home, "data"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	happ := app.NewHeimdallApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 0, app.MakeEncodingConfig())
+	stateBytes, err := json.Marshal(app.NewDefaultGenesisState())
+	require.NoError(t, err)
+
+	happ.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	happ.Commit()
+}
+
+func TestCheckInvariantsCmd(t *testing.T) {
+	home := t.TempDir()
+	initDataDir(t, home)
+
+	viper.Set(cli.HomeFlag, home)
+	defer viper.Set(cli.HomeFlag, "")
+
+	var out bytes.Buffer
+	cmd := checkInvariantsCmd()
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs([]string{})
+
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "OK      topup/dividend-account-root")
+	require.Contains(t, out.String(), "All invariants hold")
+	require.NotContains(t, out.String(), "BROKEN")
+}
+
+func TestWriteInvariantsReport(t *testing.T) {
+	happ := app.Setup(false)
+	ctx := happ.NewContext(true, tmproto.Header{})
+
+	var registry app.InvariantRegistry
+	registry.RegisterRoute("test", "holds", func(sdk.Context) (string, bool) { return "", false })
+	registry.RegisterRoute("test", "broken", func(sdk.Context) (string, bool) { return "\tbroken state\n", true })
+
+	var out bytes.Buffer
+	require.Equal(t, 1, writeInvariantsReport(&out, ctx, &registry))
+	require.Equal(t, "OK      test/holds\nBROKEN  test/broken\n\tbroken state\n", out.String())
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	checkpointKeeper "github.com/maticnetwork/heimdall/x/checkpoint/keeper"
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+)
+
+const flagOutput = "output-file"
+
+// exportCheckpointsCmd exports checkpoint history in local state as NDJSON
+func exportCheckpointsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-checkpoints",
+		Short: "Export checkpoint history as NDJSON for archival",
+		Long: fmt.Sprintf(`Export all checkpoints kept in local state as newline delimited JSON, one checkpoint per line in checkpoint number order.
+Run it before lowering checkpoint retention param to archive checkpoints which will be pruned.
+
+Example:
+$ %s export-checkpoints --output-file checkpoints.ndjson
+`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			home := viper.GetString(cli.HomeFlag)
+
+			db, err := sdk.NewLevelDB("application", path.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			happ := app.NewHeimdallApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, 5, app.MakeEncodingConfig())
+			ctx := happ.NewContext(true, tmproto.Header{Height: happ.LastBlockHeight()})
+
+			out := cmd.OutOrStdout()
+			if outputFile, _ := cmd.Flags().GetString(flagOutput); outputFile != "" {
+				file, err := os.Create(outputFile)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			count, err := writeCheckpointsNDJSON(out, happ.AppCodec(), ctx, happ.CheckpointKeeper)
+			if err != nil {
+				return err
+			}
+
+			cmd.PrintErrln("Exported checkpoints:", count)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, helper.DefaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagOutput, "", "file to write checkpoints to (default stdout)")
+	return cmd
+}
+
+// writeCheckpointsNDJSON writes checkpoints in state to w, one JSON object per line
+func writeCheckpointsNDJSON(w io.Writer, cdc codec.JSONMarshaler, ctx sdk.Context, keeper checkpointKeeper.Keeper) (uint64, error) {
+	writer := bufio.NewWriter(w)
+
+	var count uint64
+	var err error
+	keeper.IterateCheckpoints(ctx, func(checkpointNumber uint64, checkpoint hmTypes.Checkpoint) bool {
+		indexed := checkpointTypes.IndexedCheckpoint{
+			Number:     checkpointNumber,
+			Checkpoint: &checkpoint,
+		}
+		if txHash, ok := keeper.GetCheckpointTxHash(ctx, checkpointNumber); ok {
+			indexed.TxHash = txHash.String()
+		}
+
+		var line []byte
+		if line, err = cdc.MarshalJSON(&indexed); err != nil {
+			return true
+		}
+		if _, err = writer.Write(append(line, '\n')); err != nil {
+			return true
+		}
+
+		count++
+		return false
+	})
+	if err != nil {
+		return count, err
+	}
+
+	return count, writer.Flush()
+}
@@ -9,6 +9,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	borTypes "github.com/maticnetwork/heimdall/x/bor/types"
+	slashingTypes "github.com/maticnetwork/heimdall/x/slashing/types"
 
 	topupTypes "github.com/maticnetwork/heimdall/x/topup/types"
 
@@ -137,11 +138,11 @@ func initCmd(ctx *server.Context, amino *codec.LegacyAmino, mbm module.BasicMana
 				return err
 			}
 
-			// // slashing state change
-			//appStateBytes, err = slashingTypes.SetGenesisStateToAppState(appStateBytes, valSigningInfoMap)
-			//if err != nil {
-			//	return err
-			//}
+			// slashing state change
+			appState, err = slashingTypes.SetGenesisStateToAppState(authclient.Codec, appState, valSigningInfoMap)
+			if err != nil {
+				return err
+			}
 
 			// bor state change
 			appState, err = borTypes.SetGenesisStateToAppState(appState, *validatorSet)
@@ -167,6 +167,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		convertAddressToHexCmd(),
 		convertHexToAddressCmd(),
 		exportCmd(ctx),
+		exportCheckpointsCmd(),
+		checkInvariantsCmd(),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, createSimappAndExport, addModuleInitFlags)
@@ -34,6 +34,7 @@ import (
 	hmCommon "github.com/maticnetwork/heimdall/types/common"
 	hmcommon "github.com/maticnetwork/heimdall/types/common"
 	bortypes "github.com/maticnetwork/heimdall/x/bor/types"
+	slashingtypes "github.com/maticnetwork/heimdall/x/slashing/types"
 	stakingcli "github.com/maticnetwork/heimdall/x/staking/client/cli"
 	stakingtypes "github.com/maticnetwork/heimdall/x/staking/types"
 	topuptypes "github.com/maticnetwork/heimdall/x/topup/types"
@@ -250,12 +251,11 @@ testnet --v 4 --n 8 --output-dir ./output --starting-ip-address 192.168.10.2
 				return err
 			}
 
-			// TODO - Uncomment when slashing is added
 			// slashing state change
-			// appStateBytes, err = slashingtypes.SetGenesisStateToAppState(appStateBytes, valSigningInfoMap)
-			// if err != nil {
-			// 	return err
-			// }
+			appStateBytes, err = slashingtypes.SetGenesisStateToAppState(cdc, appStateBytes, valSigningInfoMap)
+			if err != nil {
+				return err
+			}
 
 			// app state json
 			appStateJSON, err := json.MarshalIndent(appStateBytes, "", " ")
@@ -33,6 +33,7 @@ var (
 	ErrEventRecordInvalid       = sdkerrors.Register(ModuleName, 5401, "Event record is invalid")
 	ErrEventUpdate              = sdkerrors.Register(ModuleName, 5402, "Event record update error")
 	ErrSideTxValidation         = sdkerrors.Register(ModuleName, 5502, "External call majority validation failed")
+	ErrTooManySideMsgs          = sdkerrors.Register(ModuleName, 5503, "Too many side messages in tx")
 	ErrValidatorSigningInfoSave = sdkerrors.Register(ModuleName, 6501, "Cannot save validator signing info")
 	ErrSignerUpdateError        = sdkerrors.Register(ModuleName, 2508, "Signer update error")
 	ErrValidatorNotDeactivated  = sdkerrors.Register(ModuleName, 6502, "Validator Not Deactivated")
@@ -46,6 +47,11 @@ var (
 	ErrUnableToFreezeValSet = sdkerrors.Register(ModuleName, 3502, "Unable to freeze validator set for next span")
 	ErrValSetMisMatch       = sdkerrors.Register(ModuleName, 3504, "Validator set mismatch")
 	ErrProducerMisMatch     = sdkerrors.Register(ModuleName, 3505, "Producer set mismatch")
+	ErrSprintNotInSpan      = sdkerrors.Register(ModuleName, 3508, "Sprint not in span")
+	ErrSprintAlreadyMissed  = sdkerrors.Register(ModuleName, 3509, "Missed sprint already reported")
+	ErrProducerNotInSpan    = sdkerrors.Register(ModuleName, 3510, "Producer not in span")
+	ErrProducerNotDown      = sdkerrors.Register(ModuleName, 3511, "Producer has not missed enough sprints")
+	ErrNoReplacementFound   = sdkerrors.Register(ModuleName, 3512, "No eligible validator to replace producer")
 )
 
 // ErrorSideTx represents side-tx error
@@ -11,6 +11,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
 	ethTypes "github.com/maticnetwork/bor/core/types"
 	"github.com/maticnetwork/bor/ethclient"
 	"github.com/maticnetwork/bor/rpc"
@@ -38,6 +39,7 @@ type IContractCaller interface {
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
+	GetMaticChainBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*ethTypes.Header, error)
 	IsTxConfirmed(common.Hash, uint64) bool
 	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
@@ -181,11 +183,12 @@ func (c *ContractCaller) GetStakingInfoInstance(stakingInfoAddress common.Addres
 	return contractInstance.(*stakinginfo.Stakinginfo), nil
 }
 
-// GetValidatorSetInstance returns stakinginfo contract instance for selected base chain
+// GetValidatorSetInstance returns validator set contract instance on bor chain
 func (c *ContractCaller) GetValidatorSetInstance(validatorSetAddress common.Address) (*validatorset.Validatorset, error) {
 	contractInstance, ok := c.ContractInstanceCache[validatorSetAddress]
 	if !ok {
-		ci, err := validatorset.NewValidatorset(validatorSetAddress, mainChainClient)
+		// validator set contract is deployed on bor chain
+		ci, err := validatorset.NewValidatorset(validatorSetAddress, maticClient)
 		c.ContractInstanceCache[validatorSetAddress] = ci
 		return ci, err
 
@@ -355,6 +358,42 @@ func (c *ContractCaller) GetMaticChainBlock(blockNum *big.Int) (header *ethTypes
 	return latestBlock, nil
 }
 
+// GetMaticChainBlockHeaders returns child chain block headers from start to end (inclusive)
+func (c *ContractCaller) GetMaticChainBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*ethTypes.Header, error) {
+	if start > end {
+		return nil, errors.New("start is greater than end")
+	}
+
+	noOfBlock := end - start + 1
+	if noOfBlock > checkpointLength {
+		return nil, errors.New("number of headers requested exceeds")
+	}
+
+	headers := make([]*ethTypes.Header, noOfBlock)
+	elems := make([]rpc.BatchElem, noOfBlock)
+	for i := range elems {
+		headers[i] = new(ethTypes.Header)
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeUint64(start + uint64(i)), false},
+			Result: headers[i],
+		}
+	}
+
+	if err := c.MaticChainRPC.BatchCallContext(context.Background(), elems); err != nil {
+		Logger.Error("Unable to fetch headers from matic chain", "Error", err)
+		return nil, err
+	}
+
+	for _, elem := range elems {
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+	}
+
+	return headers, nil
+}
+
 // GetBlockNumberFromTxHash gets block number of transaction
 func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, error) {
 	var rpcTx rpcTransaction
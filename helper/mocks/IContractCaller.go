@@ -584,6 +584,29 @@ func (_m *IContractCaller) GetMaticChainBlock(_a0 *big.Int) (*types.Header, erro
 	return r0, r1
 }
 
+// GetMaticChainBlockHeaders provides a mock function with given fields: start, end, checkpointLength
+func (_m *IContractCaller) GetMaticChainBlockHeaders(start uint64, end uint64, checkpointLength uint64) ([]*types.Header, error) {
+	ret := _m.Called(start, end, checkpointLength)
+
+	var r0 []*types.Header
+	if rf, ok := ret.Get(0).(func(uint64, uint64, uint64) []*types.Header); ok {
+		r0 = rf(start, end, checkpointLength)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Header)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, uint64, uint64) error); ok {
+		r1 = rf(start, end, checkpointLength)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMaticTokenInstance provides a mock function with given fields: maticTokenAddress
 func (_m *IContractCaller) GetMaticTokenInstance(maticTokenAddress common.Address) (*erc20.Erc20, error) {
 	ret := _m.Called(maticTokenAddress)
@@ -32,6 +32,9 @@ heimdall_rest_server = "{{ .HeimdallServerURL }}"
 # AMQP endpoint
 amqp_url = "{{ .AmqpURL }}"
 
+# Bridge queue backend: "amqp" (RabbitMQ) or "leveldb" (embedded, stored in bridge db)
+queue_backend = "{{ .QueueBackend }}"
+
 ## Poll intervals
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}"
 syncer_poll_interval = "{{ .SyncerPollInterval }}"
@@ -39,8 +42,13 @@ noack_poll_interval = "{{ .NoACKPollInterval }}"
 clerk_poll_interval = "{{ .ClerkPollInterval }}"
 span_poll_interval = "{{ .SpanPollInterval }}"
 
+# Checkpoint length policy: "static" (avg checkpoint length param) or
+# "adaptive" (scaled by rootchain gas price and child chain throughput, capped by max checkpoint length)
+checkpoint_length_policy = "{{ .CheckpointLengthPolicy }}"
+
 #### gas limits ####
 main_chain_gas_limit = "{{ .MainchainGasLimit }}"
+main_chain_max_gas_price = "{{ .MainchainMaxGasPrice }}"
 
 ##### Timeout Config #####
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
@@ -508,6 +508,18 @@ func GetSideTxSigs(txHash []byte, sideTxData []byte, unFilteredVotes []tmTypes.C
 	return sigs, nil
 }
 
+// GetPackedSideTxSigs packs side-tx sigs as concatenated [R || S || V] bytes
+func GetPackedSideTxSigs(sigs [][3]*big.Int) []byte {
+	result := make([]byte, 0, len(sigs)*65)
+	for _, sig := range sigs {
+		result = append(result, common.LeftPadBytes(sig[0].Bytes(), 32)...)
+		result = append(result, common.LeftPadBytes(sig[1].Bytes(), 32)...)
+		result = append(result, byte(sig[2].Uint64()))
+	}
+
+	return result
+}
+
 // ValidateAndCompressPubKey validate and compress the pubkey
 func ValidateAndCompressPubKey(pubkeyBytes []byte) ([]byte, error) {
 	if len(pubkeyBytes) == UNCOMPRESSED_PUBKEY_SIZE {
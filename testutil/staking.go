@@ -0,0 +1,26 @@
+package testutil
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// FakeStakingKeeper maps validator address to validator id using last byte of address
+type FakeStakingKeeper struct{}
+
+// GetValidatorInfo returns validator with id derived from address
+func (FakeStakingKeeper) GetValidatorInfo(ctx sdk.Context, address sdk.AccAddress) (hmTypes.Validator, error) {
+	if len(address) == 0 {
+		return hmTypes.Validator{}, errors.New("Validator not found")
+	}
+
+	return hmTypes.Validator{ID: FakeValidatorID(address)}, nil
+}
+
+// FakeValidatorID returns validator id FakeStakingKeeper maps address to
+func FakeValidatorID(address []byte) hmTypes.ValidatorID {
+	return hmTypes.NewValidatorID(uint64(address[len(address)-1]))
+}
@@ -37,11 +37,12 @@ func SortHeaders(headers []*Checkpoint) []*Checkpoint {
 // String returns human redable string
 func (m Checkpoint) String() string {
 	return fmt.Sprintf(
-		"Checkpoint {%v (%d:%d) %v %v %v}",
+		"Checkpoint {%v (%d:%d) %v %v %v %v}",
 		m.Proposer,
 		m.StartBlock,
 		m.EndBlock,
 		m.RootHash,
+		m.AccountRootHash,
 		m.BorChainID,
 		m.TimeStamp,
 	)
@@ -24,12 +24,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Checkpoint struct {
-	Proposer   string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	StartBlock uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty" yaml:"start_block"`
-	EndBlock   uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty" yaml:"end_block"`
-	RootHash   string `protobuf:"bytes,4,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty" yaml:"root_hash"`
-	BorChainID string `protobuf:"bytes,5,opt,name=BorChainID,proto3" json:"BorChainID,omitempty" yaml:"bor_chain_ID"`
-	TimeStamp  uint64 `protobuf:"varint,6,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty" yaml:"time_stamp"`
+	Proposer        string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	StartBlock      uint64 `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty" yaml:"start_block"`
+	EndBlock        uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty" yaml:"end_block"`
+	RootHash        string `protobuf:"bytes,4,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty" yaml:"root_hash"`
+	BorChainID      string `protobuf:"bytes,5,opt,name=BorChainID,proto3" json:"BorChainID,omitempty" yaml:"bor_chain_ID"`
+	TimeStamp       uint64 `protobuf:"varint,6,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty" yaml:"time_stamp"`
+	AccountRootHash string `protobuf:"bytes,7,opt,name=account_root_hash,json=accountRootHash,proto3" json:"account_root_hash,omitempty" yaml:"account_root_hash"`
 }
 
 func (m *Checkpoint) Reset()      { *m = Checkpoint{} }
@@ -73,30 +74,31 @@ func init() {
 }
 
 var fileDescriptor_8563020106cb712f = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x4a, 0xf3, 0x50,
-	0x18, 0x86, 0x93, 0xfe, 0xfd, 0x4b, 0x7a, 0x7e, 0xf8, 0xd1, 0x58, 0x35, 0x74, 0x48, 0x4a, 0x04,
-	0xe9, 0xd4, 0x50, 0x14, 0x0a, 0x9d, 0x24, 0xed, 0x60, 0xd7, 0xb8, 0xb9, 0x84, 0x93, 0xe4, 0xd0,
-	0x13, 0x9a, 0xe4, 0x0b, 0xe7, 0x1c, 0x95, 0xde, 0x81, 0xa3, 0xa3, 0x63, 0x2f, 0xc5, 0xd1, 0xb1,
-	0xa3, 0x53, 0x90, 0xf6, 0x0e, 0x72, 0x05, 0x92, 0x13, 0x8d, 0xdd, 0xbe, 0x97, 0xe7, 0x7d, 0xe0,
-	0x83, 0x17, 0x5d, 0x50, 0x12, 0xa7, 0x11, 0x4e, 0x12, 0x27, 0xc0, 0x9c, 0x38, 0x8f, 0xe3, 0x80,
-	0x08, 0x3c, 0x76, 0x28, 0xc1, 0x11, 0x61, 0x7c, 0x94, 0x33, 0x10, 0xa0, 0xff, 0xff, 0x29, 0x8d,
-	0xc4, 0x3a, 0x27, 0xbc, 0xdf, 0x5b, 0xc2, 0x12, 0x24, 0x72, 0xaa, 0xab, 0x6e, 0xd9, 0x6f, 0x2d,
-	0x84, 0x66, 0x94, 0x84, 0xab, 0x1c, 0xe2, 0x4c, 0xe8, 0x7d, 0xa4, 0xe5, 0x0c, 0x72, 0xe0, 0x84,
-	0x19, 0xea, 0x40, 0x1d, 0x76, 0xbd, 0x26, 0xeb, 0x13, 0xf4, 0x8f, 0x0b, 0xcc, 0x84, 0x1f, 0x24,
-	0x10, 0xae, 0x8c, 0xd6, 0x40, 0x1d, 0xb6, 0xdd, 0xb3, 0xb2, 0xb0, 0xf4, 0x35, 0x4e, 0x93, 0xa9,
-	0x7d, 0x00, 0x6d, 0x0f, 0xc9, 0xe4, 0x56, 0x41, 0x1f, 0xa3, 0x2e, 0xc9, 0xa2, 0x6f, 0xed, 0x8f,
-	0xd4, 0x7a, 0x65, 0x61, 0x1d, 0xd5, 0x5a, 0x83, 0x6c, 0x4f, 0x23, 0x59, 0xd4, 0x28, 0x0c, 0x40,
-	0xf8, 0x14, 0x73, 0x6a, 0xb4, 0xab, 0x47, 0x0e, 0x95, 0x06, 0xd9, 0x9e, 0x56, 0xdd, 0xb7, 0x98,
-	0x53, 0x7d, 0x82, 0x90, 0x0b, 0x6c, 0x46, 0x71, 0x9c, 0x2d, 0xe6, 0xc6, 0x5f, 0xe9, 0x9c, 0x97,
-	0x85, 0x75, 0x52, 0x3b, 0x01, 0x30, 0x3f, 0xac, 0xa0, 0xbf, 0x98, 0xdb, 0xde, 0x41, 0x55, 0xbf,
-	0x46, 0x48, 0xc4, 0x29, 0xf1, 0xb9, 0xc0, 0x69, 0x6e, 0x74, 0xe4, 0x7f, 0xa7, 0x65, 0x61, 0x1d,
-	0xd7, 0xe2, 0x2f, 0xb3, 0xbd, 0x6e, 0x15, 0xee, 0xaa, 0x7b, 0xaa, 0x3d, 0x6f, 0x2c, 0xe5, 0x75,
-	0x63, 0x29, 0xee, 0xcd, 0xfb, 0xce, 0x54, 0xb7, 0x3b, 0x53, 0xfd, 0xdc, 0x99, 0xea, 0xcb, 0xde,
-	0x54, 0xb6, 0x7b, 0x53, 0xf9, 0xd8, 0x9b, 0xca, 0xfd, 0xe5, 0x32, 0x16, 0xf4, 0x21, 0x18, 0x85,
-	0x90, 0x3a, 0x29, 0x16, 0x71, 0x98, 0x11, 0xf1, 0x04, 0x6c, 0xe5, 0x34, 0xfb, 0xc9, 0x69, 0x82,
-	0x8e, 0xdc, 0xe2, 0xea, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x79, 0x87, 0x01, 0x50, 0xd8, 0x01, 0x00,
-	0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0xca, 0xd3, 0x40,
+	0x1c, 0xc4, 0x13, 0x5b, 0x6b, 0xba, 0x82, 0xda, 0xb5, 0x6a, 0x28, 0x92, 0x94, 0x15, 0xa4, 0xa7,
+	0x86, 0xa2, 0x50, 0xe8, 0x49, 0xd2, 0x1e, 0xda, 0xeb, 0x7a, 0xf3, 0x12, 0x36, 0xc9, 0xd2, 0x0d,
+	0x4d, 0xb2, 0x61, 0x77, 0xab, 0xf4, 0x0d, 0xc4, 0x93, 0x47, 0x8f, 0x7d, 0x1c, 0x8f, 0x3d, 0x7a,
+	0x0a, 0xd2, 0xbe, 0x41, 0x9e, 0x40, 0xb2, 0xa9, 0x69, 0xe1, 0xbb, 0xcd, 0x64, 0xe6, 0x47, 0x06,
+	0xfe, 0x0b, 0xde, 0x31, 0x9a, 0x64, 0x31, 0x49, 0x53, 0x2f, 0x24, 0x92, 0x7a, 0x5f, 0x67, 0x21,
+	0x55, 0x64, 0xe6, 0x31, 0x4a, 0x62, 0x2a, 0xe4, 0xb4, 0x10, 0x5c, 0x71, 0xf8, 0xec, 0x7f, 0x69,
+	0xaa, 0x0e, 0x05, 0x95, 0xa3, 0xe1, 0x96, 0x6f, 0xb9, 0x8e, 0xbc, 0x5a, 0x35, 0x2d, 0xf4, 0xa3,
+	0x03, 0xc0, 0x92, 0xd1, 0x68, 0x57, 0xf0, 0x24, 0x57, 0x70, 0x04, 0xac, 0x42, 0xf0, 0x82, 0x4b,
+	0x2a, 0x6c, 0x73, 0x6c, 0x4e, 0xfa, 0xb8, 0xf5, 0x70, 0x0e, 0x9e, 0x4a, 0x45, 0x84, 0x0a, 0xc2,
+	0x94, 0x47, 0x3b, 0xfb, 0xd1, 0xd8, 0x9c, 0x74, 0xfd, 0xd7, 0x55, 0xe9, 0xc2, 0x03, 0xc9, 0xd2,
+	0x05, 0xba, 0x0b, 0x11, 0x06, 0xda, 0xf9, 0xb5, 0x81, 0x33, 0xd0, 0xa7, 0x79, 0x7c, 0xc5, 0x3a,
+	0x1a, 0x1b, 0x56, 0xa5, 0xfb, 0xa2, 0xc1, 0xda, 0x08, 0x61, 0x8b, 0xe6, 0x71, 0x8b, 0x08, 0xce,
+	0x55, 0xc0, 0x88, 0x64, 0x76, 0xb7, 0x1e, 0x72, 0x8f, 0xb4, 0x11, 0xc2, 0x56, 0xad, 0xd7, 0x44,
+	0x32, 0x38, 0x07, 0xc0, 0xe7, 0x62, 0xc9, 0x48, 0x92, 0x6f, 0x56, 0xf6, 0x63, 0xcd, 0xbc, 0xa9,
+	0x4a, 0xf7, 0x65, 0xc3, 0x84, 0x5c, 0x04, 0x51, 0x1d, 0x06, 0x9b, 0x15, 0xc2, 0x77, 0x55, 0xf8,
+	0x11, 0x00, 0x95, 0x64, 0x34, 0x90, 0x8a, 0x64, 0x85, 0xdd, 0xd3, 0xfb, 0x5e, 0x55, 0xa5, 0x3b,
+	0x68, 0xc0, 0x5b, 0x86, 0x70, 0xbf, 0x36, 0x9f, 0x6b, 0x0d, 0xd7, 0x60, 0x40, 0xa2, 0x88, 0xef,
+	0x73, 0x15, 0xdc, 0x96, 0x3e, 0xd1, 0x7f, 0x7d, 0x5b, 0x95, 0xae, 0xdd, 0xc0, 0x0f, 0x2a, 0x08,
+	0x3f, 0xbf, 0x7e, 0xc3, 0xd7, 0xe1, 0x0b, 0xeb, 0xfb, 0xd1, 0x35, 0x7e, 0x1d, 0x5d, 0xc3, 0xff,
+	0xf4, 0xfb, 0xec, 0x98, 0xa7, 0xb3, 0x63, 0xfe, 0x3d, 0x3b, 0xe6, 0xcf, 0x8b, 0x63, 0x9c, 0x2e,
+	0x8e, 0xf1, 0xe7, 0xe2, 0x18, 0x5f, 0xde, 0x6f, 0x13, 0xc5, 0xf6, 0xe1, 0x34, 0xe2, 0x99, 0x97,
+	0x11, 0x95, 0x44, 0x39, 0x55, 0xdf, 0xb8, 0xd8, 0x79, 0xed, 0x4b, 0xd0, 0x47, 0x0e, 0x7b, 0xfa,
+	0xaa, 0x1f, 0xfe, 0x0d, 0x00, 0x24, 0x2b, 0x98, 0xbd, 0x22, 0x02, 0x00, 0x00,
 }
 
 func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
@@ -119,6 +121,13 @@ func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountRootHash) > 0 {
+		i -= len(m.AccountRootHash)
+		copy(dAtA[i:], m.AccountRootHash)
+		i = encodeVarintHeaders(dAtA, i, uint64(len(m.AccountRootHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.TimeStamp != 0 {
 		i = encodeVarintHeaders(dAtA, i, uint64(m.TimeStamp))
 		i--
@@ -196,6 +205,10 @@ func (m *Checkpoint) Size() (n int) {
 	if m.TimeStamp != 0 {
 		n += 1 + sovHeaders(uint64(m.TimeStamp))
 	}
+	l = len(m.AccountRootHash)
+	if l > 0 {
+		n += 1 + l + sovHeaders(uint64(l))
+	}
 	return n
 }
 
@@ -387,6 +400,38 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeaders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeaders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeaders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountRootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHeaders(dAtA[iNdEx:])
@@ -1,8 +1,63 @@
 package types
 
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// MaxSideTxMsgs is the maximum number of side msgs in a single side-tx, txs with more side msgs are rejected by ante handler
+const MaxSideTxMsgs = 50
+
 //
 // SideTxMsg tx message
 //
 type SideTxMsg interface {
 	GetSideSignBytes() []byte
 }
+
+// GetMultiSideSignBytes returns side-tx data of multi-msg side-tx which votes on first `voted` msgs.
+// Data consists of 4-byte number of voted msgs followed by hash of msgs side sign bytes.
+// Validator votes with side-tx result on voted msgs and skips remaining msgs.
+func GetMultiSideSignBytes(voted int, signBytesHash []byte) []byte {
+	data := make([]byte, 4, 4+len(signBytesHash))
+	binary.BigEndian.PutUint32(data, uint32(voted))
+
+	return append(data, signBytesHash...)
+}
+
+// ParseMultiSideSignBytes returns number of voted msgs and hash of msgs side sign bytes from side-tx data of multi-msg side-tx
+func ParseMultiSideSignBytes(data []byte) (voted int, signBytesHash []byte, err error) {
+	if len(data) != 4+common.HashLength {
+		return 0, nil, errors.New("invalid side-tx data length")
+	}
+
+	return int(binary.BigEndian.Uint32(data[:4])), data[4:], nil
+}
+
+// HashMultiSideSignBytes returns keccak256 hash of side sign bytes of msgs, each prefixed by its 4-byte length
+func HashMultiSideSignBytes(signBytes [][]byte) []byte {
+	data := make([]byte, 0)
+	for _, bz := range signBytes {
+		prefix := make([]byte, 4)
+		binary.BigEndian.PutUint32(prefix, uint32(len(bz)))
+
+		data = append(data, prefix...)
+		data = append(data, bz...)
+	}
+
+	return crypto.Keccak256(data)
+}
+
+// GetMultiSideMsgResults returns per-msg results of vote with result on first `voted` out of `count` msgs
+func GetMultiSideMsgResults(result tmproto.SideTxResultType, voted int, count int) []tmproto.SideTxResultType {
+	results := make([]tmproto.SideTxResultType, count)
+	for i := 0; i < voted && i < count; i++ {
+		results[i] = result
+	}
+
+	return results
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/types"
+)
+
+func TestMultiSideSignBytes(t *testing.T) {
+	t.Parallel()
+
+	signBytes := [][]byte{[]byte("checkpoint"), {}, []byte("span")}
+	hash := types.HashMultiSideSignBytes(signBytes)
+	require.Len(t, hash, 32)
+
+	// msg boundaries are part of hash
+	require.NotEqual(t, hash, types.HashMultiSideSignBytes([][]byte{[]byte("checkpoint"), []byte("span")}))
+
+	data := types.GetMultiSideSignBytes(2, hash)
+	require.Equal(t, []byte{0, 0, 0, 2}, data[:4])
+	require.Len(t, data, 4+len(hash))
+
+	voted, parsedHash, err := types.ParseMultiSideSignBytes(data)
+	require.NoError(t, err)
+	require.Equal(t, 2, voted)
+	require.Equal(t, hash, parsedHash)
+
+	// truncated data
+	_, _, err = types.ParseMultiSideSignBytes(data[:len(data)-1])
+	require.Error(t, err)
+
+	// vote covers first voted msgs only
+	require.Equal(t, []tmprototypes.SideTxResultType{
+		tmprototypes.SideTxResultType_NO,
+		tmprototypes.SideTxResultType_NO,
+		tmprototypes.SideTxResultType_SKIP,
+	}, types.GetMultiSideMsgResults(tmprototypes.SideTxResultType_NO, 2, 3))
+}
@@ -0,0 +1,614 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdall/base/v1beta1/slashing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorSigningInfo defines the signing info for a validator
+type ValidatorSigningInfo struct {
+	ValID ValidatorID `protobuf:"varint,1,opt,name=val_id,json=valId,proto3,enum=heimdall.types.ValidatorID" json:"val_id,omitempty" yaml:"val_id"`
+	// height at which validator was first a candidate OR was unjailed
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// index offset into signed block bit array
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty" yaml:"index_offset"`
+	// missed blocks counter (to avoid scanning the array every time)
+	MissedBlocksCounter int64 `protobuf:"varint,4,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
+}
+
+func (m *ValidatorSigningInfo) Reset()      { *m = ValidatorSigningInfo{} }
+func (*ValidatorSigningInfo) ProtoMessage() {}
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e346b5b65bc4aa, []int{0}
+}
+func (m *ValidatorSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSigningInfo.Merge(m, src)
+}
+func (m *ValidatorSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSigningInfo proto.InternalMessageInfo
+
+// ValidatorSlashingInfo contains ID, slashed amount and jail status
+type ValidatorSlashingInfo struct {
+	ID            ValidatorID `protobuf:"varint,1,opt,name=ID,proto3,enum=heimdall.types.ValidatorID" json:"ID,omitempty"`
+	SlashedAmount uint64      `protobuf:"varint,2,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty" yaml:"slashed_amount"`
+	IsJailed      bool        `protobuf:"varint,3,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty" yaml:"is_jailed"`
+}
+
+func (m *ValidatorSlashingInfo) Reset()      { *m = ValidatorSlashingInfo{} }
+func (*ValidatorSlashingInfo) ProtoMessage() {}
+func (*ValidatorSlashingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e346b5b65bc4aa, []int{1}
+}
+func (m *ValidatorSlashingInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSlashingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSlashingInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSlashingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSlashingInfo.Merge(m, src)
+}
+func (m *ValidatorSlashingInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSlashingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSlashingInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSlashingInfo proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ValidatorSigningInfo)(nil), "heimdall.types.ValidatorSigningInfo")
+	proto.RegisterType((*ValidatorSlashingInfo)(nil), "heimdall.types.ValidatorSlashingInfo")
+}
+
+func init() {
+	proto.RegisterFile("heimdall/base/v1beta1/slashing.proto", fileDescriptor_89e346b5b65bc4aa)
+}
+
+var fileDescriptor_89e346b5b65bc4aa = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xbd, 0x8e, 0xd3, 0x30,
+	0x1c, 0x4f, 0x7a, 0x1f, 0x2a, 0x3e, 0xae, 0x42, 0xb9, 0x56, 0x94, 0x82, 0xe2, 0xca, 0x02, 0x74,
+	0x12, 0x52, 0xa2, 0xc2, 0xd6, 0xe9, 0x08, 0x1d, 0x08, 0x03, 0x48, 0x01, 0xdd, 0xc0, 0x12, 0x39,
+	0xb5, 0x9b, 0x98, 0x73, 0xe2, 0x53, 0xec, 0x0b, 0xdc, 0x1b, 0x30, 0x32, 0x32, 0xde, 0x93, 0xb0,
+	0xb0, 0x30, 0xde, 0xc8, 0x14, 0xa1, 0xf4, 0x0d, 0xf2, 0x04, 0xa8, 0x4e, 0x7a, 0x1f, 0xa8, 0x03,
+	0x9b, 0x7f, 0x5f, 0x7f, 0xcb, 0x3f, 0xff, 0xc1, 0xe3, 0x84, 0xb2, 0x94, 0x60, 0xce, 0xdd, 0x08,
+	0x4b, 0xea, 0x16, 0x93, 0x88, 0x2a, 0x3c, 0x71, 0x25, 0xc7, 0x32, 0x61, 0x59, 0xec, 0x9c, 0xe6,
+	0x42, 0x09, 0xab, 0xb7, 0x76, 0x39, 0xea, 0xfc, 0x94, 0xca, 0x51, 0x3f, 0x16, 0xb1, 0xd0, 0x92,
+	0xbb, 0x3a, 0x35, 0xae, 0xd1, 0x93, 0xcd, 0xb3, 0x0a, 0xcc, 0x19, 0xc1, 0x4a, 0xe4, 0x8d, 0x0d,
+	0xfd, 0xe8, 0x80, 0xfe, 0xf1, 0x9a, 0x7b, 0xcf, 0xe2, 0x8c, 0x65, 0xb1, 0x9f, 0x2d, 0x84, 0xf5,
+	0x16, 0xec, 0x16, 0x98, 0x87, 0x8c, 0x0c, 0xcd, 0xb1, 0x79, 0xd8, 0x7b, 0xfe, 0xd0, 0xb9, 0x7d,
+	0xad, 0x73, 0x95, 0xf2, 0x67, 0xde, 0xa8, 0x2a, 0xe1, 0xce, 0x31, 0xe6, 0xfe, 0xac, 0x2e, 0xe1,
+	0xfe, 0x39, 0x4e, 0xf9, 0x14, 0x35, 0x69, 0x14, 0xec, 0x14, 0x98, 0xfb, 0xc4, 0x9a, 0x82, 0xbb,
+	0x52, 0xe1, 0x5c, 0x85, 0x09, 0x65, 0x71, 0xa2, 0x86, 0x9d, 0xb1, 0x79, 0xb8, 0xe5, 0xdd, 0xaf,
+	0x4b, 0x78, 0xd0, 0xf8, 0x6f, 0xaa, 0x28, 0xd8, 0xd3, 0xf0, 0xb5, 0x46, 0xab, 0x2c, 0xcb, 0x08,
+	0xfd, 0x12, 0x8a, 0xc5, 0x42, 0x52, 0x35, 0xdc, 0xfa, 0x37, 0x7b, 0x53, 0x45, 0xc1, 0x9e, 0x86,
+	0xef, 0x34, 0xb2, 0x3e, 0x80, 0x41, 0xca, 0xa4, 0xa4, 0x24, 0x8c, 0xb8, 0x98, 0x9f, 0xc8, 0x70,
+	0x2e, 0xce, 0x32, 0x45, 0xf3, 0xe1, 0xb6, 0x1e, 0x32, 0xae, 0x4b, 0xf8, 0xa8, 0x19, 0xb2, 0xd1,
+	0x86, 0x82, 0x83, 0x86, 0xf7, 0x34, 0xfd, 0xaa, 0x61, 0xa7, 0xdd, 0xaf, 0x17, 0xd0, 0xf8, 0x7e,
+	0x01, 0x0d, 0xf4, 0xd3, 0x04, 0x83, 0xeb, 0x02, 0xdb, 0x9f, 0xd2, 0x0d, 0x3e, 0x03, 0x1d, 0x7f,
+	0xf6, 0x1f, 0xed, 0x05, 0x1d, 0x7f, 0x66, 0x1d, 0x81, 0x9e, 0xfe, 0x66, 0x4a, 0x42, 0x9c, 0xae,
+	0x2e, 0xd1, 0x05, 0x6d, 0x7b, 0x0f, 0xea, 0x12, 0x0e, 0xda, 0x82, 0x6e, 0xe9, 0x28, 0xd8, 0x6f,
+	0x89, 0x97, 0x1a, 0x5b, 0x13, 0x70, 0x87, 0xc9, 0xf0, 0x13, 0x66, 0x9c, 0x12, 0xdd, 0x50, 0xd7,
+	0xeb, 0xd7, 0x25, 0xbc, 0xd7, 0x36, 0xb4, 0x96, 0x50, 0xd0, 0x65, 0xf2, 0x8d, 0x3e, 0x5e, 0xbf,
+	0xc2, 0x3b, 0xfa, 0x55, 0xd9, 0xe6, 0x65, 0x65, 0x9b, 0x7f, 0x2a, 0xdb, 0xfc, 0xb6, 0xb4, 0x8d,
+	0xcb, 0xa5, 0x6d, 0xfc, 0x5e, 0xda, 0xc6, 0xc7, 0xa7, 0x31, 0x53, 0xc9, 0x59, 0xe4, 0xcc, 0x45,
+	0xea, 0xa6, 0x58, 0xb1, 0x79, 0x46, 0xd5, 0x67, 0x91, 0x9f, 0xb8, 0x57, 0xfb, 0xa5, 0x1f, 0x14,
+	0xed, 0xea, 0x7d, 0x7a, 0xf1, 0x77, 0x00, 0x51, 0x5b, 0x6b, 0x0e, 0xc4, 0x02, 0x00, 0x00,
+}
+
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValID != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ValID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SlashedAmount != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SlashedAmount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValID != 0 {
+		n += 1 + sovSlashing(uint64(m.ValID))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *ValidatorSlashingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovSlashing(uint64(m.ID))
+	}
+	if m.SlashedAmount != 0 {
+		n += 1 + sovSlashing(uint64(m.SlashedAmount))
+	}
+	if m.IsJailed {
+		n += 2
+	}
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValID", wireType)
+			}
+			m.ValID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValID |= ValidatorID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashingInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashingInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= ValidatorID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
+			}
+			m.SlashedAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashing(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashing
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashing
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashing
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashing        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashing          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashing = fmt.Errorf("proto: unexpected end of group")
+)
@@ -24,12 +24,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Span struct {
-	ID                uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
-	StartBlock        uint64       `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block" yaml:"start_block"`
-	EndBlock          uint64       `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block" yaml:"end_block"`
-	ValidatorSet      ValidatorSet `protobuf:"bytes,4,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set" yaml:"validator_set"`
-	SelectedProducers []Validator  `protobuf:"bytes,5,rep,name=selected_producers,json=selectedProducers,proto3" json:"selected_producers" yaml:"selected_producers"`
-	BorChainId        string       `protobuf:"bytes,6,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id" yaml:"bor_chain_id"`
+	ID                 uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	StartBlock         uint64       `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block" yaml:"start_block"`
+	EndBlock           uint64       `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block" yaml:"end_block"`
+	ValidatorSet       ValidatorSet `protobuf:"bytes,4,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set" yaml:"validator_set"`
+	SelectedProducers  []Validator  `protobuf:"bytes,5,rep,name=selected_producers,json=selectedProducers,proto3" json:"selected_producers" yaml:"selected_producers"`
+	BorChainId         string       `protobuf:"bytes,6,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id" yaml:"bor_chain_id"`
+	SelectionAlgorithm string       `protobuf:"bytes,7,opt,name=selection_algorithm,json=selectionAlgorithm,proto3" json:"selection_algorithm" yaml:"selection_algorithm"`
 }
 
 func (m *Span) Reset()         { *m = Span{} }
@@ -107,6 +108,13 @@ func (m *Span) GetBorChainId() string {
 	return ""
 }
 
+func (m *Span) GetSelectionAlgorithm() string {
+	if m != nil {
+		return m.SelectionAlgorithm
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Span)(nil), "heimdall.types.Span")
 }
@@ -114,34 +122,36 @@ func init() {
 func init() { proto.RegisterFile("heimdall/base/v1beta1/span.proto", fileDescriptor_cd24aeb5fc932b4a) }
 
 var fileDescriptor_cd24aeb5fc932b4a = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x8f, 0xd3, 0x30,
-	0x14, 0xc6, 0xeb, 0xb4, 0x9c, 0xa8, 0x7b, 0x20, 0x30, 0x37, 0xa4, 0x27, 0x14, 0x07, 0x4b, 0x07,
-	0x65, 0x20, 0xd1, 0x1d, 0x03, 0x12, 0x03, 0x42, 0x01, 0x21, 0x75, 0x43, 0x39, 0x89, 0x81, 0x25,
-	0x72, 0x62, 0xab, 0xb5, 0x2e, 0x8d, 0x23, 0xc7, 0x57, 0x74, 0x23, 0xff, 0x01, 0x7f, 0xd6, 0x8d,
-	0x37, 0x32, 0x59, 0xa8, 0xdd, 0x32, 0x66, 0x64, 0x42, 0x4d, 0xda, 0x92, 0x88, 0xb2, 0xf9, 0xfd,
-	0xfc, 0x7d, 0xdf, 0xd3, 0x7b, 0x7a, 0xd0, 0x9d, 0x73, 0xb1, 0x60, 0x34, 0x4d, 0xfd, 0x98, 0x16,
-	0xdc, 0x5f, 0x9e, 0xc7, 0x5c, 0xd3, 0x73, 0xbf, 0xc8, 0x69, 0xe6, 0xe5, 0x4a, 0x6a, 0x89, 0x1e,
-	0xee, 0x14, 0x9e, 0xbe, 0xc9, 0x79, 0x71, 0x7a, 0x32, 0x93, 0x33, 0x59, 0x7f, 0xf9, 0x9b, 0x57,
-	0xa3, 0x3a, 0x3d, 0x3b, 0x9c, 0xb3, 0xa4, 0xa9, 0x60, 0x54, 0x4b, 0xd5, 0xc8, 0xc8, 0xef, 0x3e,
-	0x1c, 0x5c, 0xe6, 0x34, 0x43, 0x2f, 0xa1, 0x25, 0x98, 0x0d, 0x5c, 0x30, 0x19, 0x04, 0xe3, 0x95,
-	0xc1, 0xd6, 0xf4, 0x63, 0x69, 0xb0, 0x25, 0x58, 0x65, 0xf0, 0xf0, 0x86, 0x2e, 0xd2, 0xb7, 0x44,
-	0x30, 0x12, 0x5a, 0x82, 0xa1, 0x4f, 0x70, 0x54, 0x68, 0xaa, 0x74, 0x14, 0xa7, 0x32, 0xb9, 0xb2,
-	0xad, 0xda, 0x73, 0x56, 0x1a, 0xdc, 0xc6, 0x95, 0xc1, 0xa8, 0xb1, 0xb5, 0x20, 0x09, 0x61, 0x5d,
-	0x05, 0x9b, 0x02, 0xbd, 0x83, 0x43, 0x9e, 0xb1, 0x6d, 0x4a, 0xbf, 0x4e, 0x79, 0x56, 0x1a, 0xfc,
-	0x17, 0x56, 0x06, 0x3f, 0x6a, 0x32, 0xf6, 0x88, 0x84, 0xf7, 0x79, 0xc6, 0x1a, 0x7f, 0x0e, 0x1f,
-	0xec, 0xc7, 0x89, 0x0a, 0xae, 0xed, 0x81, 0x0b, 0x26, 0xa3, 0x8b, 0xa7, 0x5e, 0x77, 0x41, 0xde,
-	0x97, 0x9d, 0xe8, 0x92, 0xeb, 0xe0, 0xd5, 0xad, 0xc1, 0xbd, 0xd2, 0xe0, 0xae, 0xb5, 0x32, 0xf8,
-	0xa4, 0xe9, 0xd4, 0xc1, 0x24, 0x3c, 0x5e, 0xb6, 0xcc, 0xe8, 0x3b, 0x80, 0xa8, 0xe0, 0x29, 0x4f,
-	0x34, 0x67, 0x51, 0xae, 0x24, 0xbb, 0x4e, 0xb8, 0x2a, 0xec, 0x7b, 0x6e, 0x7f, 0x32, 0xba, 0x18,
-	0xff, 0xb7, 0x6f, 0xf0, 0x66, 0xdb, 0xf4, 0x80, 0xb9, 0x32, 0x78, 0xbc, 0xdd, 0xd3, 0x3f, 0x7f,
-	0x24, 0x7c, 0xbc, 0x83, 0x9f, 0x77, 0x0c, 0x4d, 0xe1, 0x71, 0x2c, 0x55, 0x94, 0xcc, 0xa9, 0xc8,
-	0x22, 0xc1, 0xec, 0x23, 0x17, 0x4c, 0x86, 0xc1, 0x8b, 0xd2, 0xe0, 0x0e, 0xaf, 0x0c, 0x7e, 0xd2,
-	0xe4, 0xb6, 0x29, 0x09, 0x61, 0x2c, 0xd5, 0x87, 0x4d, 0x35, 0x65, 0xc1, 0xfb, 0xdb, 0x95, 0x03,
-	0xee, 0x56, 0x0e, 0xf8, 0xb5, 0x72, 0xc0, 0x8f, 0xb5, 0xd3, 0xbb, 0x5b, 0x3b, 0xbd, 0x9f, 0x6b,
-	0xa7, 0xf7, 0xf5, 0xf9, 0x4c, 0xe8, 0xf9, 0x75, 0xec, 0x25, 0x72, 0xe1, 0x2f, 0xa8, 0x16, 0x49,
-	0xc6, 0xf5, 0x37, 0xa9, 0xae, 0xfc, 0xfd, 0x55, 0xd5, 0x23, 0xc6, 0x47, 0xf5, 0x15, 0xbd, 0xfe,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0x06, 0xca, 0x54, 0x06, 0xb6, 0x02, 0x00, 0x00,
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8f, 0x93, 0x40,
+	0x14, 0xc7, 0x0b, 0x5b, 0x57, 0x3b, 0x5d, 0x8d, 0xce, 0xee, 0x81, 0x36, 0x86, 0x41, 0x92, 0xd5,
+	0x7a, 0x10, 0xb2, 0x6b, 0x8c, 0x89, 0x07, 0xa3, 0x68, 0x4c, 0x7a, 0x33, 0x6c, 0xe2, 0xc1, 0x0b,
+	0x19, 0x98, 0xb1, 0x9d, 0x2c, 0x30, 0x64, 0x98, 0xad, 0xd9, 0xa3, 0xdf, 0xc0, 0x8f, 0xb5, 0xc7,
+	0x3d, 0x7a, 0x9a, 0x18, 0x7a, 0xe3, 0xc8, 0x27, 0x30, 0x85, 0x52, 0x21, 0xd6, 0x1b, 0xef, 0xf7,
+	0xfe, 0xff, 0xff, 0x63, 0x5e, 0x1e, 0xb0, 0x96, 0x94, 0x25, 0x04, 0xc7, 0xb1, 0x1b, 0xe2, 0x9c,
+	0xba, 0xab, 0xb3, 0x90, 0x4a, 0x7c, 0xe6, 0xe6, 0x19, 0x4e, 0x9d, 0x4c, 0x70, 0xc9, 0xe1, 0x83,
+	0x56, 0xe1, 0xc8, 0xeb, 0x8c, 0xe6, 0xd3, 0x93, 0x05, 0x5f, 0xf0, 0xba, 0xe5, 0x6e, 0xbe, 0x1a,
+	0xd5, 0xf4, 0x74, 0x7f, 0xce, 0x0a, 0xc7, 0x8c, 0x60, 0xc9, 0x45, 0x23, 0xb3, 0x8b, 0x21, 0x18,
+	0x5e, 0x64, 0x38, 0x85, 0xcf, 0x81, 0xce, 0x88, 0xa1, 0x59, 0xda, 0x6c, 0xe8, 0x4d, 0x0a, 0x85,
+	0xf4, 0xf9, 0xc7, 0x52, 0x21, 0x9d, 0x91, 0x4a, 0xa1, 0xd1, 0x35, 0x4e, 0xe2, 0x37, 0x36, 0x23,
+	0xb6, 0xaf, 0x33, 0x02, 0x3f, 0x81, 0x71, 0x2e, 0xb1, 0x90, 0x41, 0x18, 0xf3, 0xe8, 0xd2, 0xd0,
+	0x6b, 0xcf, 0x69, 0xa9, 0x50, 0x17, 0x57, 0x0a, 0xc1, 0xc6, 0xd6, 0x81, 0xb6, 0x0f, 0xea, 0xca,
+	0xdb, 0x14, 0xf0, 0x2d, 0x18, 0xd1, 0x94, 0x6c, 0x53, 0x0e, 0xea, 0x94, 0x27, 0xa5, 0x42, 0x7f,
+	0x61, 0xa5, 0xd0, 0xc3, 0x26, 0x63, 0x87, 0x6c, 0xff, 0x1e, 0x4d, 0x49, 0xe3, 0xcf, 0xc0, 0xfd,
+	0xdd, 0x73, 0x82, 0x9c, 0x4a, 0x63, 0x68, 0x69, 0xb3, 0xf1, 0xf9, 0x63, 0xa7, 0xbf, 0x20, 0xe7,
+	0x4b, 0x2b, 0xba, 0xa0, 0xd2, 0x7b, 0x71, 0xa3, 0xd0, 0xa0, 0x54, 0xa8, 0x6f, 0xad, 0x14, 0x3a,
+	0x69, 0x26, 0xf5, 0xb0, 0xed, 0x1f, 0xad, 0x3a, 0x66, 0xf8, 0x43, 0x03, 0x30, 0xa7, 0x31, 0x8d,
+	0x24, 0x25, 0x41, 0x26, 0x38, 0xb9, 0x8a, 0xa8, 0xc8, 0x8d, 0x3b, 0xd6, 0xc1, 0x6c, 0x7c, 0x3e,
+	0xf9, 0xef, 0x5c, 0xef, 0xf5, 0x76, 0xe8, 0x1e, 0x73, 0xa5, 0xd0, 0x64, 0xbb, 0xa7, 0x7f, 0x7a,
+	0xb6, 0xff, 0xa8, 0x85, 0x9f, 0x5b, 0x06, 0xe7, 0xe0, 0x28, 0xe4, 0x22, 0x88, 0x96, 0x98, 0xa5,
+	0x01, 0x23, 0xc6, 0xa1, 0xa5, 0xcd, 0x46, 0xde, 0xb3, 0x52, 0xa1, 0x1e, 0xaf, 0x14, 0x3a, 0x6e,
+	0x72, 0xbb, 0xd4, 0xf6, 0x41, 0xc8, 0xc5, 0x87, 0x4d, 0x35, 0x27, 0xf0, 0x1b, 0x38, 0x6e, 0xf2,
+	0x19, 0x4f, 0x03, 0x1c, 0x2f, 0xb8, 0x60, 0x72, 0x99, 0x18, 0x77, 0xeb, 0xc4, 0x57, 0xa5, 0x42,
+	0xfb, 0xda, 0x95, 0x42, 0xd3, 0xee, 0x0f, 0xf7, 0x9a, 0xb6, 0x0f, 0x77, 0xf4, 0x7d, 0x0b, 0xbd,
+	0x77, 0x37, 0x85, 0xa9, 0xdd, 0x16, 0xa6, 0xf6, 0xbb, 0x30, 0xb5, 0x9f, 0x6b, 0x73, 0x70, 0xbb,
+	0x36, 0x07, 0xbf, 0xd6, 0xe6, 0xe0, 0xeb, 0xd3, 0x05, 0x93, 0xcb, 0xab, 0xd0, 0x89, 0x78, 0xe2,
+	0x26, 0x58, 0xb2, 0x28, 0xa5, 0xf2, 0x3b, 0x17, 0x97, 0xee, 0xee, 0x7a, 0xeb, 0x55, 0x86, 0x87,
+	0xf5, 0xb5, 0xbe, 0xfc, 0x33, 0x00, 0x65, 0xcb, 0x81, 0x9d, 0x1e, 0x03, 0x00, 0x00,
 }
 
 func (m *Span) Marshal() (dAtA []byte, err error) {
@@ -164,6 +174,13 @@ func (m *Span) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SelectionAlgorithm) > 0 {
+		i -= len(m.SelectionAlgorithm)
+		copy(dAtA[i:], m.SelectionAlgorithm)
+		i = encodeVarintSpan(dAtA, i, uint64(len(m.SelectionAlgorithm)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.BorChainId) > 0 {
 		i -= len(m.BorChainId)
 		copy(dAtA[i:], m.BorChainId)
@@ -251,6 +268,10 @@ func (m *Span) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSpan(uint64(l))
 	}
+	l = len(m.SelectionAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovSpan(uint64(l))
+	}
 	return n
 }
 
@@ -445,6 +466,38 @@ func (m *Span) Unmarshal(dAtA []byte) error {
 			}
 			m.BorChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSpan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSpan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectionAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpan(dAtA[iNdEx:])
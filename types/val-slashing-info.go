@@ -7,13 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
-// ValidatorSlashingInfo - contains ID, slashingAmount, isJailed
-type ValidatorSlashingInfo struct {
-	ID            ValidatorID `json:"ID"`
-	SlashedAmount uint64      `json:"SlashedAmount"`
-	IsJailed      bool        `json:"IsJailed"`
-}
-
+// NewValidatorSlashingInfo creates a new ValidatorSlashingInfo instance
 func NewValidatorSlashingInfo(id ValidatorID, slashedAmount uint64, isJailed bool) ValidatorSlashingInfo {
 
 	return ValidatorSlashingInfo{
@@ -37,8 +31,8 @@ func SortValidatorSlashingInfoByID(slashingInfos []*ValidatorSlashingInfo) []*Va
 	return slashingInfos
 }
 
-// amino marshall validator slashing info
-func MarshallValSlashingInfo(cdc *codec.LegacyAmino, valSlashingInfo ValidatorSlashingInfo) (bz []byte, err error) {
+// MarshallValSlashingInfo - amino Marshall ValidatorSlashingInfo
+func MarshallValSlashingInfo(cdc codec.BinaryMarshaler, valSlashingInfo *ValidatorSlashingInfo) (bz []byte, err error) {
 	bz, err = cdc.MarshalBinaryBare(valSlashingInfo)
 	if err != nil {
 		return bz, err
@@ -46,10 +40,10 @@ func MarshallValSlashingInfo(cdc *codec.LegacyAmino, valSlashingInfo ValidatorSl
 	return bz, nil
 }
 
-// amono unmarshall validator slashing info
-func UnmarshallValSlashingInfo(cdc *codec.LegacyAmino, value []byte) (ValidatorSlashingInfo, error) {
+// UnmarshallValSlashingInfo - amino Unmarshall ValidatorSlashingInfo
+func UnmarshallValSlashingInfo(cdc codec.BinaryMarshaler, value []byte) (ValidatorSlashingInfo, error) {
 	var valSlashingInfo ValidatorSlashingInfo
-	// unmarshall validator and return
+	// unmarshall validator slashing info and return
 	err := cdc.UnmarshalBinaryBare(value, &valSlashingInfo)
 	if err != nil {
 		return valSlashingInfo, err
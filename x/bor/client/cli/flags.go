@@ -8,4 +8,7 @@ const (
 	FlagParamTypes      = "param-type"
 	FlagPage            = "page"
 	FlagLimit           = "limit"
+	FlagStartSpanId     = "start-span-id"
+	FlagEndSpanId       = "end-span-id"
+	FlagProducerId      = "producer-id"
 )
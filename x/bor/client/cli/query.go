@@ -32,6 +32,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetQueryLatestSpan(),
 		GetQueryNextSpanSeed(),
 		PrepareNextSpan(),
+		GetQuerySpanDivergence(),
+		GetQueryProducerDowntime(),
 	)
 	return cmd
 }
@@ -184,7 +186,7 @@ $ %s query bor span --span-id 1
 func GetQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
-		Short: "Query the parameters (span|sprint|producer-count|last-eth-block) of the bor process",
+		Short: "Query the parameters (span|sprint|producer-count|last-eth-block|selection-algorithm|max-consecutive-spans) of the bor process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the bor.
 Example:
@@ -192,9 +194,11 @@ $ %s query bor param --param-type span
 $ %s query bor param --param-type sprint
 $ %s query bor param --param-type producer-count
 $ %s query bor param --param-type last-eth-block
+$ %s query bor param --param-type selection-algorithm
+$ %s query bor param --param-type max-consecutive-spans
 
 `,
-				version.AppName, version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -218,7 +222,7 @@ $ %s query bor param --param-type last-eth-block
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
-	cmd.Flags().String(FlagParamTypes, "", "--param-type=<param type span|sprint|producer-count|last-eth-block >")
+	cmd.Flags().String(FlagParamTypes, "", "--param-type=<param type span|sprint|producer-count|last-eth-block|selection-algorithm|max-consecutive-spans >")
 	_ = cmd.MarkFlagRequired(FlagParamTypes)
 	return cmd
 }
@@ -314,3 +318,87 @@ $ %s query bor prepare-next-span --bor-chain-id 1 --start-block 254 --span-id 1
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetQuerySpanDivergence() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "span-divergence",
+		Short: "Compare heimdall spans with spans committed on bor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Compare spans stored in heimdall with spans committed on bor validator set contract and report differences.
+Without span ids, latest %d spans are compared.
+Example:
+$ %s query bor span-divergence
+$ %s query bor span-divergence --start-span-id 10 --end-span-id 20
+`,
+				types.MaxSpanDivergenceRange, version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			startSpanID, err := cmd.Flags().GetUint64(FlagStartSpanId)
+			if err != nil {
+				return err
+			}
+			endSpanID, err := cmd.Flags().GetUint64(FlagEndSpanId)
+			if err != nil {
+				return err
+			}
+			cmdCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(cmdCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SpanDivergence(context.Background(), &types.QuerySpanDivergenceRequest{
+				StartSpanId: startSpanID,
+				EndSpanId:   endSpanID,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagStartSpanId, 0, "--start-span-id=10")
+	cmd.Flags().Uint64(FlagEndSpanId, 0, "--end-span-id=20")
+	return cmd
+}
+
+func GetQueryProducerDowntime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "producer-downtime",
+		Short: "show sprints missed by producers of span",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get number of sprints each producer of span with span-id missed on bor.
+Example:
+$ %s query bor producer-downtime --span-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spanId, err := cmd.Flags().GetUint64(FlagSpanId)
+			if err != nil {
+				return err
+			}
+
+			cliCmd := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(cliCmd, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ProducerDowntime(context.Background(), &types.QueryProducerDowntimeRequest{
+				SpanId: spanId,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(resp)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagSpanId, 0, "span-id")
+	return cmd
+}
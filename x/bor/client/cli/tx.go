@@ -35,6 +35,7 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		PostSendProposeSpanTx(),
+		PostReplaceProducerTx(),
 	)
 	return txCmd
 }
@@ -130,3 +131,74 @@ func PostSendProposeSpanTx() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// PostReplaceProducerTx send replace producer transaction
+func PostReplaceProducerTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-producer",
+		Short: "send replace producer tx to swap unresponsive producer for rest of the span",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdCtx := client.GetClientContextFromCmd(cmd)
+			cliCtx, err := client.ReadTxCommandFlags(cmdCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			borChainID, err := cmd.Flags().GetString(FlagBorChainId)
+			if err != nil {
+				return err
+			}
+			if borChainID == "" {
+				return fmt.Errorf("BorChainID cannot be empty")
+			}
+
+			// get proposer
+			proposerAddrStr, err := cmd.Flags().GetString(FlagProposerAddress)
+			if err != nil {
+				return err
+			}
+			proposerAddrStr = strings.ToLower(proposerAddrStr)
+			proposer, err := sdk.AccAddressFromHex(proposerAddrStr)
+			if err != nil {
+				return fmt.Errorf("invalid proposer address: %v", err)
+			}
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			spanId, err := cmd.Flags().GetUint64(FlagSpanId)
+			if err != nil {
+				return err
+			}
+			producerId, err := cmd.Flags().GetUint64(FlagProducerId)
+			if err != nil {
+				return err
+			}
+			startBlock, err := cmd.Flags().GetUint64(FlagStartBlock)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReplaceProducer(
+				proposer.String(),
+				spanId,
+				producerId,
+				startBlock,
+				borChainID,
+			)
+			//broadcast message
+			return helper.GenerateOrBroadcastTxCli(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span-id>")
+	cmd.Flags().Uint64(FlagProducerId, 0, "--producer-id=<producer-validator-id>")
+	cmd.Flags().Uint64(FlagStartBlock, 0, "--start-block=<sprint-start-block>")
+	cmd.Flags().String(FlagBorChainId, "", "--bor-chain-id=<bor-chain-id>")
+	_ = cmd.MarkFlagRequired(FlagBorChainId)
+	_ = cmd.MarkFlagRequired(FlagSpanId)
+	_ = cmd.MarkFlagRequired(FlagProducerId)
+	_ = cmd.MarkFlagRequired(FlagStartBlock)
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -13,6 +13,8 @@ func InitGenesis(ctx sdk.Context, keeper borKeeper.Keeper, data types.GenesisSta
 	if len(data.Spans) > 0 {
 		// sort data spans before inserting to ensure lastspanId fetched is correct
 		hmTypes.SortSpanByID(data.Spans)
+		// older spans might have been pruned
+		keeper.SetPrunedSpanCount(ctx, data.Spans[0].ID)
 		// add new span
 		for _, span := range data.Spans {
 			if err := keeper.AddNewRawSpan(ctx, *span); err != nil {
@@ -23,6 +25,12 @@ func InitGenesis(ctx sdk.Context, keeper borKeeper.Keeper, data types.GenesisSta
 		// update last span
 		keeper.UpdateLastSpan(ctx, data.Spans[len(data.Spans)-1].ID)
 	}
+
+	for _, replacement := range data.ProducerReplacements {
+		if err := keeper.SetProducerReplacement(ctx, replacement); err != nil {
+			keeper.Logger(ctx).Error("Error SetProducerReplacement", "error", err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -34,8 +42,17 @@ func ExportGenesis(ctx sdk.Context, keeper borKeeper.Keeper) *types.GenesisState
 		keeper.Logger(ctx).Error("Error ExportGenesis", "error", err)
 	}
 	hmTypes.SortSpanByID(allSpans)
-	return types.NewGenesisState(
+
+	replacements, err := keeper.GetAllProducerReplacements(ctx)
+	if err != nil {
+		keeper.Logger(ctx).Error("Error ExportGenesis", "error", err)
+	}
+
+	genState := types.NewGenesisState(
 		params,
 		allSpans,
 	)
+	genState.ProducerReplacements = replacements
+
+	return genState
 }
@@ -63,11 +63,40 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 		params,
 		spans,
 	)
+	replacements := []types.ProducerReplacement{
+		{SpanId: 2, StartBlock: 15, ProducerId: 1, Replacement: hmTypes.Validator{ID: 100, VotingPower: 10}},
+	}
 	bor.InitGenesis(ctx, initApp.BorKeeper, types.GenesisState{
-		Params: genesisState.Params,
-		Spans:  genesisState.Spans,
+		Params:               genesisState.Params,
+		Spans:                genesisState.Spans,
+		ProducerReplacements: replacements,
 	})
 	actualParams := bor.ExportGenesis(ctx, initApp.BorKeeper)
 	require.NotNil(t, actualParams)
 	require.LessOrEqual(t, spanCount, len(actualParams.Spans))
+	require.Equal(t, replacements, actualParams.ProducerReplacements)
+}
+
+func (suite *GenesisTestSuite) TestInitGenesisPrunedSpans() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	params := types.DefaultParams()
+	params.SpanRetention = 2
+
+	spans := []*hmTypes.Span{
+		{ID: 4, StartBlock: 1024, EndBlock: 1279, BorChainId: "15001"},
+		{ID: 3, StartBlock: 768, EndBlock: 1023, BorChainId: "15001"},
+	}
+	bor.InitGenesis(ctx, initApp.BorKeeper, types.GenesisState{
+		Params: &params,
+		Spans:  spans,
+	})
+	require.Equal(t, uint64(3), initApp.BorKeeper.GetPrunedSpanCount(ctx))
+
+	lastSpan, err := initApp.BorKeeper.GetLastSpan(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), lastSpan.ID)
+
+	exported := bor.ExportGenesis(ctx, initApp.BorKeeper)
+	require.Len(t, exported.Spans, 2)
+	require.Equal(t, uint64(3), exported.Spans[0].ID)
 }
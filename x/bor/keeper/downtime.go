@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/bor/types"
+)
+
+// GetProducerMissedSprintsKey returns key of missed sprint count of producer in span
+func GetProducerMissedSprintsKey(spanID uint64, producerID uint64) []byte {
+	return append(getProducerMissedSprintsPrefix(spanID), sdk.Uint64ToBigEndian(producerID)...)
+}
+
+// GetMissedSprintKey returns key of reported missed sprint
+func GetMissedSprintKey(sprintStartBlock uint64) []byte {
+	return append(MissedSprintKey, sdk.Uint64ToBigEndian(sprintStartBlock)...)
+}
+
+func getProducerMissedSprintsPrefix(spanID uint64) []byte {
+	return append(ProducerMissedSprintsKey, sdk.Uint64ToBigEndian(spanID)...)
+}
+
+// GetProducerReplacementKey returns key of producer replacement in span taking effect at start block
+func GetProducerReplacementKey(spanID uint64, startBlock uint64) []byte {
+	return append(getProducerReplacementsPrefix(spanID), sdk.Uint64ToBigEndian(startBlock)...)
+}
+
+func getProducerReplacementsPrefix(spanID uint64) []byte {
+	return append(ProducerReplacementKey, sdk.Uint64ToBigEndian(spanID)...)
+}
+
+// HasMissedSprint checks if missed sprint starting at block is already reported
+func (k *Keeper) HasMissedSprint(ctx sdk.Context, sprintStartBlock uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetMissedSprintKey(sprintStartBlock))
+}
+
+// AddMissedSprint records sprint missed by producer in span and returns missed sprint count of producer
+func (k *Keeper) AddMissedSprint(ctx sdk.Context, spanID uint64, sprintStartBlock uint64, producerID uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetMissedSprintKey(sprintStartBlock), sdk.Uint64ToBigEndian(producerID))
+
+	missedSprints := k.GetProducerMissedSprints(ctx, spanID, producerID) + 1
+	store.Set(GetProducerMissedSprintsKey(spanID, producerID), sdk.Uint64ToBigEndian(missedSprints))
+
+	return missedSprints
+}
+
+// GetProducerMissedSprints returns number of sprints producer missed in span
+func (k *Keeper) GetProducerMissedSprints(ctx sdk.Context, spanID uint64, producerID uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetProducerMissedSprintsKey(spanID, producerID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetProducerDowntimes returns missed sprint counts of producers in span ordered by validator id
+func (k *Keeper) GetProducerDowntimes(ctx sdk.Context, spanID uint64) []types.ProducerDowntime {
+	store := ctx.KVStore(k.storeKey)
+	prefix := getProducerMissedSprintsPrefix(spanID)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	downtimes := make([]types.ProducerDowntime, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		downtimes = append(downtimes, types.ProducerDowntime{
+			ValidatorId:   sdk.BigEndianToUint64(iterator.Key()[len(prefix):]),
+			MissedSprints: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+
+	return downtimes
+}
+
+// GetReplacementProducer returns next eligible validator to replace one of producers,
+// which is span eligible validator with highest voting power not among producers yet
+func (k *Keeper) GetReplacementProducer(ctx sdk.Context, producers []hmTypes.Validator) (hmTypes.Validator, error) {
+	producerIDs := make(map[hmTypes.ValidatorID]bool)
+	for _, producer := range producers {
+		producerIDs[producer.ID] = true
+	}
+
+	var replacement *hmTypes.Validator
+	for _, val := range k.sk.GetSpanEligibleValidators(ctx) {
+		if producerIDs[val.ID] {
+			continue
+		}
+
+		if replacement == nil || val.VotingPower > replacement.VotingPower ||
+			(val.VotingPower == replacement.VotingPower && val.ID < replacement.ID) {
+			candidate := val
+			replacement = &candidate
+		}
+	}
+
+	if replacement == nil {
+		return hmTypes.Validator{}, errors.New("no eligible validator to replace producer")
+	}
+
+	return *replacement, nil
+}
+
+// GetProducerReplacements returns producer replacements of span ordered by start block
+func (k *Keeper) GetProducerReplacements(ctx sdk.Context, spanID uint64) ([]types.ProducerReplacement, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, getProducerReplacementsPrefix(spanID))
+	defer iterator.Close()
+
+	var replacements []types.ProducerReplacement
+	for ; iterator.Valid(); iterator.Next() {
+		var replacement types.ProducerReplacement
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &replacement); err != nil {
+			return nil, err
+		}
+		replacements = append(replacements, replacement)
+	}
+
+	return replacements, nil
+}
+
+// GetAllProducerReplacements returns producer replacements of all spans
+func (k *Keeper) GetAllProducerReplacements(ctx sdk.Context) ([]types.ProducerReplacement, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ProducerReplacementKey)
+	defer iterator.Close()
+
+	var replacements []types.ProducerReplacement
+	for ; iterator.Valid(); iterator.Next() {
+		var replacement types.ProducerReplacement
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &replacement); err != nil {
+			return nil, err
+		}
+		replacements = append(replacements, replacement)
+	}
+
+	return replacements, nil
+}
+
+// SetProducerReplacement stores producer replacement of span
+func (k *Keeper) SetProducerReplacement(ctx sdk.Context, replacement types.ProducerReplacement) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(&replacement)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetProducerReplacementKey(replacement.SpanId, replacement.StartBlock), out)
+	return nil
+}
+
+// GetSpanProducersAtBlock returns producers of span at block, with replacements effective at block applied
+func (k *Keeper) GetSpanProducersAtBlock(ctx sdk.Context, span *hmTypes.Span, block uint64) ([]hmTypes.Validator, error) {
+	replacements, err := k.GetProducerReplacements(ctx, span.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	producers := make([]hmTypes.Validator, len(span.SelectedProducers))
+	copy(producers, span.SelectedProducers)
+
+	for _, replacement := range replacements {
+		if replacement.StartBlock > block {
+			break
+		}
+
+		for i := range producers {
+			if producers[i].ID.Uint64() == replacement.ProducerId {
+				producers[i] = replacement.Replacement
+				break
+			}
+		}
+	}
+
+	return producers, nil
+}
+
+// ReplaceSpanProducer replaces producer of span with next eligible validator from start block
+// till end of the span. Replacement takes over producer slots of replaced producer.
+func (k *Keeper) ReplaceSpanProducer(ctx sdk.Context, spanID uint64, producerID uint64, startBlock uint64) (hmTypes.Validator, error) {
+	span, err := k.GetSpan(ctx, spanID)
+	if err != nil {
+		return hmTypes.Validator{}, err
+	}
+
+	if startBlock < span.StartBlock || startBlock > span.EndBlock {
+		return hmTypes.Validator{}, errors.New("replacement start block is out of span")
+	}
+
+	// replacements apply in order of start block
+	replacements, err := k.GetProducerReplacements(ctx, spanID)
+	if err != nil {
+		return hmTypes.Validator{}, err
+	}
+	if len(replacements) > 0 && replacements[len(replacements)-1].StartBlock >= startBlock {
+		return hmTypes.Validator{}, errors.New("replacement start block is not after last replacement")
+	}
+
+	producers, err := k.GetSpanProducersAtBlock(ctx, span, span.EndBlock)
+	if err != nil {
+		return hmTypes.Validator{}, err
+	}
+
+	index := -1
+	for i, producer := range producers {
+		if producer.ID.Uint64() == producerID {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return hmTypes.Validator{}, errors.New("producer not found in span")
+	}
+
+	replacement, err := k.GetReplacementProducer(ctx, producers)
+	if err != nil {
+		return hmTypes.Validator{}, err
+	}
+	replacement.VotingPower = producers[index].VotingPower
+
+	if err := k.SetProducerReplacement(ctx, types.ProducerReplacement{
+		SpanId:      spanID,
+		StartBlock:  startBlock,
+		ProducerId:  producerID,
+		Replacement: replacement,
+	}); err != nil {
+		return hmTypes.Validator{}, err
+	}
+
+	return replacement, nil
+}
@@ -32,6 +32,9 @@ const (
 	ParamSprint        = "sprint"
 	ParamProducerCount = "producer-count"
 	ParamLastEthBlock  = "last-eth-block"
+
+	ParamSelectionAlgorithm  = "selection-algorithm"
+	ParamMaxConsecutiveSpans = "max-consecutive-spans"
 )
 
 // Params returns all bor params info
@@ -47,6 +50,13 @@ func (k Querier) Params(context context.Context, req *types.QueryParamsRequest)
 		LatestEthBlock: latestEthBlock.Uint64(),
 		ProducerCount:  getParams.GetProducerCount(),
 		Sprint:         getParams.GetSprintDuration(),
+
+		SelectionAlgorithm:  types.ResolveSelectionAlgorithm(getParams.GetSelectionAlgorithm()),
+		MaxConsecutiveSpans: getParams.GetMaxConsecutiveSpans(),
+
+		MissedSprintsThreshold: getParams.GetMissedSprintsThreshold(),
+
+		SpanRetention: getParams.GetSpanRetention(),
 	}, nil
 }
 
@@ -78,6 +88,20 @@ func (k Querier) Param(context context.Context, req *types.QueryParamRequest) (*
 				ProducerCount: params.ProducerCount,
 			},
 		}, nil
+	case ParamSelectionAlgorithm:
+		params := k.GetParams(ctx)
+		return &types.QueryParamResponse{
+			Params: &types.QueryParamResponse_SelectionAlgorithm{
+				SelectionAlgorithm: types.ResolveSelectionAlgorithm(params.SelectionAlgorithm),
+			},
+		}, nil
+	case ParamMaxConsecutiveSpans:
+		params := k.GetParams(ctx)
+		return &types.QueryParamResponse{
+			Params: &types.QueryParamResponse_MaxConsecutiveSpans{
+				MaxConsecutiveSpans: params.MaxConsecutiveSpans,
+			},
+		}, nil
 	case ParamLastEthBlock:
 		latestEthBlock := k.GetLastEthBlock(ctx)
 		return &types.QueryParamResponse{
@@ -218,8 +242,120 @@ func (k Querier) PrepareNextSpan(context context.Context, req *types.PrepareNext
 		selectedProducers,
 		chainId,
 	)
+	newSpan.SelectionAlgorithm = types.ResolveSelectionAlgorithm(params.SelectionAlgorithm)
 
 	return &types.PrepareNextSpanResponse{
 		Span: &newSpan,
 	}, nil
 }
+
+// SpanDivergence compares spans stored in heimdall with spans committed on bor validator set contract
+func (k Querier) SpanDivergence(c context.Context, req *types.QuerySpanDivergenceRequest) (*types.QuerySpanDivergenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "last span not found")
+	}
+
+	borSpanID, err := k.GetBorCurrentSpanID(ctx, k.contractCaller)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not fetch current span from bor. Error:%v", err)
+	}
+
+	// default to latest spans known to either chain
+	end := req.EndSpanId
+	if end == 0 {
+		end = lastSpan.ID
+		if borSpanID > end {
+			end = borSpanID
+		}
+	}
+	start := req.StartSpanId
+	if start == 0 && end >= types.MaxSpanDivergenceRange {
+		start = end - types.MaxSpanDivergenceRange + 1
+	}
+	if start > end {
+		return nil, status.Error(codes.InvalidArgument, "start span id is greater than end span id")
+	}
+	if end-start >= types.MaxSpanDivergenceRange {
+		return nil, status.Errorf(codes.InvalidArgument, "span range is larger than %v", types.MaxSpanDivergenceRange)
+	}
+
+	divergences := make([]types.SpanDivergence, 0)
+	// spans pruned from heimdall or not yet committed on bor are not divergent
+	prunedSpanCount := k.GetPrunedSpanCount(ctx)
+	for id := start; id <= end && id <= borSpanID; id++ {
+		if id < prunedSpanCount {
+			continue
+		}
+
+		borStartBlock, borEndBlock, err := k.GetBorSpan(ctx, k.contractCaller, id)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "could not fetch span %v from bor. Error:%v", id, err)
+		}
+
+		divergence := types.SpanDivergence{
+			SpanId:        id,
+			BorStartBlock: borStartBlock,
+			BorEndBlock:   borEndBlock,
+		}
+
+		span, err := k.GetSpan(ctx, id)
+		if err != nil {
+			divergence.Reason = types.SpanDivergenceMissingOnHeimdall
+			divergences = append(divergences, divergence)
+			continue
+		}
+
+		if span.StartBlock != borStartBlock || span.EndBlock != borEndBlock {
+			divergence.HeimdallStartBlock = span.StartBlock
+			divergence.HeimdallEndBlock = span.EndBlock
+			divergence.Reason = types.SpanDivergenceBlockRangeMismatch
+			divergences = append(divergences, divergence)
+		}
+	}
+
+	return &types.QuerySpanDivergenceResponse{
+		BorCurrentSpanId:   borSpanID,
+		HeimdallLastSpanId: lastSpan.ID,
+		Divergences:        divergences,
+	}, nil
+}
+
+// ProducerDowntime returns number of sprints each producer missed in span
+func (k Querier) ProducerDowntime(c context.Context, req *types.QueryProducerDowntimeRequest) (*types.QueryProducerDowntimeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasSpan(ctx, req.SpanId) {
+		return nil, status.Errorf(codes.NotFound, "span %v not found", req.SpanId)
+	}
+
+	return &types.QueryProducerDowntimeResponse{Downtimes: k.GetProducerDowntimes(ctx, req.SpanId)}, nil
+}
+
+// SpanProducers returns producers of span at block, with producer replacements effective at block applied
+func (k Querier) SpanProducers(c context.Context, req *types.QuerySpanProducersRequest) (*types.QuerySpanProducersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	span, err := k.GetSpan(ctx, req.SpanId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "span %v not found", req.SpanId)
+	}
+
+	producers, err := k.GetSpanProducersAtBlock(ctx, span, req.Block)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpanProducersResponse{Producers: producers}, nil
+}
@@ -13,6 +13,7 @@ import (
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -393,6 +394,82 @@ func (suite *KeeperTestSuite) TestQueryPrepareNextSpan() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQuerySpanDivergence() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	grpcQuery := keeper.NewQueryServerImpl(initApp.BorKeeper, &suite.contractCaller)
+
+	for _, span := range []hmTypes.Span{
+		{ID: 0, StartBlock: 0, EndBlock: 255},
+		{ID: 1, StartBlock: 256, EndBlock: 6655},
+		{ID: 2, StartBlock: 6656, EndBlock: 13055},
+	} {
+		require.NoError(t, initApp.BorKeeper.AddNewSpan(ctx, span))
+	}
+
+	// bor has different range for span 2 and one more span
+	suite.contractCaller.On("GetValidatorSetInstance", mock.Anything).Return(nil, nil)
+	suite.contractCaller.On("CurrentSpanNumber", mock.Anything).Return(big.NewInt(3))
+	suite.contractCaller.On("GetSpanDetails", big.NewInt(0), mock.Anything).Return(big.NewInt(0), big.NewInt(0), big.NewInt(255), nil)
+	suite.contractCaller.On("GetSpanDetails", big.NewInt(1), mock.Anything).Return(big.NewInt(1), big.NewInt(256), big.NewInt(6655), nil)
+	suite.contractCaller.On("GetSpanDetails", big.NewInt(2), mock.Anything).Return(big.NewInt(2), big.NewInt(6656), big.NewInt(12799), nil)
+	suite.contractCaller.On("GetSpanDetails", big.NewInt(3), mock.Anything).Return(big.NewInt(3), big.NewInt(12800), big.NewInt(19199), nil)
+
+	resp, err := grpcQuery.SpanDivergence(sdk.WrapSDKContext(ctx), &borTypes.QuerySpanDivergenceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.BorCurrentSpanId)
+	require.Equal(t, uint64(2), resp.HeimdallLastSpanId)
+	require.Equal(t, []borTypes.SpanDivergence{
+		{SpanId: 2, HeimdallStartBlock: 6656, HeimdallEndBlock: 13055, BorStartBlock: 6656, BorEndBlock: 12799, Reason: borTypes.SpanDivergenceBlockRangeMismatch},
+		{SpanId: 3, BorStartBlock: 12800, BorEndBlock: 19199, Reason: borTypes.SpanDivergenceMissingOnHeimdall},
+	}, resp.Divergences)
+
+	// matching range
+	resp, err = grpcQuery.SpanDivergence(sdk.WrapSDKContext(ctx), &borTypes.QuerySpanDivergenceRequest{StartSpanId: 0, EndSpanId: 1})
+	require.NoError(t, err)
+	require.Empty(t, resp.Divergences)
+
+	// invalid range
+	_, err = grpcQuery.SpanDivergence(sdk.WrapSDKContext(ctx), &borTypes.QuerySpanDivergenceRequest{StartSpanId: 2, EndSpanId: 1})
+	require.Error(t, err)
+	_, err = grpcQuery.SpanDivergence(sdk.WrapSDKContext(ctx), &borTypes.QuerySpanDivergenceRequest{StartSpanId: 1, EndSpanId: 1 + borTypes.MaxSpanDivergenceRange})
+	require.Error(t, err)
+
+	_, err = grpcQuery.SpanDivergence(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	// reset the contractCaller
+	suite.contractCaller = mocks.IContractCaller{}
+}
+
+func (suite *KeeperTestSuite) TestQueryProducerDowntime() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	grpcQuery := keeper.NewQueryServerImpl(initApp.BorKeeper, &suite.contractCaller)
+
+	_, err := grpcQuery.ProducerDowntime(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+
+	// span not found
+	_, err = grpcQuery.ProducerDowntime(sdk.WrapSDKContext(ctx), &borTypes.QueryProducerDowntimeRequest{SpanId: 1})
+	require.Error(t, err)
+
+	require.NoError(t, initApp.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: 1, StartBlock: 256, EndBlock: 6655}))
+	resp, err := grpcQuery.ProducerDowntime(sdk.WrapSDKContext(ctx), &borTypes.QueryProducerDowntimeRequest{SpanId: 1})
+	require.NoError(t, err)
+	require.Empty(t, resp.Downtimes)
+
+	initApp.BorKeeper.AddMissedSprint(ctx, 1, 272, 3)
+	initApp.BorKeeper.AddMissedSprint(ctx, 1, 256, 2)
+	initApp.BorKeeper.AddMissedSprint(ctx, 1, 288, 3)
+	resp, err = grpcQuery.ProducerDowntime(sdk.WrapSDKContext(ctx), &borTypes.QueryProducerDowntimeRequest{SpanId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []borTypes.ProducerDowntime{
+		{ValidatorId: 2, MissedSprints: 1},
+		{ValidatorId: 3, MissedSprints: 2},
+	}, resp.Downtimes)
+}
+
 func (suite *KeeperTestSuite) TestQueryNextSpanSeed() {
 	t, initApp, ctx := suite.T(), suite.app, suite.ctx
 
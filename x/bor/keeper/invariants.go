@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/bor/types"
+)
+
+// RegisterInvariants registers all bor invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contiguous-spans", ContiguousSpansInvariant(k))
+}
+
+// ContiguousSpansInvariant checks that retained spans have consecutive ids up to last span
+// and each span starts at previous span end + 1
+func ContiguousSpansInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		spans, err := k.GetAllSpans(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "contiguous-spans", fmt.Sprintf("\tunable to load spans: %v\n", err)), true
+		}
+
+		if len(spans) > 0 {
+			hmTypes.SortSpanByID(spans)
+
+			if pruned := k.GetPrunedSpanCount(ctx); spans[0].ID != pruned {
+				msg += fmt.Sprintf("\tfirst span is %d, pruned spans: %d\n", spans[0].ID, pruned)
+				broken = true
+			}
+
+			for i, span := range spans {
+				if span.StartBlock > span.EndBlock {
+					msg += fmt.Sprintf("\tspan %d starts at %d after its end %d\n", span.ID, span.StartBlock, span.EndBlock)
+					broken = true
+				}
+
+				if i == 0 {
+					continue
+				}
+
+				prev := spans[i-1]
+				if span.ID != prev.ID+1 {
+					msg += fmt.Sprintf("\tspan %d follows span %d\n", span.ID, prev.ID)
+					broken = true
+				} else if span.StartBlock != prev.EndBlock+1 {
+					msg += fmt.Sprintf("\tspan %d starts at %d, previous span ends at %d\n", span.ID, span.StartBlock, prev.EndBlock)
+					broken = true
+				}
+			}
+
+			store := ctx.KVStore(k.storeKey)
+			lastSpanID := strconv.FormatUint(spans[len(spans)-1].ID, 10)
+			if storedLastSpanID := string(store.Get(LastSpanIDKey)); storedLastSpanID != lastSpanID {
+				msg += fmt.Sprintf("\tlast span id is %q, latest stored span is %s\n", storedLastSpanID, lastSpanID)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "contiguous-spans", msg), broken
+	}
+}
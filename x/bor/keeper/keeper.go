@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/maticnetwork/heimdall/contracts/validatorset"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/merr"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -29,9 +30,19 @@ var (
 	SpanDurationKey       = []byte{0x24} // Key to store span duration for Bor
 	SprintDurationKey     = []byte{0x25} // Key to store span duration for Bor
 	LastSpanIDKey         = []byte{0x35} // Key to store last span start block
-	SpanPrefixKey         = []byte{0x36} // prefix key to store span
+	LegacySpanPrefixKey   = []byte{0x36} // prefix key of spans stored with full validator set, migrated to SpanPrefixKey
 	SpanCacheKey          = []byte{0x37} // key to store Cache for span
 	LastProcessedEthBlock = []byte{0x38} // key to store last processed eth block for seed
+
+	ProducerMissedSprintsKey = []byte{0x39} // prefix key to store missed sprint count of producer in span
+	MissedSprintKey          = []byte{0x3a} // prefix key to store reported missed sprints
+
+	SpanPrefixKey                 = []byte{0x3b} // prefix key to store span referencing its validator set by hash
+	ValidatorSetPrefixKey         = []byte{0x3c} // prefix key to store validator sets of spans by hash
+	ValidatorSetRefCountPrefixKey = []byte{0x3d} // prefix key to store number of spans referencing validator set
+	PrunedSpanCountKey            = []byte{0x3e} // key to store number of pruned spans
+
+	ProducerReplacementKey = []byte{0x3f} // prefix key to store producer replacements of span by start block
 )
 
 // Keeper stores all related data
@@ -89,9 +100,13 @@ func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) {
 	k.paramSpace.SetParamSet(ctx, params)
 }
 
-// GetParams gets the bor module's parameters.
+// GetParams gets the bor module's parameters, params missing in store keep default values
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
 	return
 }
 
@@ -102,13 +117,6 @@ func GetSpanKey(id uint64) []byte {
 
 // AddNewSpan adds new span for bor to store
 func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
-	store := ctx.KVStore(k.storeKey)
-	out, err := k.cdc.MarshalBinaryBare(&span)
-	if err != nil {
-		k.Logger(ctx).Error("Error marshalling span", "error", err)
-		return err
-	}
-
 	spanKey := GetSpanKey(span.ID)
 	if spanKey == nil {
 		k.Logger(ctx).Error("Error invalid span key")
@@ -116,7 +124,9 @@ func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
 
 	}
 	// store set span id
-	store.Set(spanKey, out)
+	if err := k.setSpan(ctx, span); err != nil {
+		return err
+	}
 
 	// update last span
 	k.UpdateLastSpan(ctx, span.ID)
@@ -125,14 +135,7 @@ func (k *Keeper) AddNewSpan(ctx sdk.Context, span hmTypes.Span) error {
 
 // AddNewRawSpan adds new span for bor to store
 func (k *Keeper) AddNewRawSpan(ctx sdk.Context, span hmTypes.Span) error {
-	store := ctx.KVStore(k.storeKey)
-	out, err := k.cdc.MarshalBinaryBare(&span)
-	if err != nil {
-		k.Logger(ctx).Error("Error marshalling span", "error", err)
-		return err
-	}
-	store.Set(GetSpanKey(span.ID), out)
-	return nil
+	return k.setSpan(ctx, span)
 }
 
 // GetSpan fetches span indexed by id from store
@@ -145,13 +148,7 @@ func (k *Keeper) GetSpan(ctx sdk.Context, id uint64) (*hmTypes.Span, error) {
 		return nil, errors.New("span not found for id")
 	}
 
-	var span hmTypes.Span
-	if err := k.cdc.UnmarshalBinaryBare(store.Get(spanKey), &span); err != nil {
-		k.Logger(ctx).Error("Error unmarshalling span", "error", err)
-		return nil, err
-	}
-
-	return &span, nil
+	return k.unmarshalSpan(ctx, store.Get(spanKey))
 }
 
 func (k *Keeper) HasSpan(ctx sdk.Context, id uint64) bool {
@@ -192,14 +189,11 @@ func (k *Keeper) GetSpanList(ctx sdk.Context, page uint64, limit uint64) ([]*hmT
 
 	// loop through validators to get valid validators
 	for ; iterator.Valid(); iterator.Next() {
-		var span hmTypes.Span
-		err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &span)
-		if err == nil {
-			spans = append(spans, &span)
-		} else {
-			k.Logger(ctx).Error("Error unmarshalling span", "error", err)
+		span, err := k.unmarshalSpan(ctx, iterator.Value())
+		if err != nil {
 			return nil, err
 		}
+		spans = append(spans, span)
 	}
 
 	return spans, nil
@@ -226,6 +220,7 @@ func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
 func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlock uint64, borChainID string, seed common.Hash) error {
 
 	// select next producers
+	algorithm := types.ResolveSelectionAlgorithm(k.GetParams(ctx).SelectionAlgorithm)
 	newProducers, err := k.SelectNextProducers(ctx, seed)
 	if err != nil {
 		return err
@@ -251,6 +246,7 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 		newProducers,
 		borChainID,
 	)
+	newSpan.SelectionAlgorithm = algorithm
 
 	return k.AddNewSpan(ctx, newSpan)
 }
@@ -259,15 +255,21 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, endBlo
 func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []hmTypes.Validator, err error) {
 	// spanEligibleVals are current validators who are not getting deactivated in between next span
 	spanEligibleVals := k.sk.GetSpanEligibleValidators(ctx)
-	producerCount := k.GetParams(ctx).ProducerCount
+	params := k.GetParams(ctx)
+	producerCount := params.ProducerCount
 
 	// if producers to be selected is more than current validators no need to select/shuffle
 	if len(spanEligibleVals) <= int(producerCount) {
 		return spanEligibleVals, nil
 	}
 
+	algorithm := types.ResolveSelectionAlgorithm(params.SelectionAlgorithm)
+	if algorithm == types.SelectionAlgorithmWeightedWithoutReplacement && params.MaxConsecutiveSpans > 0 {
+		spanEligibleVals = k.excludeConsecutiveProducers(ctx, spanEligibleVals, params.MaxConsecutiveSpans, producerCount)
+	}
+
 	// select next producers using seed as blockheader hash
-	newProducersIds, err := SelectNextProducers(seed, spanEligibleVals, producerCount)
+	newProducersIds, err := SelectNextProducersByAlgorithm(algorithm, seed, spanEligibleVals, producerCount)
 	if err != nil {
 		return vals, err
 	}
@@ -288,6 +290,48 @@ func (k *Keeper) SelectNextProducers(ctx sdk.Context, seed common.Hash) (vals []
 	return vals, nil
 }
 
+// excludeConsecutiveProducers removes validators which produced in last maxConsecutiveSpans spans in a row.
+// Cap is not applied if it leaves less than producerCount validators to select from.
+func (k *Keeper) excludeConsecutiveProducers(ctx sdk.Context, spanEligibleVals []hmTypes.Validator, maxConsecutiveSpans uint64, producerCount uint64) []hmTypes.Validator {
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return spanEligibleVals
+	}
+
+	// count spans in a row, ending with last span, each validator produced in
+	streaks := make(map[uint64]uint64)
+	span := lastSpan
+	for i := uint64(0); i < maxConsecutiveSpans; i++ {
+		for _, producer := range span.SelectedProducers {
+			if streaks[producer.ID.Uint64()] == i {
+				streaks[producer.ID.Uint64()] = i + 1
+			}
+		}
+
+		if span.ID == 0 || i+1 == maxConsecutiveSpans {
+			break
+		}
+
+		if span, err = k.GetSpan(ctx, span.ID-1); err != nil {
+			break
+		}
+	}
+
+	var vals []hmTypes.Validator
+	for _, val := range spanEligibleVals {
+		if streaks[val.ID.Uint64()] < maxConsecutiveSpans {
+			vals = append(vals, val)
+		}
+	}
+
+	if len(vals) < int(producerCount) {
+		k.Logger(ctx).Debug("Not enough validators left under consecutive span cap, ignoring cap", "maxConsecutiveSpans", maxConsecutiveSpans)
+		return spanEligibleVals
+	}
+
+	return vals
+}
+
 // UpdateLastSpan updates the last span start block
 func (k *Keeper) UpdateLastSpan(ctx sdk.Context, id uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -338,6 +382,45 @@ func (k Keeper) GetNextSpanSeed(ctx sdk.Context, contractCaller helper.IContract
 	return blockHeader.Hash(), nil
 }
 
+// GetBorCurrentSpanID fetches id of current span committed on bor validator set contract
+func (k Keeper) GetBorCurrentSpanID(ctx sdk.Context, contractCaller helper.IContractCaller) (uint64, error) {
+	validatorSetInstance, err := k.getBorValidatorSetInstance(ctx, contractCaller)
+	if err != nil {
+		return 0, err
+	}
+
+	currentSpanID := contractCaller.CurrentSpanNumber(validatorSetInstance)
+	if currentSpanID == nil {
+		return 0, errors.New("unable to fetch current span number from bor")
+	}
+
+	return currentSpanID.Uint64(), nil
+}
+
+// GetBorSpan fetches start and end block of span committed on bor validator set contract
+func (k Keeper) GetBorSpan(ctx sdk.Context, contractCaller helper.IContractCaller, id uint64) (uint64, uint64, error) {
+	validatorSetInstance, err := k.getBorValidatorSetInstance(ctx, contractCaller)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	_, startBlock, endBlock, err := contractCaller.GetSpanDetails(new(big.Int).SetUint64(id), validatorSetInstance)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if startBlock == nil || endBlock == nil || endBlock.Sign() == 0 {
+		return 0, 0, fmt.Errorf("span %d not committed on bor", id)
+	}
+
+	return startBlock.Uint64(), endBlock.Uint64(), nil
+}
+
+func (k Keeper) getBorValidatorSetInstance(ctx sdk.Context, contractCaller helper.IContractCaller) (*validatorset.Validatorset, error) {
+	chainParams := k.chainKeeper.GetParams(ctx).ChainParams
+	return contractCaller.GetValidatorSetInstance(common.HexToAddress(chainParams.ValidatorSetAddress))
+}
+
 //
 // Utils
 //
@@ -353,14 +436,12 @@ func (k *Keeper) IterateSpansAndApplyFn(ctx sdk.Context, f func(span hmTypes.Spa
 	// loop through spans to get valid spans
 	for ; iterator.Valid(); iterator.Next() {
 		// unmarshall span
-		var result hmTypes.Span
-		err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &result)
+		result, err := k.unmarshalSpan(ctx, iterator.Value())
 		if err != nil {
-			k.Logger(ctx).Error("Error UnmarshalBinaryBare", "error", err)
 			return err
 		}
 		// call function and return if required
-		resultError := f(result)
+		resultError := f(*result)
 		if resultError != nil {
 			k.Logger(ctx).Error("Error UnmarshalBinaryBare", "error", resultError)
 			return resultError
@@ -16,7 +16,9 @@ import (
 
 	"github.com/maticnetwork/heimdall/helper/mocks"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/x/bor/test_helper"
 	"github.com/stretchr/testify/suite"
@@ -188,6 +190,55 @@ func (suite *KeeperTestSuite) TestBorKeeperSelectNextProducers() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestBorKeeperSelectNextDistinctProducers() {
+	initApp, ctx := suite.app, suite.ctx
+	seed := common.HexToHash("testSeed")
+
+	simulation.LoadValidatorSet(6, suite.T(), initApp.StakingKeeper, ctx, false, 0)
+	params := borTypes.Params{
+		SprintDuration:     1,
+		SpanDuration:       1,
+		ProducerCount:      3,
+		SelectionAlgorithm: borTypes.SelectionAlgorithmWeightedWithoutReplacement,
+	}
+	initApp.BorKeeper.SetParams(ctx, &params)
+
+	// distinct producers with one slot each
+	out, err := initApp.BorKeeper.SelectNextProducers(ctx, seed)
+	suite.NoError(err)
+	suite.Len(out, 3)
+	lastProducers := make(map[hmTypes.ValidatorID]bool)
+	for _, val := range out {
+		suite.Equal(int64(1), val.VotingPower)
+		lastProducers[val.ID] = true
+	}
+
+	// span records selection algorithm
+	err = initApp.BorKeeper.FreezeSet(ctx, 1, 1, 100, "15001", seed)
+	suite.NoError(err)
+	span, err := initApp.BorKeeper.GetSpan(ctx, 1)
+	suite.NoError(err)
+	suite.Equal(borTypes.SelectionAlgorithmWeightedWithoutReplacement, span.SelectionAlgorithm)
+	suite.Equal(out, span.SelectedProducers)
+
+	// producers of last span are capped
+	params.MaxConsecutiveSpans = 1
+	initApp.BorKeeper.SetParams(ctx, &params)
+	out, err = initApp.BorKeeper.SelectNextProducers(ctx, seed)
+	suite.NoError(err)
+	suite.Len(out, 3)
+	for _, val := range out {
+		suite.False(lastProducers[val.ID], "producer of last span selected again")
+	}
+
+	// cap is ignored if not enough validators are left
+	params.ProducerCount = 4
+	initApp.BorKeeper.SetParams(ctx, &params)
+	out, err = initApp.BorKeeper.SelectNextProducers(ctx, seed)
+	suite.NoError(err)
+	suite.Len(out, 4)
+}
+
 func (suite *KeeperTestSuite) TestGetAllSpans() {
 	initApp, ctx := suite.app, suite.ctx
 
@@ -233,3 +284,253 @@ func (suite *KeeperTestSuite) TestGetLastEthBlock() {
 		suite.Equal(c.expOut, out, cMsg)
 	}
 }
+
+func (suite *KeeperTestSuite) TestProducerDowntime() {
+	initApp, ctx := suite.app, suite.ctx
+	seed := common.HexToHash("testSeed")
+
+	simulation.LoadValidatorSet(6, suite.T(), initApp.StakingKeeper, ctx, false, 0)
+	params := borTypes.Params{
+		SprintDuration:     16,
+		SpanDuration:       6400,
+		ProducerCount:      3,
+		SelectionAlgorithm: borTypes.SelectionAlgorithmWeightedWithoutReplacement,
+	}
+	initApp.BorKeeper.SetParams(ctx, &params)
+
+	err := initApp.BorKeeper.FreezeSet(ctx, 1, 256, 6655, "15001", seed)
+	suite.NoError(err)
+	span, err := initApp.BorKeeper.GetSpan(ctx, 1)
+	suite.NoError(err)
+	producer := span.SelectedProducers[0]
+
+	// missed sprints are counted per producer
+	suite.False(initApp.BorKeeper.HasMissedSprint(ctx, 256))
+	suite.Equal(uint64(1), initApp.BorKeeper.AddMissedSprint(ctx, 1, 256, producer.ID.Uint64()))
+	suite.Equal(uint64(2), initApp.BorKeeper.AddMissedSprint(ctx, 1, 272, producer.ID.Uint64()))
+	suite.True(initApp.BorKeeper.HasMissedSprint(ctx, 256))
+	suite.Equal(uint64(2), initApp.BorKeeper.GetProducerMissedSprints(ctx, 1, producer.ID.Uint64()))
+	suite.Equal([]borTypes.ProducerDowntime{{ValidatorId: producer.ID.Uint64(), MissedSprints: 2}}, initApp.BorKeeper.GetProducerDowntimes(ctx, 1))
+	suite.Empty(initApp.BorKeeper.GetProducerDowntimes(ctx, 2))
+
+	// start block must be within span
+	_, err = initApp.BorKeeper.ReplaceSpanProducer(ctx, 1, producer.ID.Uint64(), 6656)
+	suite.Error(err)
+
+	// replacement takes over producer slots from start block
+	replacement, err := initApp.BorKeeper.ReplaceSpanProducer(ctx, 1, producer.ID.Uint64(), 512)
+	suite.NoError(err)
+	suite.Equal(producer.VotingPower, replacement.VotingPower)
+
+	stored, err := initApp.BorKeeper.GetSpan(ctx, 1)
+	suite.NoError(err)
+	suite.Equal(span.SelectedProducers, stored.SelectedProducers)
+
+	producers, err := initApp.BorKeeper.GetSpanProducersAtBlock(ctx, stored, 511)
+	suite.NoError(err)
+	suite.Equal(span.SelectedProducers, producers)
+
+	producers, err = initApp.BorKeeper.GetSpanProducersAtBlock(ctx, stored, 512)
+	suite.NoError(err)
+	suite.Len(producers, 3)
+	for _, val := range producers {
+		suite.NotEqual(producer.ID, val.ID)
+	}
+	suite.Contains(producers, replacement)
+	suite.Equal(replacement, producers[0], "replacement keeps position of replaced producer")
+
+	// replaced producer is no longer in span
+	_, err = initApp.BorKeeper.ReplaceSpanProducer(ctx, 1, producer.ID.Uint64(), 768)
+	suite.Error(err)
+
+	// replacements apply in order of start block
+	_, err = initApp.BorKeeper.ReplaceSpanProducer(ctx, 1, replacement.ID.Uint64(), 512)
+	suite.Error(err)
+
+	// failed replacements are not recorded
+	replacements, err := initApp.BorKeeper.GetProducerReplacements(ctx, 1)
+	suite.NoError(err)
+	suite.Len(replacements, 1)
+}
+
+func (suite *KeeperTestSuite) TestSpanValidatorSetDeduplication() {
+	initApp, ctx := suite.app, suite.ctx
+
+	vals := []*hmTypes.Validator{
+		{ID: 1, VotingPower: 10, Signer: "0x0000000000000000000000000000000000000001"},
+		{ID: 2, VotingPower: 20, Signer: "0x0000000000000000000000000000000000000002"},
+	}
+	setA := hmTypes.ValidatorSet{Validators: vals, TotalVotingPower: 30}
+	setB := hmTypes.ValidatorSet{Validators: vals[:1], TotalVotingPower: 10}
+
+	spans := []hmTypes.Span{
+		{ID: 0, StartBlock: 0, EndBlock: 255, ValidatorSet: setA, BorChainId: "15001"},
+		{ID: 1, StartBlock: 256, EndBlock: 6655, ValidatorSet: setA, BorChainId: "15001"},
+		{ID: 2, StartBlock: 6656, EndBlock: 13055, ValidatorSet: setB, BorChainId: "15001"},
+	}
+	for _, span := range spans {
+		suite.NoError(initApp.BorKeeper.AddNewSpan(ctx, span))
+	}
+
+	// spans are returned unchanged
+	for _, span := range spans {
+		out, err := initApp.BorKeeper.GetSpan(ctx, span.ID)
+		suite.NoError(err)
+		suite.Equal(span.ValidatorSet, out.ValidatorSet)
+		suite.Equal(span.EndBlock, out.EndBlock)
+	}
+
+	// identical validator sets are stored once, referenced by each of their spans
+	store := ctx.KVStore(initApp.GetKey(borTypes.StoreKey))
+	iterator := sdk.KVStorePrefixIterator(store, keeper.ValidatorSetPrefixKey)
+	var refCounts []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		hash := iterator.Key()[len(keeper.ValidatorSetPrefixKey):]
+		refCounts = append(refCounts, initApp.BorKeeper.GetValidatorSetRefCount(ctx, hash))
+	}
+	iterator.Close()
+	suite.ElementsMatch([]uint64{2, 1}, refCounts)
+
+	// validator sets differing only in proposer priorities are stored once
+	rotated := []*hmTypes.Validator{
+		{ID: 1, VotingPower: 10, ProposerPriority: -5, Signer: "0x0000000000000000000000000000000000000001"},
+		{ID: 2, VotingPower: 20, ProposerPriority: 5, Signer: "0x0000000000000000000000000000000000000002"},
+	}
+	span := hmTypes.Span{ID: 3, StartBlock: 13056, EndBlock: 19455, ValidatorSet: hmTypes.ValidatorSet{Validators: rotated, TotalVotingPower: 30}, BorChainId: "15001"}
+	suite.NoError(initApp.BorKeeper.AddNewSpan(ctx, span))
+	out, err := initApp.BorKeeper.GetSpan(ctx, span.ID)
+	suite.NoError(err)
+	suite.Equal(setA, out.ValidatorSet)
+	refCounts = nil
+	iterator = sdk.KVStorePrefixIterator(store, keeper.ValidatorSetPrefixKey)
+	for ; iterator.Valid(); iterator.Next() {
+		hash := iterator.Key()[len(keeper.ValidatorSetPrefixKey):]
+		refCounts = append(refCounts, initApp.BorKeeper.GetValidatorSetRefCount(ctx, hash))
+	}
+	iterator.Close()
+	suite.ElementsMatch([]uint64{3, 1}, refCounts)
+
+	// overwriting span releases its previous validator set
+	spans[2].ValidatorSet = setA
+	suite.NoError(initApp.BorKeeper.AddNewRawSpan(ctx, spans[2]))
+	iterator = sdk.KVStorePrefixIterator(store, keeper.ValidatorSetPrefixKey)
+	suite.True(iterator.Valid())
+	suite.Equal(uint64(4), initApp.BorKeeper.GetValidatorSetRefCount(ctx, iterator.Key()[len(keeper.ValidatorSetPrefixKey):]))
+	iterator.Next()
+	suite.False(iterator.Valid())
+	iterator.Close()
+}
+
+func (suite *KeeperTestSuite) TestPruneSpans() {
+	initApp, ctx := suite.app, suite.ctx
+
+	for id := uint64(0); id < 5; id++ {
+		suite.NoError(initApp.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: id, StartBlock: id * 256, EndBlock: id*256 + 255}))
+	}
+	initApp.BorKeeper.AddMissedSprint(ctx, 1, 272, 2)
+	initApp.BorKeeper.AddMissedSprint(ctx, 3, 784, 2)
+
+	// retention disabled keeps all spans
+	suite.Equal(uint64(0), initApp.BorKeeper.PruneSpans(ctx))
+
+	params := borTypes.DefaultParams()
+	params.SpanRetention = 2
+	initApp.BorKeeper.SetParams(ctx, &params)
+	suite.Equal(uint64(3), initApp.BorKeeper.PruneSpans(ctx))
+	suite.Equal(uint64(3), initApp.BorKeeper.GetPrunedSpanCount(ctx))
+	suite.Equal(uint64(0), initApp.BorKeeper.PruneSpans(ctx))
+
+	spans, err := initApp.BorKeeper.GetAllSpans(ctx)
+	suite.NoError(err)
+	suite.Len(spans, 2)
+	suite.Equal(uint64(3), spans[0].ID)
+
+	// missed sprints of pruned spans are removed
+	suite.False(initApp.BorKeeper.HasMissedSprint(ctx, 272))
+	suite.Empty(initApp.BorKeeper.GetProducerDowntimes(ctx, 1))
+	suite.True(initApp.BorKeeper.HasMissedSprint(ctx, 784))
+
+	lastSpan, err := initApp.BorKeeper.GetLastSpan(ctx)
+	suite.NoError(err)
+	suite.Equal(uint64(4), lastSpan.ID)
+}
+
+func (suite *KeeperTestSuite) TestMigrateSpans() {
+	initApp, ctx := suite.app, suite.ctx
+	store := ctx.KVStore(initApp.GetKey(borTypes.StoreKey))
+
+	validatorSet := hmTypes.ValidatorSet{
+		Validators:       []*hmTypes.Validator{{ID: 1, VotingPower: 10, Signer: "0x0000000000000000000000000000000000000001"}},
+		TotalVotingPower: 10,
+	}
+	legacySpans := []hmTypes.Span{
+		{ID: 0, StartBlock: 0, EndBlock: 255, ValidatorSet: validatorSet, BorChainId: "15001"},
+		{ID: 1, StartBlock: 256, EndBlock: 6655, ValidatorSet: validatorSet, BorChainId: "15001"},
+	}
+	for _, span := range legacySpans {
+		bz, err := initApp.AppCodec().MarshalBinaryBare(&span)
+		suite.NoError(err)
+		store.Set(keeper.GetLegacySpanKey(span.ID), bz)
+	}
+	initApp.BorKeeper.UpdateLastSpan(ctx, 1)
+
+	suite.True(initApp.BorKeeper.HasLegacySpans(ctx))
+
+	migrated, err := initApp.BorKeeper.MigrateSpans(ctx)
+	suite.NoError(err)
+	suite.Equal(uint64(2), migrated)
+	suite.False(initApp.BorKeeper.HasLegacySpans(ctx))
+
+	spans, err := initApp.BorKeeper.GetAllSpans(ctx)
+	suite.NoError(err)
+	suite.Equal([]*hmTypes.Span{&legacySpans[0], &legacySpans[1]}, spans)
+
+	lastSpan, err := initApp.BorKeeper.GetLastSpan(ctx)
+	suite.NoError(err)
+	suite.Equal(legacySpans[1], *lastSpan)
+}
+
+func (suite *KeeperTestSuite) TestContiguousSpansInvariant() {
+	initApp, ctx := suite.app, suite.ctx
+	invariant := keeper.ContiguousSpansInvariant(initApp.BorKeeper)
+
+	for id := uint64(0); id < 4; id++ {
+		suite.NoError(initApp.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: id, StartBlock: id * 256, EndBlock: id*256 + 255}))
+	}
+
+	_, broken := invariant(ctx)
+	suite.False(broken)
+
+	// pruned spans are not expected in state
+	params := borTypes.DefaultParams()
+	params.SpanRetention = 2
+	initApp.BorKeeper.SetParams(ctx, &params)
+	suite.Equal(uint64(2), initApp.BorKeeper.PruneSpans(ctx))
+
+	_, broken = invariant(ctx)
+	suite.False(broken)
+
+	// gap between spans
+	suite.NoError(initApp.BorKeeper.AddNewSpan(ctx, hmTypes.Span{ID: 4, StartBlock: 1100, EndBlock: 1355}))
+
+	msg, broken := invariant(ctx)
+	suite.True(broken)
+	suite.Contains(msg, "span 4 starts at 1100")
+}
+
+func (suite *KeeperTestSuite) TestGetParamsMissingKeys() {
+	initApp, ctx := suite.app, suite.ctx
+
+	params := borTypes.DefaultParams()
+	params.SprintDuration = 32
+	params.MaxConsecutiveSpans = 5
+	initApp.BorKeeper.SetParams(ctx, &params)
+
+	// params added by upgrade are missing in store of earlier version
+	paramsStore := prefix.NewStore(ctx.KVStore(initApp.GetKey(paramstypes.StoreKey)), append([]byte(borTypes.ModuleName), '/'))
+	paramsStore.Delete(borTypes.KeyMaxConsecutiveSpans)
+
+	result := initApp.BorKeeper.GetParams(ctx)
+	suite.Equal(uint64(32), result.SprintDuration)
+	suite.Equal(borTypes.DefaultParams().MaxConsecutiveSpans, result.MaxConsecutiveSpans)
+}
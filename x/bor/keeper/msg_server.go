@@ -8,6 +8,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/x/bor/types"
 )
 
@@ -85,3 +86,128 @@ func (m msgServer) PostSendProposeSpanTx(goCtx context.Context, msg *types.MsgPr
 
 	return &types.MsgProposeSpanResponse{}, nil
 }
+
+func (m msgServer) ReportMissedSprint(goCtx context.Context, msg *types.MsgReportMissedSprint) (*types.MsgReportMissedSprintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.Keeper.Logger(ctx).Debug("✅ Validating report missed sprint msg",
+		"spanId", msg.SpanId,
+		"sprintStartBlock", msg.SprintStartBlock,
+		"producerId", msg.ProducerId,
+	)
+
+	span, err := m.validateSpanProducer(ctx, msg.SpanId, msg.ProducerId, msg.BorChainId, msg.SprintStartBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// sprint must start within span
+	sprintDuration := m.Keeper.GetParams(ctx).SprintDuration
+	if msg.SprintStartBlock < span.StartBlock || msg.SprintStartBlock > span.EndBlock || msg.SprintStartBlock%sprintDuration != 0 {
+		m.Keeper.Logger(ctx).Error("Sprint not in span",
+			"sprintStartBlock", msg.SprintStartBlock,
+			"spanStartBlock", span.StartBlock,
+			"spanEndBlock", span.EndBlock,
+		)
+		return nil, hmCommon.ErrSprintNotInSpan
+	}
+
+	// check for replay
+	if m.Keeper.HasMissedSprint(ctx, msg.SprintStartBlock) {
+		m.Keeper.Logger(ctx).Error("Missed sprint already reported", "sprintStartBlock", msg.SprintStartBlock)
+		return nil, hmCommon.ErrSprintAlreadyMissed
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReportMissedSprint,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanId, 10)),
+			sdk.NewAttribute(types.AttributeKeySprintStartBlock, strconv.FormatUint(msg.SprintStartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyProducerID, strconv.FormatUint(msg.ProducerId, 10)),
+		),
+	})
+
+	return &types.MsgReportMissedSprintResponse{}, nil
+}
+
+func (m msgServer) ReplaceProducer(goCtx context.Context, msg *types.MsgReplaceProducer) (*types.MsgReplaceProducerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.Keeper.Logger(ctx).Debug("✅ Validating replace producer msg",
+		"spanId", msg.SpanId,
+		"producerId", msg.ProducerId,
+		"startBlock", msg.StartBlock,
+	)
+
+	span, err := m.validateSpanProducer(ctx, msg.SpanId, msg.ProducerId, msg.BorChainId, msg.StartBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	// replacement takes over from start of a sprint within span
+	sprintDuration := m.Keeper.GetParams(ctx).SprintDuration
+	if msg.StartBlock < span.StartBlock || msg.StartBlock > span.EndBlock || msg.StartBlock%sprintDuration != 0 {
+		m.Keeper.Logger(ctx).Error("Replacement start block not in span",
+			"startBlock", msg.StartBlock,
+			"spanStartBlock", span.StartBlock,
+			"spanEndBlock", span.EndBlock,
+		)
+		return nil, hmCommon.ErrSprintNotInSpan
+	}
+
+	// producer must have missed enough sprints, zero threshold disables replacement
+	threshold := m.Keeper.GetParams(ctx).MissedSprintsThreshold
+	missedSprints := m.Keeper.GetProducerMissedSprints(ctx, msg.SpanId, msg.ProducerId)
+	if threshold == 0 || missedSprints < threshold {
+		m.Keeper.Logger(ctx).Error("Producer has not missed enough sprints",
+			"producerId", msg.ProducerId,
+			"missedSprints", missedSprints,
+			"threshold", threshold,
+		)
+		return nil, hmCommon.ErrProducerNotDown
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReplaceProducer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanId, 10)),
+			sdk.NewAttribute(types.AttributeKeyProducerID, strconv.FormatUint(msg.ProducerId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMissedSprints, strconv.FormatUint(missedSprints, 10)),
+			sdk.NewAttribute(types.AttributeKeySprintStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		),
+	})
+
+	return &types.MsgReplaceProducerResponse{}, nil
+}
+
+// validateSpanProducer checks bor chain id and that producer is selected in span
+func (m msgServer) validateSpanProducer(ctx sdk.Context, spanID uint64, producerID uint64, borChainID string, block uint64) (*hmTypes.Span, error) {
+	chainParams := m.Keeper.chainKeeper.GetParams(ctx).ChainParams
+	if chainParams.BorChainID != borChainID {
+		m.Keeper.Logger(ctx).Error("Invalid Bor chain id", "msgChainID", borChainID)
+		return nil, hmCommon.ErrInvalidBorChainID
+	}
+
+	span, err := m.Keeper.GetSpan(ctx, spanID)
+	if err != nil {
+		m.Keeper.Logger(ctx).Error("Unable to fetch span", "spanId", spanID, "Error", err)
+		return nil, hmCommon.ErrSpanNotFound
+	}
+
+	producers, err := m.Keeper.GetSpanProducersAtBlock(ctx, span, block)
+	if err != nil {
+		m.Keeper.Logger(ctx).Error("Unable to fetch span producers", "spanId", spanID, "block", block, "Error", err)
+		return nil, hmCommon.ErrSpanNotFound
+	}
+
+	for _, producer := range producers {
+		if producer.ID.Uint64() == producerID {
+			return span, nil
+		}
+	}
+
+	m.Keeper.Logger(ctx).Error("Producer not in span", "spanId", spanID, "producerId", producerID, "block", block)
+	return nil, hmCommon.ErrProducerNotInSpan
+}
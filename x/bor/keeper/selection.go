@@ -2,14 +2,34 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
 	"math"
-	"math/rand"
 
 	"github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/bor/types"
 )
 
+// producerSelector selects producer ids for next span out of span eligible validators
+type producerSelector func(blkHash common.Hash, spanEligibleValidators []hmTypes.Validator, producerCount uint64) ([]uint64, error)
+
+// producerSelectors maps selection algorithm param to its producer selector
+var producerSelectors = map[string]producerSelector{
+	types.SelectionAlgorithmWeightedWithReplacement:    SelectNextProducers,
+	types.SelectionAlgorithmWeightedWithoutReplacement: SelectNextDistinctProducers,
+}
+
+// SelectNextProducersByAlgorithm selects producers for next span using given selection algorithm
+func SelectNextProducersByAlgorithm(algorithm string, blkHash common.Hash, spanEligibleValidators []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+	selector, ok := producerSelectors[types.ResolveSelectionAlgorithm(algorithm)]
+	if !ok {
+		return nil, fmt.Errorf("unknown selection algorithm %s", algorithm)
+	}
+
+	return selector(blkHash, spanEligibleValidators, producerCount)
+}
+
 func binarySearch(array []uint64, search uint64) int {
 	if len(array) == 0 {
 		return -1
@@ -27,19 +47,19 @@ func binarySearch(array []uint64, search uint64) int {
 	return l
 }
 
-// randomRangeInclusive produces unbiased pseudo random in the range [min, max]. Uses rand.Uint64() and can be seeded beforehand.
-func randomRangeInclusive(min uint64, max uint64) uint64 {
+// randomRangeInclusive produces unbiased pseudo random in the range [min, max] using given generator.
+func randomRangeInclusive(rng *selectionRNG, min uint64, max uint64) uint64 {
 	if max <= min {
 		return max
 	}
 
 	rangeLength := max - min + 1
 	maxAllowedValue := math.MaxUint64 - math.MaxUint64%rangeLength - 1
-	randomValue := rand.Uint64()
+	randomValue := rng.Uint64()
 
 	// reject anything that is beyond the reminder to avoid bias
 	for randomValue >= maxAllowedValue {
-		randomValue = rand.Uint64()
+		randomValue = rng.Uint64()
 	}
 
 	return min + randomValue%rangeLength
@@ -57,10 +77,7 @@ func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.V
 		return selectedProducers, nil
 	}
 
-	// extract seed from hash
-	seedBytes := helper.ToBytes32(blkHash.Bytes()[:32])
-	seed := int64(binary.BigEndian.Uint64(seedBytes[:]))
-	rand.Seed(seed)
+	rng := newSelectionRNG(selectionSeed(blkHash))
 
 	// weighted range from validators' voting power
 	votingPower := make([]uint64, len(spanEligibleValidators))
@@ -77,7 +94,7 @@ func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.V
 			Weighted range will look like (1, 2)
 			Rolling inclusive will have a range of 0 - 2, making validator with staking power 1 chance of selection = 66%
 		*/
-		targetWeight := randomRangeInclusive(1, totalVotingPower)
+		targetWeight := randomRangeInclusive(rng, 1, totalVotingPower)
 		index := binarySearch(weightedRanges, targetWeight)
 		selectedProducers = append(selectedProducers, spanEligibleValidators[index].ID.Uint64())
 	}
@@ -85,6 +102,48 @@ func SelectNextProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.V
 	return selectedProducers[:producerCount], nil
 }
 
+// SelectNextDistinctProducers selects producers for next span by converting power to tickets,
+// removing selected validator from draw so every producer is picked at most once
+func SelectNextDistinctProducers(blkHash common.Hash, spanEligibleValidators []hmTypes.Validator, producerCount uint64) ([]uint64, error) {
+	selectedProducers := make([]uint64, 0)
+
+	if len(spanEligibleValidators) <= int(producerCount) {
+		for _, validator := range spanEligibleValidators {
+			selectedProducers = append(selectedProducers, uint64(validator.ID))
+		}
+
+		return selectedProducers, nil
+	}
+
+	rng := newSelectionRNG(selectionSeed(blkHash))
+
+	remaining := make([]hmTypes.Validator, len(spanEligibleValidators))
+	copy(remaining, spanEligibleValidators)
+
+	// select producers, without replacement
+	for i := uint64(0); i < producerCount; i++ {
+		votingPower := make([]uint64, len(remaining))
+		for idx, validator := range remaining {
+			votingPower[idx] = uint64(validator.VotingPower)
+		}
+
+		weightedRanges, totalVotingPower := createWeightedRanges(votingPower)
+		targetWeight := randomRangeInclusive(rng, 1, totalVotingPower)
+		index := binarySearch(weightedRanges, targetWeight)
+		selectedProducers = append(selectedProducers, remaining[index].ID.Uint64())
+
+		remaining = append(remaining[:index], remaining[index+1:]...)
+	}
+
+	return selectedProducers, nil
+}
+
+// selectionSeed extracts selection seed from block hash
+func selectionSeed(blkHash common.Hash) int64 {
+	seedBytes := helper.ToBytes32(blkHash.Bytes()[:32])
+	return int64(binary.BigEndian.Uint64(seedBytes[:]))
+}
+
 // createWeightedRanges converts array [1, 2, 3] into cumulative form [1, 3, 6]
 func createWeightedRanges(weights []uint64) ([]uint64, uint64) {
 	weightedRanges := make([]uint64, len(weights))
@@ -2,12 +2,13 @@ package keeper
 
 import (
 	"encoding/json"
-	"fmt"
+	"math/rand"
 	"reflect"
 	"testing"
 
 	"github.com/maticnetwork/bor/common"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	borTypes "github.com/maticnetwork/heimdall/x/bor/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -90,7 +91,6 @@ func TestSelectNextProducers(t *testing.T) {
 	for i, testcase := range testcases {
 		seed := common.HexToHash(testcase.seed)
 		producerIds, err := SelectNextProducers(seed, validators, testcase.producerCount)
-		fmt.Println("producerIds", producerIds)
 		require.NoError(t, err, "Error should be nil")
 		producers, slots := getSelectedValidatorsFromIDs(validators, producerIds)
 		require.Equal(t, testcase.resultSlots, slots, "Total slots should be %v (Testcase %v)", testcase.resultSlots, i+1)
@@ -98,6 +98,100 @@ func TestSelectNextProducers(t *testing.T) {
 	}
 }
 
+func newGoldenValidators(ids []uint64, powers []int64) []hmTypes.Validator {
+	validators := make([]hmTypes.Validator, len(ids))
+	for i := range ids {
+		validators[i] = hmTypes.Validator{ID: hmTypes.NewValidatorID(ids[i]), VotingPower: powers[i]}
+	}
+	return validators
+}
+
+var (
+	goldenEqualPower    = newGoldenValidators([]uint64{3, 4, 5, 1, 2}, []int64{10000, 10000, 10000, 10000, 10000})
+	goldenWeightedPower = newGoldenValidators([]uint64{1, 2, 3, 4, 5}, []int64{30, 20, 50, 50, 1})
+	goldenSkewedPower   = newGoldenValidators([]uint64{10, 20, 30, 40, 50, 60, 70}, []int64{1000000, 5, 250000, 999, 42, 700000, 1})
+)
+
+// goldenSelectionCases pins producers selected by each algorithm for fixed seeds and validator sets.
+// Any change in these vectors changes bor producer selection and forks the network.
+var goldenSelectionCases = []struct {
+	seed              string
+	validators        []hmTypes.Validator
+	producerCount     uint64
+	producers         []uint64 // weighted with replacement
+	distinctProducers []uint64 // weighted without replacement
+}{
+	{"0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a", goldenEqualPower, 4, []uint64{2, 4, 4, 1}, []uint64{2, 3, 1, 5}},
+	{"0xe09cc356df20c7a2dd38cb85b680a16ec29bd8b3e1ecc1b20f2e5603d5e7ee85", goldenEqualPower, 4, []uint64{2, 4, 3, 1}, []uint64{2, 3, 5, 4}},
+	{"0x0", goldenEqualPower, 4, []uint64{4, 2, 2, 5}, []uint64{4, 2, 3, 1}},
+	{"0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a", goldenWeightedPower, 3, []uint64{3, 1, 3}, []uint64{3, 4, 2}},
+	{"0xe09cc356df20c7a2dd38cb85b680a16ec29bd8b3e1ecc1b20f2e5603d5e7ee85", goldenWeightedPower, 3, []uint64{1, 3, 4}, []uint64{1, 4, 3}},
+	{"0x0", goldenWeightedPower, 3, []uint64{2, 4, 4}, []uint64{2, 1, 4}},
+	{"0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a", goldenSkewedPower, 5, []uint64{60, 30, 30, 10, 10}, []uint64{60, 10, 30, 40, 50}},
+	{"0xe09cc356df20c7a2dd38cb85b680a16ec29bd8b3e1ecc1b20f2e5603d5e7ee85", goldenSkewedPower, 5, []uint64{10, 60, 10, 30, 10}, []uint64{10, 60, 30, 50, 40}},
+	{"0x0", goldenSkewedPower, 5, []uint64{10, 30, 60, 10, 10}, []uint64{10, 60, 30, 40, 50}},
+}
+
+func TestSelectNextProducersGolden(t *testing.T) {
+	for i, testcase := range goldenSelectionCases {
+		producers, err := SelectNextProducers(common.HexToHash(testcase.seed), testcase.validators, testcase.producerCount)
+		require.NoError(t, err)
+		require.Equal(t, testcase.producers, producers, "Testcase %v", i+1)
+
+		// selection must not depend on global math/rand state
+		rand.Seed(int64(i))
+		again, err := SelectNextProducers(common.HexToHash(testcase.seed), testcase.validators, testcase.producerCount)
+		require.NoError(t, err)
+		require.Equal(t, producers, again, "Testcase %v", i+1)
+	}
+}
+
+func TestSelectNextDistinctProducersGolden(t *testing.T) {
+	for i, testcase := range goldenSelectionCases {
+		producers, err := SelectNextProducersByAlgorithm(borTypes.SelectionAlgorithmWeightedWithoutReplacement, common.HexToHash(testcase.seed), testcase.validators, testcase.producerCount)
+		require.NoError(t, err)
+		require.Equal(t, testcase.distinctProducers, producers, "Testcase %v", i+1)
+	}
+}
+
+func TestSelectNextProducersByAlgorithm(t *testing.T) {
+	validators := []hmTypes.Validator{
+		{ID: hmTypes.NewValidatorID(1), VotingPower: 30},
+		{ID: hmTypes.NewValidatorID(2), VotingPower: 20},
+		{ID: hmTypes.NewValidatorID(3), VotingPower: 50},
+		{ID: hmTypes.NewValidatorID(4), VotingPower: 50},
+		{ID: hmTypes.NewValidatorID(5), VotingPower: 1},
+	}
+	seed := common.HexToHash("0x8f5bab218b6bb34476f51ca588e9f4553a3a7ce5e13a66c660a5283e97e9a85a")
+
+	// empty algorithm of older spans resolves to weighted with replacement
+	legacy, err := SelectNextProducersByAlgorithm("", seed, validators, 4)
+	require.NoError(t, err)
+	expected, err := SelectNextProducers(seed, validators, 4)
+	require.NoError(t, err)
+	require.Equal(t, expected, legacy)
+
+	_, err = SelectNextProducersByAlgorithm("unknown", seed, validators, 4)
+	require.Error(t, err)
+}
+
+func TestSelectionRNG(t *testing.T) {
+	// golden vector
+	rng := newSelectionRNG(42)
+	require.Equal(t, uint64(12663951391086054483), rng.Uint64())
+	require.Equal(t, uint64(9832119173398632219), rng.Uint64())
+	require.Equal(t, uint64(5571782338101878760), rng.Uint64())
+
+	// same sequence as seeded math/rand source used by earlier releases
+	for _, seed := range []int64{0, 1, 42, -7, 1 << 40, 89482311} {
+		rng := newSelectionRNG(seed)
+		source := rand.New(rand.NewSource(seed))
+		for i := 0; i < 2000; i++ {
+			require.Equal(t, source.Uint64(), rng.Uint64(), "seed %v, index %v", seed, i)
+		}
+	}
+}
+
 func getSelectedValidatorsFromIDs(validators []hmTypes.Validator, producerIds []uint64) ([]hmTypes.Validator, int64) {
 	var vals []hmTypes.Validator
 	IDToPower := make(map[uint64]uint64)
@@ -0,0 +1,310 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/crypto"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/bor/types"
+)
+
+// MaxSpansPrunedPerSpan caps number of spans pruned while processing single span
+const MaxSpansPrunedPerSpan = 100
+
+// GetLegacySpanKey appends prefix to span id for spans stored with full validator set
+func GetLegacySpanKey(id uint64) []byte {
+	return append(LegacySpanPrefixKey, GetSpanKey(id)[len(SpanPrefixKey):]...)
+}
+
+// GetValidatorSetKey appends prefix to validator set hash
+func GetValidatorSetKey(hash []byte) []byte {
+	return append(ValidatorSetPrefixKey, hash...)
+}
+
+// GetValidatorSetRefCountKey appends prefix to validator set hash
+func GetValidatorSetRefCountKey(hash []byte) []byte {
+	return append(ValidatorSetRefCountPrefixKey, hash...)
+}
+
+// setSpan stores span referencing its validator set by hash, storing each distinct validator set once
+func (k *Keeper) setSpan(ctx sdk.Context, span hmTypes.Span) error {
+	store := ctx.KVStore(k.storeKey)
+	spanKey := GetSpanKey(span.ID)
+
+	validatorSetHash, err := k.retainValidatorSet(ctx, span.ValidatorSet)
+	if err != nil {
+		return err
+	}
+
+	// release validator set of overwritten span
+	if store.Has(spanKey) {
+		var prev types.StoredSpan
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(spanKey), &prev); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling span", "error", err)
+			return err
+		}
+		k.releaseValidatorSet(ctx, prev.ValidatorSetHash)
+	}
+
+	out, err := k.cdc.MarshalBinaryBare(&types.StoredSpan{
+		ID:                 span.ID,
+		StartBlock:         span.StartBlock,
+		EndBlock:           span.EndBlock,
+		ValidatorSetHash:   validatorSetHash,
+		SelectedProducers:  span.SelectedProducers,
+		BorChainId:         span.BorChainId,
+		SelectionAlgorithm: span.SelectionAlgorithm,
+	})
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling span", "error", err)
+		return err
+	}
+
+	store.Set(spanKey, out)
+	return nil
+}
+
+// unmarshalSpan decodes stored span and resolves its validator set
+func (k *Keeper) unmarshalSpan(ctx sdk.Context, bz []byte) (*hmTypes.Span, error) {
+	var stored types.StoredSpan
+	if err := k.cdc.UnmarshalBinaryBare(bz, &stored); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling span", "error", err)
+		return nil, err
+	}
+
+	validatorSet, err := k.GetSpanValidatorSet(ctx, stored.ValidatorSetHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hmTypes.Span{
+		ID:                 stored.ID,
+		StartBlock:         stored.StartBlock,
+		EndBlock:           stored.EndBlock,
+		ValidatorSet:       validatorSet,
+		SelectedProducers:  stored.SelectedProducers,
+		BorChainId:         stored.BorChainId,
+		SelectionAlgorithm: stored.SelectionAlgorithm,
+	}, nil
+}
+
+//
+// Validator sets
+//
+
+// GetSpanValidatorSet fetches validator set of spans by hash
+func (k *Keeper) GetSpanValidatorSet(ctx sdk.Context, hash []byte) (hmTypes.ValidatorSet, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	var validatorSet hmTypes.ValidatorSet
+	bz := store.Get(GetValidatorSetKey(hash))
+	if bz == nil {
+		return validatorSet, errors.New("span validator set not found for hash")
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &validatorSet); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling validator set", "error", err)
+		return validatorSet, err
+	}
+
+	return validatorSet, nil
+}
+
+// GetValidatorSetRefCount returns number of spans referencing validator set
+func (k *Keeper) GetValidatorSetRefCount(ctx sdk.Context, hash []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorSetRefCountKey(hash))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// retainValidatorSet stores validator set if not present yet and adds a span reference to it
+func (k *Keeper) retainValidatorSet(ctx sdk.Context, validatorSet hmTypes.ValidatorSet) ([]byte, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(&validatorSet)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling validator set", "error", err)
+		return nil, err
+	}
+
+	hash := validatorSetHash(validatorSet)
+	refCount := k.GetValidatorSetRefCount(ctx, hash)
+	if refCount == 0 {
+		store.Set(GetValidatorSetKey(hash), bz)
+	}
+	store.Set(GetValidatorSetRefCountKey(hash), sdk.Uint64ToBigEndian(refCount+1))
+
+	return hash, nil
+}
+
+// validatorSetHash returns hash identifying validator set by ids, signers and powers of its validators.
+// Proposer priorities change every block and don't make validator set of span different.
+func validatorSetHash(validatorSet hmTypes.ValidatorSet) []byte {
+	var bz []byte
+	for _, val := range validatorSet.Validators {
+		bz = append(bz, sdk.Uint64ToBigEndian(val.ID.Uint64())...)
+		bz = append(bz, val.GetSigner().Bytes()...)
+		bz = append(bz, sdk.Uint64ToBigEndian(uint64(val.VotingPower))...)
+	}
+
+	return crypto.Keccak256(bz)
+}
+
+// releaseValidatorSet removes a span reference to validator set, deleting it once unreferenced
+func (k *Keeper) releaseValidatorSet(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	refCount := k.GetValidatorSetRefCount(ctx, hash)
+	if refCount <= 1 {
+		store.Delete(GetValidatorSetKey(hash))
+		store.Delete(GetValidatorSetRefCountKey(hash))
+		return
+	}
+
+	store.Set(GetValidatorSetRefCountKey(hash), sdk.Uint64ToBigEndian(refCount-1))
+}
+
+//
+// Pruning
+//
+
+// GetPrunedSpanCount returns number of spans pruned from state, which is id of oldest retained span
+func (k Keeper) GetPrunedSpanCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(PrunedSpanCountKey) {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(store.Get(PrunedSpanCountKey))
+}
+
+// SetPrunedSpanCount sets number of spans pruned from state
+func (k Keeper) SetPrunedSpanCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PrunedSpanCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// PruneSpans removes spans older than span retention spans, always keeping last span.
+// Returns number of spans pruned.
+func (k *Keeper) PruneSpans(ctx sdk.Context) uint64 {
+	retention := k.GetParams(ctx).SpanRetention
+	if retention == 0 {
+		return 0
+	}
+
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil || lastSpan.ID < retention {
+		return 0
+	}
+
+	pruneTo := lastSpan.ID - retention + 1
+	pruned := k.GetPrunedSpanCount(ctx)
+
+	count := uint64(0)
+	for pruned < pruneTo && count < MaxSpansPrunedPerSpan {
+		k.deleteSpan(ctx, pruned)
+		pruned++
+		count++
+	}
+
+	if count > 0 {
+		k.SetPrunedSpanCount(ctx, pruned)
+		k.Logger(ctx).Info("Pruned spans from state", "count", count, "prunedSpanCount", pruned)
+	}
+
+	return count
+}
+
+// deleteSpan removes span with its missed sprint records, releasing its validator set
+func (k *Keeper) deleteSpan(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	spanKey := GetSpanKey(id)
+
+	bz := store.Get(spanKey)
+	if bz == nil {
+		return
+	}
+
+	var stored types.StoredSpan
+	if err := k.cdc.UnmarshalBinaryBare(bz, &stored); err == nil {
+		k.releaseValidatorSet(ctx, stored.ValidatorSetHash)
+
+		// collect keys first, store must not be written while iterating
+		var keys [][]byte
+		missedSprints := store.Iterator(GetMissedSprintKey(stored.StartBlock), GetMissedSprintKey(stored.EndBlock+1))
+		for ; missedSprints.Valid(); missedSprints.Next() {
+			keys = append(keys, missedSprints.Key())
+		}
+		missedSprints.Close()
+
+		downtimes := sdk.KVStorePrefixIterator(store, getProducerMissedSprintsPrefix(id))
+		for ; downtimes.Valid(); downtimes.Next() {
+			keys = append(keys, downtimes.Key())
+		}
+		downtimes.Close()
+
+		replacements := sdk.KVStorePrefixIterator(store, getProducerReplacementsPrefix(id))
+		for ; replacements.Valid(); replacements.Next() {
+			keys = append(keys, replacements.Key())
+		}
+		replacements.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	store.Delete(spanKey)
+}
+
+//
+// Migration
+//
+
+// HasLegacySpans checks if any span is still stored with full validator set
+func (k *Keeper) HasLegacySpans(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, LegacySpanPrefixKey)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// MigrateSpans moves spans stored with full validator set to compact storage.
+// Returns number of spans migrated.
+func (k *Keeper) MigrateSpans(ctx sdk.Context) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	var spans []hmTypes.Span
+	iterator := sdk.KVStorePrefixIterator(store, LegacySpanPrefixKey)
+	for ; iterator.Valid(); iterator.Next() {
+		var span hmTypes.Span
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &span); err != nil {
+			iterator.Close()
+			k.Logger(ctx).Error("Error unmarshalling legacy span", "error", err)
+			return 0, err
+		}
+		spans = append(spans, span)
+	}
+	iterator.Close()
+
+	for _, span := range spans {
+		if err := k.setSpan(ctx, span); err != nil {
+			return 0, err
+		}
+		store.Delete(GetLegacySpanKey(span.ID))
+	}
+
+	if len(spans) > 0 {
+		k.Logger(ctx).Info("Migrated spans to compact storage", "count", len(spans))
+	}
+
+	return uint64(len(spans)), nil
+}
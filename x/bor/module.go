@@ -126,6 +126,7 @@ func (a AppModule) ExportGenesis(context sdk.Context, cdc codec.JSONMarshaler) j
 }
 
 func (a AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(registry, a.keeper)
 }
 
 func (a AppModule) Route() sdk.Route {
@@ -3,6 +3,7 @@ package bor
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"strconv"
 
 	tmTypes "github.com/tendermint/tendermint/types"
@@ -28,6 +29,10 @@ func NewSideTxHandler(k keeper.Keeper, contractCaller helper.IContractCaller) hm
 		switch msg := msg.(type) {
 		case *types.MsgProposeSpan:
 			return SideHandleMsgSpan(ctx, k, *msg, contractCaller)
+		case *types.MsgReportMissedSprint:
+			return SideHandleMsgReportMissedSprint(ctx, k, *msg, contractCaller)
+		case *types.MsgReplaceProducer:
+			return SideHandleMsgReplaceProducer(ctx, k, *msg, contractCaller)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			fmt.Println(errMsg)
@@ -45,6 +50,10 @@ func NewPostTxHandler(k keeper.Keeper) hmTypes.PostTxHandler {
 		switch msg := msg.(type) {
 		case *types.MsgProposeSpan:
 			return PostHandleMsgEventSpan(ctx, k, *msg, sideTxResult)
+		case *types.MsgReportMissedSprint:
+			return PostHandleMsgReportMissedSprint(ctx, k, *msg, sideTxResult)
+		case *types.MsgReplaceProducer:
+			return PostHandleMsgReplaceProducer(ctx, k, *msg, sideTxResult)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -100,11 +109,139 @@ func SideHandleMsgSpan(ctx sdk.Context, k keeper.Keeper, msg types.MsgProposeSpa
 		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
 	}
 
+	// fetch span committed on bor validator set contract
+	borSpanID, err := k.GetBorCurrentSpanID(ctx, contractCaller)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching current span id from bor", "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	borStartBlock, borEndBlock, err := k.GetBorSpan(ctx, contractCaller, borSpanID)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching span details from bor", "spanId", borSpanID, "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	// last span must be the one bor has committed
+	if borSpanID != lastSpan.ID || borStartBlock != lastSpan.StartBlock || borEndBlock != lastSpan.EndBlock {
+		k.Logger(ctx).Error(
+			"Last span does not match span committed on bor",
+			"lastSpanId", lastSpan.ID,
+			"lastSpanStartBlock", lastSpan.StartBlock,
+			"lastSpanEndBlock", lastSpan.EndBlock,
+			"borSpanId", borSpanID,
+			"borStartBlock", borStartBlock,
+			"borEndBlock", borEndBlock,
+		)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	// proposed span must follow bor span without overlap or gap
+	if msg.SpanId != borSpanID+1 || msg.StartBlock != borEndBlock+1 || msg.EndBlock < msg.StartBlock {
+		k.Logger(ctx).Error(
+			"Span proposed overlaps or skips span committed on bor",
+			"borSpanId", borSpanID,
+			"borEndBlock", borEndBlock,
+			"msgSpanId", msg.SpanId,
+			"msgStartBlock", msg.StartBlock,
+			"msgEndBlock", msg.EndBlock,
+		)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
 	k.Logger(ctx).Debug("✅ Successfully validated External call for span msg")
 	result.Result = tmprototypes.SideTxResultType_YES
 	return
 }
 
+// SideHandleMsgReportMissedSprint validates reported producer missed its sprint using bor header starting the sprint
+func SideHandleMsgReportMissedSprint(ctx sdk.Context, k keeper.Keeper, msg types.MsgReportMissedSprint, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for report missed sprint msg",
+		"spanId", msg.SpanId,
+		"sprintStartBlock", msg.SprintStartBlock,
+		"producerId", msg.ProducerId,
+	)
+
+	span, err := k.GetSpan(ctx, msg.SpanId)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching span", "spanId", msg.SpanId, "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	// fetch bor header starting the sprint
+	header, err := contractCaller.GetMaticChainBlock(new(big.Int).SetUint64(msg.SprintStartBlock))
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching sprint start block", "sprintStartBlock", msg.SprintStartBlock, "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	// producers of span at sprint, with replacements effective before sprint applied
+	producers, err := k.GetSpanProducersAtBlock(ctx, span, msg.SprintStartBlock)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching span producers", "spanId", msg.SpanId, "sprintStartBlock", msg.SprintStartBlock, "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	producerID, missed, err := types.GetMissedSprintProducer(header, producers)
+	if err != nil {
+		k.Logger(ctx).Error("Error finding in-turn producer of sprint", "sprintStartBlock", msg.SprintStartBlock, "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	if !missed || producerID.Uint64() != msg.ProducerId {
+		k.Logger(ctx).Error(
+			"Reported producer did not miss sprint",
+			"sprintStartBlock", msg.SprintStartBlock,
+			"msgProducerId", msg.ProducerId,
+			"missed", missed,
+			"missedProducerId", producerID,
+		)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Successfully validated External call for report missed sprint msg")
+	result.Result = tmprototypes.SideTxResultType_YES
+	return
+}
+
+// SideHandleMsgReplaceProducer validates span of replaced producer is still ongoing on bor
+func SideHandleMsgReplaceProducer(ctx sdk.Context, k keeper.Keeper, msg types.MsgReplaceProducer, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	k.Logger(ctx).Debug("✅ Validating External call for replace producer msg",
+		"spanId", msg.SpanId,
+		"producerId", msg.ProducerId,
+	)
+
+	span, err := k.GetSpan(ctx, msg.SpanId)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching span", "spanId", msg.SpanId, "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	// fetch current child block
+	childBlock, err := contractCaller.GetMaticChainBlock(nil)
+	if err != nil {
+		k.Logger(ctx).Error("Error fetching current child block", "error", err)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	// replacement only makes sense for remaining sprints of an ongoing span
+	currentBlock := childBlock.Number.Uint64()
+	if !(span.StartBlock <= currentBlock && currentBlock < msg.StartBlock && msg.StartBlock <= span.EndBlock) {
+		k.Logger(ctx).Error(
+			"Replacement does not start in remaining sprints of ongoing span",
+			"currentChildBlock", currentBlock,
+			"startBlock", msg.StartBlock,
+			"spanStartBlock", span.StartBlock,
+			"spanEndBlock", span.EndBlock,
+		)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	k.Logger(ctx).Debug("✅ Successfully validated External call for replace producer msg")
+	result.Result = tmprototypes.SideTxResultType_YES
+	return
+}
+
 // PostHandleMsgEventSpan handles state persisting span msg
 func PostHandleMsgEventSpan(ctx sdk.Context, k keeper.Keeper, msg types.MsgProposeSpan, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
 	// Skip handler if span is not approved
@@ -128,6 +265,9 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k keeper.Keeper, msg types.MsgPropo
 		return nil, hmCommon.ErrUnableToFreezeValSet
 	}
 
+	// Prune spans older than retention
+	k.PruneSpans(ctx)
+
 	// TX bytes
 	txBytes := ctx.TxBytes()
 	hash := tmTypes.Tx(txBytes).Hash()
@@ -151,3 +291,112 @@ func PostHandleMsgEventSpan(ctx sdk.Context, k keeper.Keeper, msg types.MsgPropo
 		Events: ctx.EventManager().ABCIEvents(),
 	}, nil
 }
+
+// PostHandleMsgReportMissedSprint handles state persisting missed sprint msg
+func PostHandleMsgReportMissedSprint(ctx sdk.Context, k keeper.Keeper, msg types.MsgReportMissedSprint, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	// Skip handler if missed sprint is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		k.Logger(ctx).Debug("Skipping missed sprint since side-tx didn't get yes votes")
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check for replay
+	if k.HasMissedSprint(ctx, msg.SprintStartBlock) {
+		k.Logger(ctx).Debug("Skipping missed sprint as it's already processed")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	k.Logger(ctx).Debug("Persisting missed sprint state", "sideTxResult", sideTxResult)
+
+	missedSprints := k.AddMissedSprint(ctx, msg.SpanId, msg.SprintStartBlock, msg.ProducerId)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReportMissedSprint,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                 // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),               // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, common.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),            // result
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanId, 10)),
+			sdk.NewAttribute(types.AttributeKeySprintStartBlock, strconv.FormatUint(msg.SprintStartBlock, 10)),
+			sdk.NewAttribute(types.AttributeKeyProducerID, strconv.FormatUint(msg.ProducerId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMissedSprints, strconv.FormatUint(missedSprints, 10)),
+		),
+	})
+
+	// draft result with events
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+// PostHandleMsgReplaceProducer handles state persisting replace producer msg
+func PostHandleMsgReplaceProducer(ctx sdk.Context, k keeper.Keeper, msg types.MsgReplaceProducer, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	// Skip handler if replacement is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		k.Logger(ctx).Debug("Skipping replace producer since side-tx didn't get yes votes")
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check for replay, replaced producer is no longer in span
+	span, err := k.GetSpan(ctx, msg.SpanId)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch span", "spanId", msg.SpanId, "Error", err)
+		return nil, hmCommon.ErrSpanNotFound
+	}
+
+	producers, err := k.GetSpanProducersAtBlock(ctx, span, span.EndBlock)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch span producers", "spanId", msg.SpanId, "Error", err)
+		return nil, hmCommon.ErrSpanNotFound
+	}
+
+	found := false
+	for _, producer := range producers {
+		if producer.ID.Uint64() == msg.ProducerId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		k.Logger(ctx).Debug("Skipping replace producer as it's already processed")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	k.Logger(ctx).Debug("Persisting replace producer state", "sideTxResult", sideTxResult)
+
+	replacement, err := k.ReplaceSpanProducer(ctx, msg.SpanId, msg.ProducerId, msg.StartBlock)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to replace producer", "producerId", msg.ProducerId, "Error", err)
+		return nil, hmCommon.ErrNoReplacementFound
+	}
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReplaceProducer,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                 // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),               // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, common.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),            // result
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanId, 10)),
+			sdk.NewAttribute(types.AttributeKeyProducerID, strconv.FormatUint(msg.ProducerId, 10)),
+			sdk.NewAttribute(types.AttributeKeyReplacementID, replacement.ID.String()),
+			sdk.NewAttribute(types.AttributeKeySprintStartBlock, strconv.FormatUint(msg.StartBlock, 10)),
+		),
+	})
+
+	// draft result with events
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
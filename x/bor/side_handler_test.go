@@ -1,6 +1,7 @@
 package bor_test
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
 	"math/big"
 	"math/rand"
@@ -15,7 +16,10 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/maticnetwork/bor/common"
+	borConsensus "github.com/maticnetwork/bor/consensus/bor"
 	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
 
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/x/bor"
@@ -27,6 +31,8 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/x/bor/test_helper"
 	borTypes "github.com/maticnetwork/heimdall/x/bor/types"
+	"github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -92,6 +98,7 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgProposeSpan() {
 		cm        []callerMethod
 		seed      string
 		span      hmTypes.Span
+		proposal  *borTypes.MsgProposeSpan
 		error     bool
 		code      uint32
 	}{
@@ -195,10 +202,162 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgProposeSpan() {
 					args: []interface{}{bi},
 					ret:  []interface{}{&ethHeader, nil},
 				},
+				{
+					name: "GetValidatorSetInstance",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{nil, nil},
+				},
+				{
+					name: "CurrentSpanNumber",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{big.NewInt(1)},
+				},
+				{
+					name: "GetSpanDetails",
+					args: []interface{}{big.NewInt(1), mock.Anything},
+					ret:  []interface{}{big.NewInt(1), big.NewInt(0), big.NewInt(1), nil},
+				},
 			},
 			error: false,
 			code:  uint32(0),
 		},
+		{
+			msg:    "error failed to fetch bor current span",
+			code:   hmCommon.ErrInvalidMsg.ABCICode(),
+			result: tmprototypes.SideTxResultType_SKIP,
+			seed:   hmCommonTypes.HexToHeimdallHash(ethBlockHash).String(),
+			span:   hmTypes.Span{ID: 1, StartBlock: 0, EndBlock: 1, BorChainId: "15001"},
+			cm: []callerMethod{
+				{
+					name: "GetMainChainBlock",
+					args: []interface{}{big.NewInt(1)},
+					ret:  []interface{}{&ethTypes.Header{}, nil},
+				},
+				{
+					name: "GetMaticChainBlock",
+					args: []interface{}{bi},
+					ret:  []interface{}{&ethHeader, nil},
+				},
+				{
+					name: "GetValidatorSetInstance",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{nil, nil},
+				},
+				{
+					name: "CurrentSpanNumber",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{nil},
+				},
+			},
+			error: true,
+		},
+		{
+			msg:    "error last span diverges from bor span",
+			code:   hmCommon.ErrInvalidMsg.ABCICode(),
+			result: tmprototypes.SideTxResultType_SKIP,
+			seed:   hmCommonTypes.HexToHeimdallHash(ethBlockHash).String(),
+			span:   hmTypes.Span{ID: 1, StartBlock: 0, EndBlock: 1, BorChainId: "15001"},
+			cm: []callerMethod{
+				{
+					name: "GetMainChainBlock",
+					args: []interface{}{big.NewInt(1)},
+					ret:  []interface{}{&ethTypes.Header{}, nil},
+				},
+				{
+					name: "GetMaticChainBlock",
+					args: []interface{}{bi},
+					ret:  []interface{}{&ethHeader, nil},
+				},
+				{
+					name: "GetValidatorSetInstance",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{nil, nil},
+				},
+				{
+					name: "CurrentSpanNumber",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{big.NewInt(1)},
+				},
+				{
+					name: "GetSpanDetails",
+					args: []interface{}{big.NewInt(1), mock.Anything},
+					ret:  []interface{}{big.NewInt(1), big.NewInt(0), big.NewInt(5), nil},
+				},
+			},
+			error: true,
+		},
+		{
+			msg:      "error proposed span skips bor span",
+			code:     hmCommon.ErrInvalidMsg.ABCICode(),
+			result:   tmprototypes.SideTxResultType_SKIP,
+			seed:     hmCommonTypes.HexToHeimdallHash(ethBlockHash).String(),
+			span:     hmTypes.Span{ID: 1, StartBlock: 0, EndBlock: 1, BorChainId: "15001"},
+			proposal: &borTypes.MsgProposeSpan{SpanId: 3, StartBlock: 2, EndBlock: 101},
+			cm: []callerMethod{
+				{
+					name: "GetMainChainBlock",
+					args: []interface{}{big.NewInt(1)},
+					ret:  []interface{}{&ethTypes.Header{}, nil},
+				},
+				{
+					name: "GetMaticChainBlock",
+					args: []interface{}{bi},
+					ret:  []interface{}{&ethHeader, nil},
+				},
+				{
+					name: "GetValidatorSetInstance",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{nil, nil},
+				},
+				{
+					name: "CurrentSpanNumber",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{big.NewInt(1)},
+				},
+				{
+					name: "GetSpanDetails",
+					args: []interface{}{big.NewInt(1), mock.Anything},
+					ret:  []interface{}{big.NewInt(1), big.NewInt(0), big.NewInt(1), nil},
+				},
+			},
+			error: true,
+		},
+		{
+			msg:      "error proposed span overlaps bor span",
+			code:     hmCommon.ErrInvalidMsg.ABCICode(),
+			result:   tmprototypes.SideTxResultType_SKIP,
+			seed:     hmCommonTypes.HexToHeimdallHash(ethBlockHash).String(),
+			span:     hmTypes.Span{ID: 1, StartBlock: 0, EndBlock: 1, BorChainId: "15001"},
+			proposal: &borTypes.MsgProposeSpan{SpanId: 2, StartBlock: 1, EndBlock: 101},
+			cm: []callerMethod{
+				{
+					name: "GetMainChainBlock",
+					args: []interface{}{big.NewInt(1)},
+					ret:  []interface{}{&ethTypes.Header{}, nil},
+				},
+				{
+					name: "GetMaticChainBlock",
+					args: []interface{}{bi},
+					ret:  []interface{}{&ethHeader, nil},
+				},
+				{
+					name: "GetValidatorSetInstance",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{nil, nil},
+				},
+				{
+					name: "CurrentSpanNumber",
+					args: []interface{}{mock.Anything},
+					ret:  []interface{}{big.NewInt(1)},
+				},
+				{
+					name: "GetSpanDetails",
+					args: []interface{}{big.NewInt(1), mock.Anything},
+					ret:  []interface{}{big.NewInt(1), big.NewInt(0), big.NewInt(1), nil},
+				},
+			},
+			error: true,
+		},
 	}
 
 	for _, c := range tc {
@@ -213,7 +372,11 @@ func (suite *SideHandlerTestSuite) TestSideHandleMsgProposeSpan() {
 			require.NoError(t, err)
 		}
 
-		msg := borTypes.MsgProposeSpan{Seed: c.seed}
+		msg := borTypes.MsgProposeSpan{SpanId: c.span.ID + 1, StartBlock: c.span.EndBlock + 1, EndBlock: c.span.EndBlock + 100}
+		if c.proposal != nil {
+			msg = *c.proposal
+		}
+		msg.Seed = c.seed
 		result := suite.sideHandler(ctx, &msg)
 		if c.error {
 			require.Equal(t, c.code, result.Code, "Side tx handler should Fail")
@@ -291,3 +454,189 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgEventSpan() {
 		}
 	}
 }
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgReportMissedSprint() {
+	t, ctx := suite.T(), suite.ctx
+
+	keys := make([]*ecdsa.PrivateKey, 3)
+	producers := make([]hmTypes.Validator, 3)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		producers[i] = hmTypes.Validator{
+			ID:          hmTypes.ValidatorID(i + 1),
+			VotingPower: 1,
+			Signer:      crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		}
+	}
+	producers = hmTypes.SortValidatorByAddress(producers)
+	span := hmTypes.Span{ID: 1, StartBlock: 256, EndBlock: 6655, BorChainId: "15001", SelectedProducers: producers}
+	require.NoError(t, suite.app.BorKeeper.AddNewSpan(ctx, span))
+
+	// sprint sealed by first backup of producers[0]
+	sealHeader := func(signer hmTypes.Validator, difficulty int64) *ethTypes.Header {
+		header := &ethTypes.Header{Number: big.NewInt(272), Difficulty: big.NewInt(difficulty), Extra: make([]byte, 32+65)}
+		signature, err := crypto.Sign(borConsensus.SealHash(header).Bytes(), keys[signer.ID-1])
+		require.NoError(t, err)
+		copy(header.Extra[32:], signature)
+		return header
+	}
+	missedHeader := sealHeader(producers[1], 2)
+	inTurnHeader := sealHeader(producers[0], 3)
+
+	tc := []struct {
+		msg    string
+		header *ethTypes.Header
+		err    error
+		report borTypes.MsgReportMissedSprint
+		result tmprototypes.SideTxResultType
+	}{
+		{
+			msg:    "success",
+			header: missedHeader,
+			report: borTypes.NewMsgReportMissedSprint("", 1, 272, producers[0].ID.Uint64(), "15001"),
+			result: tmprototypes.SideTxResultType_YES,
+		},
+		{
+			msg:    "wrong producer",
+			header: missedHeader,
+			report: borTypes.NewMsgReportMissedSprint("", 1, 272, producers[1].ID.Uint64(), "15001"),
+			result: tmprototypes.SideTxResultType_SKIP,
+		},
+		{
+			msg:    "in-turn producer sealed sprint",
+			header: inTurnHeader,
+			report: borTypes.NewMsgReportMissedSprint("", 1, 272, producers[0].ID.Uint64(), "15001"),
+			result: tmprototypes.SideTxResultType_SKIP,
+		},
+		{
+			msg:    "header not found",
+			err:    ethereum.NotFound,
+			report: borTypes.NewMsgReportMissedSprint("", 1, 272, producers[0].ID.Uint64(), "15001"),
+			result: tmprototypes.SideTxResultType_SKIP,
+		},
+		{
+			msg:    "span not found",
+			header: missedHeader,
+			report: borTypes.NewMsgReportMissedSprint("", 2, 272, producers[0].ID.Uint64(), "15001"),
+			result: tmprototypes.SideTxResultType_SKIP,
+		},
+	}
+
+	for _, c := range tc {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.sideHandler = bor.NewSideTxHandler(suite.app.BorKeeper, &suite.contractCaller)
+		suite.contractCaller.On("GetMaticChainBlock", big.NewInt(272)).Return(c.header, c.err)
+
+		result := suite.sideHandler(ctx, &c.report)
+		require.Equal(t, c.result, result.Result, c.msg)
+	}
+
+	// producer replaced before sprint is not in-turn producer anymore
+	require.NoError(t, suite.app.BorKeeper.SetProducerReplacement(ctx, borTypes.ProducerReplacement{
+		SpanId:      1,
+		StartBlock:  272,
+		ProducerId:  producers[1].ID.Uint64(),
+		Replacement: hmTypes.Validator{ID: 10, VotingPower: 1, Signer: "0x000000000000000000000000000000000000000a"},
+	}))
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.sideHandler = bor.NewSideTxHandler(suite.app.BorKeeper, &suite.contractCaller)
+	suite.contractCaller.On("GetMaticChainBlock", big.NewInt(272)).Return(missedHeader, nil)
+
+	report := borTypes.NewMsgReportMissedSprint("", 1, 272, producers[0].ID.Uint64(), "15001")
+	result := suite.sideHandler(ctx, &report)
+	require.Equal(t, tmprototypes.SideTxResultType_SKIP, result.Result, "signer replaced before sprint")
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgReplaceProducer() {
+	t, ctx := suite.T(), suite.ctx
+
+	span := hmTypes.Span{ID: 1, StartBlock: 256, EndBlock: 6655, BorChainId: "15001"}
+	require.NoError(t, suite.app.BorKeeper.AddNewSpan(ctx, span))
+	msg := borTypes.NewMsgReplaceProducer("", 1, 1, 1024, "15001")
+
+	tc := []struct {
+		msg        string
+		childBlock int64
+		result     tmprototypes.SideTxResultType
+	}{
+		{msg: "span ongoing", childBlock: 1000, result: tmprototypes.SideTxResultType_YES},
+		{msg: "span not started", childBlock: 100, result: tmprototypes.SideTxResultType_SKIP},
+		{msg: "start block already passed", childBlock: 1024, result: tmprototypes.SideTxResultType_SKIP},
+		{msg: "span ended", childBlock: 6655, result: tmprototypes.SideTxResultType_SKIP},
+	}
+
+	for _, c := range tc {
+		suite.contractCaller = mocks.IContractCaller{}
+		suite.sideHandler = bor.NewSideTxHandler(suite.app.BorKeeper, &suite.contractCaller)
+		suite.contractCaller.On("GetMaticChainBlock", (*big.Int)(nil)).Return(&ethTypes.Header{Number: big.NewInt(c.childBlock)}, nil)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, c.result, result.Result, c.msg)
+	}
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgReportMissedSprint() {
+	t, ctx := suite.T(), suite.ctx
+	msg := borTypes.NewMsgReportMissedSprint("", 1, 272, 2, "15001")
+
+	_, err := suite.postHandler(ctx, &msg, tmprototypes.SideTxResultType_NO)
+	require.Error(t, err)
+	require.False(t, suite.app.BorKeeper.HasMissedSprint(ctx, 272))
+
+	result, err := suite.postHandler(ctx, &msg, tmprototypes.SideTxResultType_YES)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, uint64(1), suite.app.BorKeeper.GetProducerMissedSprints(ctx, 1, 2))
+
+	// replay
+	_, err = suite.postHandler(ctx, &msg, tmprototypes.SideTxResultType_YES)
+	require.ErrorIs(t, err, hmCommon.ErrOldTx)
+	require.Equal(t, uint64(1), suite.app.BorKeeper.GetProducerMissedSprints(ctx, 1, 2))
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgReplaceProducer() {
+	t, ctx := suite.T(), suite.ctx
+
+	simulation.LoadValidatorSet(4, t, suite.app.StakingKeeper, ctx, false, 0)
+	suite.app.BorKeeper.SetParams(ctx, &borTypes.Params{
+		SprintDuration:     16,
+		SpanDuration:       6400,
+		ProducerCount:      2,
+		SelectionAlgorithm: borTypes.SelectionAlgorithmWeightedWithoutReplacement,
+	})
+	require.NoError(t, suite.app.BorKeeper.FreezeSet(ctx, 1, 256, 6655, "15001", common.HexToHash("testSeed")))
+	span, err := suite.app.BorKeeper.GetSpan(ctx, 1)
+	require.NoError(t, err)
+	producerID := span.SelectedProducers[0].ID.Uint64()
+	msg := borTypes.NewMsgReplaceProducer("", 1, producerID, 1024, "15001")
+
+	_, err = suite.postHandler(ctx, &msg, tmprototypes.SideTxResultType_NO)
+	require.Error(t, err)
+
+	result, err := suite.postHandler(ctx, &msg, tmprototypes.SideTxResultType_YES)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	// span is kept as selected, producer is replaced from start block only
+	stored, err := suite.app.BorKeeper.GetSpan(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, span.SelectedProducers, stored.SelectedProducers)
+
+	producers, err := suite.app.BorKeeper.GetSpanProducersAtBlock(ctx, stored, 1023)
+	require.NoError(t, err)
+	require.Equal(t, span.SelectedProducers, producers)
+
+	producers, err = suite.app.BorKeeper.GetSpanProducersAtBlock(ctx, stored, 1024)
+	require.NoError(t, err)
+	require.Len(t, producers, 2)
+	for _, producer := range producers {
+		require.NotEqual(t, producerID, producer.ID.Uint64())
+	}
+
+	// replay
+	_, err = suite.postHandler(ctx, &msg, tmprototypes.SideTxResultType_YES)
+	require.ErrorIs(t, err, hmCommon.ErrOldTx)
+}
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/maticnetwork/heimdall/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -24,9 +25,13 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
-	SprintDuration uint64 `protobuf:"varint,1,opt,name=sprint_duration,json=sprintDuration,proto3" json:"sprint_duration" yaml:"sprint_duration"`
-	SpanDuration   uint64 `protobuf:"varint,2,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration" yaml:"span_duration"`
-	ProducerCount  uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count" yaml:"producer_count"`
+	SprintDuration         uint64 `protobuf:"varint,1,opt,name=sprint_duration,json=sprintDuration,proto3" json:"sprint_duration" yaml:"sprint_duration"`
+	SpanDuration           uint64 `protobuf:"varint,2,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration" yaml:"span_duration"`
+	ProducerCount          uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count" yaml:"producer_count"`
+	SelectionAlgorithm     string `protobuf:"bytes,4,opt,name=selection_algorithm,json=selectionAlgorithm,proto3" json:"selection_algorithm" yaml:"selection_algorithm"`
+	MaxConsecutiveSpans    uint64 `protobuf:"varint,5,opt,name=max_consecutive_spans,json=maxConsecutiveSpans,proto3" json:"max_consecutive_spans" yaml:"max_consecutive_spans"`
+	MissedSprintsThreshold uint64 `protobuf:"varint,6,opt,name=missed_sprints_threshold,json=missedSprintsThreshold,proto3" json:"missed_sprints_threshold" yaml:"missed_sprints_threshold"`
+	SpanRetention          uint64 `protobuf:"varint,7,opt,name=span_retention,json=spanRetention,proto3" json:"span_retention" yaml:"span_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -83,32 +88,314 @@ func (m *Params) GetProducerCount() uint64 {
 	return 0
 }
 
+func (m *Params) GetSelectionAlgorithm() string {
+	if m != nil {
+		return m.SelectionAlgorithm
+	}
+	return ""
+}
+
+func (m *Params) GetMaxConsecutiveSpans() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveSpans
+	}
+	return 0
+}
+
+func (m *Params) GetMissedSprintsThreshold() uint64 {
+	if m != nil {
+		return m.MissedSprintsThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetSpanRetention() uint64 {
+	if m != nil {
+		return m.SpanRetention
+	}
+	return 0
+}
+
+// ProducerDowntime is number of sprints producer missed in a span
+type ProducerDowntime struct {
+	ValidatorId   uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id" yaml:"validator_id"`
+	MissedSprints uint64 `protobuf:"varint,2,opt,name=missed_sprints,json=missedSprints,proto3" json:"missed_sprints" yaml:"missed_sprints"`
+}
+
+func (m *ProducerDowntime) Reset()         { *m = ProducerDowntime{} }
+func (m *ProducerDowntime) String() string { return proto.CompactTextString(m) }
+func (*ProducerDowntime) ProtoMessage()    {}
+func (*ProducerDowntime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_955064f0a1ce7923, []int{1}
+}
+func (m *ProducerDowntime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProducerDowntime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProducerDowntime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProducerDowntime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerDowntime.Merge(m, src)
+}
+func (m *ProducerDowntime) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProducerDowntime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerDowntime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProducerDowntime proto.InternalMessageInfo
+
+func (m *ProducerDowntime) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
+	}
+	return 0
+}
+
+func (m *ProducerDowntime) GetMissedSprints() uint64 {
+	if m != nil {
+		return m.MissedSprints
+	}
+	return 0
+}
+
+// ProducerReplacement replaces producer of span with another validator from
+// start block till end of the span
+type ProducerReplacement struct {
+	SpanId      uint64          `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id" yaml:"span_id"`
+	StartBlock  uint64          `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block" yaml:"start_block"`
+	ProducerId  uint64          `protobuf:"varint,3,opt,name=producer_id,json=producerId,proto3" json:"producer_id" yaml:"producer_id"`
+	Replacement types.Validator `protobuf:"bytes,4,opt,name=replacement,proto3" json:"replacement" yaml:"replacement"`
+}
+
+func (m *ProducerReplacement) Reset()         { *m = ProducerReplacement{} }
+func (m *ProducerReplacement) String() string { return proto.CompactTextString(m) }
+func (*ProducerReplacement) ProtoMessage()    {}
+func (*ProducerReplacement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_955064f0a1ce7923, []int{2}
+}
+func (m *ProducerReplacement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProducerReplacement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProducerReplacement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProducerReplacement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProducerReplacement.Merge(m, src)
+}
+func (m *ProducerReplacement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProducerReplacement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProducerReplacement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProducerReplacement proto.InternalMessageInfo
+
+func (m *ProducerReplacement) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
+	}
+	return 0
+}
+
+func (m *ProducerReplacement) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *ProducerReplacement) GetProducerId() uint64 {
+	if m != nil {
+		return m.ProducerId
+	}
+	return 0
+}
+
+func (m *ProducerReplacement) GetReplacement() types.Validator {
+	if m != nil {
+		return m.Replacement
+	}
+	return types.Validator{}
+}
+
+// StoredSpan is span as kept in store, referencing its deduplicated validator set by hash
+type StoredSpan struct {
+	ID                 uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	StartBlock         uint64            `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block" yaml:"start_block"`
+	EndBlock           uint64            `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block" yaml:"end_block"`
+	ValidatorSetHash   []byte            `protobuf:"bytes,4,opt,name=validator_set_hash,json=validatorSetHash,proto3" json:"validator_set_hash" yaml:"validator_set_hash"`
+	SelectedProducers  []types.Validator `protobuf:"bytes,5,rep,name=selected_producers,json=selectedProducers,proto3" json:"selected_producers" yaml:"selected_producers"`
+	BorChainId         string            `protobuf:"bytes,6,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id" yaml:"bor_chain_id"`
+	SelectionAlgorithm string            `protobuf:"bytes,7,opt,name=selection_algorithm,json=selectionAlgorithm,proto3" json:"selection_algorithm" yaml:"selection_algorithm"`
+}
+
+func (m *StoredSpan) Reset()         { *m = StoredSpan{} }
+func (m *StoredSpan) String() string { return proto.CompactTextString(m) }
+func (*StoredSpan) ProtoMessage()    {}
+func (*StoredSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_955064f0a1ce7923, []int{3}
+}
+func (m *StoredSpan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoredSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoredSpan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoredSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredSpan.Merge(m, src)
+}
+func (m *StoredSpan) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoredSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoredSpan proto.InternalMessageInfo
+
+func (m *StoredSpan) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *StoredSpan) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *StoredSpan) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *StoredSpan) GetValidatorSetHash() []byte {
+	if m != nil {
+		return m.ValidatorSetHash
+	}
+	return nil
+}
+
+func (m *StoredSpan) GetSelectedProducers() []types.Validator {
+	if m != nil {
+		return m.SelectedProducers
+	}
+	return nil
+}
+
+func (m *StoredSpan) GetBorChainId() string {
+	if m != nil {
+		return m.BorChainId
+	}
+	return ""
+}
+
+func (m *StoredSpan) GetSelectionAlgorithm() string {
+	if m != nil {
+		return m.SelectionAlgorithm
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "heimdall.bor.v1beta1.Params")
+	proto.RegisterType((*ProducerDowntime)(nil), "heimdall.bor.v1beta1.ProducerDowntime")
+	proto.RegisterType((*ProducerReplacement)(nil), "heimdall.bor.v1beta1.ProducerReplacement")
+	proto.RegisterType((*StoredSpan)(nil), "heimdall.bor.v1beta1.StoredSpan")
 }
 
 func init() { proto.RegisterFile("heimdall/bor/v1beta1/bor.proto", fileDescriptor_955064f0a1ce7923) }
 
 var fileDescriptor_955064f0a1ce7923 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x4f, 0xca, 0x2f, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0x04, 0xb1, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x60, 0xf2, 0x7a, 0x20, 0x31, 0xa8,
-	0xbc, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0x81, 0x3e, 0x88, 0x05, 0x51, 0xab, 0xd4, 0xc2,
-	0xc4, 0xc5, 0x16, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0x14, 0xc6, 0xc5, 0x5f, 0x5c, 0x50, 0x94,
-	0x99, 0x57, 0x12, 0x9f, 0x52, 0x5a, 0x94, 0x58, 0x92, 0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0xe2, 0xa4, 0xfb, 0xea, 0x9e, 0x3c, 0xba, 0xd4, 0xa7, 0x7b, 0xf2, 0x62, 0x95, 0x89, 0xb9,
-	0x39, 0x56, 0x4a, 0x68, 0x12, 0x4a, 0x41, 0x7c, 0x10, 0x11, 0x17, 0xa8, 0x80, 0x90, 0x1f, 0x17,
-	0x6f, 0x71, 0x41, 0x62, 0x1e, 0xc2, 0x54, 0x26, 0xb0, 0xa9, 0x9a, 0xaf, 0xee, 0xc9, 0xa3, 0x4a,
-	0x7c, 0xba, 0x27, 0x2f, 0x02, 0x33, 0x13, 0x49, 0x58, 0x29, 0x88, 0x07, 0xc4, 0x87, 0x9b, 0x17,
-	0xc4, 0xc5, 0x57, 0x50, 0x94, 0x9f, 0x52, 0x9a, 0x9c, 0x5a, 0x14, 0x9f, 0x9c, 0x5f, 0x9a, 0x57,
-	0x22, 0xc1, 0x0c, 0x36, 0x50, 0xfb, 0xd5, 0x3d, 0x79, 0x34, 0x99, 0x4f, 0xf7, 0xe4, 0x45, 0x21,
-	0x26, 0xa2, 0x8a, 0x2b, 0x05, 0xf1, 0xc2, 0x04, 0x9c, 0x41, 0x7c, 0x27, 0xb7, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0xcf, 0x4d, 0x2c, 0xc9, 0x4c, 0xce, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6,
-	0x87, 0x47, 0x42, 0x05, 0x38, 0x1a, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xa1, 0x6a,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x05, 0xb2, 0x09, 0x0a, 0xa3, 0x01, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1c, 0x35,
+	0x14, 0xcf, 0x6e, 0xd2, 0x0d, 0x71, 0xfe, 0x10, 0x9c, 0xb4, 0x9a, 0x44, 0xb0, 0x2e, 0x96, 0x2a,
+	0x1a, 0x01, 0xbb, 0x2a, 0x15, 0x20, 0x38, 0x80, 0xd8, 0x44, 0x15, 0xcb, 0x01, 0x55, 0x0e, 0xea,
+	0x81, 0xcb, 0xc8, 0x3b, 0xe3, 0x66, 0x46, 0x9d, 0x19, 0xaf, 0x6c, 0x6f, 0x9a, 0x1c, 0xf9, 0x06,
+	0x7c, 0x0e, 0x8e, 0x7c, 0x07, 0xa4, 0x1e, 0x7b, 0x84, 0x8b, 0x85, 0x92, 0xdb, 0x1c, 0xe7, 0x13,
+	0x20, 0x7b, 0xfe, 0x78, 0x66, 0xbb, 0xe5, 0xd4, 0xdb, 0xbc, 0xdf, 0xef, 0xf9, 0xf7, 0x9e, 0xdf,
+	0x7b, 0x7e, 0x03, 0x86, 0x11, 0x8b, 0xd3, 0x90, 0x26, 0xc9, 0x78, 0xc6, 0xc5, 0xf8, 0xf2, 0xd1,
+	0x8c, 0x29, 0xfa, 0xc8, 0x7c, 0x8f, 0xe6, 0x82, 0x2b, 0x0e, 0x0f, 0x6b, 0x7e, 0x64, 0xb0, 0x8a,
+	0x3f, 0x3e, 0xbc, 0xe0, 0x17, 0xdc, 0x3a, 0x8c, 0xcd, 0x57, 0xe9, 0x7b, 0xfc, 0xc0, 0x69, 0x51,
+	0xc9, 0x1a, 0xb1, 0x4b, 0x9a, 0xc4, 0x21, 0x55, 0xb5, 0x24, 0xfe, 0xe3, 0x0e, 0x18, 0x3c, 0xa5,
+	0x82, 0xa6, 0x12, 0x3e, 0x03, 0xef, 0xcb, 0xb9, 0x88, 0x33, 0xe5, 0x87, 0x0b, 0x41, 0x55, 0xcc,
+	0x33, 0xaf, 0x77, 0xbf, 0xf7, 0x70, 0x63, 0xf2, 0x79, 0xae, 0xd1, 0x32, 0x55, 0x68, 0x74, 0xef,
+	0x9a, 0xa6, 0xc9, 0xb7, 0x78, 0x89, 0xc0, 0x64, 0xaf, 0x44, 0xce, 0x2a, 0x00, 0xfe, 0x0c, 0x76,
+	0xe5, 0x9c, 0x66, 0x4e, 0xb5, 0x6f, 0x55, 0x4f, 0x72, 0x8d, 0xba, 0x44, 0xa1, 0xd1, 0x61, 0xad,
+	0xd9, 0x82, 0x31, 0xd9, 0x31, 0x76, 0xa3, 0x47, 0xc0, 0xde, 0x5c, 0xf0, 0x70, 0x11, 0x30, 0xe1,
+	0x07, 0x7c, 0x91, 0x29, 0x6f, 0xdd, 0x0a, 0x7e, 0x9a, 0x6b, 0xb4, 0xc4, 0x14, 0x1a, 0xdd, 0x2d,
+	0x15, 0xbb, 0x38, 0x26, 0xbb, 0x35, 0x70, 0x6a, 0x6c, 0xf8, 0x1c, 0x1c, 0x48, 0x96, 0xb0, 0xc0,
+	0x04, 0xf0, 0x69, 0x72, 0xc1, 0x45, 0xac, 0xa2, 0xd4, 0xdb, 0xb8, 0xdf, 0x7b, 0xb8, 0x35, 0xf9,
+	0x32, 0xd7, 0x68, 0x15, 0x5d, 0x68, 0x74, 0x5c, 0xe5, 0xfb, 0x26, 0x89, 0x09, 0x6c, 0xd0, 0x1f,
+	0x6a, 0x10, 0xa6, 0xe0, 0x6e, 0x4a, 0xaf, 0xfc, 0x80, 0x67, 0x92, 0x05, 0x0b, 0x15, 0x5f, 0x32,
+	0xdf, 0xdc, 0x4d, 0x7a, 0x77, 0xec, 0x15, 0xbe, 0xc9, 0x35, 0x5a, 0xed, 0x50, 0x68, 0xf4, 0x61,
+	0x19, 0x6b, 0x25, 0x8d, 0xc9, 0x41, 0x4a, 0xaf, 0x4e, 0x1d, 0x7c, 0x6e, 0x50, 0x78, 0x0d, 0xbc,
+	0x34, 0x96, 0x92, 0x85, 0x7e, 0xd9, 0x13, 0xe9, 0xab, 0x48, 0x30, 0x19, 0xf1, 0x24, 0xf4, 0x06,
+	0x36, 0xe2, 0xf7, 0xb9, 0x46, 0x6f, 0xf5, 0x29, 0x34, 0x42, 0x55, 0xd0, 0xb7, 0x78, 0x60, 0x72,
+	0xaf, 0xa4, 0xce, 0x4b, 0xe6, 0x97, 0x9a, 0x30, 0x5d, 0xb2, 0x5d, 0x14, 0x4c, 0xb1, 0xcc, 0xb6,
+	0x7d, 0xd3, 0x75, 0xa9, 0xcb, 0xb8, 0x2e, 0x75, 0x71, 0x4c, 0xec, 0x7c, 0x90, 0xc6, 0xfe, 0xb3,
+	0x07, 0xf6, 0x9f, 0x56, 0x7d, 0x3b, 0xe3, 0x2f, 0x33, 0x15, 0xa7, 0x0c, 0xfe, 0x04, 0x76, 0x9a,
+	0xa1, 0xf6, 0xe3, 0xb0, 0x9a, 0xd9, 0x4f, 0x72, 0x8d, 0x3a, 0x78, 0xa1, 0xd1, 0x41, 0x19, 0xa4,
+	0x8d, 0x62, 0xb2, 0xdd, 0x98, 0x53, 0x9b, 0x74, 0xf7, 0xa6, 0x5e, 0xdf, 0x25, 0xdd, 0x65, 0x5c,
+	0xd2, 0x5d, 0x1c, 0x93, 0xdd, 0x4e, 0x45, 0xf0, 0x5f, 0x7d, 0x70, 0x50, 0x27, 0x4d, 0xd8, 0x3c,
+	0xa1, 0x01, 0x4b, 0x59, 0xa6, 0xe0, 0x57, 0x60, 0xd3, 0x5e, 0xb7, 0x49, 0xf9, 0xa3, 0x5c, 0xa3,
+	0x1a, 0x2a, 0x34, 0xda, 0x6b, 0x95, 0xc4, 0x24, 0x3a, 0x30, 0x5f, 0xd3, 0x10, 0x3e, 0x01, 0xdb,
+	0x52, 0x51, 0xa1, 0xfc, 0x59, 0xc2, 0x83, 0x17, 0x55, 0x82, 0x0f, 0x72, 0x8d, 0xda, 0x70, 0xa1,
+	0x11, 0xac, 0xce, 0x3b, 0x10, 0x13, 0x60, 0xad, 0x89, 0x31, 0x8c, 0x4e, 0xf3, 0x28, 0xe2, 0xd0,
+	0x5b, 0x77, 0x3a, 0x2d, 0xd8, 0xe9, 0xb4, 0x40, 0x4c, 0x40, 0x6d, 0x4d, 0x43, 0xf8, 0x1c, 0x6c,
+	0x0b, 0x77, 0x2d, 0xfb, 0x64, 0xb6, 0xbf, 0x38, 0x1a, 0x35, 0xab, 0x4a, 0x5d, 0xcf, 0x99, 0x1c,
+	0x3d, 0xab, 0xab, 0x3c, 0x39, 0x79, 0xa5, 0xd1, 0x9a, 0x09, 0xd3, 0x3a, 0xe5, 0xc2, 0xb4, 0x40,
+	0x4c, 0xda, 0x2e, 0xf8, 0x9f, 0x0d, 0x00, 0xce, 0x15, 0x17, 0xa6, 0xb2, 0x34, 0x83, 0x27, 0xa0,
+	0xdf, 0x54, 0xee, 0xe8, 0x46, 0xa3, 0xfe, 0xf4, 0x2c, 0xd7, 0xa8, 0x6f, 0x53, 0xde, 0x2a, 0xb5,
+	0x4c, 0xa6, 0xfd, 0xf8, 0xdd, 0x55, 0xec, 0x3b, 0xb0, 0xc5, 0xb2, 0xb0, 0x52, 0x29, 0xeb, 0xf5,
+	0x71, 0xae, 0x91, 0x03, 0x0b, 0x8d, 0xf6, 0x4b, 0x8d, 0x06, 0xc2, 0xe4, 0x3d, 0x96, 0x85, 0xe5,
+	0x79, 0x0a, 0xa0, 0x9b, 0x3d, 0xc9, 0x94, 0x1f, 0x51, 0x19, 0xd9, 0x82, 0xed, 0x4c, 0x1e, 0xe7,
+	0x1a, 0xad, 0x60, 0x0b, 0x8d, 0x8e, 0x96, 0xa7, 0xb6, 0xe6, 0x30, 0xd9, 0x6f, 0xc0, 0x73, 0xa6,
+	0x7e, 0xa4, 0x32, 0x82, 0xbf, 0xf5, 0x40, 0xb5, 0x76, 0x58, 0xe8, 0xd7, 0x4d, 0x32, 0xdb, 0x65,
+	0xfd, 0xff, 0x9b, 0xf2, 0x75, 0xd5, 0x94, 0x15, 0x87, 0x5d, 0x0a, 0x6f, 0x72, 0x98, 0x7c, 0x50,
+	0x83, 0xf5, 0x88, 0x4b, 0x38, 0x05, 0x3b, 0x33, 0x2e, 0xfc, 0x20, 0xa2, 0xb1, 0x9d, 0xee, 0x81,
+	0x5d, 0xa2, 0xf6, 0x41, 0xb6, 0x71, 0xf7, 0x20, 0xdb, 0x28, 0x26, 0x60, 0xc6, 0xc5, 0xa9, 0xb1,
+	0xec, 0x6c, 0xad, 0x5c, 0xcb, 0x9b, 0xef, 0x78, 0x2d, 0x4f, 0x9e, 0xbc, 0xba, 0x19, 0xf6, 0x5e,
+	0xdf, 0x0c, 0x7b, 0xff, 0xde, 0x0c, 0x7b, 0xbf, 0xdf, 0x0e, 0xd7, 0x5e, 0xdf, 0x0e, 0xd7, 0xfe,
+	0xbe, 0x1d, 0xae, 0xfd, 0xfa, 0xd9, 0x45, 0xac, 0xa2, 0xc5, 0x6c, 0x14, 0xf0, 0x74, 0x9c, 0x52,
+	0x15, 0x07, 0x19, 0x53, 0x2f, 0xb9, 0x78, 0x31, 0x6e, 0x7e, 0xaf, 0x57, 0xf6, 0x67, 0x6d, 0x0b,
+	0x3a, 0x1b, 0xd8, 0x9f, 0xea, 0xe3, 0xff, 0x06, 0x00, 0x16, 0xf2, 0xf0, 0x60, 0xc9, 0x07, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -131,6 +418,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SpanRetention != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.SpanRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MissedSprintsThreshold != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.MissedSprintsThreshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxConsecutiveSpans != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.MaxConsecutiveSpans))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SelectionAlgorithm) > 0 {
+		i -= len(m.SelectionAlgorithm)
+		copy(dAtA[i:], m.SelectionAlgorithm)
+		i = encodeVarintBor(dAtA, i, uint64(len(m.SelectionAlgorithm)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.ProducerCount != 0 {
 		i = encodeVarintBor(dAtA, i, uint64(m.ProducerCount))
 		i--
@@ -146,45 +455,716 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProducerDowntime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProducerDowntime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProducerDowntime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedSprints != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.MissedSprints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValidatorId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProducerReplacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProducerReplacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProducerReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Replacement.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ProducerId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.ProducerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpanId != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoredSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoredSpan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoredSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SelectionAlgorithm) > 0 {
+		i -= len(m.SelectionAlgorithm)
+		copy(dAtA[i:], m.SelectionAlgorithm)
+		i = encodeVarintBor(dAtA, i, uint64(len(m.SelectionAlgorithm)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BorChainId) > 0 {
+		i -= len(m.BorChainId)
+		copy(dAtA[i:], m.BorChainId)
+		i = encodeVarintBor(dAtA, i, uint64(len(m.BorChainId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SelectedProducers) > 0 {
+		for iNdEx := len(m.SelectedProducers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelectedProducers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValidatorSetHash) > 0 {
+		i -= len(m.ValidatorSetHash)
+		copy(dAtA[i:], m.ValidatorSetHash)
+		i = encodeVarintBor(dAtA, i, uint64(len(m.ValidatorSetHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintBor(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SprintDuration != 0 {
+		n += 1 + sovBor(uint64(m.SprintDuration))
+	}
+	if m.SpanDuration != 0 {
+		n += 1 + sovBor(uint64(m.SpanDuration))
+	}
+	if m.ProducerCount != 0 {
+		n += 1 + sovBor(uint64(m.ProducerCount))
+	}
+	l = len(m.SelectionAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovBor(uint64(l))
+	}
+	if m.MaxConsecutiveSpans != 0 {
+		n += 1 + sovBor(uint64(m.MaxConsecutiveSpans))
+	}
+	if m.MissedSprintsThreshold != 0 {
+		n += 1 + sovBor(uint64(m.MissedSprintsThreshold))
+	}
+	if m.SpanRetention != 0 {
+		n += 1 + sovBor(uint64(m.SpanRetention))
+	}
+	return n
+}
+
+func (m *ProducerDowntime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovBor(uint64(m.ValidatorId))
+	}
+	if m.MissedSprints != 0 {
+		n += 1 + sovBor(uint64(m.MissedSprints))
+	}
+	return n
+}
+
+func (m *ProducerReplacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovBor(uint64(m.SpanId))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovBor(uint64(m.StartBlock))
+	}
+	if m.ProducerId != 0 {
+		n += 1 + sovBor(uint64(m.ProducerId))
+	}
+	l = m.Replacement.Size()
+	n += 1 + l + sovBor(uint64(l))
+	return n
+}
+
+func (m *StoredSpan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovBor(uint64(m.ID))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovBor(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBor(uint64(m.EndBlock))
+	}
+	l = len(m.ValidatorSetHash)
+	if l > 0 {
+		n += 1 + l + sovBor(uint64(l))
+	}
+	if len(m.SelectedProducers) > 0 {
+		for _, e := range m.SelectedProducers {
+			l = e.Size()
+			n += 1 + l + sovBor(uint64(l))
+		}
+	}
+	l = len(m.BorChainId)
+	if l > 0 {
+		n += 1 + l + sovBor(uint64(l))
+	}
+	l = len(m.SelectionAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovBor(uint64(l))
+	}
+	return n
+}
+
+func sovBor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBor(x uint64) (n int) {
+	return sovBor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SprintDuration", wireType)
+			}
+			m.SprintDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SprintDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanDuration", wireType)
+			}
+			m.SpanDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerCount", wireType)
+			}
+			m.ProducerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectionAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveSpans", wireType)
+			}
+			m.MaxConsecutiveSpans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveSpans |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSprintsThreshold", wireType)
+			}
+			m.MissedSprintsThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedSprintsThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanRetention", wireType)
+			}
+			m.SpanRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProducerDowntime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProducerDowntime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProducerDowntime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSprints", wireType)
+			}
+			m.MissedSprints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedSprints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintBor(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBor(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SprintDuration != 0 {
-		n += 1 + sovBor(uint64(m.SprintDuration))
-	}
-	if m.SpanDuration != 0 {
-		n += 1 + sovBor(uint64(m.SpanDuration))
-	}
-	if m.ProducerCount != 0 {
-		n += 1 + sovBor(uint64(m.ProducerCount))
+func (m *ProducerReplacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProducerReplacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProducerReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
+			}
+			m.ProducerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Replacement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovBor(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBor(x uint64) (n int) {
-	return sovBor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *StoredSpan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -207,17 +1187,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoredSpan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoredSpan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SprintDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.SprintDuration = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBor
@@ -227,16 +1207,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SprintDuration |= uint64(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			m.SpanDuration = 0
+			m.StartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBor
@@ -246,16 +1226,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpanDuration |= uint64(b&0x7F) << shift
+				m.StartBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProducerCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
 			}
-			m.ProducerCount = 0
+			m.EndBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBor
@@ -265,11 +1245,143 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProducerCount |= uint64(b&0x7F) << shift
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSetHash = append(m.ValidatorSetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSetHash == nil {
+				m.ValidatorSetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedProducers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectedProducers = append(m.SelectedProducers, types.Validator{})
+			if err := m.SelectedProducers[len(m.SelectedProducers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectionAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBor(dAtA[iNdEx:])
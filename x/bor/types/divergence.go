@@ -0,0 +1,12 @@
+package types
+
+// Span divergence reasons
+const (
+	// SpanDivergenceMissingOnHeimdall is reported for span committed on bor but not stored in heimdall
+	SpanDivergenceMissingOnHeimdall = "missing_on_heimdall"
+	// SpanDivergenceBlockRangeMismatch is reported for span with different start or end block on bor
+	SpanDivergenceBlockRangeMismatch = "block_range_mismatch"
+
+	// MaxSpanDivergenceRange is maximum number of spans compared in one span divergence query
+	MaxSpanDivergenceRange uint64 = 100
+)
@@ -2,12 +2,19 @@ package types
 
 // staking module event types
 const (
-	EventTypeProposeSpan = "propose-span"
+	EventTypeProposeSpan        = "propose-span"
+	EventTypeReportMissedSprint = "report-missed-sprint"
+	EventTypeReplaceProducer    = "replace-producer"
 
 	AttributeKeySuccess        = "success"
 	AttributeKeySpanID         = "span-id"
 	AttributeKeySpanStartBlock = "start-block"
 	AttributeKeySpanEndBlock   = "end-block"
 
+	AttributeKeySprintStartBlock = "sprint-start-block"
+	AttributeKeyProducerID       = "producer-id"
+	AttributeKeyMissedSprints    = "missed-sprints"
+	AttributeKeyReplacementID    = "replacement-id"
+
 	AttributeValueCategory = ModuleName
 )
@@ -25,8 +25,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params *Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
-	Spans  []*types.Span `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty" yaml:"spans"`
+	Params               *Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	Spans                []*types.Span         `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty" yaml:"spans"`
+	ProducerReplacements []ProducerReplacement `protobuf:"bytes,3,rep,name=producer_replacements,json=producerReplacements,proto3" json:"producer_replacements,omitempty" yaml:"producer_replacements"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,26 +72,30 @@ func init() {
 }
 
 var fileDescriptor_86fd5eb93f8ce25f = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x4f, 0xca, 0x2f, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xa9, 0xd1, 0x4b, 0xca, 0x2f, 0xd2, 0x83, 0xaa, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0x2b, 0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0xe4, 0xb0, 0x9a, 0x07, 0xd2, 0x07, 0x91, 0x57, 0x40,
-	0xc8, 0x27, 0x16, 0xa7, 0xc2, 0x15, 0x14, 0x17, 0x24, 0xe6, 0x41, 0x54, 0x28, 0xed, 0x60, 0xe4,
-	0xe2, 0x71, 0x87, 0xd8, 0x1f, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x14, 0xc8, 0xc5, 0x56, 0x90, 0x58,
-	0x94, 0x98, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa3, 0x87, 0xcd, 0x3d, 0x7a,
-	0x01, 0x60, 0x35, 0x4e, 0xd2, 0xaf, 0xee, 0xc9, 0x43, 0xd5, 0x7f, 0xba, 0x27, 0xcf, 0x5b, 0x99,
-	0x98, 0x9b, 0x63, 0xa5, 0x04, 0xe1, 0x2b, 0x05, 0x41, 0x25, 0x84, 0xfc, 0xb9, 0x58, 0x41, 0x36,
-	0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0x20, 0x4c, 0x2c, 0xa9, 0x2c, 0x48, 0x2d,
-	0xd6, 0x0b, 0x2e, 0x48, 0xcc, 0x73, 0x52, 0x7e, 0x75, 0x4f, 0x9e, 0x1f, 0xac, 0x4c, 0x27, 0x3f,
-	0x37, 0xb3, 0x24, 0x35, 0xb7, 0xa0, 0xa4, 0xf2, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x91, 0x60, 0x09,
-	0xa5, 0x20, 0x88, 0x39, 0x56, 0x2c, 0x1d, 0x0b, 0xe4, 0x19, 0x9c, 0xdc, 0x4e, 0x3c, 0x92, 0x63,
-	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
-	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x27, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
-	0x3f, 0x57, 0x3f, 0x37, 0xb1, 0x24, 0x33, 0x39, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x5b, 0x1f,
-	0x1e, 0x1c, 0x15, 0xe0, 0x00, 0x03, 0x5b, 0x9f, 0xc4, 0x06, 0x0e, 0x09, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xcf, 0x86, 0x14, 0x87, 0x9d, 0x01, 0x00, 0x00,
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x14, 0x86, 0x13, 0xb8, 0x97, 0x21, 0x70, 0x75, 0xa5, 0x28, 0x95, 0x10, 0x45, 0x31, 0x4a, 0x17,
+	0x2a, 0xa1, 0x44, 0xd0, 0x8d, 0x31, 0x43, 0x19, 0xdb, 0x86, 0xad, 0x4b, 0xe5, 0x04, 0x2b, 0x44,
+	0x8d, 0x63, 0xcb, 0x36, 0x6d, 0x79, 0x83, 0x8e, 0x7d, 0x84, 0x6e, 0x7d, 0x15, 0x46, 0xc6, 0x4e,
+	0x51, 0x15, 0x36, 0x46, 0x9e, 0xa0, 0xc2, 0x4e, 0x41, 0x55, 0xd3, 0xcd, 0xd6, 0xff, 0x9d, 0xef,
+	0x1c, 0xfb, 0x18, 0xce, 0x1c, 0x25, 0x78, 0x06, 0xd3, 0xd4, 0x0b, 0x09, 0xf3, 0x1e, 0x86, 0x21,
+	0x12, 0x70, 0xe8, 0xc5, 0x28, 0x43, 0x3c, 0xe1, 0x2e, 0x65, 0x44, 0x10, 0xd3, 0xfa, 0x62, 0xdc,
+	0x90, 0x30, 0xb7, 0x64, 0x3a, 0x56, 0x4c, 0x62, 0x22, 0x01, 0x6f, 0x7f, 0x52, 0x6c, 0xc7, 0xae,
+	0xf4, 0xed, 0xeb, 0x54, 0xde, 0x3b, 0xe6, 0x90, 0xa3, 0x03, 0xc0, 0x29, 0xcc, 0x14, 0xe1, 0x14,
+	0x35, 0xa3, 0x35, 0x51, 0xfd, 0xa7, 0x02, 0x0a, 0x64, 0xde, 0x18, 0x0d, 0x0a, 0x19, 0xc4, 0xbc,
+	0xad, 0xf7, 0xf4, 0x7e, 0x73, 0xd4, 0x75, 0xab, 0xe6, 0x71, 0xaf, 0x25, 0xe3, 0x9f, 0x6e, 0x73,
+	0x50, 0xf2, 0xbb, 0x1c, 0xfc, 0x5b, 0x42, 0x9c, 0x8e, 0x1d, 0x75, 0x77, 0x82, 0x32, 0x30, 0xaf,
+	0x8c, 0xbf, 0xfb, 0x8e, 0xbc, 0x5d, 0xeb, 0xd5, 0xfb, 0xcd, 0x91, 0x75, 0x34, 0x8a, 0x25, 0x45,
+	0xdc, 0x9d, 0x52, 0x98, 0xf9, 0x67, 0xdb, 0x1c, 0xfc, 0x97, 0xd8, 0x80, 0xe0, 0x44, 0x20, 0x4c,
+	0xc5, 0x72, 0x97, 0x83, 0x96, 0x52, 0xca, 0xc0, 0x09, 0x94, 0xc7, 0x7c, 0xd3, 0x8d, 0x13, 0xca,
+	0xc8, 0x6c, 0x11, 0x21, 0x76, 0xc7, 0x10, 0x4d, 0x61, 0x84, 0x30, 0xca, 0x04, 0x6f, 0xd7, 0x65,
+	0x87, 0xf3, 0x5f, 0x66, 0x2e, 0x4b, 0x82, 0x63, 0x85, 0x3f, 0x59, 0xe5, 0x40, 0xdb, 0xe6, 0x00,
+	0x54, 0xfa, 0xbe, 0x8d, 0xd2, 0x2d, 0x5f, 0x57, 0x05, 0x3a, 0x81, 0x45, 0x7f, 0xda, 0xf9, 0xf8,
+	0xcf, 0xf3, 0x2b, 0xd0, 0xfc, 0xcb, 0x55, 0x61, 0xeb, 0xeb, 0xc2, 0xd6, 0x3f, 0x0a, 0x5b, 0x7f,
+	0xd9, 0xd8, 0xda, 0x7a, 0x63, 0x6b, 0xef, 0x1b, 0x5b, 0xbb, 0x1d, 0xc4, 0x89, 0x98, 0x2f, 0x42,
+	0x37, 0x22, 0xd8, 0xc3, 0x50, 0x24, 0x51, 0x86, 0xc4, 0x23, 0x61, 0xf7, 0xde, 0x61, 0x71, 0x4f,
+	0x72, 0xb5, 0xf2, 0xa3, 0xc2, 0x86, 0xdc, 0xd9, 0xc5, 0xe7, 0x00, 0x48, 0xc5, 0x2f, 0x52, 0x47,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +118,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProducerReplacements) > 0 {
+		for iNdEx := len(m.ProducerReplacements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProducerReplacements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Spans) > 0 {
 		for iNdEx := len(m.Spans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -169,6 +188,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ProducerReplacements) > 0 {
+		for _, e := range m.ProducerReplacements {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -277,6 +302,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerReplacements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProducerReplacements = append(m.ProducerReplacements, ProducerReplacement{})
+			if err := m.ProducerReplacements[len(m.ProducerReplacements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
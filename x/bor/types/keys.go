@@ -15,4 +15,7 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// ConsensusVersion is version of bor store layout, store is migrated to it by app store migrations
+	ConsensusVersion uint64 = 2
 )
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/maticnetwork/bor/common"
+	borConsensus "github.com/maticnetwork/bor/consensus/bor"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// extraSeal is length of signer seal at the end of bor header extra data
+const extraSeal = 65
+
+// GetHeaderSigner recovers address of producer which sealed bor header
+func GetHeaderSigner(header *ethTypes.Header) (common.Address, error) {
+	if len(header.Extra) < extraSeal {
+		return common.Address{}, errors.New("bor header is missing signer seal")
+	}
+	signature := header.Extra[len(header.Extra)-extraSeal:]
+
+	pubkey, err := crypto.Ecrecover(borConsensus.SealHash(header).Bytes(), signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var signer common.Address
+	copy(signer[:], crypto.Keccak256(pubkey[1:])[12:])
+	return signer, nil
+}
+
+// GetMissedSprintProducer returns producer which was in-turn for sprint started by header but did not seal it.
+// Bor sets difficulty of a block to number of producers minus succession of its signer,
+// so in-turn producer is succession places before signer in producers sorted by signer address.
+func GetMissedSprintProducer(header *ethTypes.Header, producers []hmTypes.Validator) (hmTypes.ValidatorID, bool, error) {
+	signer, err := GetHeaderSigner(header)
+	if err != nil {
+		return 0, false, err
+	}
+
+	sorted := make([]hmTypes.Validator, len(producers))
+	copy(sorted, producers)
+	sorted = hmTypes.SortValidatorByAddress(sorted)
+
+	signerIndex := -1
+	for i, producer := range sorted {
+		if bytes.Equal(producer.GetSigner().Bytes(), signer.Bytes()) {
+			signerIndex = i
+			break
+		}
+	}
+	if signerIndex == -1 {
+		return 0, false, errors.New("bor header signer is not a span producer")
+	}
+
+	total := uint64(len(sorted))
+	if header.Difficulty == nil || header.Difficulty.Sign() <= 0 || header.Difficulty.Uint64() > total {
+		return 0, false, errors.New("invalid bor header difficulty")
+	}
+
+	succession := total - header.Difficulty.Uint64()
+	if succession == 0 {
+		return 0, false, nil
+	}
+
+	return sorted[(uint64(signerIndex)+total-succession)%total].ID, true, nil
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	borConsensus "github.com/maticnetwork/bor/consensus/bor"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/bor/types"
+)
+
+func sealHeader(t *testing.T, key *ecdsa.PrivateKey, number uint64, difficulty uint64) *ethTypes.Header {
+	header := &ethTypes.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Difficulty: new(big.Int).SetUint64(difficulty),
+		Extra:      make([]byte, 32+65),
+	}
+
+	signature, err := crypto.Sign(borConsensus.SealHash(header).Bytes(), key)
+	require.NoError(t, err)
+	copy(header.Extra[32:], signature)
+
+	return header
+}
+
+func TestGetMissedSprintProducer(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 4)
+	producers := make([]hmTypes.Validator, 4)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		producers[i] = hmTypes.Validator{
+			ID:          hmTypes.ValidatorID(i + 1),
+			VotingPower: 1,
+			Signer:      crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		}
+	}
+
+	sorted := hmTypes.SortValidatorByAddress(append([]hmTypes.Validator{}, producers...))
+	signerKey := func(val hmTypes.Validator) *ecdsa.PrivateKey {
+		return keys[val.ID-1]
+	}
+
+	// in-turn producer sealed sprint
+	header := sealHeader(t, signerKey(sorted[1]), 64, 4)
+	signer, err := types.GetHeaderSigner(header)
+	require.NoError(t, err)
+	require.Equal(t, sorted[1].GetSigner().Bytes(), signer.Bytes())
+
+	_, missed, err := types.GetMissedSprintProducer(header, producers)
+	require.NoError(t, err)
+	require.False(t, missed)
+
+	// first backup sealed sprint
+	header = sealHeader(t, signerKey(sorted[2]), 64, 3)
+	producerID, missed, err := types.GetMissedSprintProducer(header, producers)
+	require.NoError(t, err)
+	require.True(t, missed)
+	require.Equal(t, sorted[1].ID, producerID)
+
+	// backup wraps around sorted producers
+	header = sealHeader(t, signerKey(sorted[0]), 64, 2)
+	producerID, missed, err = types.GetMissedSprintProducer(header, producers)
+	require.NoError(t, err)
+	require.True(t, missed)
+	require.Equal(t, sorted[2].ID, producerID)
+
+	// signer is not a producer
+	outsider, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	_, _, err = types.GetMissedSprintProducer(sealHeader(t, outsider, 64, 4), producers)
+	require.Error(t, err)
+
+	// difficulty out of range
+	_, _, err = types.GetMissedSprintProducer(sealHeader(t, signerKey(sorted[0]), 64, 5), producers)
+	require.Error(t, err)
+
+	// missing seal
+	_, err = types.GetHeaderSigner(&ethTypes.Header{Number: big.NewInt(64), Difficulty: big.NewInt(1)})
+	require.Error(t, err)
+}
@@ -61,3 +61,115 @@ func (m *MsgProposeSpan) GetSigners() []sdk.AccAddress {
 func (m MsgProposeSpan) GetSideSignBytes() []byte {
 	return nil
 }
+
+//
+// Report missed sprint
+//
+
+var _ sdk.Msg = &MsgReportMissedSprint{}
+
+// NewMsgReportMissedSprint creates new report missed sprint message
+func NewMsgReportMissedSprint(
+	proposer string,
+	spanId uint64,
+	sprintStartBlock uint64,
+	producerId uint64,
+	borChainId string,
+) MsgReportMissedSprint {
+	return MsgReportMissedSprint{
+		Proposer:         proposer,
+		SpanId:           spanId,
+		SprintStartBlock: sprintStartBlock,
+		ProducerId:       producerId,
+		BorChainId:       borChainId,
+	}
+}
+
+func (m MsgReportMissedSprint) Route() string {
+	return RouterKey
+}
+
+func (m MsgReportMissedSprint) Type() string {
+	return "report-missed-sprint"
+}
+
+func (m MsgReportMissedSprint) ValidateBasic() error {
+	if len(m.Proposer) == 0 {
+		return common.ErrInvalidMsg
+	}
+	return nil
+}
+
+func (m MsgReportMissedSprint) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (m *MsgReportMissedSprint) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHex(m.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSideSignBytes returns side sign bytes
+func (m MsgReportMissedSprint) GetSideSignBytes() []byte {
+	return nil
+}
+
+//
+// Replace producer
+//
+
+var _ sdk.Msg = &MsgReplaceProducer{}
+
+// NewMsgReplaceProducer creates new replace producer message
+func NewMsgReplaceProducer(
+	proposer string,
+	spanId uint64,
+	producerId uint64,
+	startBlock uint64,
+	borChainId string,
+) MsgReplaceProducer {
+	return MsgReplaceProducer{
+		Proposer:   proposer,
+		SpanId:     spanId,
+		ProducerId: producerId,
+		StartBlock: startBlock,
+		BorChainId: borChainId,
+	}
+}
+
+func (m MsgReplaceProducer) Route() string {
+	return RouterKey
+}
+
+func (m MsgReplaceProducer) Type() string {
+	return "replace-producer"
+}
+
+func (m MsgReplaceProducer) ValidateBasic() error {
+	if len(m.Proposer) == 0 {
+		return common.ErrInvalidMsg
+	}
+	return nil
+}
+
+func (m MsgReplaceProducer) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (m *MsgReplaceProducer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHex(m.Proposer)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSideSignBytes returns side sign bytes
+func (m MsgReplaceProducer) GetSideSignBytes() []byte {
+	return nil
+}
@@ -150,45 +150,289 @@ func (m *MsgProposeSpanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgProposeSpanResponse proto.InternalMessageInfo
 
+// MsgReportMissedSprint reports producer which missed its in-turn sprint on bor
+type MsgReportMissedSprint struct {
+	Proposer         string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer" yaml:"proposer"`
+	SpanId           uint64 `protobuf:"varint,2,opt,name=span_id,json=spanId,proto3" json:"span_id" yaml:"span_id"`
+	SprintStartBlock uint64 `protobuf:"varint,3,opt,name=sprint_start_block,json=sprintStartBlock,proto3" json:"sprint_start_block" yaml:"sprint_start_block"`
+	ProducerId       uint64 `protobuf:"varint,4,opt,name=producer_id,json=producerId,proto3" json:"producer_id" yaml:"producer_id"`
+	BorChainId       string `protobuf:"bytes,5,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id" yaml:"bor_chain_id"`
+}
+
+func (m *MsgReportMissedSprint) Reset()         { *m = MsgReportMissedSprint{} }
+func (m *MsgReportMissedSprint) String() string { return proto.CompactTextString(m) }
+func (*MsgReportMissedSprint) ProtoMessage()    {}
+func (*MsgReportMissedSprint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d99edf48c57200b, []int{2}
+}
+func (m *MsgReportMissedSprint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportMissedSprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportMissedSprint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportMissedSprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportMissedSprint.Merge(m, src)
+}
+func (m *MsgReportMissedSprint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportMissedSprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportMissedSprint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportMissedSprint proto.InternalMessageInfo
+
+func (m *MsgReportMissedSprint) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgReportMissedSprint) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
+	}
+	return 0
+}
+
+func (m *MsgReportMissedSprint) GetSprintStartBlock() uint64 {
+	if m != nil {
+		return m.SprintStartBlock
+	}
+	return 0
+}
+
+func (m *MsgReportMissedSprint) GetProducerId() uint64 {
+	if m != nil {
+		return m.ProducerId
+	}
+	return 0
+}
+
+func (m *MsgReportMissedSprint) GetBorChainId() string {
+	if m != nil {
+		return m.BorChainId
+	}
+	return ""
+}
+
+// MsgReportMissedSprintResponse defines the Msg/MsgReportMissedSprint response type.
+type MsgReportMissedSprintResponse struct {
+}
+
+func (m *MsgReportMissedSprintResponse) Reset()         { *m = MsgReportMissedSprintResponse{} }
+func (m *MsgReportMissedSprintResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportMissedSprintResponse) ProtoMessage()    {}
+func (*MsgReportMissedSprintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d99edf48c57200b, []int{3}
+}
+func (m *MsgReportMissedSprintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportMissedSprintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportMissedSprintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportMissedSprintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportMissedSprintResponse.Merge(m, src)
+}
+func (m *MsgReportMissedSprintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportMissedSprintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportMissedSprintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportMissedSprintResponse proto.InternalMessageInfo
+
+// MsgReplaceProducer replaces unresponsive producer for rest of the span
+type MsgReplaceProducer struct {
+	Proposer   string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer" yaml:"proposer"`
+	SpanId     uint64 `protobuf:"varint,2,opt,name=span_id,json=spanId,proto3" json:"span_id" yaml:"span_id"`
+	ProducerId uint64 `protobuf:"varint,3,opt,name=producer_id,json=producerId,proto3" json:"producer_id" yaml:"producer_id"`
+	BorChainId string `protobuf:"bytes,4,opt,name=bor_chain_id,json=borChainId,proto3" json:"bor_chain_id" yaml:"bor_chain_id"`
+	// start_block is first block of sprint replacement takes over from
+	StartBlock uint64 `protobuf:"varint,5,opt,name=start_block,json=startBlock,proto3" json:"start_block" yaml:"start_block"`
+}
+
+func (m *MsgReplaceProducer) Reset()         { *m = MsgReplaceProducer{} }
+func (m *MsgReplaceProducer) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceProducer) ProtoMessage()    {}
+func (*MsgReplaceProducer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d99edf48c57200b, []int{4}
+}
+func (m *MsgReplaceProducer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceProducer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceProducer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceProducer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceProducer.Merge(m, src)
+}
+func (m *MsgReplaceProducer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceProducer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceProducer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceProducer proto.InternalMessageInfo
+
+func (m *MsgReplaceProducer) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgReplaceProducer) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
+	}
+	return 0
+}
+
+func (m *MsgReplaceProducer) GetProducerId() uint64 {
+	if m != nil {
+		return m.ProducerId
+	}
+	return 0
+}
+
+func (m *MsgReplaceProducer) GetBorChainId() string {
+	if m != nil {
+		return m.BorChainId
+	}
+	return ""
+}
+
+func (m *MsgReplaceProducer) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+// MsgReplaceProducerResponse defines the Msg/MsgReplaceProducer response type.
+type MsgReplaceProducerResponse struct {
+}
+
+func (m *MsgReplaceProducerResponse) Reset()         { *m = MsgReplaceProducerResponse{} }
+func (m *MsgReplaceProducerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceProducerResponse) ProtoMessage()    {}
+func (*MsgReplaceProducerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d99edf48c57200b, []int{5}
+}
+func (m *MsgReplaceProducerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceProducerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceProducerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReplaceProducerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceProducerResponse.Merge(m, src)
+}
+func (m *MsgReplaceProducerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceProducerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceProducerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReplaceProducerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgProposeSpan)(nil), "heimdall.bor.v1beta1.MsgProposeSpan")
 	proto.RegisterType((*MsgProposeSpanResponse)(nil), "heimdall.bor.v1beta1.MsgProposeSpanResponse")
+	proto.RegisterType((*MsgReportMissedSprint)(nil), "heimdall.bor.v1beta1.MsgReportMissedSprint")
+	proto.RegisterType((*MsgReportMissedSprintResponse)(nil), "heimdall.bor.v1beta1.MsgReportMissedSprintResponse")
+	proto.RegisterType((*MsgReplaceProducer)(nil), "heimdall.bor.v1beta1.MsgReplaceProducer")
+	proto.RegisterType((*MsgReplaceProducerResponse)(nil), "heimdall.bor.v1beta1.MsgReplaceProducerResponse")
 }
 
 func init() { proto.RegisterFile("heimdall/bor/v1beta1/msg.proto", fileDescriptor_7d99edf48c57200b) }
 
 var fileDescriptor_7d99edf48c57200b = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
-	0x14, 0xc0, 0x37, 0xdd, 0x75, 0x6d, 0xa7, 0x52, 0x25, 0x56, 0x0d, 0x8b, 0x66, 0xea, 0xa0, 0xb4,
-	0x68, 0x9b, 0x50, 0x05, 0x0f, 0x15, 0x3c, 0xac, 0x50, 0xd8, 0x43, 0xa1, 0xa4, 0x9e, 0xbc, 0x2c,
-	0x93, 0xcd, 0x90, 0x0d, 0x4d, 0xe6, 0x85, 0x99, 0x51, 0xdb, 0xab, 0xe0, 0x5d, 0xf0, 0xe6, 0xd1,
-	0x4f, 0xe3, 0xb1, 0xe0, 0xc5, 0xd3, 0x20, 0xbb, 0x9e, 0x72, 0xcc, 0x27, 0x90, 0x4c, 0xb2, 0xff,
-	0xa0, 0x07, 0x6f, 0xf3, 0x7e, 0xef, 0xcd, 0xef, 0x31, 0xf3, 0x1e, 0x72, 0xc7, 0x2c, 0xc9, 0x22,
-	0x9a, 0xa6, 0x7e, 0x08, 0xc2, 0xff, 0x78, 0x18, 0x32, 0x45, 0x0f, 0xfd, 0x4c, 0xc6, 0x5e, 0x2e,
-	0x40, 0x81, 0xbd, 0x3d, 0xcb, 0x7b, 0x21, 0x08, 0xaf, 0xc9, 0xf7, 0xb6, 0x63, 0x88, 0xc1, 0x14,
-	0xf8, 0xd5, 0xa9, 0xae, 0xed, 0x3d, 0x8c, 0x01, 0xe2, 0x94, 0xf9, 0x34, 0x4f, 0x7c, 0xca, 0x39,
-	0x28, 0xaa, 0x12, 0xe0, 0xb2, 0xce, 0x92, 0x2f, 0x6d, 0xb4, 0x75, 0x22, 0xe3, 0x53, 0x01, 0x39,
-	0x48, 0x76, 0x96, 0x53, 0x6e, 0xbf, 0x42, 0x37, 0x65, 0x4e, 0xf9, 0x30, 0x89, 0x1c, 0x6b, 0xc7,
-	0xda, 0xeb, 0xf4, 0x1f, 0x15, 0x1a, 0xcf, 0x50, 0xa9, 0xf1, 0xd6, 0x25, 0xcd, 0xd2, 0x23, 0xd2,
-	0x00, 0x12, 0x74, 0xab, 0xd3, 0x20, 0xb2, 0x5f, 0xa3, 0xf5, 0xbc, 0xd6, 0x08, 0x67, 0x6d, 0xc7,
-	0xda, 0xdb, 0xe8, 0xe3, 0x42, 0xe3, 0x39, 0x2b, 0x35, 0xbe, 0x5d, 0xdf, 0x9c, 0x11, 0x12, 0xcc,
-	0x93, 0xf6, 0x31, 0xda, 0x94, 0x8a, 0x0a, 0x35, 0x0c, 0x53, 0x18, 0x9d, 0x3b, 0x6d, 0xd3, 0xf8,
-	0x69, 0xa1, 0xf1, 0x32, 0x2e, 0x35, 0xb6, 0x9b, 0xe6, 0x0b, 0x48, 0x02, 0x64, 0xa2, 0x7e, 0x15,
-	0xd8, 0x6f, 0xd0, 0x06, 0xe3, 0x51, 0x63, 0xe9, 0x18, 0xcb, 0xe3, 0x42, 0xe3, 0x05, 0x2c, 0x35,
-	0xbe, 0x53, 0x3b, 0xe6, 0x88, 0x04, 0xeb, 0x8c, 0x47, 0xf5, 0xfd, 0x01, 0xba, 0x15, 0x82, 0x18,
-	0x8e, 0xc6, 0x34, 0x31, 0x3f, 0x70, 0xc3, 0x3c, 0x64, 0xb7, 0xd0, 0x78, 0x85, 0x97, 0x1a, 0xdf,
-	0xad, 0x2d, 0xcb, 0x94, 0x04, 0x28, 0x04, 0xf1, 0xb6, 0x8a, 0x06, 0x91, 0xfd, 0x1c, 0x75, 0x24,
-	0x63, 0x91, 0xd3, 0x35, 0x8a, 0x07, 0x85, 0xc6, 0x26, 0x2e, 0x35, 0xde, 0x6c, 0x1e, 0xc1, 0x58,
-	0x44, 0x02, 0x03, 0x89, 0x83, 0xee, 0xaf, 0x8e, 0x21, 0x60, 0x32, 0x07, 0x2e, 0xd9, 0x8b, 0x1f,
-	0x16, 0x6a, 0x9f, 0xc8, 0xd8, 0xfe, 0x6e, 0xa1, 0x7b, 0xa7, 0x20, 0xd5, 0x19, 0xe3, 0xd1, 0x52,
-	0xdd, 0xbb, 0x0b, 0xfb, 0x89, 0x77, 0xdd, 0x3a, 0x78, 0xab, 0xbe, 0xde, 0xfe, 0xff, 0x54, 0xcd,
-	0xba, 0x92, 0x83, 0xcf, 0xbf, 0xfe, 0x7e, 0x5b, 0xdb, 0x25, 0xc4, 0xbf, 0x76, 0x15, 0x9b, 0xb9,
-	0x1d, 0x54, 0xa3, 0x3f, 0xb2, 0x9e, 0xf5, 0x8f, 0x7f, 0x4e, 0x5c, 0xeb, 0x6a, 0xe2, 0x5a, 0x7f,
-	0x26, 0xae, 0xf5, 0x75, 0xea, 0xb6, 0xae, 0xa6, 0x6e, 0xeb, 0xf7, 0xd4, 0x6d, 0xbd, 0xdf, 0x8f,
-	0x13, 0x35, 0xfe, 0x10, 0x7a, 0x23, 0xc8, 0xfc, 0x8c, 0xaa, 0x64, 0xc4, 0x99, 0xfa, 0x04, 0xe2,
-	0x7c, 0xe1, 0xbd, 0x30, 0x66, 0x75, 0x99, 0x33, 0x19, 0x76, 0xcd, 0x56, 0xbe, 0xfc, 0x17, 0x00,
-	0x00, 0xff, 0xff, 0x20, 0xc9, 0xfd, 0xee, 0x01, 0x03, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x4f, 0xdb, 0x30,
+	0x14, 0x26, 0x6d, 0x61, 0x60, 0x26, 0x40, 0x1e, 0x6c, 0x5d, 0x05, 0x31, 0xb3, 0x36, 0x81, 0x06,
+	0x34, 0x63, 0x48, 0x3b, 0x30, 0x69, 0x87, 0x4e, 0x42, 0xea, 0xa1, 0x12, 0x4a, 0x77, 0xda, 0xa5,
+	0x72, 0x1a, 0x2b, 0x44, 0xb4, 0x71, 0x64, 0x1b, 0x06, 0x57, 0xa4, 0x1d, 0x76, 0x9b, 0xb4, 0xdb,
+	0x7e, 0xd1, 0x8e, 0x48, 0xbb, 0xec, 0x64, 0x4d, 0xc0, 0x29, 0xc7, 0xfc, 0x82, 0xa9, 0x4e, 0xd2,
+	0xa6, 0xa5, 0x9b, 0x00, 0x21, 0xed, 0x96, 0xf7, 0xbd, 0xe7, 0xef, 0xd9, 0xdf, 0xe7, 0xe7, 0x00,
+	0xf3, 0x80, 0xfa, 0x5d, 0x97, 0x74, 0x3a, 0x96, 0xc3, 0xb8, 0x75, 0xbc, 0xed, 0x50, 0x49, 0xb6,
+	0xad, 0xae, 0xf0, 0xaa, 0x21, 0x67, 0x92, 0xc1, 0xc5, 0x2c, 0x5f, 0x75, 0x18, 0xaf, 0xa6, 0xf9,
+	0xca, 0xa2, 0xc7, 0x3c, 0xa6, 0x0b, 0xac, 0xde, 0x57, 0x52, 0x5b, 0x59, 0xf6, 0x18, 0xf3, 0x3a,
+	0xd4, 0x22, 0xa1, 0x6f, 0x91, 0x20, 0x60, 0x92, 0x48, 0x9f, 0x05, 0x22, 0xc9, 0xe2, 0xcf, 0x45,
+	0x30, 0xd7, 0x10, 0xde, 0x3e, 0x67, 0x21, 0x13, 0xb4, 0x19, 0x92, 0x00, 0xbe, 0x01, 0x0f, 0x44,
+	0x48, 0x82, 0x96, 0xef, 0x96, 0x8d, 0x55, 0x63, 0xbd, 0x54, 0x5b, 0x89, 0x14, 0xca, 0xa0, 0x58,
+	0xa1, 0xb9, 0x53, 0xd2, 0xed, 0xec, 0xe2, 0x14, 0xc0, 0xf6, 0x54, 0xef, 0xab, 0xee, 0xc2, 0xb7,
+	0x60, 0x3a, 0x4c, 0x68, 0x78, 0xb9, 0xb0, 0x6a, 0xac, 0xcf, 0xd4, 0x50, 0xa4, 0x50, 0x1f, 0x8b,
+	0x15, 0x9a, 0x4f, 0x56, 0x66, 0x08, 0xb6, 0xfb, 0x49, 0xb8, 0x07, 0x66, 0x85, 0x24, 0x5c, 0xb6,
+	0x9c, 0x0e, 0x6b, 0x1f, 0x96, 0x8b, 0xba, 0xf1, 0x8b, 0x48, 0xa1, 0x3c, 0x1c, 0x2b, 0x04, 0xd3,
+	0xe6, 0x03, 0x10, 0xdb, 0x40, 0x47, 0xb5, 0x5e, 0x00, 0xdf, 0x81, 0x19, 0x1a, 0xb8, 0x29, 0x4b,
+	0x49, 0xb3, 0x3c, 0x8b, 0x14, 0x1a, 0x80, 0xb1, 0x42, 0x0b, 0x09, 0x47, 0x1f, 0xc2, 0xf6, 0x34,
+	0x0d, 0xdc, 0x64, 0x7d, 0x1d, 0x3c, 0x74, 0x18, 0x6f, 0xb5, 0x0f, 0x88, 0xaf, 0x15, 0x98, 0xd4,
+	0x07, 0x59, 0x8b, 0x14, 0x1a, 0xc2, 0x63, 0x85, 0x1e, 0x25, 0x2c, 0x79, 0x14, 0xdb, 0xc0, 0x61,
+	0xfc, 0x7d, 0x2f, 0xaa, 0xbb, 0x70, 0x03, 0x94, 0x04, 0xa5, 0x6e, 0x79, 0x4a, 0x53, 0x3c, 0x89,
+	0x14, 0xd2, 0x71, 0xac, 0xd0, 0x6c, 0x7a, 0x08, 0x4a, 0x5d, 0x6c, 0x6b, 0x10, 0x97, 0xc1, 0xe3,
+	0x61, 0x1b, 0x6c, 0x2a, 0x42, 0x16, 0x08, 0x8a, 0xcf, 0x8a, 0x60, 0xa9, 0x21, 0x3c, 0x9b, 0x86,
+	0x8c, 0xcb, 0x86, 0x2f, 0x04, 0x75, 0x9b, 0x21, 0xf7, 0x03, 0x39, 0x24, 0xb8, 0x71, 0x5b, 0xc1,
+	0x73, 0x2e, 0x17, 0x6e, 0xe3, 0x32, 0x01, 0x50, 0xe8, 0xf6, 0xad, 0xeb, 0x7e, 0xed, 0x44, 0x0a,
+	0x8d, 0xc9, 0xc6, 0x0a, 0x3d, 0xcd, 0xd8, 0x46, 0x73, 0xd8, 0x5e, 0x48, 0xc0, 0xe6, 0xc0, 0xc3,
+	0x3d, 0x30, 0x1b, 0x72, 0xe6, 0x1e, 0xb5, 0x29, 0xef, 0x6d, 0xaf, 0x34, 0xb8, 0x0b, 0x39, 0x78,
+	0x70, 0x17, 0x72, 0x20, 0xb6, 0x41, 0x16, 0xd5, 0xdd, 0x7b, 0xf4, 0x12, 0x23, 0xb0, 0x32, 0xd6,
+	0x83, 0xbe, 0x4b, 0x57, 0x05, 0x00, 0x93, 0x8a, 0x0e, 0x69, 0xd3, 0xfd, 0x74, 0x13, 0xff, 0xc7,
+	0xa2, 0x11, 0xfd, 0x8a, 0xf7, 0xa5, 0x5f, 0xe9, 0xee, 0xb3, 0x30, 0x32, 0xde, 0x93, 0x77, 0x1c,
+	0x6f, 0xbc, 0x0c, 0x2a, 0xd7, 0x55, 0xce, 0x4c, 0x78, 0xfd, 0xa5, 0x08, 0x8a, 0x0d, 0xe1, 0xc1,
+	0xef, 0x06, 0x58, 0xda, 0x67, 0x42, 0x36, 0x69, 0xe0, 0xe6, 0x46, 0xea, 0xc3, 0x09, 0x7c, 0x5e,
+	0x1d, 0xf7, 0x72, 0x56, 0x87, 0x47, 0xaf, 0xb2, 0x79, 0x93, 0xaa, 0xbe, 0xf5, 0x5b, 0x67, 0x3f,
+	0xaf, 0xbe, 0x15, 0xd6, 0x30, 0xb6, 0xc6, 0xbe, 0xda, 0xa9, 0x9d, 0x5b, 0x3d, 0x73, 0x76, 0x8d,
+	0x97, 0xf0, 0x18, 0xc0, 0x31, 0xb3, 0xbc, 0xf1, 0xd7, 0x96, 0xd7, 0x8b, 0x2b, 0x3b, 0xb7, 0x28,
+	0xce, 0xb6, 0x09, 0xbb, 0x60, 0x7e, 0xf4, 0x76, 0xae, 0xff, 0x8b, 0x27, 0x5f, 0x59, 0x79, 0x75,
+	0xd3, 0xca, 0xac, 0x5d, 0x6d, 0xef, 0xc7, 0x85, 0x69, 0x9c, 0x5f, 0x98, 0xc6, 0xef, 0x0b, 0xd3,
+	0xf8, 0x7a, 0x69, 0x4e, 0x9c, 0x5f, 0x9a, 0x13, 0xbf, 0x2e, 0xcd, 0x89, 0x8f, 0x9b, 0x9e, 0x2f,
+	0x0f, 0x8e, 0x9c, 0x6a, 0x9b, 0x75, 0xad, 0x2e, 0x91, 0x7e, 0x3b, 0xa0, 0xf2, 0x13, 0xe3, 0x87,
+	0x03, 0xf9, 0x4e, 0xb4, 0x80, 0xf2, 0x34, 0xa4, 0xc2, 0x99, 0xd2, 0xff, 0xa9, 0x9d, 0x3f, 0x03,
+	0x00, 0x80, 0x73, 0x27, 0x81, 0x13, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -204,6 +448,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	PostSendProposeSpanTx(ctx context.Context, in *MsgProposeSpan, opts ...grpc.CallOption) (*MsgProposeSpanResponse, error)
+	ReportMissedSprint(ctx context.Context, in *MsgReportMissedSprint, opts ...grpc.CallOption) (*MsgReportMissedSprintResponse, error)
+	ReplaceProducer(ctx context.Context, in *MsgReplaceProducer, opts ...grpc.CallOption) (*MsgReplaceProducerResponse, error)
 }
 
 type msgClient struct {
@@ -223,9 +469,29 @@ func (c *msgClient) PostSendProposeSpanTx(ctx context.Context, in *MsgProposeSpa
 	return out, nil
 }
 
+func (c *msgClient) ReportMissedSprint(ctx context.Context, in *MsgReportMissedSprint, opts ...grpc.CallOption) (*MsgReportMissedSprintResponse, error) {
+	out := new(MsgReportMissedSprintResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Msg/ReportMissedSprint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReplaceProducer(ctx context.Context, in *MsgReplaceProducer, opts ...grpc.CallOption) (*MsgReplaceProducerResponse, error) {
+	out := new(MsgReplaceProducerResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Msg/ReplaceProducer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	PostSendProposeSpanTx(context.Context, *MsgProposeSpan) (*MsgProposeSpanResponse, error)
+	ReportMissedSprint(context.Context, *MsgReportMissedSprint) (*MsgReportMissedSprintResponse, error)
+	ReplaceProducer(context.Context, *MsgReplaceProducer) (*MsgReplaceProducerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -235,6 +501,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) PostSendProposeSpanTx(ctx context.Context, req *MsgProposeSpan) (*MsgProposeSpanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostSendProposeSpanTx not implemented")
 }
+func (*UnimplementedMsgServer) ReportMissedSprint(ctx context.Context, req *MsgReportMissedSprint) (*MsgReportMissedSprintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMissedSprint not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceProducer(ctx context.Context, req *MsgReplaceProducer) (*MsgReplaceProducerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceProducer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -258,6 +530,42 @@ func _Msg_PostSendProposeSpanTx_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReportMissedSprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportMissedSprint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportMissedSprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Msg/ReportMissedSprint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportMissedSprint(ctx, req.(*MsgReportMissedSprint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplaceProducer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceProducer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceProducer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Msg/ReplaceProducer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceProducer(ctx, req.(*MsgReplaceProducer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdall.bor.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -266,6 +574,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PostSendProposeSpanTx",
 			Handler:    _Msg_PostSendProposeSpanTx_Handler,
 		},
+		{
+			MethodName: "ReportMissedSprint",
+			Handler:    _Msg_ReportMissedSprint_Handler,
+		},
+		{
+			MethodName: "ReplaceProducer",
+			Handler:    _Msg_ReplaceProducer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdall/bor/v1beta1/msg.proto",
@@ -353,63 +669,542 @@ func (m *MsgProposeSpanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsg(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgReportMissedSprint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgProposeSpan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgReportMissedSprint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportMissedSprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SpanId != 0 {
-		n += 1 + sovMsg(uint64(m.SpanId))
+	if len(m.BorChainId) > 0 {
+		i -= len(m.BorChainId)
+		copy(dAtA[i:], m.BorChainId)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.BorChainId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovMsg(uint64(l))
+	if m.ProducerId != 0 {
+		i = encodeVarintMsg(dAtA, i, uint64(m.ProducerId))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.StartBlock != 0 {
-		n += 1 + sovMsg(uint64(m.StartBlock))
+	if m.SprintStartBlock != 0 {
+		i = encodeVarintMsg(dAtA, i, uint64(m.SprintStartBlock))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.EndBlock != 0 {
-		n += 1 + sovMsg(uint64(m.EndBlock))
+	if m.SpanId != 0 {
+		i = encodeVarintMsg(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BorChainId)
-	if l > 0 {
-		n += 1 + l + sovMsg(uint64(l))
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sovMsg(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportMissedSprintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgProposeSpanResponse) Size() (n int) {
-	if m == nil {
+func (m *MsgReportMissedSprintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportMissedSprintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceProducer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceProducer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceProducer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		i = encodeVarintMsg(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BorChainId) > 0 {
+		i -= len(m.BorChainId)
+		copy(dAtA[i:], m.BorChainId)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.BorChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ProducerId != 0 {
+		i = encodeVarintMsg(dAtA, i, uint64(m.ProducerId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SpanId != 0 {
+		i = encodeVarintMsg(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceProducerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceProducerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceProducerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgProposeSpan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovMsg(uint64(m.SpanId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovMsg(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovMsg(uint64(m.EndBlock))
+	}
+	l = len(m.BorChainId)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeSpanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportMissedSprint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	if m.SpanId != 0 {
+		n += 1 + sovMsg(uint64(m.SpanId))
+	}
+	if m.SprintStartBlock != 0 {
+		n += 1 + sovMsg(uint64(m.SprintStartBlock))
+	}
+	if m.ProducerId != 0 {
+		n += 1 + sovMsg(uint64(m.ProducerId))
+	}
+	l = len(m.BorChainId)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportMissedSprintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceProducer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	if m.SpanId != 0 {
+		n += 1 + sovMsg(uint64(m.SpanId))
+	}
+	if m.ProducerId != 0 {
+		n += 1 + sovMsg(uint64(m.ProducerId))
+	}
+	l = len(m.BorChainId)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovMsg(uint64(m.StartBlock))
+	}
+	return n
+}
+
+func (m *MsgReplaceProducerResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsg(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsg(x uint64) (n int) {
+	return sovMsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeSpan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeSpanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeSpanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovMsg(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsg(x uint64) (n int) {
-	return sovMsg(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
+func (m *MsgReportMissedSprint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -432,32 +1227,13 @@ func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeSpan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportMissedSprint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportMissedSprint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
-			}
-			m.SpanId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsg
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SpanId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
@@ -489,11 +1265,30 @@ func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
 			}
 			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SprintStartBlock", wireType)
 			}
-			m.StartBlock = 0
+			m.SprintStartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsg
@@ -503,16 +1298,16 @@ func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartBlock |= uint64(b&0x7F) << shift
+				m.SprintStartBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
 			}
-			m.EndBlock = 0
+			m.ProducerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsg
@@ -522,7 +1317,7 @@ func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndBlock |= uint64(b&0x7F) << shift
+				m.ProducerId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -559,9 +1354,115 @@ func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
 			}
 			m.BorChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportMissedSprintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportMissedSprintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportMissedSprintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceProducer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceProducer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceProducer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -589,8 +1490,97 @@ func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seed = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerId", wireType)
+			}
+			m.ProducerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsg(dAtA[iNdEx:])
@@ -615,7 +1605,7 @@ func (m *MsgProposeSpan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeSpanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceProducerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -638,10 +1628,10 @@ func (m *MsgProposeSpanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeSpanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceProducerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceProducerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12,6 +12,22 @@ const (
 	DefaultSpanDuration             = 100 * DefaultSprintDuration
 	DefaultFirstSpanDuration uint64 = 256
 	DefaultProducerCount     uint64 = 4
+
+	DefaultSelectionAlgorithm         = SelectionAlgorithmWeightedWithReplacement
+	DefaultMaxConsecutiveSpans uint64 = 0
+
+	DefaultMissedSprintsThreshold uint64 = 3
+
+	DefaultSpanRetention uint64 = 0 // keep all spans
+	MinSpanRetention     uint64 = 2 // current and next span are always kept
+)
+
+// Producer selection algorithms
+const (
+	// SelectionAlgorithmWeightedWithReplacement samples producers by voting power, a validator may be picked more than once
+	SelectionAlgorithmWeightedWithReplacement = "weighted_with_replacement"
+	// SelectionAlgorithmWeightedWithoutReplacement samples distinct producers by voting power
+	SelectionAlgorithmWeightedWithoutReplacement = "weighted_without_replacement"
 )
 
 // Parameter keys
@@ -19,6 +35,13 @@ var (
 	KeySprintDuration = []byte("SprintDuration")
 	KeySpanDuration   = []byte("SpanDuration")
 	KeyProducerCount  = []byte("ProducerCount")
+
+	KeySelectionAlgorithm  = []byte("SelectionAlgorithm")
+	KeyMaxConsecutiveSpans = []byte("MaxConsecutiveSpans")
+
+	KeyMissedSprintsThreshold = []byte("MissedSprintsThreshold")
+
+	KeySpanRetention = []byte("SpanRetention")
 )
 
 // DefaultParams returns a default set of parameters.
@@ -27,6 +50,13 @@ func DefaultParams() Params {
 		SprintDuration: DefaultSprintDuration,
 		SpanDuration:   DefaultSpanDuration,
 		ProducerCount:  DefaultProducerCount,
+
+		SelectionAlgorithm:  DefaultSelectionAlgorithm,
+		MaxConsecutiveSpans: DefaultMaxConsecutiveSpans,
+
+		MissedSprintsThreshold: DefaultMissedSprintsThreshold,
+
+		SpanRetention: DefaultSpanRetention,
 	}
 }
 
@@ -40,6 +70,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySprintDuration, &p.SprintDuration, validateSprintDuration),
 		paramtypes.NewParamSetPair(KeySpanDuration, &p.SpanDuration, validateSpanDuration),
 		paramtypes.NewParamSetPair(KeyProducerCount, &p.ProducerCount, validateProducerCount),
+		paramtypes.NewParamSetPair(KeySelectionAlgorithm, &p.SelectionAlgorithm, validateSelectionAlgorithm),
+		paramtypes.NewParamSetPair(KeyMaxConsecutiveSpans, &p.MaxConsecutiveSpans, validateMaxConsecutiveSpans),
+		paramtypes.NewParamSetPair(KeyMissedSprintsThreshold, &p.MissedSprintsThreshold, validateMissedSprintsThreshold),
+		paramtypes.NewParamSetPair(KeySpanRetention, &p.SpanRetention, validateSpanRetention),
 	}
 }
 
@@ -57,6 +91,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSelectionAlgorithm(p.SelectionAlgorithm); err != nil {
+		return err
+	}
+
+	if err := validateMaxConsecutiveSpans(p.MaxConsecutiveSpans); err != nil {
+		return err
+	}
+
+	if err := validateMissedSprintsThreshold(p.MissedSprintsThreshold); err != nil {
+		return err
+	}
+
+	if err := validateSpanRetention(p.SpanRetention); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -98,3 +148,59 @@ func validateProducerCount(i interface{}) error {
 
 	return nil
 }
+
+// ResolveSelectionAlgorithm returns selection algorithm recorded in params or span,
+// falling back to weighted with replacement which was the only algorithm before it was recorded
+func ResolveSelectionAlgorithm(algorithm string) string {
+	if algorithm == "" {
+		return SelectionAlgorithmWeightedWithReplacement
+	}
+
+	return algorithm
+}
+
+func validateSelectionAlgorithm(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty algorithm is kept valid for params and spans stored before selection algorithm was introduced
+	switch v {
+	case "", SelectionAlgorithmWeightedWithReplacement, SelectionAlgorithmWeightedWithoutReplacement:
+		return nil
+	default:
+		return fmt.Errorf("invalid selection algorithm: %s", v)
+	}
+}
+
+func validateMaxConsecutiveSpans(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateMissedSprintsThreshold accepts zero which disables producer replacement
+func validateMissedSprintsThreshold(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateSpanRetention accepts zero which keeps all spans, otherwise current and next span must be kept
+func validateSpanRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != 0 && v < MinSpanRetention {
+		return fmt.Errorf("span retention must be 0 or at least %d: %d", MinSpanRetention, v)
+	}
+
+	return nil
+}
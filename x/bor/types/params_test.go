@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/x/bor/types"
+)
+
+func TestParamsValidateSpanRetention(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	for retention, valid := range map[uint64]bool{0: true, 1: false, 2: true, 10: true} {
+		params.SpanRetention = retention
+		if valid {
+			require.NoError(t, params.Validate(), "retention %d", retention)
+		} else {
+			require.Error(t, params.Validate(), "retention %d", retention)
+		}
+	}
+}
@@ -68,10 +68,14 @@ func (m *QueryParamsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
 type QueryParamsResponse struct {
-	SpanDuration   uint64 `protobuf:"varint,1,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration,omitempty"`
-	LatestEthBlock uint64 `protobuf:"varint,2,opt,name=latest_eth_block,json=latestEthBlock,proto3" json:"latest_eth_block,omitempty"`
-	ProducerCount  uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count,omitempty"`
-	Sprint         uint64 `protobuf:"varint,4,opt,name=sprint,proto3" json:"sprint,omitempty"`
+	SpanDuration           uint64 `protobuf:"varint,1,opt,name=span_duration,json=spanDuration,proto3" json:"span_duration,omitempty"`
+	LatestEthBlock         uint64 `protobuf:"varint,2,opt,name=latest_eth_block,json=latestEthBlock,proto3" json:"latest_eth_block,omitempty"`
+	ProducerCount          uint64 `protobuf:"varint,3,opt,name=producer_count,json=producerCount,proto3" json:"producer_count,omitempty"`
+	Sprint                 uint64 `protobuf:"varint,4,opt,name=sprint,proto3" json:"sprint,omitempty"`
+	SelectionAlgorithm     string `protobuf:"bytes,5,opt,name=selection_algorithm,json=selectionAlgorithm,proto3" json:"selection_algorithm,omitempty"`
+	MaxConsecutiveSpans    uint64 `protobuf:"varint,6,opt,name=max_consecutive_spans,json=maxConsecutiveSpans,proto3" json:"max_consecutive_spans,omitempty"`
+	MissedSprintsThreshold uint64 `protobuf:"varint,7,opt,name=missed_sprints_threshold,json=missedSprintsThreshold,proto3" json:"missed_sprints_threshold,omitempty"`
+	SpanRetention          uint64 `protobuf:"varint,8,opt,name=span_retention,json=spanRetention,proto3" json:"span_retention,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -135,6 +139,34 @@ func (m *QueryParamsResponse) GetSprint() uint64 {
 	return 0
 }
 
+func (m *QueryParamsResponse) GetSelectionAlgorithm() string {
+	if m != nil {
+		return m.SelectionAlgorithm
+	}
+	return ""
+}
+
+func (m *QueryParamsResponse) GetMaxConsecutiveSpans() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveSpans
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetMissedSprintsThreshold() uint64 {
+	if m != nil {
+		return m.MissedSprintsThreshold
+	}
+	return 0
+}
+
+func (m *QueryParamsResponse) GetSpanRetention() uint64 {
+	if m != nil {
+		return m.SpanRetention
+	}
+	return 0
+}
+
 // get param info
 type QueryParamRequest struct {
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
@@ -186,6 +218,8 @@ type QueryParamResponse struct {
 	//	*QueryParamResponse_LatestEthBlock
 	//	*QueryParamResponse_ProducerCount
 	//	*QueryParamResponse_Sprint
+	//	*QueryParamResponse_SelectionAlgorithm
+	//	*QueryParamResponse_MaxConsecutiveSpans
 	Params isQueryParamResponse_Params `protobuf_oneof:"params"`
 }
 
@@ -240,11 +274,19 @@ type QueryParamResponse_ProducerCount struct {
 type QueryParamResponse_Sprint struct {
 	Sprint uint64 `protobuf:"varint,4,opt,name=sprint,proto3,oneof" json:"sprint,omitempty"`
 }
+type QueryParamResponse_SelectionAlgorithm struct {
+	SelectionAlgorithm string `protobuf:"bytes,5,opt,name=selection_algorithm,json=selectionAlgorithm,proto3,oneof" json:"selection_algorithm,omitempty"`
+}
+type QueryParamResponse_MaxConsecutiveSpans struct {
+	MaxConsecutiveSpans uint64 `protobuf:"varint,6,opt,name=max_consecutive_spans,json=maxConsecutiveSpans,proto3,oneof" json:"max_consecutive_spans,omitempty"`
+}
 
-func (*QueryParamResponse_SpanDuration) isQueryParamResponse_Params()   {}
-func (*QueryParamResponse_LatestEthBlock) isQueryParamResponse_Params() {}
-func (*QueryParamResponse_ProducerCount) isQueryParamResponse_Params()  {}
-func (*QueryParamResponse_Sprint) isQueryParamResponse_Params()         {}
+func (*QueryParamResponse_SpanDuration) isQueryParamResponse_Params()        {}
+func (*QueryParamResponse_LatestEthBlock) isQueryParamResponse_Params()      {}
+func (*QueryParamResponse_ProducerCount) isQueryParamResponse_Params()       {}
+func (*QueryParamResponse_Sprint) isQueryParamResponse_Params()              {}
+func (*QueryParamResponse_SelectionAlgorithm) isQueryParamResponse_Params()  {}
+func (*QueryParamResponse_MaxConsecutiveSpans) isQueryParamResponse_Params() {}
 
 func (m *QueryParamResponse) GetParams() isQueryParamResponse_Params {
 	if m != nil {
@@ -281,6 +323,20 @@ func (m *QueryParamResponse) GetSprint() uint64 {
 	return 0
 }
 
+func (m *QueryParamResponse) GetSelectionAlgorithm() string {
+	if x, ok := m.GetParams().(*QueryParamResponse_SelectionAlgorithm); ok {
+		return x.SelectionAlgorithm
+	}
+	return ""
+}
+
+func (m *QueryParamResponse) GetMaxConsecutiveSpans() uint64 {
+	if x, ok := m.GetParams().(*QueryParamResponse_MaxConsecutiveSpans); ok {
+		return x.MaxConsecutiveSpans
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*QueryParamResponse) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -288,6 +344,8 @@ func (*QueryParamResponse) XXX_OneofWrappers() []interface{} {
 		(*QueryParamResponse_LatestEthBlock)(nil),
 		(*QueryParamResponse_ProducerCount)(nil),
 		(*QueryParamResponse_Sprint)(nil),
+		(*QueryParamResponse_SelectionAlgorithm)(nil),
+		(*QueryParamResponse_MaxConsecutiveSpans)(nil),
 	}
 }
 
@@ -744,344 +802,864 @@ func (m *QueryNextSpanSeedResponse) GetNextSpanSeed() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.bor.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.bor.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryParamRequest)(nil), "heimdall.bor.v1beta1.QueryParamRequest")
-	proto.RegisterType((*QueryParamResponse)(nil), "heimdall.bor.v1beta1.QueryParamResponse")
-	proto.RegisterType((*QuerySpanRequest)(nil), "heimdall.bor.v1beta1.QuerySpanRequest")
-	proto.RegisterType((*QuerySpanResponse)(nil), "heimdall.bor.v1beta1.QuerySpanResponse")
-	proto.RegisterType((*QuerySpanListRequest)(nil), "heimdall.bor.v1beta1.QuerySpanListRequest")
-	proto.RegisterType((*QuerySpanListResponse)(nil), "heimdall.bor.v1beta1.QuerySpanListResponse")
-	proto.RegisterType((*QueryLatestSpanRequest)(nil), "heimdall.bor.v1beta1.QueryLatestSpanRequest")
-	proto.RegisterType((*QueryLatestSpanResponse)(nil), "heimdall.bor.v1beta1.QueryLatestSpanResponse")
-	proto.RegisterType((*PrepareNextSpanRequest)(nil), "heimdall.bor.v1beta1.PrepareNextSpanRequest")
-	proto.RegisterType((*PrepareNextSpanResponse)(nil), "heimdall.bor.v1beta1.PrepareNextSpanResponse")
-	proto.RegisterType((*QueryNextSpanSeedRequest)(nil), "heimdall.bor.v1beta1.QueryNextSpanSeedRequest")
-	proto.RegisterType((*QueryNextSpanSeedResponse)(nil), "heimdall.bor.v1beta1.QueryNextSpanSeedResponse")
+// QuerySpanDivergence
+type QuerySpanDivergenceRequest struct {
+	StartSpanId uint64 `protobuf:"varint,1,opt,name=start_span_id,json=startSpanId,proto3" json:"start_span_id,omitempty"`
+	EndSpanId   uint64 `protobuf:"varint,2,opt,name=end_span_id,json=endSpanId,proto3" json:"end_span_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("heimdall/bor/v1beta1/query.proto", fileDescriptor_e8643ca7cfaca281) }
-
-var fileDescriptor_e8643ca7cfaca281 = []byte{
-	// 876 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0xc7, 0xe3, 0x7d, 0x63, 0x79, 0xba, 0x0d, 0x65, 0x08, 0x5b, 0x63, 0x55, 0xd9, 0xac, 0xbb,
-	0xdb, 0x64, 0xd3, 0xc4, 0x56, 0x17, 0x4e, 0x48, 0x08, 0x48, 0x00, 0xa5, 0x52, 0x85, 0xca, 0x96,
-	0x13, 0x97, 0x68, 0x1c, 0x8f, 0x12, 0xab, 0x8e, 0xc7, 0x1d, 0x4f, 0xca, 0x46, 0x55, 0x2f, 0x20,
-	0x6e, 0xa8, 0x42, 0x82, 0x2b, 0xe2, 0xc0, 0x57, 0xe0, 0x43, 0x70, 0xac, 0xc4, 0x85, 0x13, 0x42,
-	0xbb, 0x7c, 0x02, 0x3e, 0x41, 0x35, 0x2f, 0x4e, 0xdc, 0xc4, 0x79, 0xd9, 0x5b, 0xfc, 0xcc, 0x7f,
-	0x9e, 0xf9, 0xfd, 0x67, 0x9e, 0xe7, 0x51, 0xa0, 0x32, 0x20, 0xc1, 0xd0, 0xc7, 0x61, 0xe8, 0x7a,
-	0x94, 0xb9, 0x4f, 0xef, 0x79, 0x84, 0xe3, 0x7b, 0xee, 0x93, 0x11, 0x61, 0x63, 0x27, 0x66, 0x94,
-	0x53, 0x54, 0x4a, 0x15, 0x8e, 0x47, 0x99, 0xa3, 0x15, 0x56, 0xa9, 0x4f, 0xfb, 0x54, 0x0a, 0x5c,
-	0xf1, 0x4b, 0x69, 0xad, 0x4c, 0x36, 0x9c, 0x90, 0x49, 0xba, 0x24, 0xc6, 0x91, 0x56, 0x1c, 0xe6,
-	0x2b, 0x32, 0x07, 0x5a, 0xc7, 0xf9, 0x92, 0xa7, 0x38, 0x0c, 0x7c, 0xcc, 0x29, 0xd3, 0xb2, 0x5b,
-	0x7d, 0x4a, 0xfb, 0x21, 0x71, 0x71, 0x1c, 0xb8, 0x38, 0x8a, 0x28, 0xc7, 0x3c, 0xa0, 0x51, 0xa2,
-	0x56, 0xed, 0x12, 0xa0, 0xaf, 0x44, 0xce, 0x87, 0x98, 0xe1, 0x61, 0x72, 0x46, 0x9e, 0x8c, 0x48,
-	0xc2, 0xed, 0xdf, 0x0d, 0x78, 0xe7, 0xb5, 0x70, 0x12, 0xd3, 0x28, 0x21, 0xe8, 0x36, 0x5c, 0x17,
-	0x8c, 0x5d, 0x7f, 0xc4, 0x64, 0x16, 0xd3, 0xa8, 0x18, 0xb5, 0xad, 0xb3, 0x3d, 0x11, 0xfc, 0x4c,
-	0xc7, 0x50, 0x0d, 0x6e, 0x84, 0x98, 0x93, 0x84, 0x77, 0x09, 0x1f, 0x74, 0xbd, 0x90, 0xf6, 0x1e,
-	0x9b, 0x1b, 0x52, 0x57, 0x54, 0xf1, 0xcf, 0xf9, 0xa0, 0x25, 0xa2, 0xe8, 0x18, 0x8a, 0x31, 0xa3,
-	0xfe, 0xa8, 0x47, 0x58, 0xb7, 0x47, 0x47, 0x11, 0x37, 0x37, 0xa5, 0xee, 0x7a, 0x1a, 0x6d, 0x8b,
-	0x20, 0xda, 0x87, 0x9d, 0x24, 0x66, 0x41, 0xc4, 0xcd, 0x2d, 0xb9, 0xac, 0xbf, 0xec, 0x0f, 0xe0,
-	0xed, 0x29, 0xa4, 0x46, 0x47, 0x07, 0x70, 0x2d, 0x96, 0xd0, 0x5d, 0x3e, 0x8e, 0x89, 0x04, 0x7c,
-	0xf3, 0x0c, 0x54, 0xe8, 0xeb, 0x71, 0x4c, 0xec, 0x3f, 0x8c, 0xac, 0xe5, 0x89, 0xb5, 0xe3, 0x5c,
-	0x6b, 0x9d, 0xc2, 0x8c, 0xb9, 0xfa, 0x22, 0x73, 0x9d, 0xc2, 0x9c, 0xbd, 0x6a, 0xbe, 0xbd, 0x4e,
-	0x61, 0xd6, 0xa0, 0xf9, 0xba, 0xc1, 0x4e, 0x21, 0xb5, 0xd8, 0xda, 0x85, 0x1d, 0x85, 0x6e, 0x7f,
-	0x0c, 0x37, 0x24, 0xf5, 0xa3, 0x18, 0x47, 0xa9, 0xd7, 0xbb, 0xf0, 0x86, 0x64, 0x0e, 0x7c, 0x45,
-	0xdb, 0x42, 0xff, 0xff, 0x73, 0x50, 0x1c, 0xe3, 0x61, 0xf8, 0xa1, 0xad, 0x17, 0x6c, 0x91, 0x0a,
-	0x47, 0xf7, 0x7d, 0xfb, 0x23, 0x7d, 0x5b, 0x2a, 0x81, 0x76, 0x5d, 0x83, 0x2d, 0xf1, 0x2d, 0xb7,
-	0x5f, 0x3b, 0x2d, 0x39, 0x93, 0x1a, 0x16, 0x97, 0x97, 0x38, 0x52, 0x2b, 0x15, 0xf6, 0x27, 0x50,
-	0x9a, 0x6c, 0x7f, 0x10, 0x24, 0x3c, 0x65, 0x40, 0xb0, 0x15, 0xe3, 0x3e, 0xd1, 0x95, 0x20, 0x7f,
-	0xa3, 0x12, 0x6c, 0x87, 0xc1, 0x30, 0xe0, 0xfa, 0xd9, 0xd5, 0x87, 0xdd, 0x86, 0x77, 0x67, 0x32,
-	0x68, 0x88, 0x3a, 0x6c, 0x8b, 0x58, 0x62, 0x1a, 0x95, 0xcd, 0x85, 0x14, 0x4a, 0x62, 0x9b, 0xb0,
-	0x2f, 0x93, 0x3c, 0x90, 0x57, 0x9d, 0xb9, 0x0c, 0xbb, 0x0d, 0x37, 0xe7, 0x56, 0xae, 0xec, 0x92,
-	0xc3, 0xfe, 0x43, 0x46, 0x62, 0xcc, 0xc8, 0x97, 0xe4, 0x3c, 0x9b, 0x5e, 0xd4, 0x55, 0xc2, 0x31,
-	0xe3, 0xfa, 0xcd, 0x95, 0x5d, 0x90, 0x21, 0xf5, 0xda, 0x37, 0xa7, 0x8f, 0xb1, 0x91, 0x96, 0xa9,
-	0xb8, 0x78, 0x54, 0x81, 0x3d, 0x8f, 0xb2, 0x6e, 0x6f, 0x80, 0x03, 0xb9, 0xba, 0xa9, 0x4a, 0xd2,
-	0xa3, 0xac, 0x2d, 0x42, 0xf7, 0x7d, 0x81, 0x3e, 0x77, 0xea, 0x95, 0xd1, 0x2d, 0x30, 0xa5, 0xff,
-	0x34, 0xc5, 0x23, 0x42, 0xfc, 0xf4, 0x6e, 0x3e, 0x85, 0xf7, 0x72, 0xd6, 0xf4, 0x11, 0x47, 0x50,
-	0x8c, 0xc8, 0x39, 0xef, 0x4a, 0xfa, 0x84, 0x10, 0x5f, 0x37, 0xcd, 0x5e, 0x94, 0x51, 0x9f, 0xbe,
-	0xd8, 0x85, 0x6d, 0x99, 0x03, 0x7d, 0x6f, 0xc0, 0x8e, 0x9a, 0x0b, 0xa8, 0xe6, 0xe4, 0x0d, 0x3d,
-	0x67, 0x7e, 0xa2, 0x58, 0x27, 0x6b, 0x28, 0x15, 0x8f, 0x7d, 0xf4, 0xdd, 0x5f, 0xff, 0xfd, 0xbc,
-	0x51, 0x46, 0xb7, 0xdc, 0xdc, 0x99, 0xab, 0xfa, 0x01, 0xbd, 0x30, 0x60, 0x5b, 0x6e, 0x44, 0xd5,
-	0x55, 0xa9, 0x53, 0x86, 0xda, 0x6a, 0xa1, 0x46, 0x38, 0x95, 0x08, 0x0d, 0x54, 0x5f, 0x86, 0xe0,
-	0x3e, 0xcb, 0x0c, 0x9a, 0xe7, 0xe8, 0x47, 0x03, 0x76, 0xd3, 0xd2, 0x46, 0xf5, 0x25, 0x47, 0xcd,
-	0x74, 0x90, 0x75, 0x77, 0x2d, 0xad, 0x26, 0xab, 0x4a, 0xb2, 0x43, 0x74, 0x90, 0x4f, 0x26, 0xde,
-	0xb0, 0x19, 0x0a, 0x82, 0x1f, 0x0c, 0x55, 0x39, 0xe8, 0xce, 0x8a, 0xf4, 0x29, 0x46, 0x75, 0xa5,
-	0x4e, 0x23, 0x34, 0x24, 0xc2, 0x1d, 0x74, 0xb4, 0x18, 0xc1, 0x7d, 0xa6, 0x5b, 0xe1, 0x39, 0xfa,
-	0xc5, 0x00, 0x98, 0xb6, 0x24, 0x6a, 0x2c, 0x39, 0x65, 0xae, 0xa7, 0xad, 0xe6, 0x9a, 0x6a, 0x4d,
-	0x76, 0x22, 0xc9, 0x6e, 0xa3, 0xc3, 0x7c, 0x32, 0x35, 0x9e, 0x9b, 0x02, 0x0d, 0xfd, 0x66, 0xc0,
-	0x5b, 0x33, 0x3d, 0xb7, 0x88, 0x2d, 0x7f, 0x20, 0x2c, 0x62, 0x5b, 0xd0, 0xc8, 0xb6, 0x2b, 0xd9,
-	0x4e, 0x50, 0x75, 0x41, 0x49, 0xa9, 0x6d, 0x4d, 0xd1, 0x73, 0x8a, 0xf0, 0x57, 0x03, 0xf6, 0xb2,
-	0xfd, 0x8a, 0x9c, 0x25, 0x97, 0x91, 0xd3, 0xf4, 0x96, 0xbb, 0xb6, 0x7e, 0xbd, 0x87, 0x9d, 0xa0,
-	0x35, 0xc5, 0x90, 0x68, 0x7d, 0xf1, 0xe7, 0x45, 0xd9, 0x78, 0x79, 0x51, 0x36, 0xfe, 0xbd, 0x28,
-	0x1b, 0x3f, 0x5d, 0x96, 0x0b, 0x2f, 0x2f, 0xcb, 0x85, 0xbf, 0x2f, 0xcb, 0x85, 0x6f, 0x1a, 0xfd,
-	0x80, 0x0f, 0x46, 0x9e, 0xd3, 0xa3, 0x43, 0x77, 0x88, 0x79, 0xd0, 0x8b, 0x08, 0xff, 0x96, 0xb2,
-	0xc7, 0xd3, 0xb4, 0xe7, 0x32, 0xb1, 0x1c, 0x61, 0xde, 0x8e, 0xfc, 0x23, 0xf2, 0xfe, 0xab, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xe6, 0x4b, 0xe2, 0x67, 0x62, 0x09, 0x00, 0x00,
+func (m *QuerySpanDivergenceRequest) Reset()         { *m = QuerySpanDivergenceRequest{} }
+func (m *QuerySpanDivergenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpanDivergenceRequest) ProtoMessage()    {}
+func (*QuerySpanDivergenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{14}
+}
+func (m *QuerySpanDivergenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpanDivergenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpanDivergenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpanDivergenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpanDivergenceRequest.Merge(m, src)
+}
+func (m *QuerySpanDivergenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpanDivergenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpanDivergenceRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QuerySpanDivergenceRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QuerySpanDivergenceRequest) GetStartSpanId() uint64 {
+	if m != nil {
+		return m.StartSpanId
+	}
+	return 0
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	Param(ctx context.Context, in *QueryParamRequest, opts ...grpc.CallOption) (*QueryParamResponse, error)
-	SpanList(ctx context.Context, in *QuerySpanListRequest, opts ...grpc.CallOption) (*QuerySpanListResponse, error)
-	Span(ctx context.Context, in *QuerySpanRequest, opts ...grpc.CallOption) (*QuerySpanResponse, error)
-	LatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error)
-	PrepareNextSpan(ctx context.Context, in *PrepareNextSpanRequest, opts ...grpc.CallOption) (*PrepareNextSpanResponse, error)
-	NextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error)
+func (m *QuerySpanDivergenceRequest) GetEndSpanId() uint64 {
+	if m != nil {
+		return m.EndSpanId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QuerySpanDivergenceResponse struct {
+	BorCurrentSpanId   uint64           `protobuf:"varint,1,opt,name=bor_current_span_id,json=borCurrentSpanId,proto3" json:"bor_current_span_id,omitempty"`
+	HeimdallLastSpanId uint64           `protobuf:"varint,2,opt,name=heimdall_last_span_id,json=heimdallLastSpanId,proto3" json:"heimdall_last_span_id,omitempty"`
+	Divergences        []SpanDivergence `protobuf:"bytes,3,rep,name=divergences,proto3" json:"divergences"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySpanDivergenceResponse) Reset()         { *m = QuerySpanDivergenceResponse{} }
+func (m *QuerySpanDivergenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpanDivergenceResponse) ProtoMessage()    {}
+func (*QuerySpanDivergenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{15}
+}
+func (m *QuerySpanDivergenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpanDivergenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpanDivergenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpanDivergenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpanDivergenceResponse.Merge(m, src)
+}
+func (m *QuerySpanDivergenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpanDivergenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpanDivergenceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpanDivergenceResponse proto.InternalMessageInfo
+
+func (m *QuerySpanDivergenceResponse) GetBorCurrentSpanId() uint64 {
+	if m != nil {
+		return m.BorCurrentSpanId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Param(ctx context.Context, in *QueryParamRequest, opts ...grpc.CallOption) (*QueryParamResponse, error) {
-	out := new(QueryParamResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/Param", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySpanDivergenceResponse) GetHeimdallLastSpanId() uint64 {
+	if m != nil {
+		return m.HeimdallLastSpanId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) SpanList(ctx context.Context, in *QuerySpanListRequest, opts ...grpc.CallOption) (*QuerySpanListResponse, error) {
-	out := new(QuerySpanListResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/SpanList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySpanDivergenceResponse) GetDivergences() []SpanDivergence {
+	if m != nil {
+		return m.Divergences
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Span(ctx context.Context, in *QuerySpanRequest, opts ...grpc.CallOption) (*QuerySpanResponse, error) {
-	out := new(QuerySpanResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/Span", in, out, opts...)
-	if err != nil {
-		return nil, err
+// SpanDivergence describes span which differs between heimdall and bor validator set contract
+type SpanDivergence struct {
+	SpanId             uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	HeimdallStartBlock uint64 `protobuf:"varint,2,opt,name=heimdall_start_block,json=heimdallStartBlock,proto3" json:"heimdall_start_block,omitempty"`
+	HeimdallEndBlock   uint64 `protobuf:"varint,3,opt,name=heimdall_end_block,json=heimdallEndBlock,proto3" json:"heimdall_end_block,omitempty"`
+	BorStartBlock      uint64 `protobuf:"varint,4,opt,name=bor_start_block,json=borStartBlock,proto3" json:"bor_start_block,omitempty"`
+	BorEndBlock        uint64 `protobuf:"varint,5,opt,name=bor_end_block,json=borEndBlock,proto3" json:"bor_end_block,omitempty"`
+	Reason             string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *SpanDivergence) Reset()         { *m = SpanDivergence{} }
+func (m *SpanDivergence) String() string { return proto.CompactTextString(m) }
+func (*SpanDivergence) ProtoMessage()    {}
+func (*SpanDivergence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{16}
+}
+func (m *SpanDivergence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpanDivergence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpanDivergence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SpanDivergence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpanDivergence.Merge(m, src)
+}
+func (m *SpanDivergence) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpanDivergence) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpanDivergence.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error) {
-	out := new(QueryLatestSpanResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/LatestSpan", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SpanDivergence proto.InternalMessageInfo
+
+func (m *SpanDivergence) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) PrepareNextSpan(ctx context.Context, in *PrepareNextSpanRequest, opts ...grpc.CallOption) (*PrepareNextSpanResponse, error) {
-	out := new(PrepareNextSpanResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/PrepareNextSpan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SpanDivergence) GetHeimdallStartBlock() uint64 {
+	if m != nil {
+		return m.HeimdallStartBlock
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) NextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error) {
-	out := new(QueryNextSpanSeedResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/NextSpanSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SpanDivergence) GetHeimdallEndBlock() uint64 {
+	if m != nil {
+		return m.HeimdallEndBlock
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	Param(context.Context, *QueryParamRequest) (*QueryParamResponse, error)
-	SpanList(context.Context, *QuerySpanListRequest) (*QuerySpanListResponse, error)
-	Span(context.Context, *QuerySpanRequest) (*QuerySpanResponse, error)
-	LatestSpan(context.Context, *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error)
-	PrepareNextSpan(context.Context, *PrepareNextSpanRequest) (*PrepareNextSpanResponse, error)
-	NextSpanSeed(context.Context, *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error)
+func (m *SpanDivergence) GetBorStartBlock() uint64 {
+	if m != nil {
+		return m.BorStartBlock
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *SpanDivergence) GetBorEndBlock() uint64 {
+	if m != nil {
+		return m.BorEndBlock
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *SpanDivergence) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Param(ctx context.Context, req *QueryParamRequest) (*QueryParamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Param not implemented")
+
+// QueryProducerDowntime
+type QueryProducerDowntimeRequest struct {
+	SpanId uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty" yaml:"span_id"`
 }
-func (*UnimplementedQueryServer) SpanList(ctx context.Context, req *QuerySpanListRequest) (*QuerySpanListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SpanList not implemented")
+
+func (m *QueryProducerDowntimeRequest) Reset()         { *m = QueryProducerDowntimeRequest{} }
+func (m *QueryProducerDowntimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProducerDowntimeRequest) ProtoMessage()    {}
+func (*QueryProducerDowntimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{17}
 }
-func (*UnimplementedQueryServer) Span(ctx context.Context, req *QuerySpanRequest) (*QuerySpanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Span not implemented")
+func (m *QueryProducerDowntimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LatestSpan(ctx context.Context, req *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestSpan not implemented")
+func (m *QueryProducerDowntimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProducerDowntimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) PrepareNextSpan(ctx context.Context, req *PrepareNextSpanRequest) (*PrepareNextSpanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PrepareNextSpan not implemented")
+func (m *QueryProducerDowntimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProducerDowntimeRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) NextSpanSeed(ctx context.Context, req *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NextSpanSeed not implemented")
+func (m *QueryProducerDowntimeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryProducerDowntimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProducerDowntimeRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.bor.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryProducerDowntimeRequest proto.InternalMessageInfo
+
+func (m *QueryProducerDowntimeRequest) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Param_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Param(ctx, in)
+type QueryProducerDowntimeResponse struct {
+	Downtimes []ProducerDowntime `protobuf:"bytes,1,rep,name=downtimes,proto3" json:"downtimes"`
+}
+
+func (m *QueryProducerDowntimeResponse) Reset()         { *m = QueryProducerDowntimeResponse{} }
+func (m *QueryProducerDowntimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProducerDowntimeResponse) ProtoMessage()    {}
+func (*QueryProducerDowntimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{18}
+}
+func (m *QueryProducerDowntimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProducerDowntimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProducerDowntimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.bor.v1beta1.Query/Param",
+}
+func (m *QueryProducerDowntimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProducerDowntimeResponse.Merge(m, src)
+}
+func (m *QueryProducerDowntimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProducerDowntimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProducerDowntimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProducerDowntimeResponse proto.InternalMessageInfo
+
+func (m *QueryProducerDowntimeResponse) GetDowntimes() []ProducerDowntime {
+	if m != nil {
+		return m.Downtimes
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Param(ctx, req.(*QueryParamRequest))
+	return nil
+}
+
+// QuerySpanProducers
+type QuerySpanProducersRequest struct {
+	SpanId uint64 `protobuf:"varint,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty" yaml:"span_id"`
+	Block  uint64 `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty" yaml:"block"`
+}
+
+func (m *QuerySpanProducersRequest) Reset()         { *m = QuerySpanProducersRequest{} }
+func (m *QuerySpanProducersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpanProducersRequest) ProtoMessage()    {}
+func (*QuerySpanProducersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{19}
+}
+func (m *QuerySpanProducersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpanProducersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpanProducersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySpanProducersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpanProducersRequest.Merge(m, src)
+}
+func (m *QuerySpanProducersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpanProducersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpanProducersRequest.DiscardUnknown(m)
 }
 
-func _Query_SpanList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpanListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySpanProducersRequest proto.InternalMessageInfo
+
+func (m *QuerySpanProducersRequest) GetSpanId() uint64 {
+	if m != nil {
+		return m.SpanId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SpanList(ctx, in)
+	return 0
+}
+
+func (m *QuerySpanProducersRequest) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.bor.v1beta1.Query/SpanList",
+	return 0
+}
+
+type QuerySpanProducersResponse struct {
+	Producers []types.Validator `protobuf:"bytes,1,rep,name=producers,proto3" json:"producers"`
+}
+
+func (m *QuerySpanProducersResponse) Reset()         { *m = QuerySpanProducersResponse{} }
+func (m *QuerySpanProducersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpanProducersResponse) ProtoMessage()    {}
+func (*QuerySpanProducersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8643ca7cfaca281, []int{20}
+}
+func (m *QuerySpanProducersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpanProducersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpanProducersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SpanList(ctx, req.(*QuerySpanListRequest))
+}
+func (m *QuerySpanProducersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpanProducersResponse.Merge(m, src)
+}
+func (m *QuerySpanProducersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpanProducersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpanProducersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpanProducersResponse proto.InternalMessageInfo
+
+func (m *QuerySpanProducersResponse) GetProducers() []types.Validator {
+	if m != nil {
+		return m.Producers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Span_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpanRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.bor.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.bor.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamRequest)(nil), "heimdall.bor.v1beta1.QueryParamRequest")
+	proto.RegisterType((*QueryParamResponse)(nil), "heimdall.bor.v1beta1.QueryParamResponse")
+	proto.RegisterType((*QuerySpanRequest)(nil), "heimdall.bor.v1beta1.QuerySpanRequest")
+	proto.RegisterType((*QuerySpanResponse)(nil), "heimdall.bor.v1beta1.QuerySpanResponse")
+	proto.RegisterType((*QuerySpanListRequest)(nil), "heimdall.bor.v1beta1.QuerySpanListRequest")
+	proto.RegisterType((*QuerySpanListResponse)(nil), "heimdall.bor.v1beta1.QuerySpanListResponse")
+	proto.RegisterType((*QueryLatestSpanRequest)(nil), "heimdall.bor.v1beta1.QueryLatestSpanRequest")
+	proto.RegisterType((*QueryLatestSpanResponse)(nil), "heimdall.bor.v1beta1.QueryLatestSpanResponse")
+	proto.RegisterType((*PrepareNextSpanRequest)(nil), "heimdall.bor.v1beta1.PrepareNextSpanRequest")
+	proto.RegisterType((*PrepareNextSpanResponse)(nil), "heimdall.bor.v1beta1.PrepareNextSpanResponse")
+	proto.RegisterType((*QueryNextSpanSeedRequest)(nil), "heimdall.bor.v1beta1.QueryNextSpanSeedRequest")
+	proto.RegisterType((*QueryNextSpanSeedResponse)(nil), "heimdall.bor.v1beta1.QueryNextSpanSeedResponse")
+	proto.RegisterType((*QuerySpanDivergenceRequest)(nil), "heimdall.bor.v1beta1.QuerySpanDivergenceRequest")
+	proto.RegisterType((*QuerySpanDivergenceResponse)(nil), "heimdall.bor.v1beta1.QuerySpanDivergenceResponse")
+	proto.RegisterType((*SpanDivergence)(nil), "heimdall.bor.v1beta1.SpanDivergence")
+	proto.RegisterType((*QueryProducerDowntimeRequest)(nil), "heimdall.bor.v1beta1.QueryProducerDowntimeRequest")
+	proto.RegisterType((*QueryProducerDowntimeResponse)(nil), "heimdall.bor.v1beta1.QueryProducerDowntimeResponse")
+	proto.RegisterType((*QuerySpanProducersRequest)(nil), "heimdall.bor.v1beta1.QuerySpanProducersRequest")
+	proto.RegisterType((*QuerySpanProducersResponse)(nil), "heimdall.bor.v1beta1.QuerySpanProducersResponse")
+}
+
+func init() { proto.RegisterFile("heimdall/bor/v1beta1/query.proto", fileDescriptor_e8643ca7cfaca281) }
+
+var fileDescriptor_e8643ca7cfaca281 = []byte{
+	// 1399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x26, 0x71, 0xda, 0xbc, 0xfc, 0x69, 0x3a, 0x49, 0x53, 0x77, 0x29, 0x4e, 0xba, 0xcd,
+	0xbf, 0xa6, 0xb1, 0xb7, 0x49, 0x2b, 0x54, 0x90, 0x0a, 0x34, 0x69, 0x51, 0x0a, 0x11, 0x2a, 0x4e,
+	0xc5, 0x01, 0x0e, 0xcb, 0xda, 0x3b, 0xb2, 0x57, 0x5d, 0xef, 0xb8, 0x33, 0xe3, 0x34, 0x51, 0xd5,
+	0x0b, 0x88, 0x1b, 0x42, 0x48, 0x70, 0xad, 0x38, 0xc0, 0x27, 0xe0, 0xc0, 0x67, 0xe8, 0x05, 0xa9,
+	0x12, 0x17, 0x4e, 0x15, 0x6a, 0xb8, 0x70, 0xe0, 0xd2, 0x4f, 0x80, 0xe6, 0xcf, 0xae, 0xd7, 0xf6,
+	0xda, 0x71, 0x7a, 0xf3, 0xbe, 0xf7, 0xe6, 0xbd, 0xdf, 0xfc, 0x7e, 0x6f, 0xe6, 0x8d, 0x61, 0xa1,
+	0x86, 0xfd, 0xba, 0xe7, 0x06, 0x81, 0x5d, 0x26, 0xd4, 0xde, 0xdf, 0x28, 0x63, 0xee, 0x6e, 0xd8,
+	0x8f, 0x9a, 0x98, 0x1e, 0x16, 0x1b, 0x94, 0x70, 0x82, 0x66, 0xa3, 0x88, 0x62, 0x99, 0xd0, 0xa2,
+	0x8e, 0x30, 0x67, 0xab, 0xa4, 0x4a, 0x64, 0x80, 0x2d, 0x7e, 0xa9, 0x58, 0x33, 0x91, 0xcd, 0x65,
+	0x38, 0x4e, 0xc7, 0x1a, 0x6e, 0xa8, 0x23, 0x2e, 0xa5, 0x47, 0x24, 0x0a, 0x9a, 0x4b, 0xe9, 0x21,
+	0xfb, 0x6e, 0xe0, 0x7b, 0x2e, 0x27, 0x54, 0x87, 0xe5, 0x53, 0x91, 0x97, 0x63, 0xff, 0xc5, 0x2a,
+	0x21, 0xd5, 0x00, 0xdb, 0x6e, 0xc3, 0xb7, 0xdd, 0x30, 0x24, 0xdc, 0xe5, 0x3e, 0x09, 0x99, 0xf2,
+	0x5a, 0xb3, 0x80, 0x3e, 0x13, 0x35, 0xef, 0xbb, 0xd4, 0xad, 0xb3, 0x12, 0x7e, 0xd4, 0xc4, 0x8c,
+	0x5b, 0xff, 0x0e, 0xc1, 0x4c, 0x9b, 0x99, 0x35, 0x48, 0xc8, 0x30, 0xba, 0x0c, 0x93, 0x62, 0x0f,
+	0x8e, 0xd7, 0xa4, 0x32, 0x4b, 0xce, 0x58, 0x30, 0x56, 0x47, 0x4a, 0x13, 0xc2, 0x78, 0x47, 0xdb,
+	0xd0, 0x2a, 0x4c, 0x07, 0x2e, 0xc7, 0x8c, 0x3b, 0x98, 0xd7, 0x9c, 0x72, 0x40, 0x2a, 0x0f, 0x73,
+	0x43, 0x32, 0x6e, 0x4a, 0xd9, 0xef, 0xf2, 0xda, 0x96, 0xb0, 0xa2, 0x25, 0x98, 0x6a, 0x50, 0xe2,
+	0x35, 0x2b, 0x98, 0x3a, 0x15, 0xd2, 0x0c, 0x79, 0x6e, 0x58, 0xc6, 0x4d, 0x46, 0xd6, 0x6d, 0x61,
+	0x44, 0x73, 0x30, 0xca, 0x1a, 0xd4, 0x0f, 0x79, 0x6e, 0x44, 0xba, 0xf5, 0x17, 0xb2, 0x61, 0x86,
+	0xe1, 0x00, 0x57, 0x44, 0x55, 0xc7, 0x0d, 0xaa, 0x84, 0xfa, 0xbc, 0x56, 0xcf, 0x65, 0x17, 0x8c,
+	0xd5, 0xb1, 0x12, 0x8a, 0x5d, 0xb7, 0x23, 0x0f, 0xda, 0x84, 0x73, 0x75, 0xf7, 0xc0, 0xa9, 0x88,
+	0xbd, 0x54, 0x9a, 0xdc, 0xdf, 0xc7, 0x8e, 0x40, 0xce, 0x72, 0xa3, 0x32, 0xef, 0x4c, 0xdd, 0x3d,
+	0xd8, 0x6e, 0xf9, 0xf6, 0x84, 0x0b, 0xdd, 0x84, 0x5c, 0xdd, 0x67, 0x0c, 0x7b, 0x8e, 0xaa, 0xca,
+	0x1c, 0x5e, 0xa3, 0x98, 0xd5, 0x48, 0xe0, 0xe5, 0x4e, 0xc9, 0x65, 0x73, 0xca, 0xbf, 0xa7, 0xdc,
+	0x0f, 0x22, 0xaf, 0xd8, 0x9d, 0x24, 0x8b, 0x62, 0x8e, 0x43, 0xc9, 0xd6, 0x69, 0xb5, 0x3b, 0x61,
+	0x2d, 0x45, 0x46, 0xeb, 0x06, 0x9c, 0x6d, 0x51, 0xad, 0x05, 0x40, 0xf3, 0x30, 0xde, 0x10, 0xdf,
+	0xcc, 0xe1, 0x87, 0x0d, 0x2c, 0x69, 0x1e, 0x2b, 0x81, 0x32, 0x3d, 0x38, 0x6c, 0x60, 0xeb, 0xd9,
+	0x50, 0x52, 0xb8, 0x58, 0xa0, 0xa5, 0x54, 0x81, 0x76, 0x32, 0x1d, 0x12, 0xad, 0xf5, 0x92, 0x68,
+	0x27, 0xd3, 0x25, 0xd2, 0x4a, 0xba, 0x48, 0x3b, 0x99, 0x4e, 0x99, 0x72, 0xed, 0x32, 0xed, 0x64,
+	0x62, 0xa1, 0x36, 0xfa, 0x08, 0xb5, 0x93, 0x49, 0x95, 0xea, 0x46, 0x5f, 0xa9, 0x76, 0x32, 0xa9,
+	0x62, 0x6d, 0x9d, 0x86, 0x51, 0xc5, 0x91, 0xf5, 0x01, 0x4c, 0x4b, 0x7a, 0xf6, 0x24, 0xd7, 0x8a,
+	0xd4, 0xab, 0x70, 0x4a, 0x92, 0xe3, 0x7b, 0x8a, 0x96, 0x2d, 0xf4, 0xfa, 0xe5, 0xfc, 0xd4, 0xa1,
+	0x5b, 0x0f, 0xde, 0xb3, 0xb4, 0xc3, 0x12, 0x98, 0xdd, 0xf0, 0x9e, 0x67, 0xdd, 0x82, 0xb3, 0x89,
+	0x04, 0x9a, 0xde, 0x55, 0x18, 0x11, 0xdf, 0x72, 0xf9, 0xf8, 0xe6, 0x6c, 0x31, 0xbe, 0x12, 0x84,
+	0x4a, 0xac, 0x28, 0x63, 0x65, 0x84, 0xf5, 0x21, 0xcc, 0xc6, 0xcb, 0x77, 0x7d, 0xc6, 0x23, 0x0c,
+	0x08, 0x46, 0x1a, 0x6e, 0x15, 0xeb, 0x83, 0x23, 0x7f, 0xa3, 0x59, 0xc8, 0x06, 0x7e, 0xdd, 0xe7,
+	0xfa, 0x94, 0xa8, 0x0f, 0x6b, 0x1b, 0xce, 0x75, 0x64, 0xd0, 0x20, 0xd6, 0x20, 0x2b, 0x77, 0x9b,
+	0x33, 0x16, 0x86, 0x7b, 0xa2, 0x50, 0x21, 0x56, 0x0e, 0xe6, 0x64, 0x92, 0x5d, 0xa9, 0x69, 0x82,
+	0x0c, 0x6b, 0x1b, 0xce, 0x77, 0x79, 0x4e, 0xbc, 0x4b, 0x0e, 0x73, 0xf7, 0x29, 0x6e, 0xb8, 0x14,
+	0x7f, 0x8a, 0x0f, 0x92, 0xe9, 0x45, 0x03, 0x33, 0xee, 0x52, 0xae, 0x9b, 0x4b, 0x6d, 0x17, 0xa4,
+	0x49, 0xb5, 0xd5, 0xf9, 0x96, 0x18, 0x43, 0xd1, 0xa9, 0x16, 0xc4, 0xa3, 0x05, 0x98, 0x28, 0x13,
+	0xea, 0x54, 0x6a, 0xae, 0x2f, 0xbd, 0xc3, 0xaa, 0xf7, 0xcb, 0x84, 0x6e, 0x0b, 0xd3, 0x3d, 0x4f,
+	0x40, 0xef, 0xaa, 0x7a, 0x62, 0xe8, 0x26, 0xe4, 0xe4, 0xfe, 0xa3, 0x14, 0x7b, 0x18, 0x7b, 0x11,
+	0x37, 0xb7, 0xe1, 0x42, 0x8a, 0x4f, 0x97, 0x58, 0x84, 0xa9, 0x10, 0x1f, 0x70, 0xd9, 0x8e, 0x0e,
+	0xc3, 0xd8, 0xd3, 0xa7, 0x73, 0x22, 0x4c, 0x44, 0x5b, 0x5f, 0x81, 0x19, 0xab, 0x77, 0xc7, 0xdf,
+	0xc7, 0xb4, 0x8a, 0xc3, 0x0a, 0x8e, 0xd8, 0xb1, 0x60, 0x52, 0xb1, 0xd3, 0xd6, 0x8f, 0x25, 0x45,
+	0xd9, 0x9e, 0xe2, 0x21, 0x0f, 0xe3, 0x38, 0xf4, 0x9c, 0x76, 0x92, 0xc6, 0x70, 0xe8, 0x29, 0xbf,
+	0xf5, 0x87, 0x01, 0x6f, 0xa5, 0x96, 0xd0, 0x38, 0x0b, 0x30, 0x23, 0x79, 0x6c, 0x52, 0x8a, 0xc3,
+	0xce, 0x4a, 0xd3, 0x82, 0x4e, 0xe5, 0xd1, 0xe5, 0x36, 0xe0, 0x5c, 0x44, 0x96, 0x13, 0xb8, 0x8c,
+	0x77, 0x14, 0x46, 0x91, 0x73, 0xd7, 0x65, 0xd1, 0x92, 0x5d, 0x18, 0xf7, 0xe2, 0xba, 0x2c, 0x37,
+	0x2c, 0xdb, 0x71, 0xb1, 0x98, 0x36, 0x27, 0x8b, 0xed, 0x20, 0xb7, 0x46, 0x9e, 0xbf, 0x9c, 0xcf,
+	0x94, 0x92, 0xcb, 0xad, 0xff, 0x0c, 0x98, 0x6a, 0x8f, 0x4a, 0xf6, 0x88, 0xd1, 0xd6, 0x23, 0xd7,
+	0x20, 0x9e, 0xc6, 0x4e, 0xb2, 0xcd, 0x3a, 0xb0, 0xee, 0xb5, 0xda, 0x6d, 0x1d, 0x62, 0xab, 0x23,
+	0x68, 0x55, 0xf1, 0x6a, 0xdc, 0x4c, 0x47, 0x9e, 0xbb, 0xa1, 0xa7, 0xa2, 0x97, 0xe1, 0x8c, 0xe0,
+	0x2e, 0x99, 0x5a, 0x8d, 0x9e, 0xc9, 0x32, 0xa1, 0x89, 0xac, 0x16, 0x08, 0x43, 0x22, 0x61, 0x56,
+	0xe9, 0x58, 0x26, 0x34, 0xce, 0x35, 0x07, 0xa3, 0x14, 0xbb, 0x8c, 0x84, 0xf2, 0xea, 0x1a, 0x2b,
+	0xe9, 0x2f, 0xeb, 0x13, 0xb8, 0xa8, 0x2e, 0x70, 0x7d, 0x89, 0xde, 0x21, 0x8f, 0x43, 0xee, 0xd7,
+	0xf1, 0x1b, 0xdd, 0x56, 0x0f, 0xe1, 0xed, 0x1e, 0xc9, 0x74, 0x37, 0x7c, 0x0c, 0x63, 0x9e, 0xb6,
+	0x45, 0x17, 0xc7, 0x72, 0xba, 0x52, 0x9d, 0x29, 0xb4, 0x56, 0xad, 0xe5, 0x56, 0x43, 0x1f, 0x0f,
+	0xa1, 0x56, 0x14, 0xcd, 0xde, 0x04, 0x36, 0x5a, 0x86, 0x6c, 0x42, 0xb8, 0xad, 0xe9, 0xd7, 0x2f,
+	0xe7, 0x27, 0x54, 0xa8, 0x34, 0x5b, 0x25, 0xe5, 0xb6, 0xbe, 0x04, 0x33, 0xad, 0xa2, 0xde, 0xdb,
+	0x2d, 0x18, 0x8b, 0x26, 0x51, 0xb4, 0xb7, 0x0b, 0x9d, 0x27, 0xff, 0xf3, 0xe8, 0xd5, 0x14, 0x6d,
+	0x27, 0x5e, 0xb1, 0xf9, 0xcb, 0x04, 0x64, 0x65, 0x76, 0xf4, 0x8d, 0x01, 0xa3, 0xea, 0xc5, 0x83,
+	0x56, 0xd3, 0xc9, 0xe9, 0x7e, 0x2b, 0x99, 0x57, 0x06, 0x88, 0x54, 0x40, 0xad, 0xc5, 0xaf, 0xff,
+	0xfc, 0xe7, 0xc7, 0xa1, 0x3c, 0xba, 0x68, 0xa7, 0xbe, 0xd9, 0xd4, 0xe8, 0x42, 0xdf, 0x1b, 0x90,
+	0x95, 0x0b, 0xd1, 0xca, 0x71, 0xa9, 0x23, 0x0c, 0xab, 0xc7, 0x07, 0x6a, 0x08, 0x9b, 0x12, 0xc2,
+	0x3a, 0x5a, 0xeb, 0x07, 0xc1, 0x7e, 0x92, 0x78, 0x7c, 0x3c, 0x45, 0xdf, 0x19, 0x70, 0x3a, 0x9a,
+	0x42, 0x68, 0xad, 0x4f, 0xa9, 0x8e, 0x61, 0x67, 0x5e, 0x1d, 0x28, 0x56, 0x23, 0x5b, 0x91, 0xc8,
+	0x2e, 0xa1, 0xf9, 0x74, 0x64, 0xa2, 0x63, 0x0a, 0x81, 0x40, 0xf0, 0xad, 0xa1, 0x2e, 0x79, 0xb4,
+	0x7c, 0x4c, 0xfa, 0x08, 0xc6, 0xca, 0xb1, 0x71, 0x1a, 0xc2, 0xba, 0x84, 0xb0, 0x8c, 0x16, 0x7b,
+	0x43, 0xb0, 0x9f, 0xe8, 0x26, 0x7e, 0x8a, 0x7e, 0x32, 0x00, 0x5a, 0xd3, 0x13, 0xad, 0xf7, 0xa9,
+	0xd2, 0x35, 0x7e, 0xcd, 0xc2, 0x80, 0xd1, 0x1a, 0xd9, 0x15, 0x89, 0xec, 0x32, 0xba, 0x94, 0x8e,
+	0x4c, 0x3d, 0xd9, 0x0a, 0x02, 0x1a, 0xfa, 0xd9, 0x80, 0x33, 0x1d, 0xe3, 0xb1, 0x17, 0xb6, 0xf4,
+	0xd9, 0x6d, 0x16, 0x06, 0x8c, 0xd6, 0xd8, 0x6c, 0x89, 0xed, 0x0a, 0x5a, 0xe9, 0xd1, 0x52, 0x6a,
+	0x59, 0x41, 0x8c, 0x47, 0x85, 0xf0, 0x99, 0x01, 0x13, 0xc9, 0xd1, 0x8a, 0x8a, 0x7d, 0xc8, 0x48,
+	0x99, 0xcf, 0xa6, 0x3d, 0x70, 0xfc, 0x60, 0xc2, 0xc6, 0xd0, 0x0a, 0x62, 0x9e, 0xa3, 0x5f, 0xbb,
+	0x27, 0xd1, 0xb5, 0x63, 0x5a, 0xa8, 0x6b, 0xc4, 0x9b, 0x1b, 0x27, 0x58, 0xa1, 0x51, 0x16, 0x24,
+	0xca, 0x15, 0xb4, 0xd4, 0xe7, 0x04, 0xb4, 0x26, 0x26, 0xfa, 0xdd, 0x80, 0xe9, 0xce, 0xcb, 0x1a,
+	0x6d, 0xf6, 0xbb, 0x09, 0xd2, 0x27, 0x8d, 0x79, 0xfd, 0x44, 0x6b, 0x34, 0xd8, 0x77, 0x25, 0xd8,
+	0xeb, 0x68, 0xa3, 0x97, 0xea, 0x6a, 0x5d, 0x21, 0x1a, 0x1b, 0x89, 0x83, 0xf3, 0x9b, 0x01, 0x93,
+	0x6d, 0x37, 0x39, 0xb2, 0x8f, 0x21, 0xab, 0x73, 0xca, 0x98, 0xd7, 0x06, 0x5f, 0xa0, 0xf1, 0xbe,
+	0x2f, 0xf1, 0xde, 0x44, 0xef, 0xf4, 0x21, 0x37, 0x9e, 0x09, 0x2d, 0xb0, 0xf6, 0x13, 0x39, 0x81,
+	0x9e, 0x6e, 0x7d, 0xf4, 0xfc, 0x55, 0xde, 0x78, 0xf1, 0x2a, 0x6f, 0xfc, 0xfd, 0x2a, 0x6f, 0xfc,
+	0x70, 0x94, 0xcf, 0xbc, 0x38, 0xca, 0x67, 0xfe, 0x3a, 0xca, 0x67, 0xbe, 0x58, 0xaf, 0xfa, 0xbc,
+	0xd6, 0x2c, 0x17, 0x2b, 0xa4, 0x6e, 0xd7, 0x5d, 0xee, 0x57, 0x42, 0xcc, 0x1f, 0x13, 0xfa, 0xb0,
+	0x55, 0xe8, 0x40, 0x96, 0x92, 0x83, 0xa8, 0x3c, 0x2a, 0xff, 0x77, 0x5f, 0xff, 0x7f, 0x00, 0xe2,
+	0x75, 0xc3, 0xa7, 0x71, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	Param(ctx context.Context, in *QueryParamRequest, opts ...grpc.CallOption) (*QueryParamResponse, error)
+	SpanList(ctx context.Context, in *QuerySpanListRequest, opts ...grpc.CallOption) (*QuerySpanListResponse, error)
+	Span(ctx context.Context, in *QuerySpanRequest, opts ...grpc.CallOption) (*QuerySpanResponse, error)
+	LatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error)
+	PrepareNextSpan(ctx context.Context, in *PrepareNextSpanRequest, opts ...grpc.CallOption) (*PrepareNextSpanResponse, error)
+	NextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error)
+	SpanDivergence(ctx context.Context, in *QuerySpanDivergenceRequest, opts ...grpc.CallOption) (*QuerySpanDivergenceResponse, error)
+	ProducerDowntime(ctx context.Context, in *QueryProducerDowntimeRequest, opts ...grpc.CallOption) (*QueryProducerDowntimeResponse, error)
+	SpanProducers(ctx context.Context, in *QuerySpanProducersRequest, opts ...grpc.CallOption) (*QuerySpanProducersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Span(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.bor.v1beta1.Query/Span",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Span(ctx, req.(*QuerySpanRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LatestSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestSpanRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Param(ctx context.Context, in *QueryParamRequest, opts ...grpc.CallOption) (*QueryParamResponse, error) {
+	out := new(QueryParamResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/Param", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestSpan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.bor.v1beta1.Query/LatestSpan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestSpan(ctx, req.(*QueryLatestSpanRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PrepareNextSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PrepareNextSpanRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) SpanList(ctx context.Context, in *QuerySpanListRequest, opts ...grpc.CallOption) (*QuerySpanListResponse, error) {
+	out := new(QuerySpanListResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/SpanList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PrepareNextSpan(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) Span(ctx context.Context, in *QuerySpanRequest, opts ...grpc.CallOption) (*QuerySpanResponse, error) {
+	out := new(QuerySpanResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/Span", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.bor.v1beta1.Query/PrepareNextSpan",
+	return out, nil
+}
+
+func (c *queryClient) LatestSpan(ctx context.Context, in *QueryLatestSpanRequest, opts ...grpc.CallOption) (*QueryLatestSpanResponse, error) {
+	out := new(QueryLatestSpanResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/LatestSpan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PrepareNextSpan(ctx, req.(*PrepareNextSpanRequest))
+	return out, nil
+}
+
+func (c *queryClient) PrepareNextSpan(ctx context.Context, in *PrepareNextSpanRequest, opts ...grpc.CallOption) (*PrepareNextSpanResponse, error) {
+	out := new(PrepareNextSpanResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/PrepareNextSpan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_NextSpanSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextSpanSeedRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) NextSpanSeed(ctx context.Context, in *QueryNextSpanSeedRequest, opts ...grpc.CallOption) (*QueryNextSpanSeedResponse, error) {
+	out := new(QueryNextSpanSeedResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/NextSpanSeed", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NextSpanSeed(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) SpanDivergence(ctx context.Context, in *QuerySpanDivergenceRequest, opts ...grpc.CallOption) (*QuerySpanDivergenceResponse, error) {
+	out := new(QuerySpanDivergenceResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/SpanDivergence", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.bor.v1beta1.Query/NextSpanSeed",
+	return out, nil
+}
+
+func (c *queryClient) ProducerDowntime(ctx context.Context, in *QueryProducerDowntimeRequest, opts ...grpc.CallOption) (*QueryProducerDowntimeResponse, error) {
+	out := new(QueryProducerDowntimeResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/ProducerDowntime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NextSpanSeed(ctx, req.(*QueryNextSpanSeedRequest))
+	return out, nil
+}
+
+func (c *queryClient) SpanProducers(ctx context.Context, in *QuerySpanProducersRequest, opts ...grpc.CallOption) (*QuerySpanProducersResponse, error) {
+	out := new(QuerySpanProducersResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.bor.v1beta1.Query/SpanProducers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Param(context.Context, *QueryParamRequest) (*QueryParamResponse, error)
+	SpanList(context.Context, *QuerySpanListRequest) (*QuerySpanListResponse, error)
+	Span(context.Context, *QuerySpanRequest) (*QuerySpanResponse, error)
+	LatestSpan(context.Context, *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error)
+	PrepareNextSpan(context.Context, *PrepareNextSpanRequest) (*PrepareNextSpanResponse, error)
+	NextSpanSeed(context.Context, *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error)
+	SpanDivergence(context.Context, *QuerySpanDivergenceRequest) (*QuerySpanDivergenceResponse, error)
+	ProducerDowntime(context.Context, *QueryProducerDowntimeRequest) (*QueryProducerDowntimeResponse, error)
+	SpanProducers(context.Context, *QuerySpanProducersRequest) (*QuerySpanProducersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Param(ctx context.Context, req *QueryParamRequest) (*QueryParamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Param not implemented")
+}
+func (*UnimplementedQueryServer) SpanList(ctx context.Context, req *QuerySpanListRequest) (*QuerySpanListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpanList not implemented")
+}
+func (*UnimplementedQueryServer) Span(ctx context.Context, req *QuerySpanRequest) (*QuerySpanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Span not implemented")
+}
+func (*UnimplementedQueryServer) LatestSpan(ctx context.Context, req *QueryLatestSpanRequest) (*QueryLatestSpanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestSpan not implemented")
+}
+func (*UnimplementedQueryServer) PrepareNextSpan(ctx context.Context, req *PrepareNextSpanRequest) (*PrepareNextSpanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareNextSpan not implemented")
+}
+func (*UnimplementedQueryServer) NextSpanSeed(ctx context.Context, req *QueryNextSpanSeedRequest) (*QueryNextSpanSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextSpanSeed not implemented")
+}
+func (*UnimplementedQueryServer) SpanDivergence(ctx context.Context, req *QuerySpanDivergenceRequest) (*QuerySpanDivergenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpanDivergence not implemented")
+}
+func (*UnimplementedQueryServer) ProducerDowntime(ctx context.Context, req *QueryProducerDowntimeRequest) (*QueryProducerDowntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProducerDowntime not implemented")
+}
+func (*UnimplementedQueryServer) SpanProducers(ctx context.Context, req *QuerySpanProducersRequest) (*QuerySpanProducersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpanProducers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Param_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Param(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/Param",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Param(ctx, req.(*QueryParamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpanList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpanList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/SpanList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpanList(ctx, req.(*QuerySpanListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Span_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Span(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/Span",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Span(ctx, req.(*QuerySpanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestSpanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestSpan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/LatestSpan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestSpan(ctx, req.(*QueryLatestSpanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrepareNextSpan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareNextSpanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrepareNextSpan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/PrepareNextSpan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrepareNextSpan(ctx, req.(*PrepareNextSpanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextSpanSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextSpanSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextSpanSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/NextSpanSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextSpanSeed(ctx, req.(*QueryNextSpanSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpanDivergence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanDivergenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpanDivergence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/SpanDivergence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpanDivergence(ctx, req.(*QuerySpanDivergenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProducerDowntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProducerDowntimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProducerDowntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/ProducerDowntime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProducerDowntime(ctx, req.(*QueryProducerDowntimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpanProducers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpanProducersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpanProducers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.bor.v1beta1.Query/SpanProducers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpanProducers(ctx, req.(*QuerySpanProducersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdall.bor.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -1113,6 +1691,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextSpanSeed",
 			Handler:    _Query_NextSpanSeed_Handler,
 		},
+		{
+			MethodName: "SpanDivergence",
+			Handler:    _Query_SpanDivergence_Handler,
+		},
+		{
+			MethodName: "ProducerDowntime",
+			Handler:    _Query_ProducerDowntime_Handler,
+		},
+		{
+			MethodName: "SpanProducers",
+			Handler:    _Query_SpanProducers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdall/bor/v1beta1/query.proto",
@@ -1161,6 +1751,28 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SpanRetention != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpanRetention))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MissedSprintsThreshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedSprintsThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxConsecutiveSpans != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxConsecutiveSpans))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SelectionAlgorithm) > 0 {
+		i -= len(m.SelectionAlgorithm)
+		copy(dAtA[i:], m.SelectionAlgorithm)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SelectionAlgorithm)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Sprint != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.Sprint))
 		i--
@@ -1294,6 +1906,32 @@ func (m *QueryParamResponse_Sprint) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	dAtA[i] = 0x20
 	return len(dAtA) - i, nil
 }
+func (m *QueryParamResponse_SelectionAlgorithm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamResponse_SelectionAlgorithm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.SelectionAlgorithm)
+	copy(dAtA[i:], m.SelectionAlgorithm)
+	i = encodeVarintQuery(dAtA, i, uint64(len(m.SelectionAlgorithm)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *QueryParamResponse_MaxConsecutiveSpans) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamResponse_MaxConsecutiveSpans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintQuery(dAtA, i, uint64(m.MaxConsecutiveSpans))
+	i--
+	dAtA[i] = 0x30
+	return len(dAtA) - i, nil
+}
 func (m *QuerySpanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1613,18 +2251,288 @@ func (m *QueryNextSpanSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySpanDivergenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
+
+func (m *QuerySpanDivergenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpanDivergenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndSpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndSpanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartSpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartSpanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpanDivergenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpanDivergenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpanDivergenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Divergences) > 0 {
+		for iNdEx := len(m.Divergences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Divergences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HeimdallLastSpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HeimdallLastSpanId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BorCurrentSpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BorCurrentSpanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpanDivergence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpanDivergence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpanDivergence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BorEndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BorEndBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BorStartBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BorStartBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HeimdallEndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HeimdallEndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HeimdallStartBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HeimdallStartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProducerDowntimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerDowntimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerDowntimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProducerDowntimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProducerDowntimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProducerDowntimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Downtimes) > 0 {
+		for iNdEx := len(m.Downtimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Downtimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpanProducersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpanProducersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpanProducersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SpanId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SpanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpanProducersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpanProducersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpanProducersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Producers) > 0 {
+		for iNdEx := len(m.Producers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Producers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1651,6 +2559,19 @@ func (m *QueryParamsResponse) Size() (n int) {
 	if m.Sprint != 0 {
 		n += 1 + sovQuery(uint64(m.Sprint))
 	}
+	l = len(m.SelectionAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxConsecutiveSpans != 0 {
+		n += 1 + sovQuery(uint64(m.MaxConsecutiveSpans))
+	}
+	if m.MissedSprintsThreshold != 0 {
+		n += 1 + sovQuery(uint64(m.MissedSprintsThreshold))
+	}
+	if m.SpanRetention != 0 {
+		n += 1 + sovQuery(uint64(m.SpanRetention))
+	}
 	return n
 }
 
@@ -1715,6 +2636,25 @@ func (m *QueryParamResponse_Sprint) Size() (n int) {
 	n += 1 + sovQuery(uint64(m.Sprint))
 	return n
 }
+func (m *QueryParamResponse_SelectionAlgorithm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SelectionAlgorithm)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+func (m *QueryParamResponse_MaxConsecutiveSpans) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovQuery(uint64(m.MaxConsecutiveSpans))
+	return n
+}
 func (m *QuerySpanRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1843,16 +2783,678 @@ func (m *QueryNextSpanSeedResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QuerySpanDivergenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartSpanId != 0 {
+		n += 1 + sovQuery(uint64(m.StartSpanId))
+	}
+	if m.EndSpanId != 0 {
+		n += 1 + sovQuery(uint64(m.EndSpanId))
+	}
+	return n
+}
+
+func (m *QuerySpanDivergenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BorCurrentSpanId != 0 {
+		n += 1 + sovQuery(uint64(m.BorCurrentSpanId))
+	}
+	if m.HeimdallLastSpanId != 0 {
+		n += 1 + sovQuery(uint64(m.HeimdallLastSpanId))
+	}
+	if len(m.Divergences) > 0 {
+		for _, e := range m.Divergences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SpanDivergence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovQuery(uint64(m.SpanId))
+	}
+	if m.HeimdallStartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.HeimdallStartBlock))
+	}
+	if m.HeimdallEndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.HeimdallEndBlock))
+	}
+	if m.BorStartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.BorStartBlock))
+	}
+	if m.BorEndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.BorEndBlock))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProducerDowntimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovQuery(uint64(m.SpanId))
+	}
+	return n
+}
+
+func (m *QueryProducerDowntimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Downtimes) > 0 {
+		for _, e := range m.Downtimes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySpanProducersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SpanId != 0 {
+		n += 1 + sovQuery(uint64(m.SpanId))
+	}
+	if m.Block != 0 {
+		n += 1 + sovQuery(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *QuerySpanProducersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Producers) > 0 {
+		for _, e := range m.Producers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanDuration", wireType)
+			}
+			m.SpanDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEthBlock", wireType)
+			}
+			m.LatestEthBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestEthBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerCount", wireType)
+			}
+			m.ProducerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProducerCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sprint", wireType)
+			}
+			m.Sprint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sprint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectionAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveSpans", wireType)
+			}
+			m.MaxConsecutiveSpans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveSpans |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedSprintsThreshold", wireType)
+			}
+			m.MissedSprintsThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedSprintsThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanRetention", wireType)
+			}
+			m.SpanRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanDuration", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Params = &QueryParamResponse_SpanDuration{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestEthBlock", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Params = &QueryParamResponse_LatestEthBlock{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProducerCount", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Params = &QueryParamResponse_ProducerCount{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sprint", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Params = &QueryParamResponse_Sprint{v}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Params = &QueryParamResponse_SelectionAlgorithm{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveSpans", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Params = &QueryParamResponse_MaxConsecutiveSpans{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1875,12 +3477,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1905,7 +3526,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1928,17 +3549,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanDuration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
 			}
-			m.SpanDuration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1948,16 +3569,86 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpanDuration |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Span == nil {
+				m.Span = &types.Span{}
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestEthBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			m.LatestEthBlock = 0
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1967,16 +3658,16 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestEthBlock |= uint64(b&0x7F) << shift
+				m.Page |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProducerCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.ProducerCount = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1986,16 +3677,69 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProducerCount |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sprint", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Sprint = 0
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spans", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2005,11 +3749,79 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sprint |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spans = append(m.Spans, &types.Span{})
+			if err := m.Spans[len(m.Spans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestSpanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestSpanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2034,7 +3846,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2057,17 +3869,17 @@ func (m *QueryParamRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestSpanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2077,23 +3889,27 @@ func (m *QueryParamRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParamsType = string(dAtA[iNdEx:postIndex])
+			if m.Span == nil {
+				m.Span = &types.Span{}
+			}
+			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2119,7 +3935,7 @@ func (m *QueryParamRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamResponse) Unmarshal(dAtA []byte) error {
+func (m *PrepareNextSpanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,17 +3958,17 @@ func (m *QueryParamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrepareNextSpanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrepareNextSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			var v uint64
+			m.StartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2162,17 +3978,16 @@ func (m *QueryParamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.StartBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Params = &QueryParamResponse_SpanDuration{v}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestEthBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
 			}
-			var v uint64
+			m.SpanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2182,37 +3997,16 @@ func (m *QueryParamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.SpanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Params = &QueryParamResponse_LatestEthBlock{v}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProducerCount", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Params = &QueryParamResponse_ProducerCount{v}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sprint", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2222,84 +4016,24 @@ func (m *QueryParamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Params = &QueryParamResponse_Sprint{v}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySpanRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpanRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
-			}
-			m.SpanId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SpanId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			m.BorChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2324,7 +4058,7 @@ func (m *QuerySpanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpanResponse) Unmarshal(dAtA []byte) error {
+func (m *PrepareNextSpanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2347,10 +4081,10 @@ func (m *QuerySpanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrepareNextSpanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrepareNextSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2413,7 +4147,7 @@ func (m *QuerySpanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextSpanSeedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2436,50 +4170,12 @@ func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpanListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextSpanSeedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpanListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextSpanSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
-			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2504,7 +4200,7 @@ func (m *QuerySpanListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2527,17 +4223,17 @@ func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpanListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextSpanSeedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpanListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextSpanSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSpanSeed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2547,25 +4243,23 @@ func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spans = append(m.Spans, &types.Span{})
-			if err := m.Spans[len(m.Spans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NextSpanSeed = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2591,7 +4285,7 @@ func (m *QuerySpanListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestSpanRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpanDivergenceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2610,16 +4304,54 @@ func (m *QueryLatestSpanRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestSpanRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpanDivergenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpanDivergenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSpanId", wireType)
+			}
+			m.StartSpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSpanId", wireType)
+			}
+			m.EndSpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2644,7 +4376,7 @@ func (m *QueryLatestSpanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpanDivergenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2667,15 +4399,53 @@ func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestSpanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpanDivergenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpanDivergenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorCurrentSpanId", wireType)
+			}
+			m.BorCurrentSpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorCurrentSpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeimdallLastSpanId", wireType)
+			}
+			m.HeimdallLastSpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeimdallLastSpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Divergences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2702,10 +4472,8 @@ func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Span == nil {
-				m.Span = &types.Span{}
-			}
-			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Divergences = append(m.Divergences, SpanDivergence{})
+			if err := m.Divergences[len(m.Divergences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2733,7 +4501,7 @@ func (m *QueryLatestSpanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrepareNextSpanRequest) Unmarshal(dAtA []byte) error {
+func (m *SpanDivergence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2756,17 +4524,17 @@ func (m *PrepareNextSpanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrepareNextSpanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpanDivergence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrepareNextSpanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpanDivergence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
 			}
-			m.StartBlock = 0
+			m.SpanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2776,16 +4544,16 @@ func (m *PrepareNextSpanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartBlock |= uint64(b&0x7F) << shift
+				m.SpanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeimdallStartBlock", wireType)
 			}
-			m.SpanId = 0
+			m.HeimdallStartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2795,14 +4563,71 @@ func (m *PrepareNextSpanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpanId |= uint64(b&0x7F) << shift
+				m.HeimdallStartBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeimdallEndBlock", wireType)
+			}
+			m.HeimdallEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeimdallEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorStartBlock", wireType)
+			}
+			m.BorStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorStartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorEndBlock", wireType)
+			}
+			m.BorEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BorEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2830,7 +4655,7 @@ func (m *PrepareNextSpanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorChainId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2856,7 +4681,7 @@ func (m *PrepareNextSpanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrepareNextSpanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProducerDowntimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2879,15 +4704,87 @@ func (m *PrepareNextSpanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrepareNextSpanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProducerDowntimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrepareNextSpanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProducerDowntimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProducerDowntimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProducerDowntimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProducerDowntimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtimes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2914,10 +4811,8 @@ func (m *PrepareNextSpanResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Span == nil {
-				m.Span = &types.Span{}
-			}
-			if err := m.Span.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Downtimes = append(m.Downtimes, ProducerDowntime{})
+			if err := m.Downtimes[len(m.Downtimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2945,7 +4840,7 @@ func (m *PrepareNextSpanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSpanSeedRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpanProducersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2968,12 +4863,50 @@ func (m *QueryNextSpanSeedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSpanSeedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpanProducersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSpanSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpanProducersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanId", wireType)
+			}
+			m.SpanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2998,7 +4931,7 @@ func (m *QueryNextSpanSeedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpanProducersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3021,17 +4954,17 @@ func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSpanSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpanProducersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSpanSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpanProducersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSpanSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Producers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3041,23 +4974,25 @@ func (m *QueryNextSpanSeedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextSpanSeed = string(dAtA[iNdEx:postIndex])
+			m.Producers = append(m.Producers, types.Validator{})
+			if err := m.Producers[len(m.Producers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/version"
@@ -32,6 +33,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdQueryLastNoACK(),
 		GetCmdQueryHeaderFromIndex(),
 		GetCmdQueryCheckpointCount(),
+		GetCmdQueryBlockProof(),
+		GetCmdQueryCheckpointByTxHash(),
+		GetCmdQueryCheckpointsByBlockRange(),
 	)
 
 	return checkpointQueryCmd
@@ -190,3 +194,132 @@ func GetCmdQueryCheckpointCount() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryBlockProof get checkpoint and header inclusion proof for child block
+func GetCmdQueryBlockProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof [block-number]",
+		Args:  cobra.ExactArgs(1),
+		Short: "get covering checkpoint and header inclusion proof for child block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query checkpoint covering child block and block header inclusion proof against its root hash.
+
+Example:
+$ %s query checkpoint proof 1000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			blockNumber, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockProof(context.Background(), &types.QueryBlockProofRequest{BlockNumber: blockNumber})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCheckpointByTxHash get checkpoint acked by rootchain tx hash
+func GetCmdQueryCheckpointByTxHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-hash [tx-hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "get checkpoint acked by rootchain tx hash",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query checkpoint acked on rootchain by given tx hash.
+
+Example:
+$ %s query checkpoint tx-hash 0x7d1d2a3e9b0cbe0e4e4a1bd3e5e1c3e3b2e0f7aa9d9c2c3e40c0e2c0cb8c1e11
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CheckpointByTxHash(context.Background(), &types.QueryCheckpointByTxHashRequest{TxHash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Checkpoint)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCheckpointsByBlockRange get checkpoints overlapping child block range
+func GetCmdQueryCheckpointsByBlockRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-range [start-block] [end-block]",
+		Args:  cobra.ExactArgs(2),
+		Short: "get checkpoints overlapping child block range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query checkpoints overlapping given child block range (inclusive).
+
+Example:
+$ %s query checkpoint block-range 1000 2000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startBlock, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endBlock, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CheckpointsByBlockRange(context.Background(), &types.QueryCheckpointsByBlockRangeRequest{
+				StartBlock: startBlock,
+				EndBlock:   endBlock,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/x/checkpoint/keeper"
 	"github.com/maticnetwork/heimdall/x/checkpoint/types"
 )
@@ -22,21 +23,29 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState *types.GenesisS
 
 	// Add finalised checkpoints to state
 	if len(genState.Checkpoints) != 0 {
-		// check if we are provided all the headers
-		if int(genState.AckCount) != len(genState.Checkpoints) {
+		// check if we are provided all the headers after pruned ones
+		if int(genState.AckCount) < len(genState.Checkpoints) {
 			panic(errors.New("Incorrect state in state-dump , Please Check "))
 		}
+		// older checkpoints might have been pruned
+		prunedCount := genState.AckCount - uint64(len(genState.Checkpoints))
+		keeper.SetPrunedCheckpointCount(ctx, prunedCount)
 		// sort headers before loading to state
 		genState.Checkpoints = hmTypes.SortHeaders(genState.Checkpoints)
 		// load checkpoints to state
 		for i, checkpoint := range genState.Checkpoints {
-			checkpointIndex := uint64(i) + 1
+			checkpointIndex := prunedCount + uint64(i) + 1
 			if err := keeper.AddCheckpoint(ctx, checkpointIndex, checkpoint); err != nil {
 				keeper.Logger(ctx).Error("InitGenesis | AddCheckpoint", "error", err)
 			}
 		}
 	}
 
+	// Restore rootchain ack tx hash indexes of checkpoints
+	for _, txHash := range genState.CheckpointTxHashes {
+		keeper.SetCheckpointTxHashIndex(ctx, hmCommonTypes.HexToHeimdallHash(txHash.TxHash), txHash.Number)
+	}
+
 	// Add checkpoint in buffer
 	if genState.BufferedCheckpoint != nil {
 		if err := keeper.SetCheckpointBuffer(ctx, genState.BufferedCheckpoint); err != nil {
@@ -53,13 +62,16 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	params := keeper.GetParams(ctx)
 
 	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		params,
 		bufferedCheckpoint,
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
 		hmTypes.SortHeaders(keeper.GetCheckpoints(ctx)),
 	)
+	genState.CheckpointTxHashes = keeper.GetCheckpointTxHashes(ctx)
+
+	return genState
 
 	//return types.DefaultGenesis()
 
@@ -84,3 +84,122 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.LessOrEqual(t, len(actualParams.Checkpoints), len(genesisState.Checkpoints))
 
 }
+
+func (suite *GenesisTestSuite) TestInitGenesisPrunedCheckpoints() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	checkpoints := make([]*hmTypes.Checkpoint, 2)
+	for i := range checkpoints {
+		checkpoints[i] = hmTypes.CreateBlock(
+			uint64(i)*256,
+			uint64(i)*256+255,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(i)+1,
+		)
+	}
+
+	// 3 oldest checkpoints were pruned before export
+	genesisState := types.NewGenesisState(types.DefaultParams(), nil, 0, 5, checkpoints)
+	require.NoError(t, genesisState.Validate())
+
+	checkpoint.InitGenesis(ctx, initApp.CheckpointKeeper, genesisState)
+
+	require.Equal(t, uint64(3), initApp.CheckpointKeeper.GetPrunedCheckpointCount(ctx))
+	result, err := initApp.CheckpointKeeper.GetCheckpointByNumber(ctx, 4)
+	require.NoError(t, err)
+	require.Equal(t, *checkpoints[0], result)
+	result, err = initApp.CheckpointKeeper.GetLastCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, *checkpoints[1], result)
+
+	exported := checkpoint.ExportGenesis(ctx, initApp.CheckpointKeeper)
+	require.Equal(t, checkpoints, exported.Checkpoints)
+
+	genesisState = types.NewGenesisState(types.DefaultParams(), nil, 1, 1, checkpoints)
+	require.Error(t, genesisState.Validate())
+}
+
+func (suite *GenesisTestSuite) TestInitExportGenesisTxHashes() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	checkpoints := make([]*hmTypes.Checkpoint, 2)
+	for i := range checkpoints {
+		checkpoints[i] = hmTypes.CreateBlock(
+			uint64(i)*256,
+			uint64(i)*256+255,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(i)+1,
+		)
+	}
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), nil, 0, 2, checkpoints)
+	genesisState.CheckpointTxHashes = []types.CheckpointTxHash{
+		{Number: 1, TxHash: hmCommonTypes.HexToHeimdallHash("0x01").String()},
+		{Number: 2, TxHash: hmCommonTypes.HexToHeimdallHash("0x02").String()},
+	}
+
+	checkpoint.InitGenesis(ctx, initApp.CheckpointKeeper, genesisState)
+
+	// tx hash index is restored
+	number, result, err := initApp.CheckpointKeeper.GetCheckpointByTxHash(ctx, hmCommonTypes.HexToHeimdallHash("0x02"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+	require.Equal(t, *checkpoints[1], result)
+
+	exported := checkpoint.ExportGenesis(ctx, initApp.CheckpointKeeper)
+	require.Equal(t, genesisState.CheckpointTxHashes, exported.CheckpointTxHashes)
+}
+
+func (suite *GenesisTestSuite) TestExportImportGenesisPruned() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.CheckpointKeeper
+
+	for number := uint64(1); number <= 5; number++ {
+		checkpoint := hmTypes.CreateBlock(
+			(number-1)*100,
+			(number-1)*100+99,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			number,
+		)
+		require.NoError(t, keeper.AddCheckpoint(ctx, number, checkpoint))
+		keeper.SetCheckpointTxHashIndex(ctx, hmCommonTypes.BytesToHeimdallHash([]byte{byte(number)}), number)
+		keeper.UpdateACKCount(ctx)
+	}
+
+	params := keeper.GetParams(ctx)
+	params.CheckpointRetention = 2
+	keeper.SetParams(ctx, params)
+	require.Equal(t, uint64(3), keeper.PruneCheckpoints(ctx))
+
+	exported := checkpoint.ExportGenesis(ctx, keeper)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Checkpoints, 2)
+
+	// import exported state in fresh app
+	importApp, importCtx, _ := test_helper.CreateTestApp(true)
+	checkpoint.InitGenesis(importCtx, importApp.CheckpointKeeper, exported)
+
+	require.Equal(t, uint64(3), importApp.CheckpointKeeper.GetPrunedCheckpointCount(importCtx))
+	require.Equal(t, uint64(5), importApp.CheckpointKeeper.GetACKCount(importCtx))
+
+	// checkpoints keep their numbers and indexes after import
+	number, result, err := importApp.CheckpointKeeper.GetCheckpointByBlock(importCtx, 350)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), number)
+	require.Equal(t, *exported.Checkpoints[0], result)
+
+	number, _, err = importApp.CheckpointKeeper.GetCheckpointByTxHash(importCtx, hmCommonTypes.BytesToHeimdallHash([]byte{5}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), number)
+
+	_, err = importApp.CheckpointKeeper.GetCheckpointByNumber(importCtx, 3)
+	require.Error(t, err)
+
+	require.Equal(t, exported, checkpoint.ExportGenesis(importCtx, importApp.CheckpointKeeper))
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -8,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common/hexutil"
 	"github.com/maticnetwork/heimdall/helper"
 	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/x/checkpoint/types"
@@ -201,3 +203,88 @@ func (k Querier) LatestCheckpoint(c context.Context, req *types.QueryLatestCheck
 		LatestCheckpoint: &res,
 	}, nil
 }
+
+// BlockProof queries checkpoint covering child block and block header inclusion proof
+func (k Querier) BlockProof(c context.Context, req *types.QueryBlockProofRequest) (*types.QueryBlockProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	checkpointNumber, checkpoint, err := k.GetCheckpointByBlock(ctx, req.BlockNumber)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no checkpoint found for block %v", req.BlockNumber)
+	}
+
+	params := k.GetParams(ctx)
+	headers, err := k.contractCaller.GetMaticChainBlockHeaders(checkpoint.StartBlock, checkpoint.EndBlock, params.MaxCheckpointLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not fetch headers from matic chain. Error:%v", err)
+	}
+
+	index := req.BlockNumber - checkpoint.StartBlock
+	rootHash, proof, err := types.GetHeaderProof(headers, index)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not generate header proof. Error:%v", err)
+	}
+
+	if !bytes.Equal(rootHash, hmCommonTypes.HexToHeimdallHash(checkpoint.RootHash).Bytes()) {
+		return nil, status.Error(codes.Internal, "root hash of matic chain headers does not match checkpoint")
+	}
+
+	return &types.QueryBlockProofResponse{
+		CheckpointNumber: checkpointNumber,
+		Checkpoint:       &checkpoint,
+		Index:            index,
+		HeaderHash:       hexutil.Encode(types.GetHeaderLeaf(headers[index])),
+		Proof:            hexutil.Encode(proof),
+	}, nil
+}
+
+// CheckpointByTxHash queries checkpoint acked by rootchain tx hash
+func (k Querier) CheckpointByTxHash(c context.Context, req *types.QueryCheckpointByTxHashRequest) (*types.QueryCheckpointByTxHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.TxHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty tx hash")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	txHash := hmCommonTypes.HexToHeimdallHash(req.TxHash)
+	checkpointNumber, checkpoint, err := k.GetCheckpointByTxHash(ctx, txHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryCheckpointByTxHashResponse{
+		Checkpoint: &types.IndexedCheckpoint{Number: checkpointNumber, Checkpoint: &checkpoint, TxHash: txHash.String()},
+	}, nil
+}
+
+// CheckpointsByBlockRange queries checkpoints overlapping child block range. At most
+// MaxCheckpointsByBlockRange checkpoints are returned, rest can be queried from block after last returned one.
+func (k Querier) CheckpointsByBlockRange(c context.Context, req *types.QueryCheckpointsByBlockRangeRequest) (*types.QueryCheckpointsByBlockRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StartBlock > req.EndBlock {
+		return nil, status.Error(codes.InvalidArgument, "start block is greater than end block")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	numbers, checkpoints, err := k.GetCheckpointsByBlockRange(ctx, req.StartBlock, req.EndBlock, types.MaxCheckpointsByBlockRange)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := make([]*types.IndexedCheckpoint, len(checkpoints))
+	for i := range checkpoints {
+		res[i] = &types.IndexedCheckpoint{Number: numbers[i], Checkpoint: checkpoints[i]}
+		if txHash, ok := k.GetCheckpointTxHash(ctx, numbers[i]); ok {
+			res[i].TxHash = txHash.String()
+		}
+	}
+
+	return &types.QueryCheckpointsByBlockRangeResponse{Checkpoints: res}, nil
+}
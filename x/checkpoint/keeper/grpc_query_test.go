@@ -9,6 +9,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
 	"github.com/maticnetwork/heimdall/app"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -235,3 +239,149 @@ func (suite *GrpcQueryTestSuite) TestQueryNextCheckpoint() {
 	require.Equal(t, checkpointBlock.RootHash, result.NextCheckpoint.RootHash)
 	require.Equal(t, checkpointBlock.BorChainID, result.NextCheckpoint.BorChainID)
 }
+
+func (suite *GrpcQueryTestSuite) TestQueryBlockProof() {
+	t, initApp, ctx, grpcQuery := suite.T(), suite.app, suite.ctx, suite.grpcQuery
+
+	startBlock := uint64(100)
+	endBlock := uint64(102)
+	headers := make([]*ethTypes.Header, 0)
+	for i := startBlock; i <= endBlock; i++ {
+		headers = append(headers, &ethTypes.Header{
+			Number:      new(big.Int).SetUint64(i),
+			Time:        uint64(1600000000) + i,
+			TxHash:      ethCommon.BytesToHash([]byte{byte(i)}),
+			ReceiptHash: ethCommon.BytesToHash([]byte{byte(i), 1}),
+		})
+	}
+
+	// bor root hash: leaves padded with empty leaf to next power of two
+	leaves := make([][]byte, 0)
+	for _, header := range headers {
+		leaves = append(leaves, types.GetHeaderLeaf(header))
+	}
+	rootHash := crypto.Keccak256(
+		crypto.Keccak256(leaves[0], leaves[1]),
+		crypto.Keccak256(leaves[2], make([]byte, 32)),
+	)
+
+	checkpoint := hmTypes.CreateBlock(
+		startBlock,
+		endBlock,
+		hmCommonTypes.BytesToHeimdallHash(rootHash),
+		hmCommonTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	err := initApp.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpoint)
+	require.NoError(t, err)
+
+	params := initApp.CheckpointKeeper.GetParams(ctx)
+	suite.contractCaller.On("GetMaticChainBlockHeaders", startBlock, endBlock, params.MaxCheckpointLength).Return(headers, nil)
+
+	for i, header := range headers {
+		result, err := grpcQuery.BlockProof(sdk.WrapSDKContext(ctx), &types.QueryBlockProofRequest{
+			BlockNumber: header.Number.Uint64(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), result.CheckpointNumber)
+		require.Equal(t, checkpoint, result.Checkpoint)
+		require.Equal(t, uint64(i), result.Index)
+		require.Equal(t, hexutil.Encode(leaves[i]), result.HeaderHash)
+		require.True(t, types.VerifyHeaderProof(leaves[i], result.Index, rootHash, hexutil.MustDecode(result.Proof)))
+	}
+
+	// block not covered by any checkpoint
+	_, err = grpcQuery.BlockProof(sdk.WrapSDKContext(ctx), &types.QueryBlockProofRequest{BlockNumber: endBlock + 1})
+	require.Error(t, err)
+}
+
+func (suite *GrpcQueryTestSuite) TestQueryBlockProofRootHashMismatch() {
+	t, initApp, ctx, grpcQuery := suite.T(), suite.app, suite.ctx, suite.grpcQuery
+
+	header := &ethTypes.Header{Number: big.NewInt(10), Time: 1600000000}
+	checkpoint := hmTypes.CreateBlock(
+		10,
+		10,
+		hmCommonTypes.HexToHeimdallHash("123"),
+		hmCommonTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	err := initApp.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpoint)
+	require.NoError(t, err)
+
+	params := initApp.CheckpointKeeper.GetParams(ctx)
+	suite.contractCaller.On("GetMaticChainBlockHeaders", uint64(10), uint64(10), params.MaxCheckpointLength).Return([]*ethTypes.Header{header}, nil)
+
+	result, err := grpcQuery.BlockProof(sdk.WrapSDKContext(ctx), &types.QueryBlockProofRequest{BlockNumber: 10})
+	require.Nil(t, result)
+	require.Error(t, err)
+}
+
+func (suite *GrpcQueryTestSuite) TestQueryCheckpointByTxHash() {
+	t, initApp, ctx, grpcQuery := suite.T(), suite.app, suite.ctx, suite.grpcQuery
+
+	checkpoint := hmTypes.CreateBlock(
+		0,
+		255,
+		hmCommonTypes.HexToHeimdallHash("123"),
+		hmCommonTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	err := initApp.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpoint)
+	require.NoError(t, err)
+
+	txHash := hmCommonTypes.HexToHeimdallHash("0xabcd")
+	initApp.CheckpointKeeper.SetCheckpointTxHashIndex(ctx, txHash, 1)
+
+	result, err := grpcQuery.CheckpointByTxHash(sdk.WrapSDKContext(ctx), &types.QueryCheckpointByTxHashRequest{TxHash: txHash.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), result.Checkpoint.Number)
+	require.Equal(t, checkpoint, result.Checkpoint.Checkpoint)
+
+	_, err = grpcQuery.CheckpointByTxHash(sdk.WrapSDKContext(ctx), &types.QueryCheckpointByTxHashRequest{})
+	require.Error(t, err)
+
+	_, err = grpcQuery.CheckpointByTxHash(sdk.WrapSDKContext(ctx), &types.QueryCheckpointByTxHashRequest{TxHash: "0x1234"})
+	require.Error(t, err)
+}
+
+func (suite *GrpcQueryTestSuite) TestQueryCheckpointsByBlockRange() {
+	t, initApp, ctx, grpcQuery := suite.T(), suite.app, suite.ctx, suite.grpcQuery
+
+	checkpoints := make([]*hmTypes.Checkpoint, 0)
+	startBlock := uint64(0)
+	for i := 0; i < 3; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			startBlock,
+			startBlock+255,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		err := initApp.CheckpointKeeper.AddCheckpoint(ctx, uint64(i)+1, checkpoint)
+		require.NoError(t, err)
+		checkpoints = append(checkpoints, checkpoint)
+		startBlock += 256
+	}
+
+	result, err := grpcQuery.CheckpointsByBlockRange(sdk.WrapSDKContext(ctx), &types.QueryCheckpointsByBlockRangeRequest{
+		StartBlock: 255,
+		EndBlock:   256,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Checkpoints, 2)
+	require.Equal(t, uint64(1), result.Checkpoints[0].Number)
+	require.Equal(t, checkpoints[0], result.Checkpoints[0].Checkpoint)
+	require.Equal(t, uint64(2), result.Checkpoints[1].Number)
+	require.Equal(t, checkpoints[1], result.Checkpoints[1].Checkpoint)
+
+	_, err = grpcQuery.CheckpointsByBlockRange(sdk.WrapSDKContext(ctx), &types.QueryCheckpointsByBlockRangeRequest{
+		StartBlock: 10,
+		EndBlock:   5,
+	})
+	require.Error(t, err)
+}
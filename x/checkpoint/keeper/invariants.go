@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/x/checkpoint/types"
+)
+
+// RegisterInvariants registers all checkpoint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contiguous-checkpoints", ContiguousCheckpointsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "buffered-checkpoint", BufferedCheckpointInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "ack-count", AckCountInvariant(k))
+}
+
+// ContiguousCheckpointsInvariant checks that every acked checkpoint is stored and starts at previous checkpoint end + 1
+func ContiguousCheckpointsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		ackCount := k.GetACKCount(ctx)
+		for number := k.GetPrunedCheckpointCount(ctx) + 1; number <= ackCount; number++ {
+			checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+			if err != nil {
+				msg += fmt.Sprintf("\tcheckpoint %d is missing\n", number)
+				broken = true
+				continue
+			}
+
+			if checkpoint.StartBlock > checkpoint.EndBlock {
+				msg += fmt.Sprintf("\tcheckpoint %d starts at %d after its end %d\n", number, checkpoint.StartBlock, checkpoint.EndBlock)
+				broken = true
+			}
+
+			if number == 1 {
+				continue
+			}
+
+			prev, err := k.GetCheckpointByNumber(ctx, number-1)
+			if err == nil && checkpoint.StartBlock != prev.EndBlock+1 {
+				msg += fmt.Sprintf("\tcheckpoint %d starts at %d, previous checkpoint ends at %d\n", number, checkpoint.StartBlock, prev.EndBlock)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "contiguous-checkpoints", msg), broken
+	}
+}
+
+// BufferedCheckpointInvariant checks that checkpoint in buffer starts at last checkpoint end + 1
+func BufferedCheckpointInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		buffered, err := k.GetCheckpointFromBuffer(ctx)
+		if err == nil && buffered != nil && k.GetACKCount(ctx) > 0 {
+			lastCheckpoint, err := k.GetLastCheckpoint(ctx)
+			if err != nil {
+				msg = "\tlast checkpoint is missing\n"
+				broken = true
+			} else if buffered.StartBlock != lastCheckpoint.EndBlock+1 {
+				msg = fmt.Sprintf("\tbuffered checkpoint starts at %d, last checkpoint ends at %d\n", buffered.StartBlock, lastCheckpoint.EndBlock)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "buffered-checkpoint", msg), broken
+	}
+}
+
+// AckCountInvariant checks that ack count equals number of stored and pruned checkpoints
+func AckCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(k.storeKey)
+
+		iterator := sdk.KVStorePrefixIterator(store, CheckpointKey)
+		stored := uint64(0)
+		for ; iterator.Valid(); iterator.Next() {
+			stored++
+		}
+		iterator.Close()
+
+		ackCount := k.GetACKCount(ctx)
+		pruned := k.GetPrunedCheckpointCount(ctx)
+		broken := ackCount != stored+pruned
+
+		return sdk.FormatInvariant(types.ModuleName, "ack-count", fmt.Sprintf(
+			"\tack count: %d\n\tstored checkpoints: %d\n\tpruned checkpoints: %d\n", ackCount, stored, pruned,
+		)), broken
+	}
+}
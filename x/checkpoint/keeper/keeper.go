@@ -16,6 +16,7 @@ import (
 	stakingKeeper "github.com/maticnetwork/heimdall/x/staking/keeper"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/x/checkpoint/types"
 )
 
@@ -26,11 +27,23 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	CheckpointKey       = []byte{0x13} // prefix key for when storing checkpoint after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+
+	CheckpointBlockIndexKey  = []byte{0x15} // prefix key for child block to checkpoint number index
+	CheckpointTxHashIndexKey = []byte{0x16} // prefix key for rootchain ack tx hash to checkpoint number index
+	CheckpointTxHashKey      = []byte{0x17} // prefix key for checkpoint number to rootchain ack tx hash
+	PrunedCheckpointCountKey = []byte{0x18} // key to store number of pruned checkpoints
+
+	BufferCheckpointHeightKey = []byte{0x19} // key to store heimdall height account root hash of buffered checkpoint refers to
+	CheckpointHeightKey       = []byte{0x1a} // prefix key for checkpoint number to heimdall height its account root hash refers to
 )
 
+// MaxCheckpointsPrunedPerAck caps number of checkpoints pruned while processing single ack
+const MaxCheckpointsPrunedPerAck = 100
+
 // ModuleCommunicator manages different module interaction
 type ModuleCommunicator interface {
 	GetAllDividendAccounts(ctx sdk.Context) []*hmTypes.DividendAccount
+	PruneDividendAccountHistory(ctx sdk.Context, height int64)
 }
 
 type (
@@ -77,6 +90,7 @@ func (k *Keeper) AddCheckpoint(ctx sdk.Context, checkpointNumber uint64, checkpo
 	if err != nil {
 		return err
 	}
+	k.setCheckpointBlockIndex(ctx, checkpointNumber, checkpoint)
 	k.Logger(ctx).Info("Adding good checkpoint to state", "checkpoint", checkpoint, "checkpointNumber", checkpointNumber)
 	return nil
 }
@@ -125,6 +139,157 @@ func (k *Keeper) GetCheckpointByNumber(ctx sdk.Context, number uint64) (hmTypes.
 	}
 }
 
+// setCheckpointBlockIndex indexes checkpoint number by checkpoint end block
+func (k *Keeper) setCheckpointBlockIndex(ctx sdk.Context, checkpointNumber uint64, checkpoint *hmTypes.Checkpoint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCheckpointBlockIndexKey(checkpoint.EndBlock), sdk.Uint64ToBigEndian(checkpointNumber))
+}
+
+// MigrateCheckpointBlockIndex indexes checkpoints acked before child block index was introduced.
+// Rootchain ack tx hashes of those checkpoints are not in store, so they are not indexed by tx hash.
+func (k *Keeper) MigrateCheckpointBlockIndex(ctx sdk.Context) error {
+	k.IterateCheckpoints(ctx, func(checkpointNumber uint64, checkpoint hmTypes.Checkpoint) bool {
+		k.setCheckpointBlockIndex(ctx, checkpointNumber, &checkpoint)
+		return false
+	})
+
+	return nil
+}
+
+// GetCheckpointByBlock returns checkpoint number and checkpoint which covers given child block
+func (k *Keeper) GetCheckpointByBlock(ctx sdk.Context, blockNumber uint64) (uint64, hmTypes.Checkpoint, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// first indexed end block >= block number is the only candidate
+	iterator := store.Iterator(GetCheckpointBlockIndexKey(blockNumber), sdk.PrefixEndBytes(CheckpointBlockIndexKey))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, hmTypes.Checkpoint{}, errors.New("no checkpoint found for block")
+	}
+
+	checkpointNumber := sdk.BigEndianToUint64(iterator.Value())
+	checkpoint, err := k.GetCheckpointByNumber(ctx, checkpointNumber)
+	if err != nil {
+		return 0, hmTypes.Checkpoint{}, err
+	}
+
+	if checkpoint.StartBlock > blockNumber {
+		return 0, hmTypes.Checkpoint{}, errors.New("no checkpoint found for block")
+	}
+
+	return checkpointNumber, checkpoint, nil
+}
+
+// GetCheckpointsByBlockRange returns numbers and checkpoints overlapping given child block range,
+// at most limit checkpoints are returned
+func (k *Keeper) GetCheckpointsByBlockRange(ctx sdk.Context, startBlock uint64, endBlock uint64, limit int) ([]uint64, []*hmTypes.Checkpoint, error) {
+	if startBlock > endBlock {
+		return nil, nil, errors.New("start block is greater than end block")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// checkpoints are indexed by end block, so first overlapping checkpoint ends at or after start block
+	iterator := store.Iterator(GetCheckpointBlockIndexKey(startBlock), sdk.PrefixEndBytes(CheckpointBlockIndexKey))
+	defer iterator.Close()
+
+	var numbers []uint64
+	var checkpoints []*hmTypes.Checkpoint
+
+	for ; iterator.Valid() && len(checkpoints) < limit; iterator.Next() {
+		checkpointNumber := sdk.BigEndianToUint64(iterator.Value())
+		checkpoint, err := k.GetCheckpointByNumber(ctx, checkpointNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if checkpoint.StartBlock > endBlock {
+			break
+		}
+
+		numbers = append(numbers, checkpointNumber)
+		checkpoints = append(checkpoints, &checkpoint)
+	}
+
+	return numbers, checkpoints, nil
+}
+
+// SetCheckpointTxHashIndex indexes checkpoint number by rootchain ack tx hash
+func (k *Keeper) SetCheckpointTxHashIndex(ctx sdk.Context, txHash hmCommonTypes.HeimdallHash, checkpointNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCheckpointTxHashIndexKey(txHash), sdk.Uint64ToBigEndian(checkpointNumber))
+	store.Set(GetCheckpointTxHashKey(checkpointNumber), txHash.Bytes())
+}
+
+// GetCheckpointTxHash returns rootchain ack tx hash of checkpoint
+func (k *Keeper) GetCheckpointTxHash(ctx sdk.Context, checkpointNumber uint64) (hmCommonTypes.HeimdallHash, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := GetCheckpointTxHashKey(checkpointNumber)
+	if !store.Has(key) {
+		return hmCommonTypes.HeimdallHash{}, false
+	}
+
+	return hmCommonTypes.BytesToHeimdallHash(store.Get(key)), true
+}
+
+// GetCheckpointByTxHash returns checkpoint number and checkpoint acked by given rootchain tx hash
+func (k *Keeper) GetCheckpointByTxHash(ctx sdk.Context, txHash hmCommonTypes.HeimdallHash) (uint64, hmTypes.Checkpoint, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	key := GetCheckpointTxHashIndexKey(txHash)
+	if !store.Has(key) {
+		// checkpoints acked before tx hash index was introduced can not be looked up by tx hash
+		if first := k.firstTxHashIndexedCheckpoint(ctx); first > k.GetPrunedCheckpointCount(ctx)+1 {
+			return 0, hmTypes.Checkpoint{}, fmt.Errorf("no checkpoint found for tx hash, checkpoints acked before checkpoint %d are not indexed by tx hash", first)
+		}
+
+		return 0, hmTypes.Checkpoint{}, errors.New("no checkpoint found for tx hash")
+	}
+
+	checkpointNumber := sdk.BigEndianToUint64(store.Get(key))
+	checkpoint, err := k.GetCheckpointByNumber(ctx, checkpointNumber)
+	if err != nil {
+		return 0, hmTypes.Checkpoint{}, err
+	}
+
+	return checkpointNumber, checkpoint, nil
+}
+
+// firstTxHashIndexedCheckpoint returns lowest checkpoint number indexed by rootchain ack tx hash,
+// or next checkpoint number if no checkpoint is indexed
+func (k *Keeper) firstTxHashIndexedCheckpoint(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, CheckpointTxHashKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return k.GetACKCount(ctx) + 1
+	}
+
+	return sdk.BigEndianToUint64(iterator.Key()[len(CheckpointTxHashKey):])
+}
+
+// GetCheckpointTxHashes returns rootchain ack tx hashes of all checkpoints in store
+func (k *Keeper) GetCheckpointTxHashes(ctx sdk.Context) []types.CheckpointTxHash {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, CheckpointTxHashKey)
+	defer iterator.Close()
+
+	var txHashes []types.CheckpointTxHash
+	for ; iterator.Valid(); iterator.Next() {
+		txHashes = append(txHashes, types.CheckpointTxHash{
+			Number: sdk.BigEndianToUint64(iterator.Key()[len(CheckpointTxHashKey):]),
+			TxHash: hmCommonTypes.BytesToHeimdallHash(iterator.Value()).String(),
+		})
+	}
+
+	return txHashes
+}
+
 // GetCheckpointList returns all checkpoints with params like page and limit
 func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) ([]*hmTypes.Checkpoint, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -183,6 +348,94 @@ func GetCheckpointKey(checkpointNumber uint64) []byte {
 	return append(CheckpointKey, checkpointNumberBytes...)
 }
 
+// GetCheckpointBlockIndexKey appends prefix to checkpoint end block
+func GetCheckpointBlockIndexKey(endBlock uint64) []byte {
+	return append(CheckpointBlockIndexKey, sdk.Uint64ToBigEndian(endBlock)...)
+}
+
+// GetCheckpointTxHashIndexKey appends prefix to rootchain ack tx hash
+func GetCheckpointTxHashIndexKey(txHash hmCommonTypes.HeimdallHash) []byte {
+	return append(CheckpointTxHashIndexKey, txHash.Bytes()...)
+}
+
+// GetCheckpointTxHashKey appends prefix to checkpoint number
+func GetCheckpointTxHashKey(checkpointNumber uint64) []byte {
+	return append(CheckpointTxHashKey, sdk.Uint64ToBigEndian(checkpointNumber)...)
+}
+
+// GetCheckpointHeightKey appends prefix to checkpoint number
+func GetCheckpointHeightKey(checkpointNumber uint64) []byte {
+	return append(CheckpointHeightKey, sdk.Uint64ToBigEndian(checkpointNumber)...)
+}
+
+// SetCheckpointBufferHeight sets heimdall height whose state account root hash of buffered checkpoint was checked against
+func (k *Keeper) SetCheckpointBufferHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(BufferCheckpointHeightKey, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetCheckpointBufferHeight returns heimdall height account root hash of buffered checkpoint refers to
+func (k *Keeper) GetCheckpointBufferHeight(ctx sdk.Context) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(BufferCheckpointHeightKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetCheckpointHeight sets heimdall height account root hash of acked checkpoint refers to
+func (k *Keeper) SetCheckpointHeight(ctx sdk.Context, checkpointNumber uint64, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCheckpointHeightKey(checkpointNumber), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetCheckpointHeight returns heimdall height account root hash of acked checkpoint refers to.
+// Height is not known for checkpoints acked before it was recorded or imported from genesis.
+func (k *Keeper) GetCheckpointHeight(ctx sdk.Context, checkpointNumber uint64) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCheckpointHeightKey(checkpointNumber))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// GetOldestCheckpointHeight returns heimdall height account root hash of oldest acked checkpoint in state refers to.
+// Checkpoints without recorded height are skipped.
+func (k *Keeper) GetOldestCheckpointHeight(ctx sdk.Context) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, CheckpointHeightKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(iterator.Value())), true
+}
+
+// PruneDividendAccountHistory removes dividend account history not needed to read dividend accounts
+// at heights acked checkpoints in state refer to
+func (k *Keeper) PruneDividendAccountHistory(ctx sdk.Context) {
+	if height, found := k.GetOldestCheckpointHeight(ctx); found {
+		k.moduleCommunicator.PruneDividendAccountHistory(ctx, height)
+	}
+}
+
+// MatchesAccountRootHash checks if account root hash of current dividend accounts equals given one
+func (k *Keeper) MatchesAccountRootHash(ctx sdk.Context, accountRootHash string) bool {
+	accountRoot, err := types.GetAccountRootHash(k.moduleCommunicator.GetAllDividendAccounts(ctx))
+	if err != nil {
+		return false
+	}
+
+	return hmCommonTypes.BytesToHeimdallHash(accountRoot).String() == accountRootHash
+}
+
 // HasStoreValue check if value exists in store or not
 func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -193,6 +446,7 @@ func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(BufferCheckpointKey)
+	store.Delete(BufferCheckpointHeightKey)
 }
 
 // GetCheckpointFromBuffer gets checkpoint in buffer
@@ -254,6 +508,92 @@ func (k *Keeper) GetCheckpoints(ctx sdk.Context) []*hmTypes.Checkpoint {
 	return headers
 }
 
+// IterateCheckpoints iterates over checkpoints in state in checkpoint number order
+func (k *Keeper) IterateCheckpoints(ctx sdk.Context, handler func(checkpointNumber uint64, checkpoint hmTypes.Checkpoint) (stop bool)) {
+	ackCount := k.GetACKCount(ctx)
+	for number := k.GetPrunedCheckpointCount(ctx) + 1; number <= ackCount; number++ {
+		checkpoint, err := k.GetCheckpointByNumber(ctx, number)
+		if err != nil {
+			continue
+		}
+
+		if handler(number, checkpoint) {
+			break
+		}
+	}
+}
+
+//
+// Pruning
+//
+
+// GetPrunedCheckpointCount returns number of checkpoints pruned from state
+func (k Keeper) GetPrunedCheckpointCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(PrunedCheckpointCountKey) {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(store.Get(PrunedCheckpointCountKey))
+}
+
+// SetPrunedCheckpointCount sets number of checkpoints pruned from state
+func (k Keeper) SetPrunedCheckpointCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PrunedCheckpointCountKey, sdk.Uint64ToBigEndian(count))
+}
+
+// PruneCheckpoints removes checkpoints older than checkpoint retention acks, always keeping latest checkpoint.
+// Returns number of checkpoints pruned.
+func (k *Keeper) PruneCheckpoints(ctx sdk.Context) uint64 {
+	retention := k.GetParams(ctx).CheckpointRetention
+	if retention == 0 {
+		return 0
+	}
+
+	ackCount := k.GetACKCount(ctx)
+	if ackCount <= retention {
+		return 0
+	}
+
+	pruneTo := ackCount - retention
+	pruned := k.GetPrunedCheckpointCount(ctx)
+
+	count := uint64(0)
+	for pruned < pruneTo && count < MaxCheckpointsPrunedPerAck {
+		pruned++
+		k.deleteCheckpoint(ctx, pruned)
+		count++
+	}
+
+	if count > 0 {
+		k.SetPrunedCheckpointCount(ctx, pruned)
+		k.Logger(ctx).Info("Pruned checkpoints from state", "count", count, "prunedCheckpointCount", pruned)
+	}
+
+	return count
+}
+
+// deleteCheckpoint removes checkpoint and its indexes from store
+func (k *Keeper) deleteCheckpoint(ctx sdk.Context, checkpointNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	if checkpoint, err := k.GetCheckpointByNumber(ctx, checkpointNumber); err == nil {
+		blockIndexKey := GetCheckpointBlockIndexKey(checkpoint.EndBlock)
+		if store.Has(blockIndexKey) && sdk.BigEndianToUint64(store.Get(blockIndexKey)) == checkpointNumber {
+			store.Delete(blockIndexKey)
+		}
+	}
+
+	if txHash, ok := k.GetCheckpointTxHash(ctx, checkpointNumber); ok {
+		store.Delete(GetCheckpointTxHashIndexKey(txHash))
+		store.Delete(GetCheckpointTxHashKey(checkpointNumber))
+	}
+
+	store.Delete(GetCheckpointHeightKey(checkpointNumber))
+	store.Delete(GetCheckpointKey(checkpointNumber))
+}
+
 //
 // Ack count
 //
@@ -308,8 +648,12 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the checkpoint module's parameters, params missing in store keep default values
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSubspace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSubspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
 	return
 }
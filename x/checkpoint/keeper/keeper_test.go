@@ -7,8 +7,11 @@ import (
 	"github.com/maticnetwork/heimdall/x/checkpoint/test_helper"
 
 	checkpointKeeper "github.com/maticnetwork/heimdall/x/checkpoint/keeper"
+	"github.com/maticnetwork/heimdall/x/checkpoint/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/maticnetwork/heimdall/app"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
@@ -119,3 +122,305 @@ func (suite *KeeperTestSuite) TestFlushCheckpointBuffer() {
 	result := keeper.HasStoreValue(ctx, key)
 	require.False(t, result)
 }
+
+func (suite *KeeperTestSuite) TestGetCheckpointByBlock() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.CheckpointKeeper
+
+	startBlock := uint64(0)
+	for i := 0; i < 3; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			startBlock,
+			startBlock+255,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		err := keeper.AddCheckpoint(ctx, uint64(i)+1, checkpoint)
+		require.NoError(t, err)
+		startBlock += 256
+	}
+
+	number, checkpoint, err := keeper.GetCheckpointByBlock(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+	require.Equal(t, uint64(0), checkpoint.StartBlock)
+
+	number, checkpoint, err = keeper.GetCheckpointByBlock(ctx, 256)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+	require.Equal(t, uint64(256), checkpoint.StartBlock)
+
+	number, _, err = keeper.GetCheckpointByBlock(ctx, 767)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), number)
+
+	_, _, err = keeper.GetCheckpointByBlock(ctx, 768)
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestGetCheckpointsByBlockRange() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.CheckpointKeeper
+
+	startBlock := uint64(0)
+	for i := 0; i < 4; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			startBlock,
+			startBlock+99,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		err := keeper.AddCheckpoint(ctx, uint64(i)+1, checkpoint)
+		require.NoError(t, err)
+		startBlock += 100
+	}
+
+	numbers, checkpoints, err := keeper.GetCheckpointsByBlockRange(ctx, 150, 250, types.MaxCheckpointsByBlockRange)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, numbers)
+	require.Equal(t, uint64(100), checkpoints[0].StartBlock)
+	require.Equal(t, uint64(299), checkpoints[1].EndBlock)
+
+	numbers, _, err = keeper.GetCheckpointsByBlockRange(ctx, 0, 1000, types.MaxCheckpointsByBlockRange)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4}, numbers)
+
+	// result is capped by limit
+	numbers, _, err = keeper.GetCheckpointsByBlockRange(ctx, 0, 1000, 2)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, numbers)
+
+	numbers, _, err = keeper.GetCheckpointsByBlockRange(ctx, 400, 500, types.MaxCheckpointsByBlockRange)
+	require.NoError(t, err)
+	require.Empty(t, numbers)
+
+	_, _, err = keeper.GetCheckpointsByBlockRange(ctx, 10, 5, types.MaxCheckpointsByBlockRange)
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestGetCheckpointByTxHash() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.CheckpointKeeper
+
+	checkpoint := hmTypes.CreateBlock(
+		0,
+		255,
+		hmCommonTypes.HexToHeimdallHash("123"),
+		hmCommonTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	err := keeper.AddCheckpoint(ctx, 1, checkpoint)
+	require.NoError(t, err)
+
+	txHash := hmCommonTypes.HexToHeimdallHash("0xabcd")
+	keeper.SetCheckpointTxHashIndex(ctx, txHash, 1)
+
+	number, result, err := keeper.GetCheckpointByTxHash(ctx, txHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), number)
+	require.Equal(t, *checkpoint, result)
+
+	_, _, err = keeper.GetCheckpointByTxHash(ctx, hmCommonTypes.HexToHeimdallHash("0x1234"))
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestMigrateCheckpointBlockIndex() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.CheckpointKeeper
+	store := ctx.KVStore(initApp.GetKey(types.StoreKey))
+
+	// checkpoints acked before indexes were introduced
+	startBlock := uint64(0)
+	for i := 0; i < 3; i++ {
+		checkpoint := hmTypes.CreateBlock(
+			startBlock,
+			startBlock+99,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		err := keeper.AddCheckpoint(ctx, uint64(i)+1, checkpoint)
+		require.NoError(t, err)
+		store.Delete(checkpointKeeper.GetCheckpointBlockIndexKey(checkpoint.EndBlock))
+		keeper.UpdateACKCount(ctx)
+		startBlock += 100
+	}
+
+	_, _, err := keeper.GetCheckpointByBlock(ctx, 150)
+	require.Error(t, err)
+	_, _, err = keeper.GetCheckpointByTxHash(ctx, hmCommonTypes.HexToHeimdallHash("0xabcd"))
+	require.EqualError(t, err, "no checkpoint found for tx hash, checkpoints acked before checkpoint 4 are not indexed by tx hash")
+
+	require.NoError(t, keeper.MigrateCheckpointBlockIndex(ctx))
+
+	number, _, err := keeper.GetCheckpointByBlock(ctx, 150)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), number)
+
+	numbers, _, err := keeper.GetCheckpointsByBlockRange(ctx, 0, 1000, types.MaxCheckpointsByBlockRange)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, numbers)
+
+	// checkpoints acked after migration are indexed by tx hash
+	checkpoint := hmTypes.CreateBlock(
+		startBlock,
+		startBlock+99,
+		hmCommonTypes.HexToHeimdallHash("123"),
+		hmCommonTypes.HexToHeimdallAddress("123"),
+		"1234",
+		uint64(time.Now().Unix()),
+	)
+	err = keeper.AddCheckpoint(ctx, 4, checkpoint)
+	require.NoError(t, err)
+	keeper.SetCheckpointTxHashIndex(ctx, hmCommonTypes.HexToHeimdallHash("0xabcd"), 4)
+	keeper.UpdateACKCount(ctx)
+
+	number, _, err = keeper.GetCheckpointByTxHash(ctx, hmCommonTypes.HexToHeimdallHash("0xabcd"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), number)
+
+	_, _, err = keeper.GetCheckpointByTxHash(ctx, hmCommonTypes.HexToHeimdallHash("0x1234"))
+	require.EqualError(t, err, "no checkpoint found for tx hash, checkpoints acked before checkpoint 4 are not indexed by tx hash")
+
+	// no pre-index checkpoints left once they are pruned
+	keeper.SetPrunedCheckpointCount(ctx, 3)
+	_, _, err = keeper.GetCheckpointByTxHash(ctx, hmCommonTypes.HexToHeimdallHash("0x1234"))
+	require.EqualError(t, err, "no checkpoint found for tx hash")
+}
+
+func (suite *KeeperTestSuite) TestPruneCheckpoints() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.CheckpointKeeper
+
+	startBlock := uint64(0)
+	for i := 0; i < 5; i++ {
+		number := uint64(i) + 1
+		checkpoint := hmTypes.CreateBlock(
+			startBlock,
+			startBlock+99,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+		err := keeper.AddCheckpoint(ctx, number, checkpoint)
+		require.NoError(t, err)
+		keeper.SetCheckpointTxHashIndex(ctx, hmCommonTypes.BytesToHeimdallHash([]byte{byte(number)}), number)
+		keeper.UpdateACKCount(ctx)
+		startBlock += 100
+	}
+
+	// retention disabled by default
+	require.Equal(t, uint64(0), keeper.PruneCheckpoints(ctx))
+
+	params := keeper.GetParams(ctx)
+	params.CheckpointRetention = 2
+	keeper.SetParams(ctx, params)
+
+	require.Equal(t, uint64(3), keeper.PruneCheckpoints(ctx))
+	require.Equal(t, uint64(3), keeper.GetPrunedCheckpointCount(ctx))
+	require.Equal(t, uint64(0), keeper.PruneCheckpoints(ctx))
+
+	for number := uint64(1); number <= 3; number++ {
+		_, err := keeper.GetCheckpointByNumber(ctx, number)
+		require.Error(t, err)
+		_, _, err = keeper.GetCheckpointByTxHash(ctx, hmCommonTypes.BytesToHeimdallHash([]byte{byte(number)}))
+		require.Error(t, err)
+		_, ok := keeper.GetCheckpointTxHash(ctx, number)
+		require.False(t, ok)
+	}
+
+	_, _, err := keeper.GetCheckpointByBlock(ctx, 50)
+	require.Error(t, err)
+
+	// latest checkpoints are kept
+	number, _, err := keeper.GetCheckpointByBlock(ctx, 450)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), number)
+	_, err = keeper.GetLastCheckpoint(ctx)
+	require.NoError(t, err)
+
+	var numbers []uint64
+	keeper.IterateCheckpoints(ctx, func(checkpointNumber uint64, _ hmTypes.Checkpoint) bool {
+		numbers = append(numbers, checkpointNumber)
+		return false
+	})
+	require.Equal(t, []uint64{4, 5}, numbers)
+	require.Len(t, keeper.GetCheckpoints(ctx), 2)
+}
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.CheckpointKeeper
+
+	invariants := app.InvariantRegistry{}
+	checkpointKeeper.RegisterInvariants(&invariants, keeper)
+
+	createCheckpoint := func(start, end uint64) *hmTypes.Checkpoint {
+		return hmTypes.CreateBlock(
+			start,
+			end,
+			hmCommonTypes.HexToHeimdallHash("123"),
+			hmCommonTypes.HexToHeimdallAddress("123"),
+			"1234",
+			uint64(time.Now().Unix()),
+		)
+	}
+
+	for i := uint64(0); i < 3; i++ {
+		require.NoError(t, keeper.AddCheckpoint(ctx, i+1, createCheckpoint(i*100, i*100+99)))
+		keeper.UpdateACKCount(ctx)
+	}
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, createCheckpoint(300, 399)))
+
+	require.Empty(t, invariants.CheckInvariants(ctx))
+
+	// pruned checkpoints are accounted for
+	params := keeper.GetParams(ctx)
+	params.CheckpointRetention = 1
+	keeper.SetParams(ctx, params)
+	keeper.PruneCheckpoints(ctx)
+	require.Empty(t, invariants.CheckInvariants(ctx))
+
+	// buffered checkpoint not following last checkpoint
+	require.NoError(t, keeper.SetCheckpointBuffer(ctx, createCheckpoint(350, 399)))
+	_, broken := checkpointKeeper.BufferedCheckpointInvariant(keeper)(ctx)
+	require.True(t, broken)
+	keeper.FlushCheckpointBuffer(ctx)
+
+	// gap between checkpoints
+	require.NoError(t, keeper.AddCheckpoint(ctx, 4, createCheckpoint(310, 399)))
+	keeper.UpdateACKCount(ctx)
+	msg, broken := checkpointKeeper.ContiguousCheckpointsInvariant(keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "checkpoint 4 starts at 310")
+
+	// ack count ahead of stored checkpoints
+	keeper.UpdateACKCount(ctx)
+	_, broken = checkpointKeeper.AckCountInvariant(keeper)(ctx)
+	require.True(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestGetParamsMissingKeys() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.CheckpointKeeper
+
+	params := types.DefaultParams()
+	params.ChildBlockInterval = 1000
+	params.CheckpointRetention = 10
+	keeper.SetParams(ctx, params)
+
+	// params added by upgrade are missing in store of earlier version
+	paramsStore := prefix.NewStore(ctx.KVStore(initApp.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	paramsStore.Delete(types.KeyCheckpointRetention)
+
+	result := keeper.GetParams(ctx)
+	require.Equal(t, uint64(1000), result.ChildBlockInterval)
+	require.Equal(t, types.DefaultCheckpointRetention, result.CheckpointRetention)
+}
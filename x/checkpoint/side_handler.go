@@ -192,17 +192,28 @@ func PostHandleMsgCheckpoint(ctx sdk.Context, k keeper.Keeper, msg types.MsgChec
 
 	// Add checkpoint to buffer with root hash and account hash
 	err = k.SetCheckpointBuffer(ctx, &hmTypes.Checkpoint{
-		StartBlock: msg.StartBlock,
-		EndBlock:   msg.EndBlock,
-		RootHash:   string(msg.RootHash),
-		Proposer:   msg.Proposer,
-		BorChainID: msg.BorChainID,
-		TimeStamp:  timeStamp,
+		StartBlock:      msg.StartBlock,
+		EndBlock:        msg.EndBlock,
+		RootHash:        string(msg.RootHash),
+		AccountRootHash: msg.AccountRootHash,
+		Proposer:        msg.Proposer,
+		BorChainID:      msg.BorChainID,
+		TimeStamp:       timeStamp,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// Side txs are post handled before txs of block, so state checked is the one at end of previous height.
+	// Height is only recorded if dividend accounts didn't change since account root hash was validated.
+	height := ctx.BlockHeight() - 1
+	if k.MatchesAccountRootHash(ctx, msg.AccountRootHash) {
+		k.SetCheckpointBufferHeight(ctx, height)
+	}
+
+	// Reference validator set which signs checkpoint, ack may come after validator updates
+	k.Sk.SetBufferedCheckpointValidatorSet(ctx, height)
+
 	logger.Debug("New checkpoint into buffer stored",
 		"startBlock", msg.StartBlock,
 		"endBlock", msg.EndBlock,
@@ -292,6 +303,14 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k keeper.Keeper, msg types.MsgC
 	}
 	logger.Debug("Checkpoint added to store", "checkpointNumber", msg.Number)
 
+	// Index checkpoint by rootchain tx hash
+	k.SetCheckpointTxHashIndex(ctx, hmCommonTypes.HexToHeimdallHash(msg.TxHash), msg.Number)
+
+	// Keep height account root hash of checkpoint refers to
+	if height, found := k.GetCheckpointBufferHeight(ctx); found {
+		k.SetCheckpointHeight(ctx, msg.Number, height)
+	}
+
 	// Flush buffer
 	k.FlushCheckpointBuffer(ctx)
 	logger.Debug("Checkpoint buffer flushed after receiving checkpoint ack")
@@ -300,6 +319,15 @@ func PostHandleMsgCheckpointAck(ctx sdk.Context, k keeper.Keeper, msg types.MsgC
 	k.UpdateACKCount(ctx)
 	logger.Info("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
 
+	// Prune checkpoints older than retention
+	k.PruneCheckpoints(ctx)
+
+	// Prune dividend account history older than retained checkpoints refer to
+	k.PruneDividendAccountHistory(ctx)
+
+	// Reference validator set snapshot recorded when checkpoint was buffered
+	k.Sk.SetCheckpointValidatorSet(ctx, msg.Number)
+
 	// Increment accum (selects new proposer)
 	k.Sk.IncrementAccum(ctx, 1)
 
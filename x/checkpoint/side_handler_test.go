@@ -301,6 +301,7 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpoint() {
 		require.Equal(t, bufferedHeader.StartBlock, header.StartBlock)
 		require.Equal(t, bufferedHeader.EndBlock, header.EndBlock)
 		require.Equal(t, bufferedHeader.RootHash, header.RootHash)
+		require.Equal(t, bufferedHeader.AccountRootHash, msgCheckpoint.AccountRootHash)
 		require.Equal(t, bufferedHeader.Proposer, header.Proposer)
 		require.Equal(t, bufferedHeader.BorChainID, header.BorChainID)
 		require.Empty(t, err, "Unable to set checkpoint from buffer, Error: %v", err)
@@ -399,6 +400,17 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 
 		afterAckBufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
 		require.Nil(t, afterAckBufferedCheckpoint)
+
+		number, checkpoint, err := keeper.GetCheckpointByTxHash(ctx, hmCommonTypes.HexToHeimdallHash("123123"))
+		require.NoError(t, err)
+		require.Equal(t, checkpointNumber, number)
+		require.Equal(t, header.StartBlock, checkpoint.StartBlock)
+		require.Equal(t, header.EndBlock, checkpoint.EndBlock)
+
+		// validator set which signed checkpoint is referenced
+		_, validatorSet, err := initApp.StakingKeeper.GetValidatorSetForCheckpoint(ctx, checkpointNumber)
+		require.NoError(t, err)
+		require.Len(t, validatorSet.Validators, 2)
 	})
 
 	suite.Run("Replay", func() {
@@ -461,3 +473,75 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointAck() {
 		require.Nil(t, afterAckBufferedCheckpoint)
 	})
 }
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgCheckpointHeight() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx.WithBlockHeight(10)
+	keeper := initApp.CheckpointKeeper
+
+	params := keeper.GetParams(ctx)
+	header, err := chSim.GenRandCheckpoint(0, 256, params.MaxCheckpointLength)
+	require.NoError(t, err)
+	chSim.LoadValidatorSet(2, t, initApp.StakingKeeper, ctx, false, 10)
+	initApp.StakingKeeper.IncrementAccum(ctx, 1)
+
+	dividendAccount := hmTypes.DividendAccount{
+		User:      hmCommonTypes.HexToHeimdallAddress("123").String(),
+		FeeAmount: big.NewInt(10).String(),
+	}
+	require.NoError(t, initApp.TopupKeeper.AddDividendAccount(ctx.WithBlockHeight(9), dividendAccount))
+	accountRoot, err := types.GetAccountRootHash([]*hmTypes.DividendAccount{&dividendAccount})
+	require.NoError(t, err)
+
+	proposer, err := sdk.AccAddressFromHex(header.Proposer)
+	require.NoError(t, err)
+
+	// account root hash not matching current dividend accounts has no height
+	msgCheckpoint := types.NewMsgCheckpointBlock(
+		proposer,
+		header.StartBlock,
+		header.EndBlock,
+		hmCommonTypes.HexToHeimdallHash(header.RootHash),
+		hmCommonTypes.HexToHeimdallHash(header.RootHash),
+		"1234",
+	)
+	_, err = suite.postHandler(ctx, &msgCheckpoint, abci.SideTxResultType_YES)
+	require.NoError(t, err)
+	_, found := keeper.GetCheckpointBufferHeight(ctx)
+	require.False(t, found)
+	keeper.FlushCheckpointBuffer(ctx)
+
+	// matching account root hash refers to state at end of previous height
+	msgCheckpoint.AccountRootHash = hmCommonTypes.BytesToHeimdallHash(accountRoot).String()
+	_, err = suite.postHandler(ctx, &msgCheckpoint, abci.SideTxResultType_YES)
+	require.NoError(t, err)
+	height, found := keeper.GetCheckpointBufferHeight(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(9), height)
+
+	// validator set changes before ack
+	initApp.StakingKeeper.IncrementAccum(ctx.WithBlockHeight(11), 1)
+
+	msgCheckpointAck := types.NewMsgCheckpointAck(
+		proposer,
+		1,
+		proposer,
+		header.StartBlock,
+		header.EndBlock,
+		hmCommonTypes.HexToHeimdallHash(header.RootHash),
+		hmCommonTypes.HexToHeimdallHash("123123"),
+		uint64(1),
+	)
+	_, err = suite.postHandler(ctx.WithBlockHeight(12), &msgCheckpointAck, abci.SideTxResultType_YES)
+	require.NoError(t, err)
+
+	height, found = keeper.GetCheckpointHeight(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, int64(9), height)
+	_, found = keeper.GetCheckpointBufferHeight(ctx)
+	require.False(t, found)
+
+	// validator set snapshot recorded when checkpoint was buffered is referenced
+	snapshotHeight, _, err := initApp.StakingKeeper.GetValidatorSetForCheckpoint(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), snapshotHeight)
+}
@@ -29,7 +29,7 @@ func CreateTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, client.Contex
 
 	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
 
-	params := types.NewParams(5*time.Second, 256, 1024, 10000)
+	params := types.NewParams(5*time.Second, 256, 1024, 10000, 0)
 
 	Checkpoints := make([]hmTypes.Checkpoint, 0)
 
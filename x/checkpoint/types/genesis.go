@@ -42,7 +42,7 @@ func (gs GenesisState) Validate() error {
 	}
 
 	if len(gs.Checkpoints) != 0 {
-		if int(gs.AckCount) != len(gs.Checkpoints) {
+		if int(gs.AckCount) < len(gs.Checkpoints) {
 			return errors.New("Incorrect state in state-dump , Please Check")
 		}
 	}
@@ -33,6 +33,9 @@ type Params struct {
 	AvgCheckpointLength  uint64        `protobuf:"varint,2,opt,name=avg_checkpoint_length,json=avgCheckpointLength,proto3" json:"avg_checkpoint_length,omitempty" yaml:"avg_checkpoint_length"`
 	MaxCheckpointLength  uint64        `protobuf:"varint,3,opt,name=max_checkpoint_length,json=maxCheckpointLength,proto3" json:"max_checkpoint_length,omitempty" yaml:"max_checkpoint_length"`
 	ChildBlockInterval   uint64        `protobuf:"varint,4,opt,name=child_block_interval,json=childBlockInterval,proto3" json:"child_block_interval,omitempty" yaml:"child_block_interval"`
+	// checkpoint_retention is number of latest acked checkpoints kept in
+	// state, 0 keeps all checkpoints
+	CheckpointRetention uint64 `protobuf:"varint,5,opt,name=checkpoint_retention,json=checkpointRetention,proto3" json:"checkpoint_retention,omitempty" yaml:"checkpoint_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -74,6 +77,7 @@ type GenesisState struct {
 	LastNoACK          uint64              `protobuf:"varint,3,opt,name=last_no_ack,json=lastNoAck,proto3" json:"last_no_ack,omitempty" yaml:"last_no_ack"`
 	AckCount           uint64              `protobuf:"varint,4,opt,name=ack_count,json=ackCount,proto3" json:"ack_count,omitempty" yaml:"ack_count"`
 	Checkpoints        []*types.Checkpoint `protobuf:"bytes,5,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	CheckpointTxHashes []CheckpointTxHash  `protobuf:"bytes,6,rep,name=checkpoint_tx_hashes,json=checkpointTxHashes,proto3" json:"checkpoint_tx_hashes" yaml:"checkpoint_tx_hashes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,9 +113,63 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// CheckpointTxHash is rootchain ack tx hash of checkpoint
+type CheckpointTxHash struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+}
+
+func (m *CheckpointTxHash) Reset()         { *m = CheckpointTxHash{} }
+func (m *CheckpointTxHash) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTxHash) ProtoMessage()    {}
+func (*CheckpointTxHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74f23451aca0c1ff, []int{2}
+}
+func (m *CheckpointTxHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointTxHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointTxHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointTxHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTxHash.Merge(m, src)
+}
+func (m *CheckpointTxHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointTxHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTxHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTxHash proto.InternalMessageInfo
+
+func (m *CheckpointTxHash) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *CheckpointTxHash) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "heimdall.checkpoint.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "heimdall.checkpoint.v1beta1.GenesisState")
+	proto.RegisterType((*CheckpointTxHash)(nil), "heimdall.checkpoint.v1beta1.CheckpointTxHash")
 }
 
 func init() {
@@ -119,42 +177,49 @@ func init() {
 }
 
 var fileDescriptor_74f23451aca0c1ff = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xb5, 0xdb, 0x10, 0x35, 0x1b, 0x0e, 0x68, 0x13, 0x50, 0x48, 0xc1, 0x1b, 0x39, 0x97, 0xf6,
-	0x62, 0x2b, 0xed, 0xad, 0xe2, 0x52, 0x07, 0x09, 0x21, 0x0a, 0x02, 0xd3, 0x13, 0x17, 0x6b, 0xbc,
-	0xd9, 0xd8, 0x96, 0x3f, 0x36, 0xb2, 0x37, 0x21, 0xe5, 0x17, 0x70, 0x41, 0xe2, 0xd8, 0x63, 0x7f,
-	0x4e, 0x8f, 0x3d, 0x72, 0x32, 0x28, 0xf9, 0x07, 0xfe, 0x01, 0x08, 0xc5, 0x76, 0xe2, 0x88, 0x46,
-	0xbd, 0xad, 0xdf, 0xbc, 0x79, 0xcf, 0x33, 0x6f, 0x17, 0x1d, 0xbb, 0xcc, 0x0b, 0x47, 0x10, 0x04,
-	0x3a, 0x75, 0x19, 0xf5, 0x27, 0xdc, 0x8b, 0x84, 0x3e, 0x1b, 0xd8, 0x4c, 0xc0, 0x40, 0x77, 0x58,
-	0xc4, 0x12, 0x2f, 0xd1, 0x26, 0x31, 0x17, 0x1c, 0x1f, 0xae, 0xa9, 0x5a, 0x45, 0xd5, 0x4a, 0x6a,
-	0xb7, 0xed, 0x70, 0x87, 0xe7, 0x3c, 0x7d, 0x75, 0x2a, 0x5a, 0xba, 0x8a, 0xc3, 0xb9, 0x13, 0x30,
-	0x3d, 0xff, 0xb2, 0xa7, 0x63, 0x7d, 0x34, 0x8d, 0x41, 0x78, 0x3c, 0x2a, 0xeb, 0xfd, 0x8d, 0xbb,
-	0x0d, 0x09, 0xdb, 0xf8, 0xba, 0x0c, 0x46, 0x2c, 0x2e, 0x7d, 0xd5, 0x1f, 0xfb, 0xa8, 0xfe, 0x11,
-	0x62, 0x08, 0x13, 0xfc, 0x0d, 0x3d, 0xab, 0xbc, 0x2d, 0x7b, 0x3a, 0x1e, 0xb3, 0xd8, 0x12, 0x5e,
-	0xc8, 0x3a, 0x72, 0x4f, 0x3e, 0x6a, 0x9e, 0x3c, 0xd7, 0x0a, 0x43, 0x6d, 0x6d, 0xa8, 0xbd, 0x2e,
-	0x0d, 0x8d, 0xe3, 0xdb, 0x94, 0x48, 0x59, 0x4a, 0x5e, 0x5e, 0x41, 0x18, 0x9c, 0xa9, 0xbb, 0x65,
-	0xd4, 0xeb, 0xdf, 0x44, 0x36, 0xdb, 0x55, 0xd1, 0xc8, 0x6b, 0x97, 0x5e, 0xc8, 0xf0, 0x25, 0x7a,
-	0x0a, 0x33, 0xc7, 0xda, 0x6a, 0x0c, 0x58, 0xe4, 0x08, 0xb7, 0xb3, 0xd7, 0x93, 0x8f, 0x6a, 0x46,
-	0x2f, 0x4b, 0xc9, 0x8b, 0x42, 0x7b, 0x27, 0x4d, 0x35, 0x5b, 0x30, 0x73, 0x86, 0x1b, 0xf8, 0x22,
-	0x47, 0x57, 0xaa, 0x21, 0xcc, 0x77, 0xa8, 0xee, 0xff, 0xaf, 0xba, 0x93, 0xa6, 0x9a, 0xad, 0x10,
-	0xe6, 0xf7, 0x54, 0x3f, 0xa1, 0x36, 0x75, 0xbd, 0x60, 0x64, 0xd9, 0x01, 0xa7, 0xbe, 0xe5, 0x45,
-	0x82, 0xc5, 0x33, 0x08, 0x3a, 0xb5, 0x5c, 0x94, 0x64, 0x29, 0x39, 0x5c, 0xaf, 0xe1, 0x3e, 0x4b,
-	0x35, 0x71, 0x0e, 0x1b, 0x2b, 0xf4, 0x6d, 0x09, 0x9e, 0x1d, 0x7c, 0xbf, 0x21, 0xd2, 0xf5, 0x0d,
-	0x91, 0xd4, 0xbf, 0x7b, 0xe8, 0xf1, 0x9b, 0xe2, 0x66, 0x7c, 0x16, 0x20, 0x18, 0x3e, 0x47, 0xf5,
-	0x49, 0x9e, 0x4f, 0x99, 0x42, 0x5f, 0x7b, 0xe0, 0xa6, 0x68, 0x45, 0x94, 0x46, 0x6d, 0x95, 0x87,
-	0x59, 0x36, 0x62, 0x1f, 0xb5, 0x8a, 0x18, 0xd8, 0x68, 0x6b, 0xc8, 0x7c, 0xb5, 0xcd, 0x93, 0x6e,
-	0xa5, 0x27, 0xae, 0x26, 0x2c, 0xd1, 0xaa, 0x79, 0x0d, 0x25, 0x4b, 0x49, 0xb7, 0x98, 0x65, 0x87,
-	0x80, 0x6a, 0xe2, 0x35, 0x5a, 0xf5, 0xe0, 0x21, 0x6a, 0x06, 0x90, 0x08, 0x2b, 0xe2, 0x16, 0x50,
-	0xbf, 0xdc, 0x74, 0x7f, 0x91, 0x92, 0xc6, 0x05, 0x24, 0xe2, 0x03, 0x3f, 0x1f, 0xbe, 0xcb, 0x52,
-	0x82, 0x0b, 0xd5, 0x2d, 0xa6, 0x6a, 0x36, 0x82, 0x82, 0x40, 0x7d, 0x3c, 0x40, 0x0d, 0xa0, 0xbe,
-	0x45, 0xf9, 0x34, 0x12, 0xe5, 0x5e, 0xdb, 0x59, 0x4a, 0x9e, 0x94, 0x57, 0x60, 0x5d, 0x52, 0xcd,
-	0x03, 0xa0, 0xfe, 0x70, 0x75, 0xc4, 0xaf, 0x50, 0xb3, 0xfa, 0xb5, 0xa4, 0xf3, 0xa8, 0xb7, 0xff,
-	0xf0, 0x70, 0xe6, 0x36, 0x7d, 0x13, 0x80, 0x6c, 0xbc, 0xbf, 0x5d, 0x28, 0xf2, 0xdd, 0x42, 0x91,
-	0xff, 0x2c, 0x14, 0xf9, 0xe7, 0x52, 0x91, 0xee, 0x96, 0x8a, 0xf4, 0x6b, 0xa9, 0x48, 0x5f, 0x4e,
-	0x1d, 0x4f, 0xb8, 0x53, 0x5b, 0xa3, 0x3c, 0xd4, 0x43, 0x10, 0x1e, 0x8d, 0x98, 0xf8, 0xca, 0x63,
-	0x5f, 0xdf, 0xbc, 0xb3, 0xf9, 0xf6, 0x3b, 0xcf, 0x0d, 0xed, 0x7a, 0xfe, 0x58, 0x4e, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x46, 0x29, 0x0d, 0xe6, 0x0b, 0x04, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x63, 0x9a, 0x86, 0x66, 0x83, 0x50, 0xb5, 0x09, 0x55, 0x48, 0xc1, 0x8e, 0x9c, 0x4b,
+	0x2b, 0x84, 0xad, 0xb6, 0xb7, 0x8a, 0x4b, 0x1d, 0x24, 0x40, 0x14, 0x04, 0x4b, 0x25, 0x24, 0x2e,
+	0xd6, 0xda, 0xd9, 0xda, 0x96, 0xff, 0x6c, 0x64, 0x6f, 0x42, 0xca, 0x19, 0x24, 0x8e, 0x1c, 0x7b,
+	0xec, 0xe3, 0xf4, 0xd8, 0x23, 0x27, 0x03, 0xe9, 0x1b, 0xf8, 0x09, 0x50, 0xd6, 0x1b, 0x3b, 0x6d,
+	0xa2, 0xde, 0xec, 0x99, 0x6f, 0x7e, 0xe3, 0x9d, 0x6f, 0xbc, 0x60, 0xd7, 0x25, 0x5e, 0x38, 0xc0,
+	0x41, 0xa0, 0xdb, 0x2e, 0xb1, 0xfd, 0x21, 0xf5, 0x22, 0xa6, 0x8f, 0xf7, 0x2c, 0xc2, 0xf0, 0x9e,
+	0xee, 0x90, 0x88, 0x24, 0x5e, 0xa2, 0x0d, 0x63, 0xca, 0x28, 0xdc, 0x9e, 0x4b, 0xb5, 0x52, 0xaa,
+	0x09, 0x69, 0xa7, 0xe5, 0x50, 0x87, 0x72, 0x9d, 0x3e, 0x7b, 0xca, 0x4b, 0x3a, 0xb2, 0x43, 0xa9,
+	0x13, 0x10, 0x9d, 0xbf, 0x59, 0xa3, 0x53, 0x7d, 0x30, 0x8a, 0x31, 0xf3, 0x68, 0x24, 0xf2, 0xbd,
+	0xa2, 0xbb, 0x85, 0x13, 0x52, 0xf4, 0x75, 0x09, 0x1e, 0x90, 0x58, 0xf4, 0x55, 0xff, 0xad, 0x81,
+	0xda, 0x07, 0x1c, 0xe3, 0x30, 0x81, 0xdf, 0xc0, 0x56, 0xd9, 0xdb, 0xb4, 0x46, 0xa7, 0xa7, 0x24,
+	0x36, 0x99, 0x17, 0x92, 0xb6, 0xd4, 0x95, 0x76, 0x1a, 0xfb, 0x8f, 0xb5, 0xbc, 0xa1, 0x36, 0x6f,
+	0xa8, 0xbd, 0x14, 0x0d, 0x8d, 0xdd, 0xcb, 0x54, 0xa9, 0x64, 0xa9, 0xf2, 0xf4, 0x0c, 0x87, 0xc1,
+	0xa1, 0xba, 0x1a, 0xa3, 0x9e, 0xff, 0x51, 0x24, 0xd4, 0x2a, 0x93, 0x06, 0xcf, 0x9d, 0x78, 0x21,
+	0x81, 0x27, 0xe0, 0x11, 0x1e, 0x3b, 0xe6, 0x42, 0x61, 0x40, 0x22, 0x87, 0xb9, 0xed, 0x7b, 0x5d,
+	0x69, 0xa7, 0x6a, 0x74, 0xb3, 0x54, 0x79, 0x92, 0xb3, 0x57, 0xca, 0x54, 0xd4, 0xc4, 0x63, 0xa7,
+	0x5f, 0x84, 0x8f, 0x79, 0x74, 0x46, 0x0d, 0xf1, 0x64, 0x05, 0x75, 0xed, 0x36, 0x75, 0xa5, 0x4c,
+	0x45, 0xcd, 0x10, 0x4f, 0x96, 0xa8, 0x1f, 0x41, 0xcb, 0x76, 0xbd, 0x60, 0x60, 0x5a, 0x01, 0xb5,
+	0x7d, 0xd3, 0x8b, 0x18, 0x89, 0xc7, 0x38, 0x68, 0x57, 0x39, 0x54, 0xc9, 0x52, 0x65, 0x7b, 0x3e,
+	0x86, 0x65, 0x95, 0x8a, 0x20, 0x0f, 0x1b, 0xb3, 0xe8, 0x1b, 0x11, 0x84, 0x08, 0x2c, 0x8c, 0xc5,
+	0x8c, 0x09, 0x23, 0xd1, 0x6c, 0xae, 0xed, 0xf5, 0x65, 0xe4, 0xb2, 0x4a, 0x45, 0xcd, 0x32, 0x8c,
+	0xe6, 0xd1, 0xc3, 0x8d, 0x9f, 0x17, 0x4a, 0xe5, 0xfc, 0x42, 0xa9, 0xa8, 0x3f, 0xaa, 0xe0, 0xc1,
+	0xab, 0x7c, 0xdb, 0x3e, 0x31, 0xcc, 0x08, 0x3c, 0x02, 0xb5, 0x21, 0xf7, 0x5c, 0x38, 0xdb, 0xd3,
+	0xee, 0xd8, 0x3e, 0x2d, 0x5f, 0x0f, 0xa3, 0x3a, 0xf3, 0x18, 0x89, 0x42, 0xe8, 0x83, 0x66, 0x6e,
+	0x2d, 0x19, 0x2c, 0x0c, 0x8e, 0xdb, 0xd5, 0xd8, 0xef, 0x94, 0x3c, 0x76, 0x36, 0x24, 0x89, 0x56,
+	0xce, 0xd0, 0x90, 0xb3, 0x54, 0xe9, 0xe4, 0x87, 0x59, 0x01, 0x50, 0x11, 0x9c, 0x47, 0xcb, 0x1a,
+	0xd8, 0x07, 0x8d, 0x00, 0x27, 0xcc, 0x8c, 0xa8, 0x89, 0x6d, 0x5f, 0xb8, 0xd7, 0x9b, 0xa6, 0x4a,
+	0xfd, 0x18, 0x27, 0xec, 0x3d, 0x3d, 0xea, 0xbf, 0xcd, 0x52, 0x05, 0xe6, 0xd4, 0x05, 0xa5, 0x8a,
+	0xea, 0x41, 0x2e, 0xb0, 0x7d, 0xb8, 0x07, 0xea, 0xd8, 0xf6, 0x4d, 0x9b, 0x8e, 0x22, 0x26, 0xbc,
+	0x6a, 0x65, 0xa9, 0xb2, 0x99, 0x57, 0x15, 0x29, 0x15, 0x6d, 0x60, 0xdb, 0xef, 0xcf, 0x1e, 0xe1,
+	0x0b, 0xd0, 0x28, 0x3f, 0x2d, 0x69, 0xaf, 0x77, 0xd7, 0xee, 0x3e, 0x1c, 0x5a, 0x94, 0xc3, 0xef,
+	0xd2, 0x0d, 0x57, 0xd9, 0xc4, 0x74, 0x71, 0xe2, 0x92, 0xa4, 0x5d, 0xe3, 0x9c, 0xe7, 0x77, 0x0e,
+	0xbd, 0x84, 0x9e, 0x4c, 0x5e, 0xe3, 0xc4, 0x35, 0x7a, 0xe2, 0x17, 0x5b, 0x5e, 0x84, 0x02, 0xcc,
+	0x77, 0xeb, 0x66, 0x19, 0x49, 0x8a, 0x3d, 0x90, 0xd4, 0xcf, 0x60, 0xf3, 0x36, 0x16, 0x6e, 0x81,
+	0x5a, 0x34, 0x0a, 0x2d, 0x12, 0xf3, 0x55, 0xa8, 0x22, 0xf1, 0x06, 0x9f, 0x81, 0xfb, 0x82, 0xcb,
+	0x3d, 0xad, 0x1b, 0x30, 0x4b, 0x95, 0x87, 0x79, 0x6f, 0x91, 0x50, 0x51, 0x8d, 0xe5, 0xdf, 0xf6,
+	0xee, 0x72, 0x2a, 0x4b, 0x57, 0x53, 0x59, 0xfa, 0x3b, 0x95, 0xa5, 0x5f, 0xd7, 0x72, 0xe5, 0xea,
+	0x5a, 0xae, 0xfc, 0xbe, 0x96, 0x2b, 0x5f, 0x0e, 0x1c, 0x8f, 0xb9, 0x23, 0x4b, 0xb3, 0x69, 0xa8,
+	0x87, 0x98, 0x79, 0x76, 0x44, 0xd8, 0x57, 0x1a, 0xfb, 0x7a, 0x71, 0x37, 0x4d, 0x16, 0xef, 0x46,
+	0x3e, 0x50, 0xab, 0xc6, 0x2f, 0x98, 0x83, 0xff, 0x03, 0x00, 0xdc, 0x5f, 0x81, 0x3b, 0x3f, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -177,6 +242,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CheckpointRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CheckpointRetention))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.ChildBlockInterval != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ChildBlockInterval))
 		i--
@@ -223,6 +293,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CheckpointTxHashes) > 0 {
+		for iNdEx := len(m.CheckpointTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CheckpointTxHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Checkpoints) > 0 {
 		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +356,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CheckpointTxHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointTxHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointTxHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Number != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -300,6 +419,9 @@ func (m *Params) Size() (n int) {
 	if m.ChildBlockInterval != 0 {
 		n += 1 + sovGenesis(uint64(m.ChildBlockInterval))
 	}
+	if m.CheckpointRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.CheckpointRetention))
+	}
 	return n
 }
 
@@ -327,6 +449,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.CheckpointTxHashes) > 0 {
+		for _, e := range m.CheckpointTxHashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointTxHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovGenesis(uint64(m.Number))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -455,6 +599,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointRetention", wireType)
+			}
+			m.CheckpointRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -649,6 +812,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTxHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointTxHashes = append(m.CheckpointTxHashes, CheckpointTxHash{})
+			if err := m.CheckpointTxHashes[len(m.CheckpointTxHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointTxHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointTxHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointTxHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -15,6 +15,12 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_capability"
+
+	// MaxCheckpointsByBlockRange is max number of checkpoints returned for child block range
+	MaxCheckpointsByBlockRange = 100
+
+	// ConsensusVersion is version of checkpoint store layout, store is migrated to it by app store migrations
+	ConsensusVersion uint64 = 2
 )
 
 func KeyPrefix(p string) []byte {
@@ -3,10 +3,13 @@ package types
 import (
 	"bytes"
 	"errors"
+	"math/big"
 
 	"github.com/cbergoon/merkletree"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	ethCrypto "github.com/maticnetwork/bor/crypto"
 	"github.com/tendermint/crypto/sha3"
 
 	"github.com/maticnetwork/heimdall/helper"
@@ -105,6 +108,96 @@ func VerifyAccountProof(dividendAccounts []*hmTypes.DividendAccount, userAddr sd
 	return false, nil
 }
 
+// VerifyAccountProofWithRoot checks if merkle proof of dividend account at index in account tree leads to account root hash
+func VerifyAccountProofWithRoot(dividendAccount *hmTypes.DividendAccount, index uint64, proof []byte, accountRootHash []byte) (bool, error) {
+	if len(proof)%32 != 0 {
+		return false, errors.New("invalid proof length")
+	}
+
+	hash, err := dividendAccount.CalculateHash()
+	if err != nil {
+		return false, err
+	}
+
+	// account tree pairs odd node with itself, so its sibling in proof is node itself
+	for i := 0; i < len(proof); i += 32 {
+		h := sha3.NewLegacyKeccak256()
+		if index%2 == 0 {
+			h.Write(hash)
+			h.Write(proof[i : i+32])
+		} else {
+			h.Write(proof[i : i+32])
+			h.Write(hash)
+		}
+		hash = h.Sum(nil)
+		index /= 2
+	}
+
+	return bytes.Equal(hash, accountRootHash), nil
+}
+
+// GetHeaderLeaf returns leaf of child block header in checkpoint root hash tree
+func GetHeaderLeaf(header *ethTypes.Header) []byte {
+	return ethCrypto.Keccak256(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// GetHeaderProof returns checkpoint root hash of headers and inclusion proof of header at index
+func GetHeaderProof(headers []*ethTypes.Header, index uint64) ([]byte, []byte, error) {
+	if index >= uint64(len(headers)) {
+		return nil, nil, errors.New("header index out of range")
+	}
+
+	// leaves are padded with empty leaves upto next power of two
+	level := make([][]byte, nextPowerOfTwo(uint64(len(headers))))
+	for i := range level {
+		if i < len(headers) {
+			level[i] = GetHeaderLeaf(headers[i])
+		} else {
+			level[i] = make([]byte, 32)
+		}
+	}
+
+	var proof []byte
+	for len(level) > 1 {
+		proof = append(proof, level[index^1]...)
+
+		next := make([][]byte, len(level)/2)
+		for i := range next {
+			next[i] = ethCrypto.Keccak256(level[2*i], level[2*i+1])
+		}
+
+		level = next
+		index /= 2
+	}
+
+	return level[0], proof, nil
+}
+
+// VerifyHeaderProof checks inclusion proof of leaf at index against checkpoint root hash
+func VerifyHeaderProof(leaf []byte, index uint64, rootHash []byte, proof []byte) bool {
+	if len(proof)%32 != 0 {
+		return false
+	}
+
+	computed := leaf
+	for i := 0; i < len(proof); i += 32 {
+		sibling := proof[i : i+32]
+		if index%2 == 0 {
+			computed = ethCrypto.Keccak256(computed, sibling)
+		} else {
+			computed = ethCrypto.Keccak256(sibling, computed)
+		}
+		index /= 2
+	}
+
+	return bytes.Equal(computed, rootHash)
+}
+
 //
 //func convert(input []([32]byte)) [][]byte {
 //	var output [][]byte
@@ -136,22 +229,22 @@ func appendBytes32(data ...[]byte) []byte {
 	return result
 }
 
-//
-//func nextPowerOfTwo(n uint64) uint64 {
-//	if n == 0 {
-//		return 1
-//	}
-//	// http://graphics.stanford.edu/~seander/bithacks.html#RoundUpPowerOf2
-//	n--
-//	n |= n >> 1
-//	n |= n >> 2
-//	n |= n >> 4
-//	n |= n >> 8
-//	n |= n >> 16
-//	n |= n >> 32
-//	n++
-//	return n
-//}
+func nextPowerOfTwo(n uint64) uint64 {
+	if n == 0 {
+		return 1
+	}
+	// http://graphics.stanford.edu/~seander/bithacks.html#RoundUpPowerOf2
+	n--
+	n |= n >> 1
+	n |= n >> 2
+	n |= n >> 4
+	n |= n >> 8
+	n |= n >> 16
+	n |= n >> 32
+	n++
+	return n
+}
+
 //
 //// spins go-routines to fetch batch elements to allow creation of large merkle trees
 //func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem, checkpointLength uint64) (err error) {
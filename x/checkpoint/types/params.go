@@ -15,6 +15,7 @@ const (
 	DefaultAvgCheckpointLength  uint64        = 256
 	DefaultMaxCheckpointLength  uint64        = 1024
 	DefaultChildBlockInterval   uint64        = 10000
+	DefaultCheckpointRetention  uint64        = 0 // keep all checkpoints
 )
 
 // Parameter keys
@@ -23,6 +24,7 @@ var (
 	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
 	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
 	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+	KeyCheckpointRetention  = []byte("CheckpointRetention")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -33,12 +35,14 @@ func NewParams(
 	checkpointLength uint64,
 	maxCheckpointLength uint64,
 	childBlockInterval uint64,
+	checkpointRetention uint64,
 ) Params {
 	return Params{
 		CheckpointBufferTime: checkpointBufferTime,
 		AvgCheckpointLength:  checkpointLength,
 		MaxCheckpointLength:  maxCheckpointLength,
 		ChildBlockInterval:   childBlockInterval,
+		CheckpointRetention:  checkpointRetention,
 	}
 }
 
@@ -56,6 +60,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAvgCheckpointLength, &p.AvgCheckpointLength, validateAvgCheckpointLength),
 		paramtypes.NewParamSetPair(KeyMaxCheckpointLength, &p.MaxCheckpointLength, validateMaxCheckpointLength),
 		paramtypes.NewParamSetPair(KeyChildBlockInterval, &p.ChildBlockInterval, validateChildBlockInterval),
+		paramtypes.NewParamSetPair(KeyCheckpointRetention, &p.CheckpointRetention, validateCheckpointRetention),
 	}
 }
 
@@ -73,6 +78,7 @@ func DefaultParams() Params {
 		AvgCheckpointLength:  DefaultAvgCheckpointLength,
 		MaxCheckpointLength:  DefaultMaxCheckpointLength,
 		ChildBlockInterval:   DefaultChildBlockInterval,
+		CheckpointRetention:  DefaultCheckpointRetention,
 	}
 }
 
@@ -84,6 +90,7 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
 	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
 	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("CheckpointRetention: %d\n", p.CheckpointRetention))
 	return sb.String()
 }
 
@@ -143,3 +150,12 @@ func validateChildBlockInterval(i interface{}) error {
 
 	return nil
 }
+
+func validateCheckpointRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
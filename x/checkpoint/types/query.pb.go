@@ -738,6 +738,381 @@ func (m *QueryLatestCheckpointResponse) GetLatestCheckpoint() *types.Checkpoint
 	return nil
 }
 
+// QueryBlockProofRequest is request for child block inclusion proof
+type QueryBlockProofRequest struct {
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (m *QueryBlockProofRequest) Reset()         { *m = QueryBlockProofRequest{} }
+func (m *QueryBlockProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProofRequest) ProtoMessage()    {}
+func (*QueryBlockProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{17}
+}
+func (m *QueryBlockProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProofRequest.Merge(m, src)
+}
+func (m *QueryBlockProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProofRequest proto.InternalMessageInfo
+
+func (m *QueryBlockProofRequest) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+// QueryBlockProofResponse is response for child block inclusion proof
+type QueryBlockProofResponse struct {
+	CheckpointNumber uint64            `protobuf:"varint,1,opt,name=checkpoint_number,json=checkpointNumber,proto3" json:"checkpoint_number,omitempty"`
+	Checkpoint       *types.Checkpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Index            uint64            `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	HeaderHash       string            `protobuf:"bytes,4,opt,name=header_hash,json=headerHash,proto3" json:"header_hash,omitempty"`
+	Proof            string            `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryBlockProofResponse) Reset()         { *m = QueryBlockProofResponse{} }
+func (m *QueryBlockProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProofResponse) ProtoMessage()    {}
+func (*QueryBlockProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{18}
+}
+func (m *QueryBlockProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProofResponse.Merge(m, src)
+}
+func (m *QueryBlockProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProofResponse proto.InternalMessageInfo
+
+func (m *QueryBlockProofResponse) GetCheckpointNumber() uint64 {
+	if m != nil {
+		return m.CheckpointNumber
+	}
+	return 0
+}
+
+func (m *QueryBlockProofResponse) GetCheckpoint() *types.Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *QueryBlockProofResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *QueryBlockProofResponse) GetHeaderHash() string {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return ""
+}
+
+func (m *QueryBlockProofResponse) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+// IndexedCheckpoint is checkpoint along with its checkpoint number and
+// rootchain ack tx hash (if known)
+type IndexedCheckpoint struct {
+	Number     uint64            `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Checkpoint *types.Checkpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	TxHash     string            `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *IndexedCheckpoint) Reset()         { *m = IndexedCheckpoint{} }
+func (m *IndexedCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*IndexedCheckpoint) ProtoMessage()    {}
+func (*IndexedCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{19}
+}
+func (m *IndexedCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedCheckpoint.Merge(m, src)
+}
+func (m *IndexedCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedCheckpoint proto.InternalMessageInfo
+
+func (m *IndexedCheckpoint) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *IndexedCheckpoint) GetCheckpoint() *types.Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *IndexedCheckpoint) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryCheckpointByTxHashRequest is request for checkpoint by rootchain tx hash
+type QueryCheckpointByTxHashRequest struct {
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QueryCheckpointByTxHashRequest) Reset()         { *m = QueryCheckpointByTxHashRequest{} }
+func (m *QueryCheckpointByTxHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointByTxHashRequest) ProtoMessage()    {}
+func (*QueryCheckpointByTxHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{20}
+}
+func (m *QueryCheckpointByTxHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointByTxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointByTxHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointByTxHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointByTxHashRequest.Merge(m, src)
+}
+func (m *QueryCheckpointByTxHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointByTxHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointByTxHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointByTxHashRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointByTxHashRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// QueryCheckpointByTxHashResponse is response for checkpoint by rootchain tx
+// hash
+type QueryCheckpointByTxHashResponse struct {
+	Checkpoint *IndexedCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (m *QueryCheckpointByTxHashResponse) Reset()         { *m = QueryCheckpointByTxHashResponse{} }
+func (m *QueryCheckpointByTxHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointByTxHashResponse) ProtoMessage()    {}
+func (*QueryCheckpointByTxHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{21}
+}
+func (m *QueryCheckpointByTxHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointByTxHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointByTxHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointByTxHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointByTxHashResponse.Merge(m, src)
+}
+func (m *QueryCheckpointByTxHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointByTxHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointByTxHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointByTxHashResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointByTxHashResponse) GetCheckpoint() *IndexedCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+// QueryCheckpointsByBlockRangeRequest is request for checkpoints by child block
+// range
+type QueryCheckpointsByBlockRangeRequest struct {
+	StartBlock uint64 `protobuf:"varint,1,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock   uint64 `protobuf:"varint,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *QueryCheckpointsByBlockRangeRequest) Reset()         { *m = QueryCheckpointsByBlockRangeRequest{} }
+func (m *QueryCheckpointsByBlockRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointsByBlockRangeRequest) ProtoMessage()    {}
+func (*QueryCheckpointsByBlockRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{22}
+}
+func (m *QueryCheckpointsByBlockRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointsByBlockRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointsByBlockRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointsByBlockRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointsByBlockRangeRequest.Merge(m, src)
+}
+func (m *QueryCheckpointsByBlockRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointsByBlockRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointsByBlockRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointsByBlockRangeRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointsByBlockRangeRequest) GetStartBlock() uint64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *QueryCheckpointsByBlockRangeRequest) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+// QueryCheckpointsByBlockRangeResponse is response for checkpoints by child
+// block range
+type QueryCheckpointsByBlockRangeResponse struct {
+	Checkpoints []*IndexedCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+}
+
+func (m *QueryCheckpointsByBlockRangeResponse) Reset()         { *m = QueryCheckpointsByBlockRangeResponse{} }
+func (m *QueryCheckpointsByBlockRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointsByBlockRangeResponse) ProtoMessage()    {}
+func (*QueryCheckpointsByBlockRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e67796a25ee620ee, []int{23}
+}
+func (m *QueryCheckpointsByBlockRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointsByBlockRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointsByBlockRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointsByBlockRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointsByBlockRangeResponse.Merge(m, src)
+}
+func (m *QueryCheckpointsByBlockRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointsByBlockRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointsByBlockRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointsByBlockRangeResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointsByBlockRangeResponse) GetCheckpoints() []*IndexedCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.checkpoint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.checkpoint.v1beta1.QueryParamsResponse")
@@ -756,6 +1131,13 @@ func init() {
 	proto.RegisterType((*QueryBorChainID)(nil), "heimdall.checkpoint.v1beta1.QueryBorChainID")
 	proto.RegisterType((*QueryLatestCheckpointRequest)(nil), "heimdall.checkpoint.v1beta1.QueryLatestCheckpointRequest")
 	proto.RegisterType((*QueryLatestCheckpointResponse)(nil), "heimdall.checkpoint.v1beta1.QueryLatestCheckpointResponse")
+	proto.RegisterType((*QueryBlockProofRequest)(nil), "heimdall.checkpoint.v1beta1.QueryBlockProofRequest")
+	proto.RegisterType((*QueryBlockProofResponse)(nil), "heimdall.checkpoint.v1beta1.QueryBlockProofResponse")
+	proto.RegisterType((*IndexedCheckpoint)(nil), "heimdall.checkpoint.v1beta1.IndexedCheckpoint")
+	proto.RegisterType((*QueryCheckpointByTxHashRequest)(nil), "heimdall.checkpoint.v1beta1.QueryCheckpointByTxHashRequest")
+	proto.RegisterType((*QueryCheckpointByTxHashResponse)(nil), "heimdall.checkpoint.v1beta1.QueryCheckpointByTxHashResponse")
+	proto.RegisterType((*QueryCheckpointsByBlockRangeRequest)(nil), "heimdall.checkpoint.v1beta1.QueryCheckpointsByBlockRangeRequest")
+	proto.RegisterType((*QueryCheckpointsByBlockRangeResponse)(nil), "heimdall.checkpoint.v1beta1.QueryCheckpointsByBlockRangeResponse")
 }
 
 func init() {
@@ -763,62 +1145,82 @@ func init() {
 }
 
 var fileDescriptor_e67796a25ee620ee = []byte{
-	// 867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x97, 0x25, 0x6a, 0x5e, 0xa4, 0x76, 0x19, 0x4a, 0x77, 0xe5, 0x2e, 0x0e, 0xb8, 0x2a,
-	0x94, 0x85, 0xd8, 0x69, 0x52, 0x89, 0x52, 0x71, 0x69, 0x02, 0x5a, 0x21, 0x75, 0x57, 0x10, 0x2e,
-	0x88, 0x4b, 0x34, 0x76, 0xa7, 0x8e, 0x65, 0xc7, 0x93, 0x7a, 0x26, 0xd0, 0x0a, 0x71, 0xe1, 0x04,
-	0x37, 0x24, 0x2e, 0x1c, 0xcb, 0x85, 0x3b, 0x07, 0x90, 0xf8, 0x07, 0x3d, 0x56, 0xe2, 0xc2, 0x09,
-	0xa1, 0x96, 0x03, 0x3f, 0x03, 0x65, 0x3c, 0x8e, 0x1d, 0x27, 0x75, 0x6d, 0x6e, 0xee, 0x9b, 0xf7,
-	0xbe, 0xf7, 0xbd, 0xf7, 0xbe, 0x7e, 0x0a, 0xbc, 0x39, 0x24, 0xee, 0xe8, 0x18, 0xfb, 0xbe, 0x69,
-	0x0f, 0x89, 0xed, 0x8d, 0xa9, 0x1b, 0x70, 0xf3, 0x8b, 0x5d, 0x8b, 0x70, 0xbc, 0x6b, 0x9e, 0x4e,
-	0x48, 0x78, 0x6e, 0x8c, 0x43, 0xca, 0x29, 0xda, 0x8c, 0x13, 0x8d, 0x24, 0xd1, 0x90, 0x89, 0xea,
-	0x5b, 0x79, 0x28, 0x0e, 0x09, 0x08, 0x73, 0x59, 0x84, 0xa3, 0x6e, 0xe7, 0xa5, 0x8e, 0x98, 0x23,
-	0xd3, 0x1e, 0x3b, 0x94, 0x3a, 0x3e, 0x31, 0xf1, 0xd8, 0x35, 0x71, 0x10, 0x50, 0x8e, 0xb9, 0x4b,
-	0x83, 0x18, 0x64, 0x6b, 0x06, 0x62, 0x61, 0x46, 0x66, 0xe5, 0x43, 0x82, 0x8f, 0x49, 0x18, 0x27,
-	0xbd, 0xbe, 0x3c, 0x29, 0x35, 0x94, 0xba, 0xee, 0x50, 0x87, 0x8a, 0x4f, 0x73, 0xfa, 0x15, 0x45,
-	0xf5, 0x75, 0x40, 0x9f, 0x4c, 0x93, 0x3e, 0xc6, 0x21, 0x1e, 0xb1, 0x3e, 0x39, 0x9d, 0x10, 0xc6,
-	0xf5, 0xcf, 0xe0, 0xe5, 0xb9, 0x28, 0x1b, 0xd3, 0x80, 0x11, 0x74, 0x08, 0xd5, 0xb1, 0x88, 0x3c,
-	0x52, 0x5e, 0x53, 0x76, 0xea, 0xed, 0x2d, 0x23, 0x67, 0x51, 0x46, 0x54, 0xdc, 0xbd, 0x7f, 0xf9,
-	0x57, 0xa3, 0xd2, 0x97, 0x85, 0xfa, 0x06, 0xac, 0x0b, 0xe4, 0x43, 0xdb, 0xeb, 0xd1, 0x49, 0xc0,
-	0xe3, 0x8e, 0x7b, 0xf0, 0x4a, 0x26, 0x2e, 0x7b, 0x6e, 0x42, 0x0d, 0xdb, 0xde, 0xc0, 0x9e, 0x06,
-	0x45, 0xdb, 0xfb, 0xfd, 0x15, 0x2c, 0x93, 0xf4, 0x16, 0x6c, 0x88, 0xaa, 0xde, 0xac, 0xbb, 0xc4,
-	0x43, 0x1b, 0x50, 0x0d, 0x26, 0x23, 0x8b, 0x84, 0xb2, 0x46, 0xfe, 0xa5, 0x87, 0xf0, 0x70, 0xa1,
-	0xa2, 0x40, 0x27, 0x74, 0x00, 0x90, 0x8c, 0xf8, 0xe8, 0x9e, 0x18, 0x5f, 0x4d, 0xc6, 0xe7, 0xe7,
-	0x63, 0xc2, 0x8c, 0x14, 0x68, 0x2a, 0x5b, 0xd7, 0xe0, 0x71, 0xa6, 0x67, 0x77, 0x72, 0x72, 0x42,
-	0xc2, 0x78, 0xf6, 0x21, 0xbc, 0x7a, 0xcb, 0xbb, 0x64, 0xf6, 0x14, 0x5e, 0x4a, 0xe0, 0x06, 0x96,
-	0x78, 0x94, 0x27, 0xc8, 0xe3, 0xf0, 0xc0, 0xce, 0x00, 0xea, 0x0f, 0xe5, 0x96, 0x8f, 0x30, 0xe3,
-	0xcf, 0xe9, 0xa1, 0xed, 0xc5, 0x14, 0xf6, 0xe5, 0x22, 0x53, 0x0f, 0xb2, 0xb7, 0x06, 0x75, 0x1f,
-	0x33, 0x3e, 0x08, 0xe8, 0x00, 0xdb, 0x9e, 0xdc, 0x4b, 0xcd, 0x8f, 0xf3, 0x74, 0x1b, 0xd4, 0x0c,
-	0xf9, 0x23, 0x97, 0xcd, 0xce, 0xf0, 0x21, 0xc0, 0x18, 0x3b, 0x6e, 0x20, 0x14, 0x2d, 0x29, 0x6f,
-	0x67, 0x29, 0x4b, 0xa9, 0xc5, 0x69, 0x52, 0x74, 0xa9, 0x42, 0xdd, 0x82, 0xcd, 0xa5, 0x4d, 0x24,
-	0xc7, 0x1e, 0xac, 0xa5, 0xf6, 0xe3, 0xbb, 0x6c, 0x7a, 0xbf, 0x17, 0xee, 0xd8, 0xce, 0xaa, 0x3d,
-	0x07, 0xa6, 0x3f, 0x95, 0x83, 0x3c, 0x27, 0x67, 0x7c, 0x51, 0x4f, 0x0d, 0xa8, 0x5b, 0x34, 0x1c,
-	0xd8, 0x43, 0xec, 0x06, 0x1f, 0x7d, 0x20, 0x26, 0xa9, 0xf5, 0xc1, 0xa2, 0x61, 0x2f, 0x8a, 0x1c,
-	0xac, 0x7c, 0x7b, 0xd1, 0xa8, 0xfc, 0x7b, 0xd1, 0xa8, 0xe8, 0xa1, 0x24, 0x9b, 0x05, 0x92, 0x64,
-	0x3f, 0x85, 0xb5, 0x80, 0x9c, 0xf1, 0x41, 0x4a, 0x4e, 0xd1, 0x5e, 0x9e, 0xe4, 0xfe, 0x37, 0x3d,
-	0x63, 0x4e, 0x9a, 0x7c, 0x30, 0x07, 0xae, 0xbf, 0x0f, 0x6b, 0xa2, 0x67, 0x77, 0x46, 0xa8, 0x0c,
-	0xe3, 0x58, 0xa0, 0x47, 0x98, 0x13, 0xb6, 0x38, 0xfc, 0x4c, 0xa0, 0x8b, 0xef, 0x89, 0x40, 0x7d,
-	0xf1, 0xb6, 0x38, 0x55, 0xae, 0x40, 0xfd, 0x0c, 0x60, 0xfb, 0xbb, 0x3a, 0xbc, 0x28, 0x5a, 0xa1,
-	0x1f, 0x15, 0xa8, 0x46, 0x4a, 0x40, 0x66, 0xee, 0x62, 0x16, 0xed, 0x4b, 0x6d, 0x15, 0x2f, 0x88,
-	0x06, 0xd0, 0xdf, 0xfe, 0xe6, 0x8f, 0x7f, 0x7e, 0xb8, 0xb7, 0x8d, 0xb6, 0xcc, 0x3c, 0xcb, 0x8e,
-	0x3c, 0x0c, 0xfd, 0xa4, 0xc0, 0x4a, 0xec, 0x53, 0x68, 0xf7, 0xee, 0x5e, 0x19, 0xaf, 0x53, 0xdb,
-	0x65, 0x4a, 0x24, 0x41, 0x43, 0x10, 0xdc, 0x41, 0x6f, 0xe4, 0x12, 0xc4, 0xb6, 0xd7, 0x14, 0xfe,
-	0x85, 0x7e, 0x51, 0x00, 0x92, 0xbd, 0xa2, 0xce, 0xdd, 0x2d, 0x17, 0xce, 0xae, 0xee, 0x95, 0x2b,
-	0x92, 0x4c, 0xf7, 0x05, 0xd3, 0x36, 0x6a, 0xe5, 0x32, 0x4d, 0x85, 0xbe, 0x8a, 0xac, 0xf9, 0x6b,
-	0xf4, 0x9b, 0x02, 0x0f, 0xb2, 0x1e, 0x88, 0xde, 0x2b, 0x43, 0x62, 0xce, 0x57, 0xd5, 0x83, 0xff,
-	0x53, 0x5a, 0x4a, 0x10, 0x91, 0x15, 0xa3, 0x9f, 0x15, 0xa8, 0xcd, 0x9c, 0x13, 0x15, 0x38, 0x6f,
-	0xd6, 0x7f, 0xd5, 0x4e, 0xa9, 0x1a, 0xc9, 0xb1, 0x25, 0x38, 0x3e, 0x41, 0x3b, 0xb9, 0x1c, 0xa7,
-	0x56, 0xdd, 0x0c, 0x68, 0x13, 0xdb, 0x1e, 0xfa, 0x55, 0x81, 0xd5, 0x79, 0x0f, 0x45, 0xef, 0x96,
-	0x59, 0x52, 0xca, 0xda, 0xd5, 0xfd, 0xf2, 0x85, 0xa5, 0x78, 0x27, 0x21, 0x86, 0x7e, 0x57, 0x60,
-	0x75, 0xde, 0x4e, 0x8b, 0xf0, 0x5e, 0xea, 0xe4, 0x45, 0x78, 0x2f, 0x77, 0x6e, 0x7d, 0x4f, 0xf0,
-	0x36, 0xd0, 0x3b, 0xb9, 0xbc, 0xa7, 0xce, 0xdc, 0x4c, 0xe2, 0x42, 0xd5, 0x59, 0xe3, 0x2c, 0xa2,
-	0xea, 0x5b, 0xcc, 0xb8, 0x88, 0xaa, 0x6f, 0xf3, 0xe9, 0x82, 0xaa, 0x8e, 0x5c, 0xb9, 0xfb, 0xec,
-	0xf2, 0x5a, 0x53, 0xae, 0xae, 0x35, 0xe5, 0xef, 0x6b, 0x4d, 0xf9, 0xfe, 0x46, 0xab, 0x5c, 0xdd,
-	0x68, 0x95, 0x3f, 0x6f, 0xb4, 0xca, 0xe7, 0x1d, 0xc7, 0xe5, 0xc3, 0x89, 0x65, 0xd8, 0x74, 0x64,
-	0x8e, 0x30, 0x77, 0xed, 0x80, 0xf0, 0x2f, 0x69, 0xe8, 0x25, 0xa8, 0x67, 0x69, 0x5c, 0xe1, 0xfb,
-	0x56, 0x55, 0xfc, 0xe0, 0xec, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xb0, 0x1e, 0x1e, 0x86,
-	0x0b, 0x00, 0x00,
+	// 1185 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0x23, 0x45,
+	0x13, 0xf6, 0xe4, 0xeb, 0x4d, 0x2a, 0xaf, 0x92, 0xdd, 0x26, 0x24, 0xd6, 0x64, 0x71, 0xd8, 0x09,
+	0x81, 0xb0, 0x8b, 0x67, 0xf2, 0x25, 0x48, 0xb2, 0x7b, 0x20, 0x0e, 0x68, 0x59, 0x29, 0x1b, 0x85,
+	0xc0, 0x01, 0x71, 0xb1, 0xda, 0xe3, 0x8e, 0x3d, 0xb2, 0x3d, 0xe3, 0x4c, 0xb7, 0xc1, 0x51, 0x14,
+	0x09, 0x71, 0xe2, 0x88, 0xc4, 0x85, 0xe3, 0x72, 0xe1, 0x0e, 0x12, 0x48, 0xfc, 0x02, 0xf6, 0x46,
+	0x24, 0x2e, 0x48, 0x48, 0x08, 0x25, 0x1c, 0xf8, 0x19, 0x68, 0xba, 0x7b, 0x3c, 0x5f, 0xf1, 0xc4,
+	0x93, 0xdc, 0x3c, 0xd5, 0xf5, 0x54, 0x3d, 0xd5, 0x5d, 0xd5, 0xfd, 0x18, 0xde, 0xa8, 0x13, 0xab,
+	0x55, 0xc5, 0xcd, 0xa6, 0x61, 0xd6, 0x89, 0xd9, 0x68, 0x3b, 0x96, 0xcd, 0x8c, 0xcf, 0x56, 0x2b,
+	0x84, 0xe1, 0x55, 0xe3, 0xb8, 0x43, 0xdc, 0x13, 0xbd, 0xed, 0x3a, 0xcc, 0x41, 0xf3, 0xbe, 0xa3,
+	0x1e, 0x38, 0xea, 0xd2, 0x51, 0x7d, 0x33, 0x2d, 0x4a, 0x8d, 0xd8, 0x84, 0x5a, 0x54, 0xc4, 0x51,
+	0x97, 0xd2, 0x5c, 0x5b, 0xb4, 0x26, 0xdd, 0xee, 0xd5, 0x1c, 0xa7, 0xd6, 0x24, 0x06, 0x6e, 0x5b,
+	0x06, 0xb6, 0x6d, 0x87, 0x61, 0x66, 0x39, 0xb6, 0x1f, 0x64, 0xb1, 0x17, 0xa4, 0x82, 0x29, 0xe9,
+	0xc1, 0xeb, 0x04, 0x57, 0x89, 0xeb, 0x3b, 0xdd, 0xbf, 0xda, 0x29, 0x54, 0x94, 0x3a, 0x53, 0x73,
+	0x6a, 0x0e, 0xff, 0x69, 0x78, 0xbf, 0x84, 0x55, 0x9b, 0x01, 0xf4, 0xa1, 0xe7, 0x74, 0x80, 0x5d,
+	0xdc, 0xa2, 0x87, 0xe4, 0xb8, 0x43, 0x28, 0xd3, 0x3e, 0x81, 0x97, 0x22, 0x56, 0xda, 0x76, 0x6c,
+	0x4a, 0xd0, 0x0e, 0x8c, 0xb5, 0xb9, 0x25, 0xaf, 0xbc, 0xaa, 0x2c, 0x4f, 0xae, 0x2d, 0xea, 0x29,
+	0x1b, 0xa5, 0x0b, 0x70, 0x69, 0xe4, 0xc5, 0x5f, 0x0b, 0xb9, 0x43, 0x09, 0xd4, 0x66, 0x61, 0x86,
+	0x47, 0xde, 0x31, 0x1b, 0xbb, 0x4e, 0xc7, 0x66, 0x7e, 0xc6, 0x0d, 0x78, 0x39, 0x66, 0x97, 0x39,
+	0xe7, 0x61, 0x02, 0x9b, 0x8d, 0xb2, 0xe9, 0x19, 0x79, 0xda, 0x91, 0xc3, 0x71, 0x2c, 0x9d, 0xb4,
+	0x15, 0x98, 0xe5, 0xa8, 0xdd, 0x5e, 0x76, 0x19, 0x0f, 0xcd, 0xc2, 0x98, 0xdd, 0x69, 0x55, 0x88,
+	0x2b, 0x31, 0xf2, 0x4b, 0x73, 0x61, 0x2e, 0x81, 0x18, 0x20, 0x13, 0xda, 0x06, 0x08, 0x4a, 0xcc,
+	0x0f, 0xf1, 0xf2, 0xd5, 0xa0, 0x7c, 0x76, 0xd2, 0x26, 0x54, 0x0f, 0x05, 0x0d, 0x79, 0x6b, 0x05,
+	0xb8, 0x17, 0xcb, 0x59, 0xea, 0x1c, 0x1d, 0x11, 0xd7, 0xaf, 0xbd, 0x0e, 0xaf, 0xf4, 0x59, 0x97,
+	0xcc, 0x9e, 0xc0, 0xdd, 0x20, 0x5c, 0xb9, 0xc2, 0x17, 0xf3, 0xca, 0xb5, 0x1c, 0xee, 0x98, 0xb1,
+	0x80, 0xda, 0x9c, 0xdc, 0xe5, 0x3d, 0x4c, 0xd9, 0xbe, 0xb3, 0x63, 0x36, 0x7c, 0x0a, 0x9b, 0x30,
+	0x1b, 0x5f, 0x90, 0xb9, 0x0b, 0x30, 0xd9, 0xc4, 0x94, 0x95, 0x6d, 0xa7, 0x8c, 0xcd, 0x86, 0xdc,
+	0x97, 0x89, 0xa6, 0xef, 0xa7, 0x99, 0xa0, 0xc6, 0xc8, 0xef, 0x59, 0xb4, 0x77, 0x0c, 0xef, 0x03,
+	0xb4, 0x71, 0xcd, 0xb2, 0x79, 0x47, 0x4b, 0xca, 0x4b, 0x71, 0xca, 0xb2, 0xd5, 0x7c, 0x37, 0xd9,
+	0x74, 0x21, 0xa0, 0x56, 0x81, 0xf9, 0x2b, 0x93, 0x48, 0x8e, 0xbb, 0x30, 0x1d, 0xda, 0x9f, 0xa6,
+	0x45, 0xbd, 0xf3, 0x1b, 0xbe, 0x66, 0x77, 0xa6, 0xcc, 0x48, 0x30, 0xed, 0x89, 0x2c, 0x64, 0x9f,
+	0x74, 0x59, 0xb2, 0x9f, 0x16, 0x60, 0xb2, 0xe2, 0xb8, 0x65, 0xb3, 0x8e, 0x2d, 0xfb, 0xe9, 0x7b,
+	0xbc, 0x92, 0x89, 0x43, 0xa8, 0x38, 0xee, 0xae, 0xb0, 0x6c, 0x8f, 0x7f, 0xf5, 0x7c, 0x21, 0xf7,
+	0xef, 0xf3, 0x85, 0x9c, 0xe6, 0xc2, 0xfc, 0x95, 0x81, 0x24, 0xd9, 0x8f, 0x60, 0xda, 0x26, 0x5d,
+	0x56, 0x0e, 0xb5, 0x93, 0xd8, 0x97, 0x07, 0xa9, 0xd3, 0xf4, 0x8c, 0xd6, 0xc2, 0xe4, 0xed, 0x48,
+	0x70, 0xed, 0x31, 0x4c, 0xf3, 0x9c, 0xa5, 0x1e, 0xa1, 0x2c, 0x8c, 0xfd, 0x06, 0xdd, 0xc3, 0x8c,
+	0xd0, 0x64, 0xf1, 0xbd, 0x06, 0x4d, 0xae, 0x07, 0x0d, 0xda, 0xe4, 0x6b, 0xc9, 0xaa, 0x52, 0x1b,
+	0xb4, 0x19, 0x0b, 0xa8, 0x3d, 0x92, 0x7d, 0x58, 0x6a, 0x3a, 0x66, 0xe3, 0xc0, 0x75, 0x9c, 0x23,
+	0xff, 0x00, 0xee, 0xc3, 0xff, 0x2b, 0x9e, 0xb1, 0x1c, 0x19, 0xeb, 0x49, 0x6e, 0xdb, 0x17, 0xb3,
+	0xfd, 0x9b, 0x02, 0x73, 0x09, 0xb4, 0x64, 0xf8, 0x30, 0x32, 0x42, 0x91, 0x18, 0xa1, 0x31, 0x11,
+	0x81, 0x6e, 0x33, 0xec, 0x68, 0x06, 0x46, 0x2d, 0xbb, 0x4a, 0xba, 0xf9, 0x61, 0x1e, 0x5c, 0x7c,
+	0x78, 0x87, 0x21, 0x2e, 0xec, 0x72, 0x1d, 0xd3, 0x7a, 0x7e, 0x44, 0x1c, 0x86, 0x30, 0x7d, 0x80,
+	0x69, 0xdd, 0x83, 0xb5, 0x3d, 0xc2, 0xf9, 0x51, 0xbe, 0x24, 0x3e, 0xb4, 0x2f, 0x14, 0xb8, 0xfb,
+	0xd4, 0x0b, 0x40, 0xaa, 0x41, 0xba, 0x7e, 0x77, 0xdb, 0xad, 0x68, 0xcf, 0xc1, 0xff, 0x58, 0x57,
+	0x90, 0x1b, 0xe6, 0x0c, 0xc6, 0x58, 0xd7, 0x23, 0xa6, 0x6d, 0x41, 0x21, 0x7e, 0x39, 0x9d, 0x7c,
+	0xcc, 0x97, 0xfc, 0x93, 0x09, 0x41, 0x95, 0x08, 0xf4, 0x18, 0x16, 0xfa, 0x42, 0xe5, 0xb1, 0xec,
+	0x47, 0x28, 0x8b, 0x8e, 0xd1, 0x53, 0xe7, 0x20, 0xb1, 0x1d, 0x91, 0xab, 0xd6, 0x84, 0xc5, 0x58,
+	0x4a, 0x5a, 0x12, 0xed, 0x70, 0x88, 0xed, 0x1a, 0x09, 0x4d, 0x33, 0x65, 0xd8, 0x65, 0x65, 0xde,
+	0x3e, 0x72, 0x1b, 0x81, 0x9b, 0xb8, 0xb3, 0xf7, 0x16, 0x10, 0xbb, 0x2a, 0x97, 0x87, 0xc4, 0x5b,
+	0x40, 0xec, 0x2a, 0x5f, 0xd4, 0xba, 0xf0, 0x5a, 0x7a, 0x12, 0x59, 0xdc, 0x01, 0x4c, 0x06, 0xd4,
+	0xa8, 0xbc, 0x92, 0xb2, 0x56, 0x17, 0x0e, 0xb1, 0xf6, 0xe7, 0x34, 0x8c, 0xf2, 0xd4, 0xe8, 0x5b,
+	0x05, 0xc6, 0xc4, 0x45, 0x89, 0x8c, 0xd4, 0x88, 0xc9, 0xd7, 0x5d, 0x5d, 0x19, 0x1c, 0x20, 0x2a,
+	0xd1, 0x1e, 0x7e, 0xf9, 0xfb, 0x3f, 0xdf, 0x0c, 0x2d, 0xa1, 0x45, 0x23, 0x4d, 0xd1, 0x88, 0x27,
+	0x1e, 0x7d, 0xa7, 0xc0, 0xb8, 0xff, 0x8c, 0xa3, 0xd5, 0xeb, 0x73, 0xc5, 0xa4, 0x80, 0xba, 0x96,
+	0x05, 0x22, 0x09, 0xea, 0x9c, 0xe0, 0x32, 0x7a, 0x3d, 0x95, 0x20, 0x36, 0x1b, 0x45, 0xfe, 0xbc,
+	0xa3, 0x1f, 0x14, 0x80, 0xd0, 0x44, 0xad, 0x5f, 0x9f, 0x32, 0x71, 0x2b, 0xaa, 0x1b, 0xd9, 0x40,
+	0x92, 0xe9, 0x26, 0x67, 0xba, 0x86, 0x56, 0x52, 0x99, 0x86, 0x4c, 0xa7, 0x62, 0xba, 0xcf, 0xd0,
+	0xcf, 0x0a, 0xdc, 0x89, 0x4b, 0x04, 0xb4, 0x95, 0x85, 0x44, 0x44, 0x76, 0xa8, 0xdb, 0x37, 0x81,
+	0x66, 0x6a, 0x08, 0xa1, 0x54, 0xd0, 0xf7, 0x0a, 0x4c, 0xf4, 0x84, 0x05, 0x1a, 0xe0, 0x78, 0xe3,
+	0xf2, 0x44, 0x5d, 0xcf, 0x84, 0x91, 0x1c, 0x57, 0x38, 0xc7, 0x07, 0x68, 0x39, 0x95, 0xa3, 0xa7,
+	0x64, 0x8a, 0xb6, 0x53, 0xc4, 0x66, 0x03, 0xfd, 0xa4, 0xc0, 0x54, 0x54, 0x62, 0xa0, 0x77, 0xb2,
+	0x6c, 0x52, 0x48, 0xf9, 0xa8, 0x9b, 0xd9, 0x81, 0x99, 0x78, 0x07, 0x26, 0x8a, 0x7e, 0x51, 0x60,
+	0x2a, 0xaa, 0x36, 0x06, 0xe1, 0x7d, 0xa5, 0xd0, 0x51, 0x37, 0xb3, 0x03, 0x25, 0xef, 0x0d, 0xce,
+	0x5b, 0x47, 0x6f, 0xa5, 0xf2, 0xf6, 0x84, 0x4b, 0x31, 0xb0, 0xf3, 0xae, 0x8e, 0xeb, 0x8a, 0x41,
+	0xba, 0xba, 0x8f, 0x56, 0x51, 0xb7, 0x6f, 0x02, 0xcd, 0xd4, 0xd5, 0x42, 0xb4, 0xa0, 0x1f, 0x15,
+	0x80, 0x40, 0x68, 0x0c, 0x72, 0x85, 0x24, 0x44, 0x8d, 0xba, 0x91, 0x0d, 0x24, 0x69, 0x6e, 0x71,
+	0x9a, 0xeb, 0x68, 0x35, 0xfd, 0x36, 0xf6, 0x30, 0xc6, 0x69, 0x58, 0x34, 0x9d, 0xa1, 0x5f, 0x15,
+	0x40, 0xc9, 0xe7, 0x18, 0x3d, 0xca, 0x74, 0x15, 0x44, 0xdf, 0x7f, 0xf5, 0xf1, 0xcd, 0xc0, 0xb2,
+	0x98, 0xb7, 0x79, 0x31, 0x2b, 0x48, 0x4f, 0x2d, 0x86, 0x75, 0x8b, 0x9e, 0xc0, 0x30, 0x4e, 0xa5,
+	0xd2, 0x38, 0x43, 0xe7, 0x0a, 0xcc, 0xf5, 0x79, 0x80, 0xd1, 0xbb, 0x59, 0x18, 0x5d, 0x25, 0x10,
+	0xd4, 0x9d, 0x5b, 0x44, 0xc8, 0x34, 0xc6, 0xfc, 0x78, 0x8a, 0xae, 0x87, 0x2c, 0x3d, 0x7b, 0x71,
+	0x51, 0x50, 0xce, 0x2f, 0x0a, 0xca, 0xdf, 0x17, 0x05, 0xe5, 0xeb, 0xcb, 0x42, 0xee, 0xfc, 0xb2,
+	0x90, 0xfb, 0xe3, 0xb2, 0x90, 0xfb, 0x74, 0xbd, 0x66, 0xb1, 0x7a, 0xa7, 0xa2, 0x9b, 0x4e, 0xcb,
+	0x68, 0x61, 0x66, 0x99, 0x36, 0x61, 0x9f, 0x3b, 0x6e, 0x23, 0x08, 0xdd, 0x0d, 0x07, 0xe7, 0x4a,
+	0xaf, 0x32, 0xc6, 0xff, 0xe1, 0xaf, 0xff, 0x37, 0x00, 0x9b, 0x09, 0x78, 0x72, 0xf7, 0x10, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -849,6 +1251,14 @@ type QueryClient interface {
 	NextCheckpoint(ctx context.Context, in *QueryNextCheckpointRequest, opts ...grpc.CallOption) (*QueryNextCheckpointResponse, error)
 	// NextCheckpoint queries the next checkpoint.
 	LatestCheckpoint(ctx context.Context, in *QueryLatestCheckpointRequest, opts ...grpc.CallOption) (*QueryLatestCheckpointResponse, error)
+	// BlockProof queries the checkpoint covering a child block and the
+	// block header inclusion proof against its root hash.
+	BlockProof(ctx context.Context, in *QueryBlockProofRequest, opts ...grpc.CallOption) (*QueryBlockProofResponse, error)
+	// CheckpointByTxHash queries the checkpoint acked by a rootchain tx hash.
+	CheckpointByTxHash(ctx context.Context, in *QueryCheckpointByTxHashRequest, opts ...grpc.CallOption) (*QueryCheckpointByTxHashResponse, error)
+	// CheckpointsByBlockRange queries checkpoints overlapping a child block
+	// range.
+	CheckpointsByBlockRange(ctx context.Context, in *QueryCheckpointsByBlockRangeRequest, opts ...grpc.CallOption) (*QueryCheckpointsByBlockRangeResponse, error)
 }
 
 type queryClient struct {
@@ -931,6 +1341,33 @@ func (c *queryClient) LatestCheckpoint(ctx context.Context, in *QueryLatestCheck
 	return out, nil
 }
 
+func (c *queryClient) BlockProof(ctx context.Context, in *QueryBlockProofRequest, opts ...grpc.CallOption) (*QueryBlockProofResponse, error) {
+	out := new(QueryBlockProofResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.checkpoint.v1beta1.Query/BlockProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointByTxHash(ctx context.Context, in *QueryCheckpointByTxHashRequest, opts ...grpc.CallOption) (*QueryCheckpointByTxHashResponse, error) {
+	out := new(QueryCheckpointByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.checkpoint.v1beta1.Query/CheckpointByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointsByBlockRange(ctx context.Context, in *QueryCheckpointsByBlockRangeRequest, opts ...grpc.CallOption) (*QueryCheckpointsByBlockRangeResponse, error) {
+	out := new(QueryCheckpointsByBlockRangeResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.checkpoint.v1beta1.Query/CheckpointsByBlockRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the staking parameters.
@@ -949,6 +1386,14 @@ type QueryServer interface {
 	NextCheckpoint(context.Context, *QueryNextCheckpointRequest) (*QueryNextCheckpointResponse, error)
 	// NextCheckpoint queries the next checkpoint.
 	LatestCheckpoint(context.Context, *QueryLatestCheckpointRequest) (*QueryLatestCheckpointResponse, error)
+	// BlockProof queries the checkpoint covering a child block and the
+	// block header inclusion proof against its root hash.
+	BlockProof(context.Context, *QueryBlockProofRequest) (*QueryBlockProofResponse, error)
+	// CheckpointByTxHash queries the checkpoint acked by a rootchain tx hash.
+	CheckpointByTxHash(context.Context, *QueryCheckpointByTxHashRequest) (*QueryCheckpointByTxHashResponse, error)
+	// CheckpointsByBlockRange queries checkpoints overlapping a child block
+	// range.
+	CheckpointsByBlockRange(context.Context, *QueryCheckpointsByBlockRangeRequest) (*QueryCheckpointsByBlockRangeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -979,6 +1424,15 @@ func (*UnimplementedQueryServer) NextCheckpoint(ctx context.Context, req *QueryN
 func (*UnimplementedQueryServer) LatestCheckpoint(ctx context.Context, req *QueryLatestCheckpointRequest) (*QueryLatestCheckpointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestCheckpoint not implemented")
 }
+func (*UnimplementedQueryServer) BlockProof(ctx context.Context, req *QueryBlockProofRequest) (*QueryBlockProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProof not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointByTxHash(ctx context.Context, req *QueryCheckpointByTxHashRequest) (*QueryCheckpointByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointByTxHash not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointsByBlockRange(ctx context.Context, req *QueryCheckpointsByBlockRangeRequest) (*QueryCheckpointsByBlockRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointsByBlockRange not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1128,23 +1582,77 @@ func _Query_LatestCheckpoint_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "heimdall.checkpoint.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AckCount",
-			Handler:    _Query_AckCount_Handler,
-		},
-		{
-			MethodName: "Checkpoint",
-			Handler:    _Query_Checkpoint_Handler,
-		},
-		{
+func _Query_BlockProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.checkpoint.v1beta1.Query/BlockProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockProof(ctx, req.(*QueryBlockProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.checkpoint.v1beta1.Query/CheckpointByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointByTxHash(ctx, req.(*QueryCheckpointByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointsByBlockRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointsByBlockRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointsByBlockRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.checkpoint.v1beta1.Query/CheckpointsByBlockRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointsByBlockRange(ctx, req.(*QueryCheckpointsByBlockRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.checkpoint.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AckCount",
+			Handler:    _Query_AckCount_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Query_Checkpoint_Handler,
+		},
+		{
 			MethodName: "CheckpointBuffer",
 			Handler:    _Query_CheckpointBuffer_Handler,
 		},
@@ -1164,6 +1672,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LatestCheckpoint",
 			Handler:    _Query_LatestCheckpoint_Handler,
 		},
+		{
+			MethodName: "BlockProof",
+			Handler:    _Query_BlockProof_Handler,
+		},
+		{
+			MethodName: "CheckpointByTxHash",
+			Handler:    _Query_CheckpointByTxHash_Handler,
+		},
+		{
+			MethodName: "CheckpointsByBlockRange",
+			Handler:    _Query_CheckpointsByBlockRange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdall/checkpoint/v1beta1/query.proto",
@@ -1678,150 +2198,419 @@ func (m *QueryLatestCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBlockProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBlockProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBlockProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAckCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAckCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AckCount != 0 {
-		n += 1 + sovQuery(uint64(m.AckCount))
-	}
-	return n
+func (m *QueryBlockProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCheckpointRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBlockProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Number != 0 {
-		n += 1 + sovQuery(uint64(m.Number))
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *QueryCheckpointResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.AckCount != 0 {
-		n += 1 + sovQuery(uint64(m.AckCount))
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.Checkpoint != nil {
-		l = m.Checkpoint.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.CheckpointNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCheckpointBufferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IndexedCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCheckpointBufferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IndexedCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CheckpointBuffer != nil {
-		l = m.CheckpointBuffer.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastNoAckRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCheckpointByTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLastNoAckResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastNoAck != 0 {
-		n += 1 + sovQuery(uint64(m.LastNoAck))
-	}
-	return n
+func (m *QueryCheckpointByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCheckpointListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCheckpointByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCheckpointListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCheckpointByTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CheckpointList) > 0 {
-		for _, e := range m.CheckpointList {
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointsByBlockRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointsByBlockRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointsByBlockRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointsByBlockRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointsByBlockRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointsByBlockRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAckCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAckCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AckCount != 0 {
+		n += 1 + sovQuery(uint64(m.AckCount))
+	}
+	return n
+}
+
+func (m *QueryCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AckCount != 0 {
+		n += 1 + sovQuery(uint64(m.AckCount))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointBufferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCheckpointBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckpointBuffer != nil {
+		l = m.CheckpointBuffer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastNoAckRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastNoAckResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastNoAck != 0 {
+		n += 1 + sovQuery(uint64(m.LastNoAck))
+	}
+	return n
+}
+
+func (m *QueryCheckpointListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CheckpointList) > 0 {
+		for _, e := range m.CheckpointList {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -1852,51 +2641,610 @@ func (m *QueryNextCheckpointResponse) Size() (n int) {
 		l = m.NextCheckpoint.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryBorChainID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BorChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestCheckpoint != nil {
+		l = m.LatestCheckpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *QueryBlockProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckpointNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointNumber))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexedCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointByTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointByTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointsByBlockRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartBlock != 0 {
+		n += 1 + sovQuery(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EndBlock))
+	}
+	return n
+}
+
+func (m *QueryCheckpointsByBlockRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAckCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAckCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAckCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAckCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAckCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAckCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckCount", wireType)
+			}
+			m.AckCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryBorChainID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BorChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryLatestCheckpointRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckCount", wireType)
+			}
+			m.AckCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &types.Checkpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryLatestCheckpointResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LatestCheckpoint != nil {
-		l = m.LatestCheckpoint.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointBufferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1919,10 +3267,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointBufferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1949,7 +3297,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointBufferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1972,15 +3320,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointBufferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointBuffer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2007,7 +3355,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CheckpointBuffer == nil {
+				m.CheckpointBuffer = &types.Checkpoint{}
+			}
+			if err := m.CheckpointBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2035,7 +3386,60 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAckCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastNoAckRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastNoAckRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastNoAckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastNoAckResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2054,16 +3458,35 @@ func (m *QueryAckCountRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAckCountRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAckCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastNoAckResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastNoAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNoAck", wireType)
+			}
+			m.LastNoAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastNoAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2088,7 +3511,7 @@ func (m *QueryAckCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAckCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2111,17 +3534,17 @@ func (m *QueryAckCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAckCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAckCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.AckCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2131,11 +3554,28 @@ func (m *QueryAckCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AckCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &types.QueryPaginationParams{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2160,7 +3600,7 @@ func (m *QueryAckCountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2183,17 +3623,17 @@ func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckpointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointList", wireType)
 			}
-			m.Number = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2203,11 +3643,26 @@ func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CheckpointList = append(m.CheckpointList, &types.Checkpoint{})
+			if err := m.CheckpointList[len(m.CheckpointList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2232,7 +3687,7 @@ func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2255,36 +3710,17 @@ func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckpointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckCount", wireType)
-			}
-			m.AckCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AckCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2294,27 +3730,23 @@ func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Checkpoint == nil {
-				m.Checkpoint = &types.Checkpoint{}
-			}
-			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BorChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2340,7 +3772,7 @@ func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckpointBufferRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2363,12 +3795,48 @@ func (m *QueryCheckpointBufferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckpointBufferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckpointBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextCheckpoint == nil {
+				m.NextCheckpoint = &MsgCheckpoint{}
+			}
+			if err := m.NextCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2393,7 +3861,7 @@ func (m *QueryCheckpointBufferRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckpointBufferResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBorChainID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2416,17 +3884,17 @@ func (m *QueryCheckpointBufferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckpointBufferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBorChainID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckpointBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBorChainID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointBuffer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorChainID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2436,27 +3904,23 @@ func (m *QueryCheckpointBufferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CheckpointBuffer == nil {
-				m.CheckpointBuffer = &types.Checkpoint{}
-			}
-			if err := m.CheckpointBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BorChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2482,7 +3946,7 @@ func (m *QueryCheckpointBufferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastNoAckRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2505,10 +3969,10 @@ func (m *QueryLastNoAckRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastNoAckRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestCheckpointRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastNoAckRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2535,7 +3999,7 @@ func (m *QueryLastNoAckRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastNoAckResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2558,17 +4022,17 @@ func (m *QueryLastNoAckResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastNoAckResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastNoAckResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastNoAck", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpoint", wireType)
 			}
-			m.LastNoAck = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2578,11 +4042,28 @@ func (m *QueryLastNoAckResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastNoAck |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestCheckpoint == nil {
+				m.LatestCheckpoint = &types.Checkpoint{}
+			}
+			if err := m.LatestCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2607,7 +4088,7 @@ func (m *QueryLastNoAckResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckpointListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2630,48 +4111,31 @@ func (m *QueryCheckpointListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckpointListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckpointListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			var msglen int
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &types.QueryPaginationParams{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2696,7 +4160,7 @@ func (m *QueryCheckpointListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCheckpointListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2719,15 +4183,34 @@ func (m *QueryCheckpointListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCheckpointListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCheckpointListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointNumber", wireType)
+			}
+			m.CheckpointNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2754,11 +4237,96 @@ func (m *QueryCheckpointListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CheckpointList = append(m.CheckpointList, &types.Checkpoint{})
-			if err := m.CheckpointList[len(m.CheckpointList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Checkpoint == nil {
+				m.Checkpoint = &types.Checkpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2783,7 +4351,7 @@ func (m *QueryCheckpointListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextCheckpointRequest) Unmarshal(dAtA []byte) error {
+func (m *IndexedCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2806,15 +4374,70 @@ func (m *QueryNextCheckpointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextCheckpointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexedCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexedCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &types.Checkpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2842,7 +4465,7 @@ func (m *QueryNextCheckpointRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorChainID = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2868,7 +4491,7 @@ func (m *QueryNextCheckpointRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextCheckpointResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointByTxHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2891,17 +4514,17 @@ func (m *QueryNextCheckpointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextCheckpointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointByTxHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextCheckpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2911,27 +4534,23 @@ func (m *QueryNextCheckpointResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NextCheckpoint == nil {
-				m.NextCheckpoint = &MsgCheckpoint{}
-			}
-			if err := m.NextCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2957,7 +4576,7 @@ func (m *QueryNextCheckpointResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBorChainID) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointByTxHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2980,17 +4599,17 @@ func (m *QueryBorChainID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBorChainID: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointByTxHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBorChainID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3000,23 +4619,27 @@ func (m *QueryBorChainID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorChainID = string(dAtA[iNdEx:postIndex])
+			if m.Checkpoint == nil {
+				m.Checkpoint = &IndexedCheckpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3042,7 +4665,7 @@ func (m *QueryBorChainID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestCheckpointRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointsByBlockRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3065,12 +4688,50 @@ func (m *QueryLatestCheckpointRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestCheckpointRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointsByBlockRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointsByBlockRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3095,7 +4756,7 @@ func (m *QueryLatestCheckpointRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestCheckpointResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointsByBlockRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3118,15 +4779,15 @@ func (m *QueryLatestCheckpointResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestCheckpointResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointsByBlockRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointsByBlockRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCheckpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3153,10 +4814,8 @@ func (m *QueryLatestCheckpointResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestCheckpoint == nil {
-				m.LatestCheckpoint = &types.Checkpoint{}
-			}
-			if err := m.LatestCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoints = append(m.Checkpoints, &IndexedCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,21 +1,23 @@
 package cli
 
 import (
+	"context"
 	"fmt"
-	// "strings"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
-	// "github.com/cosmos/cosmos-sdk/client/flags"
-	// sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/maticnetwork/heimdall/x/sidechannel/types"
 )
 
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string) *cobra.Command {
-	// Group topup queries under a subcommand
+	// Group sidechannel queries under a subcommand
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
 		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
@@ -24,7 +26,184 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	// this line is used by starport scaffolding # 1
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQuerySideTxTally(),
+		GetCmdQuerySideTxTallies(),
+		GetCmdQueryParticipation(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current sidechannel parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as sidechannel parameters.
+
+Example:
+$ %s query sidechannel params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySideTxTally implements the side-tx tally query command.
+func GetCmdQuerySideTxTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally [tx-hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show vote tally of side-tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query total, yes, no and skip power and validator votes of side-tx.
+
+Example:
+$ %s query sidechannel tally 0x5c41...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SideTxTally(context.Background(), &types.QuerySideTxTallyRequest{TxHash: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Tally)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySideTxTallies implements the side-tx tallies query command.
+func GetCmdQuerySideTxTallies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tallies [height]",
+		Args:  cobra.ExactArgs(1),
+		Short: "show vote tallies of side-txs included at height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query vote tallies of all side-txs included at given block height.
+
+Example:
+$ %s query sidechannel tallies 1200
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SideTxTallies(context.Background(), &types.QuerySideTxTalliesRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParticipation implements the side-tx participation query command.
+func GetCmdQueryParticipation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participation [validator-id]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "show side-tx participation of validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query voted, missed and disagreed side-txs of validator over the participation window.
+Participation of all validators is shown if validator id is omitted.
+
+Example:
+$ %s query sidechannel participation 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.Participations(context.Background(), &types.QueryParticipationsRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(res)
+			}
+
+			valID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Participation(context.Background(), &types.QueryParticipationRequest{ValId: valID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Participation)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
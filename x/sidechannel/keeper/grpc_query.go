@@ -1,7 +1,102 @@
 package keeper
 
-// import (
-// 	"github.com/maticnetwork/heimdall/x/sidechannel/types"
-// )
+import (
+	"context"
+	"encoding/hex"
+	"strings"
 
-// var _ types.QueryServer = Keeper{}
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/sidechannel/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the sidechannel QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper) types.QueryServer {
+	return &Querier{Keeper: keeper}
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries sidechannel params
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// SideTxTally queries vote tally of side-tx by tx hash
+func (k Querier) SideTxTally(c context.Context, req *types.QuerySideTxTallyRequest) (*types.QuerySideTxTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := hex.DecodeString(strings.TrimPrefix(req.TxHash, "0x"))
+	if err != nil || len(hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid tx hash")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	tally := k.GetSideTxTally(ctx, hash)
+	if tally == nil {
+		return nil, status.Errorf(codes.NotFound, "side-tx tally not found for %s", req.TxHash)
+	}
+
+	return &types.QuerySideTxTallyResponse{Tally: tally}, nil
+}
+
+// SideTxTallies queries vote tallies of side-txs included at height
+func (k Querier) SideTxTallies(c context.Context, req *types.QuerySideTxTalliesRequest) (*types.QuerySideTxTalliesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySideTxTalliesResponse{Tallies: k.GetSideTxTallies(ctx, req.Height)}, nil
+}
+
+// Participation queries side-tx participation of validator
+func (k Querier) Participation(c context.Context, req *types.QueryParticipationRequest) (*types.QueryParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	participation, found := k.GetSideTxParticipation(ctx, hmTypes.NewValidatorID(req.ValId))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "side-tx participation not found for validator %d", req.ValId)
+	}
+
+	return &types.QueryParticipationResponse{Participation: participation}, nil
+}
+
+// Participations queries side-tx participation of all validators
+func (k Querier) Participations(c context.Context, req *types.QueryParticipationsRequest) (*types.QueryParticipationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParticipationsResponse{Participations: k.GetSideTxParticipations(ctx)}, nil
+}
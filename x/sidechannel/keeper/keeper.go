@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -16,15 +17,24 @@ import (
 
 type (
 	Keeper struct {
-		cdc      codec.Marshaler
-		storeKey sdk.StoreKey
+		cdc           codec.Marshaler
+		storeKey      sdk.StoreKey
+		paramSubspace paramtypes.Subspace
+		sk            types.StakingKeeper
 	}
 )
 
-func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, paramstore paramtypes.Subspace, sk types.StakingKeeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramstore,
+		sk:            sk,
 	}
 }
 
@@ -71,6 +81,24 @@ func (k Keeper) RemoveTx(ctx sdk.Context, height uint64, hash []byte) {
 	store.Delete(TxStoreKey(height, hash))
 }
 
+// SetLastSideBlockHeight sets height of side-txs last processed in begin side block
+func (k Keeper) SetLastSideBlockHeight(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(LastSideBlockHeightKey, sdk.Uint64ToBigEndian(height))
+}
+
+// GetLastSideBlockHeight returns height of side-txs last processed in begin side block
+func (k Keeper) GetLastSideBlockHeight(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(LastSideBlockHeightKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
 //
 // Validators methods
 //
@@ -120,6 +148,111 @@ func (k Keeper) RemoveValidators(ctx sdk.Context, height uint64) {
 	store.Delete(ValidatorsKey(height))
 }
 
+//
+// Side-tx tally methods
+//
+
+// SetSideTxTally stores vote tally of side-tx
+func (k Keeper) SetSideTxTally(ctx sdk.Context, tally *types.SideTxTally) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(tally)
+	if err != nil {
+		return err
+	}
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, tally.Height)
+
+	store.Set(SideTxTallyKey(tally.Height, tally.TxHash), bz)
+	store.Set(SideTxTallyHeightKey(tally.TxHash), heightBytes)
+
+	return nil
+}
+
+// GetSideTxTally returns vote tally of side-tx by tx hash, nil if it is not stored
+func (k Keeper) GetSideTxTally(ctx sdk.Context, hash []byte) *types.SideTxTally {
+	store := ctx.KVStore(k.storeKey)
+
+	heightBytes := store.Get(SideTxTallyHeightKey(hash))
+	if heightBytes == nil {
+		return nil
+	}
+
+	bz := store.Get(SideTxTallyKey(binary.BigEndian.Uint64(heightBytes), hash))
+	if bz == nil {
+		return nil
+	}
+
+	var tally types.SideTxTally
+	if err := k.cdc.UnmarshalBinaryBare(bz, &tally); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling side-tx tally", "error", err)
+		return nil
+	}
+
+	return &tally
+}
+
+// GetSideTxTallies returns vote tallies of side-txs included at height
+func (k Keeper) GetSideTxTallies(ctx sdk.Context, height uint64) []types.SideTxTally {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, SideTxTalliesKey(height))
+	defer iterator.Close()
+
+	tallies := make([]types.SideTxTally, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var tally types.SideTxTally
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &tally); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling side-tx tally", "error", err)
+			continue
+		}
+
+		tallies = append(tallies, tally)
+	}
+
+	return tallies
+}
+
+// PruneSideTxTallies removes vote tallies of side-txs included before height
+func (k Keeper) PruneSideTxTallies(ctx sdk.Context, beforeHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(SideTxTalliesKey(0), SideTxTalliesKey(beforeHeight))
+	defer iterator.Close()
+
+	// collect keys first, store must not be modified while iterating
+	prefixLength := len(SideTxTalliesKey(0))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+		store.Delete(SideTxTallyHeightKey(key[prefixLength:]))
+	}
+}
+
+//
+// Params methods
+//
+
+// SetParams sets the sidechannel module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the sidechannel module's parameters, params missing in store keep default values
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSubspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return
+}
+
 //
 // Iterators
 //
@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -222,6 +224,150 @@ func (suite *KeeperTestSuite) TestValidators() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestSideTxTally() {
+	t, k, ctx := suite.T(), suite.keeper, suite.ctx
+
+	tally := func(height uint64, tx string) *types.SideTxTally {
+		return &types.SideTxTally{
+			Height:         height,
+			ExecutedHeight: height + 2,
+			TxHash:         tmtypes.Tx(tx).Hash(),
+			TotalPower:     30,
+			YesPower:       10,
+			NoPower:        20,
+			Result:         tmproto.SideTxResultType_NO,
+			Votes: []types.SideTxVote{
+				{Address: []byte("validator-1"), Power: 10, Result: tmproto.SideTxResultType_YES},
+				{Address: []byte("validator-2"), Power: 20, Result: tmproto.SideTxResultType_NO},
+			},
+		}
+	}
+
+	require.NoError(t, k.SetSideTxTally(ctx, tally(10, "transaction-1")))
+	require.NoError(t, k.SetSideTxTally(ctx, tally(10, "transaction-2")))
+	require.NoError(t, k.SetSideTxTally(ctx, tally(20, "transaction-3")))
+
+	result := k.GetSideTxTally(ctx, tmtypes.Tx("transaction-2").Hash())
+	require.NotNil(t, result)
+	require.Equal(t, tally(10, "transaction-2"), result)
+	require.Nil(t, k.GetSideTxTally(ctx, tmtypes.Tx("transaction-4").Hash()))
+
+	require.Len(t, k.GetSideTxTallies(ctx, 10), 2)
+	require.Len(t, k.GetSideTxTallies(ctx, 20), 1)
+	require.Empty(t, k.GetSideTxTallies(ctx, 15))
+
+	// prune tallies before height 20
+	k.PruneSideTxTallies(ctx, 20)
+	require.Empty(t, k.GetSideTxTallies(ctx, 10))
+	require.Nil(t, k.GetSideTxTally(ctx, tmtypes.Tx("transaction-1").Hash()))
+	require.NotNil(t, k.GetSideTxTally(ctx, tmtypes.Tx("transaction-3").Hash()))
+}
+
+func (suite *KeeperTestSuite) TestSideTxParticipation() {
+	t, k, ctx := suite.T(), suite.keeper, suite.ctx
+
+	params := types.DefaultParams()
+	params.ParticipationWindow = 4
+	params.MinParticipationRatio = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(ctx, params)
+
+	addr1 := []byte("validator-1")
+	addr2 := []byte("validator-2")
+	validators := []*abci.Validator{
+		{Address: addr1, Power: 10},
+		{Address: addr2, Power: 20},
+		{Address: []byte{}, Power: 30}, // unknown validator is ignored
+	}
+
+	handle := func(result tmproto.SideTxResultType, votes ...types.SideTxVote) sdk.Events {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		k.HandleSideTxParticipation(ctx, validators, votes, result)
+		return ctx.EventManager().Events()
+	}
+	yes := types.SideTxVote{Address: addr1, Result: tmproto.SideTxResultType_YES}
+
+	// validator-2 misses side-txs, reported once window is full
+	for i := 0; i < 3; i++ {
+		require.Empty(t, handle(tmproto.SideTxResultType_YES, yes))
+	}
+
+	events := handle(tmproto.SideTxResultType_YES, yes)
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeLowParticipation, events[0].Type)
+
+	participation, found := k.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr2))
+	require.True(t, found)
+	require.Equal(t, types.SideTxParticipation{
+		ValID:            testutil.FakeValidatorID(addr2),
+		IndexOffset:      4,
+		MissedCount:      4,
+		LowParticipation: true,
+	}, participation)
+
+	// not reported again while below ratio
+	require.Empty(t, handle(tmproto.SideTxResultType_YES, yes))
+
+	// validator-2 votes against the result, old misses roll out of the window
+	no := types.SideTxVote{Address: addr2, Result: tmproto.SideTxResultType_NO}
+	require.Empty(t, handle(tmproto.SideTxResultType_YES, yes, no))
+	require.Empty(t, handle(tmproto.SideTxResultType_YES, yes, no))
+
+	participation, _ = k.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr2))
+	require.Equal(t, uint64(2), participation.VotedCount)
+	require.Equal(t, uint64(2), participation.MissedCount)
+	require.Equal(t, uint64(2), participation.DisagreedCount)
+	require.False(t, participation.LowParticipation)
+
+	// votes on skipped side-tx never disagree
+	require.Empty(t, handle(tmproto.SideTxResultType_SKIP, yes, no))
+	participation, _ = k.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr2))
+	require.Equal(t, uint64(3), participation.VotedCount)
+	require.Equal(t, uint64(2), participation.DisagreedCount)
+
+	participation, _ = k.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr1))
+	require.Equal(t, uint64(4), participation.VotedCount)
+	require.Equal(t, uint64(0), participation.MissedCount)
+	require.Equal(t, uint64(8), participation.IndexOffset)
+
+	require.Len(t, k.GetSideTxParticipations(ctx), 2)
+
+	// changing participation window resets tracked windows and counters
+	params.ParticipationWindow = 2
+	k.SetParams(ctx, params)
+	require.Empty(t, handle(tmproto.SideTxResultType_YES, yes))
+
+	participation, _ = k.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr2))
+	require.Equal(t, types.SideTxParticipation{
+		ValID:       testutil.FakeValidatorID(addr2),
+		IndexOffset: 1,
+		MissedCount: 1,
+	}, participation)
+
+	// counters don't underflow on slots filled before window change
+	events = handle(tmproto.SideTxResultType_YES, yes)
+	require.Len(t, events, 1)
+	participation, _ = k.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr2))
+	require.Equal(t, uint64(2), participation.MissedCount)
+	require.Equal(t, uint64(0), participation.VotedCount)
+
+	participation, _ = k.GetSideTxParticipation(ctx, testutil.FakeValidatorID(addr1))
+	require.Equal(t, uint64(2), participation.VotedCount)
+	require.Equal(t, uint64(2), participation.IndexOffset)
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	t, k, ctx := suite.T(), suite.keeper, suite.ctx
+
+	// params missing in store, e.g. after upgrade adding them, keep default values
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	params := types.DefaultParams()
+	params.VoteRetentionBlocks = 100
+	params.SideBlockDelay = 1
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+}
+
 func (suite *KeeperTestSuite) TestLogger() {
 	t, k, ctx := suite.T(), suite.keeper, suite.ctx
 
@@ -236,11 +382,16 @@ func (suite *KeeperTestSuite) TestLogger() {
 func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, tmproto.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, keeper.NewKeeper(types.ModuleCdc, key)
+	subspace := paramtypes.NewSubspace(types.ModuleCdc, codec.NewLegacyAmino(), paramsKey, paramsTKey, types.ModuleName)
+	return ctx, keeper.NewKeeper(types.ModuleCdc, key, subspace, testutil.FakeStakingKeeper{})
 }
@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 var (
@@ -10,6 +14,24 @@ var (
 
 	// ValidatorsKeyPrefix prefix for validators
 	ValidatorsKeyPrefix = []byte{0x02}
+
+	// SideTxTallyKeyPrefix prefix for side-tx vote tallies
+	SideTxTallyKeyPrefix = []byte{0x03}
+
+	// SideTxTallyHeightKeyPrefix prefix for side-tx hash to tally height index
+	SideTxTallyHeightKeyPrefix = []byte{0x04}
+
+	// SideTxParticipationKeyPrefix prefix for validator side-tx participation
+	SideTxParticipationKeyPrefix = []byte{0x05}
+
+	// SideTxParticipationWindowKeyPrefix prefix for validator side-tx participation window
+	SideTxParticipationWindowKeyPrefix = []byte{0x06}
+
+	// SideTxParticipationWindowSizeKey key for participation window size tracked windows were built with
+	SideTxParticipationWindowSizeKey = []byte{0x07}
+
+	// LastSideBlockHeightKey key for height of side-txs last processed in begin side block
+	LastSideBlockHeightKey = []byte{0x08}
 )
 
 // TxStoreKey returns key used to get tx from store
@@ -40,3 +62,52 @@ func ValidatorsKey(height uint64) []byte {
 	result = append(result, b...)
 	return result
 }
+
+// SideTxTalliesKey returns key prefix used to get side-tx tallies at height from store
+func SideTxTalliesKey(height uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, height)
+
+	result := []byte{}
+	result = append(result, SideTxTallyKeyPrefix...)
+	result = append(result, b...)
+	return result
+}
+
+// SideTxTallyKey returns key used to get side-tx tally from store
+func SideTxTallyKey(height uint64, hash []byte) []byte {
+	result := SideTxTalliesKey(height)
+	result = append(result, hash...)
+	return result
+}
+
+// SideTxTallyHeightKey returns key used to get height of side-tx tally by tx hash
+func SideTxTallyHeightKey(hash []byte) []byte {
+	result := []byte{}
+	result = append(result, SideTxTallyHeightKeyPrefix...)
+	result = append(result, hash...)
+	return result
+}
+
+// SideTxParticipationKey returns key used to get side-tx participation of validator from store
+func SideTxParticipationKey(valID hmTypes.ValidatorID) []byte {
+	result := []byte{}
+	result = append(result, SideTxParticipationKeyPrefix...)
+	result = append(result, sdk.Uint64ToBigEndian(valID.Uint64())...)
+	return result
+}
+
+// SideTxParticipationWindowPrefixKey returns key prefix used to get participation window of validator
+func SideTxParticipationWindowPrefixKey(valID hmTypes.ValidatorID) []byte {
+	result := []byte{}
+	result = append(result, SideTxParticipationWindowKeyPrefix...)
+	result = append(result, sdk.Uint64ToBigEndian(valID.Uint64())...)
+	return result
+}
+
+// SideTxParticipationWindowKey returns key used to get participation of validator at window index
+func SideTxParticipationWindowKey(valID hmTypes.ValidatorID, index uint64) []byte {
+	result := SideTxParticipationWindowPrefixKey(valID)
+	result = append(result, sdk.Uint64ToBigEndian(index)...)
+	return result
+}
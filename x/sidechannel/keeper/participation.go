@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/sidechannel/types"
+)
+
+// participation of validator in a single side-tx, stored in participation window
+const (
+	participationVoted     byte = 0x01
+	participationDisagreed byte = 0x02
+	participationMissed    byte = 0x03
+)
+
+// GetSideTxParticipation returns side-tx participation of validator
+func (k Keeper) GetSideTxParticipation(ctx sdk.Context, valID hmTypes.ValidatorID) (participation types.SideTxParticipation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(SideTxParticipationKey(valID))
+	if bz == nil {
+		return participation, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &participation); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling side-tx participation", "error", err)
+		return participation, false
+	}
+
+	return participation, true
+}
+
+// SetSideTxParticipation sets side-tx participation of validator
+func (k Keeper) SetSideTxParticipation(ctx sdk.Context, participation types.SideTxParticipation) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(&participation)
+	if err != nil {
+		return err
+	}
+
+	store.Set(SideTxParticipationKey(participation.ValID), bz)
+
+	return nil
+}
+
+// GetSideTxParticipations returns side-tx participation of all tracked validators
+func (k Keeper) GetSideTxParticipations(ctx sdk.Context) []types.SideTxParticipation {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, SideTxParticipationKeyPrefix)
+	defer iterator.Close()
+
+	participations := make([]types.SideTxParticipation, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var participation types.SideTxParticipation
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &participation); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling side-tx participation", "error", err)
+			continue
+		}
+
+		participations = append(participations, participation)
+	}
+
+	return participations
+}
+
+// getParticipationWindow returns participation of validator at window index, 0 if index is not filled yet
+func (k Keeper) getParticipationWindow(ctx sdk.Context, valID hmTypes.ValidatorID, index uint64) byte {
+	bz := ctx.KVStore(k.storeKey).Get(SideTxParticipationWindowKey(valID, index))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return bz[0]
+}
+
+// setParticipationWindow sets participation of validator at window index
+func (k Keeper) setParticipationWindow(ctx sdk.Context, valID hmTypes.ValidatorID, index uint64, value byte) {
+	ctx.KVStore(k.storeKey).Set(SideTxParticipationWindowKey(valID, index), []byte{value})
+}
+
+// resetParticipationOnWindowChange clears participation windows and counters of all validators
+// if participation window param changed since windows were filled, as window slots and counters
+// are only valid for window size they were built with.
+func (k Keeper) resetParticipationOnWindowChange(ctx sdk.Context, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	var size uint64
+	if bz := store.Get(SideTxParticipationWindowSizeKey); bz != nil {
+		size = sdk.BigEndianToUint64(bz)
+	}
+
+	if size == window {
+		return
+	}
+
+	if size != 0 {
+		// collect keys first, store must not be written while iterating
+		var keys [][]byte
+		for _, prefix := range [][]byte{SideTxParticipationKeyPrefix, SideTxParticipationWindowKeyPrefix} {
+			iterator := sdk.KVStorePrefixIterator(store, prefix)
+			for ; iterator.Valid(); iterator.Next() {
+				keys = append(keys, iterator.Key())
+			}
+			iterator.Close()
+		}
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+
+		k.Logger(ctx).Info("Participation window changed, side-tx participation reset", "oldWindow", size, "newWindow", window)
+	}
+
+	store.Set(SideTxParticipationWindowSizeKey, sdk.Uint64ToBigEndian(window))
+}
+
+// HandleSideTxParticipation records participation of each validator in a tallied side-tx.
+// Validators without vote are counted as missed, validators voting against an approved
+// or rejected side-tx are counted as disagreed.
+func (k Keeper) HandleSideTxParticipation(
+	ctx sdk.Context,
+	validators []*abci.Validator,
+	votes []types.SideTxVote,
+	result tmprototypes.SideTxResultType,
+) {
+	params := k.GetParams(ctx)
+	k.resetParticipationOnWindowChange(ctx, params.ParticipationWindow)
+
+	for _, v := range validators {
+		value := participationMissed
+		for _, vote := range votes {
+			if bytes.Equal(vote.Address, v.Address) {
+				value = participationVoted
+				if result != tmprototypes.SideTxResultType_SKIP && vote.Result != result {
+					value = participationDisagreed
+				}
+
+				break
+			}
+		}
+
+		k.handleValidatorParticipation(ctx, params, v.Address, value)
+	}
+}
+
+func (k Keeper) handleValidatorParticipation(ctx sdk.Context, params types.Params, address []byte, value byte) {
+	logger := k.Logger(ctx)
+
+	// fetch validator from signer address
+	validator, err := k.sk.GetValidatorInfo(ctx, address)
+	if err != nil {
+		logger.Error("Validator not found for side-tx participation", "address", sdk.AccAddress(address).String(), "error", err)
+		return
+	}
+
+	participation, found := k.GetSideTxParticipation(ctx, validator.ID)
+	if !found {
+		participation = types.SideTxParticipation{ValID: validator.ID}
+	}
+
+	// overwrite oldest entry of the window and update counters
+	index := participation.IndexOffset % params.ParticipationWindow
+	participation.IndexOffset++
+
+	switch k.getParticipationWindow(ctx, validator.ID, index) {
+	case participationVoted:
+		participation.VotedCount--
+	case participationDisagreed:
+		participation.VotedCount--
+		participation.DisagreedCount--
+	case participationMissed:
+		participation.MissedCount--
+	}
+
+	switch value {
+	case participationVoted:
+		participation.VotedCount++
+	case participationDisagreed:
+		participation.VotedCount++
+		participation.DisagreedCount++
+	case participationMissed:
+		participation.MissedCount++
+	}
+
+	k.setParticipationWindow(ctx, validator.ID, index, value)
+
+	// participation is evaluated only once the window is full
+	if participation.IndexOffset >= params.ParticipationWindow {
+		ratio := sdk.NewDec(int64(participation.VotedCount)).QuoInt64(int64(participation.VotedCount + participation.MissedCount))
+		lowParticipation := ratio.LT(params.MinParticipationRatio)
+
+		// report validator once when it falls below min participation ratio
+		if lowParticipation && !participation.LowParticipation {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeLowParticipation,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyValID, validator.ID.String()),
+					sdk.NewAttribute(types.AttributeKeyParticipationRatio, ratio.String()),
+					sdk.NewAttribute(types.AttributeKeyVotedCount, strconv.FormatUint(participation.VotedCount, 10)),
+					sdk.NewAttribute(types.AttributeKeyMissedCount, strconv.FormatUint(participation.MissedCount, 10)),
+					sdk.NewAttribute(types.AttributeKeyDisagreedCount, strconv.FormatUint(participation.DisagreedCount, 10)),
+				),
+			)
+
+			logger.Info("Low side-tx participation", "valID", validator.ID, "ratio", ratio, "voted", participation.VotedCount, "missed", participation.MissedCount)
+		}
+
+		participation.LowParticipation = lowParticipation
+	}
+
+	if err := k.SetSideTxParticipation(ctx, participation); err != nil {
+		logger.Error("Error storing side-tx participation", "valID", validator.ID, "error", err)
+	}
+}
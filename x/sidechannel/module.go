@@ -1,6 +1,7 @@
 package sidechannel
 
 import (
+	"context"
 	"encoding/json"
 	"math/rand"
 
@@ -59,7 +60,7 @@ func (a AppModuleBasic) RegisterInterfaces(reg cdctypes.InterfaceRegistry) {
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	//types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 }
 
 // DefaultGenesis returns the capability module's default genesis state.
@@ -81,8 +82,9 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONMarshaler, config client.TxE
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the auth module.
 func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	// TODO : uncomment below
-	// types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterRESTRoutes registers the capability module's REST service handlers.
@@ -141,7 +143,7 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 // RegisterQueryService registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterQueryService(server grpc.Server) {
-	//types.RegisterQueryServer(server, am.keeper)
+	types.RegisterQueryServer(server, keeper.NewQueryServerImpl(am.keeper))
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -181,9 +183,17 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
 // Side channel module's end block will remove all validators for `height` block
+// and side-tx vote tallies older than retention window
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	height := ctx.BlockHeader().Height
-	am.keeper.RemoveValidators(ctx, uint64(height))
+	height := uint64(ctx.BlockHeader().Height)
+	am.keeper.RemoveValidators(ctx, height)
+
+	// prune tallies, all of them if recording is disabled
+	retention := am.keeper.GetParams(ctx).VoteRetentionBlocks
+	if height > retention {
+		am.keeper.PruneSideTxTallies(ctx, height-retention)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
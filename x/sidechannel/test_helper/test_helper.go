@@ -20,7 +20,7 @@ import (
 // returns context and app with params set on account keeper
 func CreateTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, client.Context) {
 	genesisState := app.NewDefaultGenesisState()
-	sideChannelGenesis := types.NewGenesisState(types.DefaultGenesisState().PastCommits)
+	sideChannelGenesis := types.NewGenesisState(types.DefaultParams(), types.DefaultGenesisState().PastCommits)
 
 	// setup with isCheckTx
 	initApp := app.Setup(false)
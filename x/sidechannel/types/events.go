@@ -0,0 +1,14 @@
+package types
+
+// Sidechannel module event types
+var (
+	EventTypeLowParticipation = "low-participation"
+
+	AttributeKeyValID              = "val-id"
+	AttributeKeyParticipationRatio = "participation-ratio"
+	AttributeKeyVotedCount         = "voted-count"
+	AttributeKeyMissedCount        = "missed-count"
+	AttributeKeyDisagreedCount     = "disagreed-count"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// StakingKeeper expected staking keeper to map side-tx signers to validators (noalias)
+type StakingKeeper interface {
+	GetValidatorInfo(ctx sdk.Context, address sdk.AccAddress) (validator hmTypes.Validator, err error)
+}
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultEnabled                    = true
+	DefaultVoteRetentionBlocks uint64 = 10000
+	DefaultSideBlockDelay      uint64 = 2 // sidechannel takes 2 blocks to process
+	DefaultParticipationWindow uint64 = 100
+)
+
+var (
+	DefaultApprovalThreshold     = sdk.NewDec(2).Quo(sdk.NewDec(3))
+	DefaultRejectionThreshold    = sdk.NewDec(2).Quo(sdk.NewDec(3))
+	DefaultMinParticipationRatio = sdk.NewDecWithPrec(5, 1)
+)
+
+// Parameter keys
+var (
+	KeyEnabled               = []byte("Enabled")
+	KeyVoteRetentionBlocks   = []byte("VoteRetentionBlocks")
+	KeyApprovalThreshold     = []byte("ApprovalThreshold")
+	KeyRejectionThreshold    = []byte("RejectionThreshold")
+	KeySideBlockDelay        = []byte("SideBlockDelay")
+	KeyParticipationWindow   = []byte("ParticipationWindow")
+	KeyMinParticipationRatio = []byte("MinParticipationRatio")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// NewParams creates a new Params object
+func NewParams(
+	enabled bool,
+	voteRetentionBlocks uint64,
+	approvalThreshold sdk.Dec,
+	rejectionThreshold sdk.Dec,
+	sideBlockDelay uint64,
+	participationWindow uint64,
+	minParticipationRatio sdk.Dec,
+) Params {
+	return Params{
+		Enabled:               enabled,
+		VoteRetentionBlocks:   voteRetentionBlocks,
+		ApprovalThreshold:     approvalThreshold,
+		RejectionThreshold:    rejectionThreshold,
+		SideBlockDelay:        sideBlockDelay,
+		ParticipationWindow:   participationWindow,
+		MinParticipationRatio: minParticipationRatio,
+	}
+}
+
+// ParamKeyTable for sidechannel module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of sidechannel module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyVoteRetentionBlocks, &p.VoteRetentionBlocks, validateVoteRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyApprovalThreshold, &p.ApprovalThreshold, validateThreshold),
+		paramtypes.NewParamSetPair(KeyRejectionThreshold, &p.RejectionThreshold, validateThreshold),
+		paramtypes.NewParamSetPair(KeySideBlockDelay, &p.SideBlockDelay, validateSideBlockDelay),
+		paramtypes.NewParamSetPair(KeyParticipationWindow, &p.ParticipationWindow, validateParticipationWindow),
+		paramtypes.NewParamSetPair(KeyMinParticipationRatio, &p.MinParticipationRatio, validateMinParticipationRatio),
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultEnabled,
+		DefaultVoteRetentionBlocks,
+		DefaultApprovalThreshold,
+		DefaultRejectionThreshold,
+		DefaultSideBlockDelay,
+		DefaultParticipationWindow,
+		DefaultMinParticipationRatio,
+	)
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+
+	if err := validateVoteRetentionBlocks(p.VoteRetentionBlocks); err != nil {
+		return err
+	}
+
+	if err := validateThreshold(p.ApprovalThreshold); err != nil {
+		return err
+	}
+
+	if err := validateThreshold(p.RejectionThreshold); err != nil {
+		return err
+	}
+
+	// yes and no power can't both exceed thresholds, otherwise side-tx could be approved and rejected
+	if p.ApprovalThreshold.Add(p.RejectionThreshold).LT(sdk.OneDec()) {
+		return errors.New("sum of approval and rejection thresholds must be at least 1")
+	}
+
+	if err := validateSideBlockDelay(p.SideBlockDelay); err != nil {
+		return err
+	}
+
+	if err := validateParticipationWindow(p.ParticipationWindow); err != nil {
+		return err
+	}
+
+	return validateMinParticipationRatio(p.MinParticipationRatio)
+}
+
+// ApprovalPower returns minimum yes power required to approve side-tx
+func (p Params) ApprovalPower(totalPower int64) int64 {
+	return p.ApprovalThreshold.MulInt64(totalPower).TruncateInt64() + 1
+}
+
+// RejectionPower returns minimum no power required to reject side-tx
+func (p Params) RejectionPower(totalPower int64) int64 {
+	return p.RejectionThreshold.MulInt64(totalPower).TruncateInt64() + 1
+}
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateVoteRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("threshold must be positive: %s", v)
+	}
+
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("threshold must be less than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateSideBlockDelay(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("side block delay must be positive")
+	}
+
+	return nil
+}
+
+func validateParticipationWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("participation window must be positive")
+	}
+
+	return nil
+}
+
+func validateMinParticipationRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min participation ratio must be non-negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min participation ratio too large: %s", v)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +28,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// enable/disable sidechannel
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// number of blocks side-tx vote tallies are kept, 0 disables recording
+	VoteRetentionBlocks uint64 `protobuf:"varint,2,opt,name=vote_retention_blocks,json=voteRetentionBlocks,proto3" json:"vote_retention_blocks,omitempty" yaml:"vote_retention_blocks"`
+	// side-tx is approved if yes power is more than this fraction of total power
+	ApprovalThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=approval_threshold,json=approvalThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"approval_threshold" yaml:"approval_threshold"`
+	// side-tx is rejected if no power is more than this fraction of total power
+	RejectionThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=rejection_threshold,json=rejectionThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rejection_threshold" yaml:"rejection_threshold"`
+	// number of blocks after inclusion at which side-tx votes are tallied
+	SideBlockDelay uint64 `protobuf:"varint,5,opt,name=side_block_delay,json=sideBlockDelay,proto3" json:"side_block_delay,omitempty" yaml:"side_block_delay"`
+	// number of latest side-txs validator participation is tracked over
+	ParticipationWindow uint64 `protobuf:"varint,6,opt,name=participation_window,json=participationWindow,proto3" json:"participation_window,omitempty" yaml:"participation_window"`
+	// validator is reported once its vote ratio in a full window drops below this
+	MinParticipationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_participation_ratio,json=minParticipationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_participation_ratio" yaml:"min_participation_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +82,27 @@ func (m *Params) GetEnabled() bool {
 	return false
 }
 
+func (m *Params) GetVoteRetentionBlocks() uint64 {
+	if m != nil {
+		return m.VoteRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSideBlockDelay() uint64 {
+	if m != nil {
+		return m.SideBlockDelay
+	}
+	return 0
+}
+
+func (m *Params) GetParticipationWindow() uint64 {
+	if m != nil {
+		return m.ParticipationWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "heimdall.sidechannel.v1beta1.Params")
 }
@@ -78,20 +112,37 @@ func init() {
 }
 
 var fileDescriptor_ceedcf0c36c1a655 = []byte{
-	// 197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x2f, 0xce, 0x4c, 0x49, 0x4d, 0xce, 0x48, 0xcc, 0xcb, 0x4b, 0xcd,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x29, 0xd5, 0x43, 0x52, 0xaa, 0x07, 0x55, 0x2a,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x28, 0xe9, 0x70, 0xb1,
-	0x05, 0x80, 0xcd, 0x10, 0x92, 0xe0, 0x62, 0x4f, 0xcd, 0x4b, 0x4c, 0xca, 0x49, 0x4d, 0x91, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x08, 0x82, 0x71, 0xad, 0x38, 0x66, 0x2c, 0x90, 0x67, 0x7c, 0xb1, 0x40,
-	0x9e, 0xd1, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0x93, 0xf3,
-	0x52, 0x4b, 0xca, 0xf3, 0x8b, 0xb2, 0xf5, 0xe1, 0xce, 0xaf, 0x40, 0xf1, 0x40, 0x49, 0x65, 0x41,
-	0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x11, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0xaf, 0x07,
-	0x10, 0xe5, 0x00, 0x00, 0x00,
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc5, 0x73, 0xd0, 0xa6, 0xd5, 0x09, 0x21, 0xb8, 0xb4, 0xaa, 0x69, 0x2b, 0x5f, 0xe4, 0x01,
+	0x85, 0x01, 0x5b, 0x15, 0x4c, 0x1d, 0xa3, 0x32, 0x81, 0x50, 0x74, 0xaa, 0x84, 0xc4, 0x62, 0x9d,
+	0xed, 0x53, 0x7c, 0xe4, 0x7c, 0x67, 0x9d, 0x8f, 0x84, 0x20, 0xb1, 0x33, 0x32, 0x32, 0x56, 0xe2,
+	0xcb, 0x74, 0xec, 0x88, 0x18, 0x2c, 0x94, 0x2c, 0xcc, 0xf9, 0x04, 0xc8, 0xe7, 0xba, 0x4d, 0x20,
+	0x1d, 0xb2, 0xf8, 0xec, 0x9f, 0xdf, 0xbd, 0xf7, 0xd7, 0xb3, 0x0f, 0x3e, 0x4b, 0x19, 0xcf, 0x12,
+	0x2a, 0x44, 0x50, 0xf0, 0x84, 0xc5, 0x29, 0x95, 0x92, 0x89, 0x60, 0x7c, 0x12, 0x31, 0x43, 0x4f,
+	0x82, 0x9c, 0x6a, 0x9a, 0x15, 0x7e, 0xae, 0x95, 0x51, 0xe8, 0xb8, 0x91, 0xfa, 0x4b, 0x52, 0xff,
+	0x5a, 0x7a, 0xb8, 0x37, 0x54, 0x43, 0x65, 0x85, 0x41, 0x75, 0x57, 0xef, 0xf1, 0x7e, 0x6c, 0xc3,
+	0xf6, 0xc0, 0x9a, 0x20, 0x07, 0xee, 0x30, 0x49, 0x23, 0xc1, 0x12, 0x07, 0x74, 0x41, 0x6f, 0x97,
+	0x34, 0x8f, 0xe8, 0x1c, 0xee, 0x8f, 0x95, 0x61, 0xa1, 0x66, 0x86, 0x49, 0xc3, 0x95, 0x0c, 0x23,
+	0xa1, 0xe2, 0x51, 0xe1, 0xdc, 0xeb, 0x82, 0xde, 0x56, 0xbf, 0xbb, 0x28, 0xf1, 0xf1, 0x94, 0x66,
+	0xe2, 0xd4, 0x5b, 0x2b, 0xf3, 0x48, 0xa7, 0xe2, 0xa4, 0xc1, 0x7d, 0x4b, 0xd1, 0x67, 0x88, 0x68,
+	0x9e, 0x6b, 0x35, 0xa6, 0x22, 0x34, 0xa9, 0x66, 0x45, 0xaa, 0x44, 0xe2, 0xdc, 0xef, 0x82, 0xde,
+	0x83, 0xfe, 0xeb, 0xcb, 0x12, 0xb7, 0x7e, 0x95, 0xf8, 0xe9, 0x90, 0x9b, 0xf4, 0x63, 0xe4, 0xc7,
+	0x2a, 0x0b, 0x62, 0x55, 0x64, 0xaa, 0xb8, 0x5e, 0x9e, 0x17, 0xc9, 0x28, 0x30, 0xd3, 0x9c, 0x15,
+	0xfe, 0x19, 0x8b, 0x17, 0x25, 0x7e, 0x52, 0x0f, 0xf0, 0xbf, 0xa3, 0x47, 0x1e, 0x37, 0xf0, 0xbc,
+	0x61, 0xe8, 0x0b, 0xec, 0x68, 0xf6, 0x81, 0xc5, 0x76, 0xca, 0xdb, 0xf0, 0x2d, 0x1b, 0xfe, 0x66,
+	0xe3, 0xf0, 0xc3, 0x3a, 0x7c, 0x8d, 0xa5, 0x47, 0xd0, 0x0d, 0xbd, 0x8d, 0x7f, 0x05, 0x1f, 0x55,
+	0x9f, 0xa8, 0xee, 0x27, 0x4c, 0x98, 0xa0, 0x53, 0x67, 0xdb, 0x76, 0x79, 0xb4, 0x28, 0xf1, 0x41,
+	0xed, 0xf6, 0xaf, 0xc2, 0x23, 0x0f, 0x2b, 0x64, 0xdb, 0x3b, 0xab, 0x00, 0x22, 0x70, 0x2f, 0xa7,
+	0xda, 0xf0, 0x98, 0xe7, 0xd4, 0xc6, 0x4e, 0xb8, 0x4c, 0xd4, 0xc4, 0x69, 0x5b, 0x2b, 0xbc, 0x28,
+	0xf1, 0x51, 0x6d, 0xb5, 0x4e, 0xe5, 0x91, 0xce, 0x0a, 0x7e, 0x67, 0x29, 0xfa, 0x0a, 0xe0, 0x41,
+	0xc6, 0x65, 0xb8, 0xba, 0x45, 0x57, 0x8b, 0xb3, 0x63, 0xeb, 0x19, 0x6c, 0x5c, 0x8f, 0x5b, 0x4f,
+	0x71, 0x87, 0xad, 0x47, 0xf6, 0x33, 0x2e, 0x07, 0xcb, 0x2f, 0x48, 0x75, 0x3d, 0xdd, 0xfd, 0x7e,
+	0x81, 0xc1, 0x9f, 0x0b, 0x0c, 0xfa, 0x6f, 0x2f, 0x67, 0x2e, 0xb8, 0x9a, 0xb9, 0xe0, 0xf7, 0xcc,
+	0x05, 0xdf, 0xe6, 0x6e, 0xeb, 0x6a, 0xee, 0xb6, 0x7e, 0xce, 0xdd, 0xd6, 0xfb, 0x97, 0x4b, 0x43,
+	0x64, 0xd4, 0xf0, 0x58, 0x32, 0x33, 0x51, 0x7a, 0x14, 0xdc, 0x1c, 0x9b, 0x4f, 0x2b, 0x07, 0xc7,
+	0x8e, 0x15, 0xb5, 0xed, 0xcf, 0xff, 0xe2, 0xef, 0x00, 0xb5, 0x1a, 0x1e, 0xed, 0x5d, 0x03, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -116,6 +167,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.Enabled != that1.Enabled {
 		return false
 	}
+	if this.VoteRetentionBlocks != that1.VoteRetentionBlocks {
+		return false
+	}
+	if !this.ApprovalThreshold.Equal(that1.ApprovalThreshold) {
+		return false
+	}
+	if !this.RejectionThreshold.Equal(that1.RejectionThreshold) {
+		return false
+	}
+	if this.SideBlockDelay != that1.SideBlockDelay {
+		return false
+	}
+	if this.ParticipationWindow != that1.ParticipationWindow {
+		return false
+	}
+	if !this.MinParticipationRatio.Equal(that1.MinParticipationRatio) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +207,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinParticipationRatio.Size()
+		i -= size
+		if _, err := m.MinParticipationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ParticipationWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParticipationWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SideBlockDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SideBlockDelay))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.RejectionThreshold.Size()
+		i -= size
+		if _, err := m.RejectionThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ApprovalThreshold.Size()
+		i -= size
+		if _, err := m.ApprovalThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.VoteRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VoteRetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -171,6 +285,21 @@ func (m *Params) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
+	if m.VoteRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.VoteRetentionBlocks))
+	}
+	l = m.ApprovalThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RejectionThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SideBlockDelay != 0 {
+		n += 1 + sovParams(uint64(m.SideBlockDelay))
+	}
+	if m.ParticipationWindow != 0 {
+		n += 1 + sovParams(uint64(m.ParticipationWindow))
+	}
+	l = m.MinParticipationRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -229,6 +358,162 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteRetentionBlocks", wireType)
+			}
+			m.VoteRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApprovalThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectionThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RejectionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SideBlockDelay", wireType)
+			}
+			m.SideBlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SideBlockDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationWindow", wireType)
+			}
+			m.ParticipationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinParticipationRatio", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinParticipationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/x/sidechannel/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, types.DefaultParams().Validate())
+
+	testCases := []struct {
+		name   string
+		modify func(p *types.Params)
+	}{
+		{"zero approval threshold", func(p *types.Params) { p.ApprovalThreshold = sdk.ZeroDec() }},
+		{"approval threshold of one", func(p *types.Params) { p.ApprovalThreshold = sdk.OneDec() }},
+		{"negative rejection threshold", func(p *types.Params) { p.RejectionThreshold = sdk.NewDec(-1) }},
+		{"thresholds allow approval and rejection", func(p *types.Params) {
+			p.ApprovalThreshold = sdk.NewDecWithPrec(4, 1)
+			p.RejectionThreshold = sdk.NewDecWithPrec(5, 1)
+		}},
+		{"zero side block delay", func(p *types.Params) { p.SideBlockDelay = 0 }},
+		{"zero participation window", func(p *types.Params) { p.ParticipationWindow = 0 }},
+		{"negative min participation ratio", func(p *types.Params) { p.MinParticipationRatio = sdk.NewDec(-1) }},
+		{"min participation ratio above one", func(p *types.Params) { p.MinParticipationRatio = sdk.NewDecWithPrec(11, 1) }},
+	}
+
+	for _, tc := range testCases {
+		params := types.DefaultParams()
+		tc.modify(&params)
+		require.Error(t, params.Validate(), tc.name)
+	}
+}
+
+func TestParamsRequiredPower(t *testing.T) {
+	t.Parallel()
+
+	params := types.DefaultParams()
+
+	// default thresholds match totalPower*2/3 + 1
+	for _, totalPower := range []int64{1, 2, 3, 10, 100, 299, 300, 1000001} {
+		require.Equal(t, totalPower*2/3+1, params.ApprovalPower(totalPower))
+		require.Equal(t, totalPower*2/3+1, params.RejectionPower(totalPower))
+	}
+
+	params.ApprovalThreshold = sdk.NewDecWithPrec(5, 1)
+	require.Equal(t, int64(51), params.ApprovalPower(100))
+	require.Equal(t, int64(51), params.ApprovalPower(101))
+}
@@ -0,0 +1,2051 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdall/sidechannel/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QuerySideTxTallyRequest struct {
+	// hex encoded tx hash
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *QuerySideTxTallyRequest) Reset()         { *m = QuerySideTxTallyRequest{} }
+func (m *QuerySideTxTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySideTxTallyRequest) ProtoMessage()    {}
+func (*QuerySideTxTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{2}
+}
+func (m *QuerySideTxTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySideTxTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySideTxTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySideTxTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySideTxTallyRequest.Merge(m, src)
+}
+func (m *QuerySideTxTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySideTxTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySideTxTallyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySideTxTallyRequest proto.InternalMessageInfo
+
+func (m *QuerySideTxTallyRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type QuerySideTxTallyResponse struct {
+	Tally *SideTxTally `protobuf:"bytes,1,opt,name=tally,proto3" json:"tally,omitempty"`
+}
+
+func (m *QuerySideTxTallyResponse) Reset()         { *m = QuerySideTxTallyResponse{} }
+func (m *QuerySideTxTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySideTxTallyResponse) ProtoMessage()    {}
+func (*QuerySideTxTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{3}
+}
+func (m *QuerySideTxTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySideTxTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySideTxTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySideTxTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySideTxTallyResponse.Merge(m, src)
+}
+func (m *QuerySideTxTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySideTxTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySideTxTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySideTxTallyResponse proto.InternalMessageInfo
+
+func (m *QuerySideTxTallyResponse) GetTally() *SideTxTally {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
+type QuerySideTxTalliesRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuerySideTxTalliesRequest) Reset()         { *m = QuerySideTxTalliesRequest{} }
+func (m *QuerySideTxTalliesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySideTxTalliesRequest) ProtoMessage()    {}
+func (*QuerySideTxTalliesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{4}
+}
+func (m *QuerySideTxTalliesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySideTxTalliesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySideTxTalliesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySideTxTalliesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySideTxTalliesRequest.Merge(m, src)
+}
+func (m *QuerySideTxTalliesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySideTxTalliesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySideTxTalliesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySideTxTalliesRequest proto.InternalMessageInfo
+
+func (m *QuerySideTxTalliesRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QuerySideTxTalliesResponse struct {
+	Tallies []SideTxTally `protobuf:"bytes,1,rep,name=tallies,proto3" json:"tallies"`
+}
+
+func (m *QuerySideTxTalliesResponse) Reset()         { *m = QuerySideTxTalliesResponse{} }
+func (m *QuerySideTxTalliesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySideTxTalliesResponse) ProtoMessage()    {}
+func (*QuerySideTxTalliesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{5}
+}
+func (m *QuerySideTxTalliesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySideTxTalliesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySideTxTalliesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySideTxTalliesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySideTxTalliesResponse.Merge(m, src)
+}
+func (m *QuerySideTxTalliesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySideTxTalliesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySideTxTalliesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySideTxTalliesResponse proto.InternalMessageInfo
+
+func (m *QuerySideTxTalliesResponse) GetTallies() []SideTxTally {
+	if m != nil {
+		return m.Tallies
+	}
+	return nil
+}
+
+type QueryParticipationRequest struct {
+	ValId uint64 `protobuf:"varint,1,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+}
+
+func (m *QueryParticipationRequest) Reset()         { *m = QueryParticipationRequest{} }
+func (m *QueryParticipationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationRequest) ProtoMessage()    {}
+func (*QueryParticipationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{6}
+}
+func (m *QueryParticipationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationRequest.Merge(m, src)
+}
+func (m *QueryParticipationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationRequest proto.InternalMessageInfo
+
+func (m *QueryParticipationRequest) GetValId() uint64 {
+	if m != nil {
+		return m.ValId
+	}
+	return 0
+}
+
+type QueryParticipationResponse struct {
+	Participation SideTxParticipation `protobuf:"bytes,1,opt,name=participation,proto3" json:"participation"`
+}
+
+func (m *QueryParticipationResponse) Reset()         { *m = QueryParticipationResponse{} }
+func (m *QueryParticipationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationResponse) ProtoMessage()    {}
+func (*QueryParticipationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{7}
+}
+func (m *QueryParticipationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationResponse.Merge(m, src)
+}
+func (m *QueryParticipationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationResponse proto.InternalMessageInfo
+
+func (m *QueryParticipationResponse) GetParticipation() SideTxParticipation {
+	if m != nil {
+		return m.Participation
+	}
+	return SideTxParticipation{}
+}
+
+type QueryParticipationsRequest struct {
+}
+
+func (m *QueryParticipationsRequest) Reset()         { *m = QueryParticipationsRequest{} }
+func (m *QueryParticipationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationsRequest) ProtoMessage()    {}
+func (*QueryParticipationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{8}
+}
+func (m *QueryParticipationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationsRequest.Merge(m, src)
+}
+func (m *QueryParticipationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationsRequest proto.InternalMessageInfo
+
+type QueryParticipationsResponse struct {
+	Participations []SideTxParticipation `protobuf:"bytes,1,rep,name=participations,proto3" json:"participations"`
+}
+
+func (m *QueryParticipationsResponse) Reset()         { *m = QueryParticipationsResponse{} }
+func (m *QueryParticipationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationsResponse) ProtoMessage()    {}
+func (*QueryParticipationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f50f430de626cc, []int{9}
+}
+func (m *QueryParticipationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationsResponse.Merge(m, src)
+}
+func (m *QueryParticipationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationsResponse proto.InternalMessageInfo
+
+func (m *QueryParticipationsResponse) GetParticipations() []SideTxParticipation {
+	if m != nil {
+		return m.Participations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.sidechannel.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.sidechannel.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QuerySideTxTallyRequest)(nil), "heimdall.sidechannel.v1beta1.QuerySideTxTallyRequest")
+	proto.RegisterType((*QuerySideTxTallyResponse)(nil), "heimdall.sidechannel.v1beta1.QuerySideTxTallyResponse")
+	proto.RegisterType((*QuerySideTxTalliesRequest)(nil), "heimdall.sidechannel.v1beta1.QuerySideTxTalliesRequest")
+	proto.RegisterType((*QuerySideTxTalliesResponse)(nil), "heimdall.sidechannel.v1beta1.QuerySideTxTalliesResponse")
+	proto.RegisterType((*QueryParticipationRequest)(nil), "heimdall.sidechannel.v1beta1.QueryParticipationRequest")
+	proto.RegisterType((*QueryParticipationResponse)(nil), "heimdall.sidechannel.v1beta1.QueryParticipationResponse")
+	proto.RegisterType((*QueryParticipationsRequest)(nil), "heimdall.sidechannel.v1beta1.QueryParticipationsRequest")
+	proto.RegisterType((*QueryParticipationsResponse)(nil), "heimdall.sidechannel.v1beta1.QueryParticipationsResponse")
+}
+
+func init() {
+	proto.RegisterFile("heimdall/sidechannel/v1beta1/query.proto", fileDescriptor_f3f50f430de626cc)
+}
+
+var fileDescriptor_f3f50f430de626cc = []byte{
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x86, 0x1b, 0x58, 0x53, 0xe1, 0xa9, 0x3b, 0x98, 0xc1, 0x4a, 0xa8, 0xc2, 0x14, 0x4d, 0x68,
+	0x13, 0x2c, 0x5e, 0xdb, 0xad, 0x0c, 0x09, 0x09, 0xa9, 0x27, 0x76, 0x41, 0xa3, 0xec, 0x02, 0x08,
+	0x55, 0x6e, 0x6b, 0x25, 0x16, 0x69, 0x92, 0x35, 0x6e, 0x69, 0x55, 0x8d, 0x03, 0xbf, 0x00, 0x89,
+	0x0b, 0x12, 0x3f, 0x83, 0x1b, 0x27, 0x8e, 0x3b, 0x4e, 0xe2, 0xc2, 0x09, 0xa1, 0x96, 0x1f, 0x32,
+	0xd5, 0x76, 0xd6, 0x66, 0xad, 0xb2, 0x66, 0xb7, 0xd4, 0xf1, 0xfb, 0xfa, 0xf9, 0xbe, 0x7c, 0xaf,
+	0x0b, 0x36, 0x6d, 0x42, 0x5b, 0x4d, 0xec, 0x38, 0x28, 0xa0, 0x4d, 0xd2, 0xb0, 0xb1, 0xeb, 0x12,
+	0x07, 0x75, 0x0b, 0x75, 0xc2, 0x70, 0x01, 0x1d, 0x77, 0x48, 0xbb, 0x6f, 0xfa, 0x6d, 0x8f, 0x79,
+	0x30, 0x1f, 0xee, 0x34, 0xa7, 0x76, 0x9a, 0x72, 0xa7, 0xb6, 0x15, 0xeb, 0xe3, 0xe3, 0x36, 0x6e,
+	0x05, 0xc2, 0x48, 0x33, 0x63, 0xb7, 0x4e, 0x9b, 0x8b, 0xfd, 0x79, 0xcb, 0xf3, 0x2c, 0x87, 0x20,
+	0xec, 0x53, 0x84, 0x5d, 0xd7, 0x63, 0x98, 0x51, 0xcf, 0x0d, 0xdd, 0x56, 0x2d, 0xcf, 0xf2, 0xf8,
+	0x23, 0x1a, 0x3f, 0x89, 0x55, 0x63, 0x15, 0xc0, 0x57, 0x63, 0xf6, 0x43, 0x7e, 0x70, 0x95, 0x1c,
+	0x77, 0x48, 0xc0, 0x8c, 0x37, 0xe0, 0x76, 0x64, 0x35, 0xf0, 0x3d, 0x37, 0x20, 0xb0, 0x02, 0x54,
+	0x01, 0x98, 0x53, 0xd6, 0x95, 0xcd, 0xe5, 0xe2, 0x86, 0x19, 0x57, 0xaa, 0x29, 0xd4, 0x95, 0xa5,
+	0xd3, 0xbf, 0x0f, 0x52, 0x55, 0xa9, 0x34, 0x8a, 0x60, 0x8d, 0x5b, 0xbf, 0xa6, 0x4d, 0x72, 0xd4,
+	0x3b, 0xc2, 0x8e, 0xd3, 0x97, 0xa7, 0xc2, 0x35, 0x90, 0x61, 0xbd, 0x9a, 0x8d, 0x03, 0x9b, 0xfb,
+	0xdf, 0xaa, 0xaa, 0xac, 0xf7, 0x02, 0x07, 0xb6, 0xf1, 0x0e, 0xe4, 0x66, 0x35, 0x92, 0xe9, 0x39,
+	0x48, 0xb3, 0xf1, 0x82, 0x44, 0xda, 0x8a, 0x47, 0x9a, 0x76, 0x10, 0x3a, 0xa3, 0x04, 0xee, 0x5d,
+	0x32, 0xa7, 0x24, 0x6c, 0x04, 0xbc, 0x0b, 0x54, 0x9b, 0x50, 0xcb, 0x66, 0xdc, 0x7e, 0xa9, 0x2a,
+	0x7f, 0x19, 0x16, 0xd0, 0xe6, 0x89, 0x24, 0xd3, 0x01, 0xc8, 0x30, 0xb1, 0x94, 0x53, 0xd6, 0x6f,
+	0x26, 0xa2, 0x92, 0xdd, 0x0a, 0xf5, 0x46, 0x51, 0xd2, 0x1d, 0xe2, 0x36, 0xa3, 0x0d, 0xea, 0xf3,
+	0x4f, 0x1a, 0xd2, 0xdd, 0x01, 0x6a, 0x17, 0x3b, 0x35, 0xda, 0x94, 0x74, 0xe9, 0x2e, 0x76, 0x0e,
+	0x9a, 0xc6, 0x00, 0x68, 0xf3, 0x34, 0x12, 0xee, 0x3d, 0xc8, 0xfa, 0xd3, 0x2f, 0x64, 0xe3, 0x0a,
+	0x8b, 0x20, 0x46, 0x1c, 0x25, 0x6a, 0xd4, 0xcd, 0xc8, 0xcf, 0x3b, 0xfc, 0x62, 0xb0, 0x3e, 0x81,
+	0xfb, 0x73, 0xdf, 0x4a, 0xb6, 0x1a, 0x58, 0x89, 0xb8, 0x85, 0xfd, 0xbb, 0x36, 0xdc, 0x25, 0xbb,
+	0xe2, 0xb7, 0x0c, 0x48, 0x73, 0x00, 0xf8, 0x5d, 0x01, 0xaa, 0x18, 0x50, 0xb8, 0x13, 0xef, 0x3e,
+	0x9b, 0x0f, 0xad, 0x90, 0x40, 0x21, 0x4a, 0x33, 0x1e, 0x7f, 0xfe, 0xfd, 0xff, 0xeb, 0x8d, 0x87,
+	0x70, 0x03, 0x2d, 0x70, 0x01, 0xc0, 0x1f, 0x0a, 0x58, 0x9e, 0x9a, 0x0a, 0xb8, 0xb7, 0xc0, 0x81,
+	0xb3, 0x89, 0xd2, 0xca, 0x49, 0x65, 0x12, 0x76, 0x8f, 0xc3, 0x22, 0xb8, 0x1d, 0x0f, 0xcb, 0x03,
+	0x84, 0x06, 0x32, 0xb4, 0x27, 0xf0, 0xa7, 0x02, 0xb2, 0x91, 0x44, 0xc0, 0x27, 0x89, 0x00, 0x26,
+	0xc1, 0xd3, 0xf6, 0x93, 0x0b, 0x25, 0x7b, 0x99, 0xb3, 0xef, 0x40, 0xf3, 0x6a, 0x76, 0x4a, 0x02,
+	0x34, 0x10, 0x89, 0x3e, 0x81, 0xbf, 0x14, 0x90, 0x8d, 0x8c, 0xd0, 0x42, 0xf0, 0xf3, 0x72, 0xa9,
+	0xed, 0x27, 0x17, 0x4a, 0xf8, 0x67, 0x1c, 0xbe, 0x0c, 0x77, 0xaf, 0x9c, 0x92, 0x89, 0x18, 0x0d,
+	0xc4, 0x25, 0xc0, 0xfb, 0xbf, 0x12, 0x4d, 0x16, 0x4c, 0x8c, 0x72, 0xf1, 0x05, 0x9e, 0x5e, 0x43,
+	0x29, 0xab, 0x28, 0xf1, 0x2a, 0xb6, 0xe1, 0xa3, 0x04, 0x55, 0x54, 0x5e, 0x9e, 0x0e, 0x75, 0xe5,
+	0x6c, 0xa8, 0x2b, 0xff, 0x86, 0xba, 0xf2, 0x65, 0xa4, 0xa7, 0xce, 0x46, 0x7a, 0xea, 0xcf, 0x48,
+	0x4f, 0xbd, 0xdd, 0xb5, 0x28, 0xb3, 0x3b, 0x75, 0xb3, 0xe1, 0xb5, 0x50, 0x0b, 0x33, 0xda, 0x70,
+	0x09, 0xfb, 0xe8, 0xb5, 0x3f, 0x4c, 0xdc, 0x7b, 0x11, 0x7f, 0xd6, 0xf7, 0x49, 0x50, 0x57, 0xf9,
+	0x1f, 0x5c, 0xe9, 0x7c, 0x00, 0x49, 0x03, 0x9f, 0xfc, 0xb9, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the sidechannel parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SideTxTally queries the vote tally of a side-tx by tx hash.
+	SideTxTally(ctx context.Context, in *QuerySideTxTallyRequest, opts ...grpc.CallOption) (*QuerySideTxTallyResponse, error)
+	// SideTxTallies queries the vote tallies of side-txs included at height.
+	SideTxTallies(ctx context.Context, in *QuerySideTxTalliesRequest, opts ...grpc.CallOption) (*QuerySideTxTalliesResponse, error)
+	// Participation queries side-tx participation of a validator.
+	Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error)
+	// Participations queries side-tx participation of all validators.
+	Participations(ctx context.Context, in *QueryParticipationsRequest, opts ...grpc.CallOption) (*QueryParticipationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.sidechannel.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SideTxTally(ctx context.Context, in *QuerySideTxTallyRequest, opts ...grpc.CallOption) (*QuerySideTxTallyResponse, error) {
+	out := new(QuerySideTxTallyResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.sidechannel.v1beta1.Query/SideTxTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SideTxTallies(ctx context.Context, in *QuerySideTxTalliesRequest, opts ...grpc.CallOption) (*QuerySideTxTalliesResponse, error) {
+	out := new(QuerySideTxTalliesResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.sidechannel.v1beta1.Query/SideTxTallies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error) {
+	out := new(QueryParticipationResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.sidechannel.v1beta1.Query/Participation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Participations(ctx context.Context, in *QueryParticipationsRequest, opts ...grpc.CallOption) (*QueryParticipationsResponse, error) {
+	out := new(QueryParticipationsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.sidechannel.v1beta1.Query/Participations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the sidechannel parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SideTxTally queries the vote tally of a side-tx by tx hash.
+	SideTxTally(context.Context, *QuerySideTxTallyRequest) (*QuerySideTxTallyResponse, error)
+	// SideTxTallies queries the vote tallies of side-txs included at height.
+	SideTxTallies(context.Context, *QuerySideTxTalliesRequest) (*QuerySideTxTalliesResponse, error)
+	// Participation queries side-tx participation of a validator.
+	Participation(context.Context, *QueryParticipationRequest) (*QueryParticipationResponse, error)
+	// Participations queries side-tx participation of all validators.
+	Participations(context.Context, *QueryParticipationsRequest) (*QueryParticipationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SideTxTally(ctx context.Context, req *QuerySideTxTallyRequest) (*QuerySideTxTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SideTxTally not implemented")
+}
+func (*UnimplementedQueryServer) SideTxTallies(ctx context.Context, req *QuerySideTxTalliesRequest) (*QuerySideTxTalliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SideTxTallies not implemented")
+}
+func (*UnimplementedQueryServer) Participation(ctx context.Context, req *QueryParticipationRequest) (*QueryParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Participation not implemented")
+}
+func (*UnimplementedQueryServer) Participations(ctx context.Context, req *QueryParticipationsRequest) (*QueryParticipationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Participations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.sidechannel.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SideTxTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySideTxTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SideTxTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.sidechannel.v1beta1.Query/SideTxTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SideTxTally(ctx, req.(*QuerySideTxTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SideTxTallies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySideTxTalliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SideTxTallies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.sidechannel.v1beta1.Query/SideTxTallies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SideTxTallies(ctx, req.(*QuerySideTxTalliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Participation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Participation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.sidechannel.v1beta1.Query/Participation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Participation(ctx, req.(*QueryParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Participations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Participations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.sidechannel.v1beta1.Query/Participations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Participations(ctx, req.(*QueryParticipationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.sidechannel.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SideTxTally",
+			Handler:    _Query_SideTxTally_Handler,
+		},
+		{
+			MethodName: "SideTxTallies",
+			Handler:    _Query_SideTxTallies_Handler,
+		},
+		{
+			MethodName: "Participation",
+			Handler:    _Query_Participation_Handler,
+		},
+		{
+			MethodName: "Participations",
+			Handler:    _Query_Participations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heimdall/sidechannel/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySideTxTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySideTxTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySideTxTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySideTxTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySideTxTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySideTxTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tally != nil {
+		{
+			size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySideTxTalliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySideTxTalliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySideTxTalliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySideTxTalliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySideTxTalliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySideTxTalliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tallies) > 0 {
+		for iNdEx := len(m.Tallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Participation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participations) > 0 {
+		for iNdEx := len(m.Participations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySideTxTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySideTxTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tally != nil {
+		l = m.Tally.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySideTxTalliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QuerySideTxTalliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tallies) > 0 {
+		for _, e := range m.Tallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValId != 0 {
+		n += 1 + sovQuery(uint64(m.ValId))
+	}
+	return n
+}
+
+func (m *QueryParticipationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Participation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParticipationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParticipationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participations) > 0 {
+		for _, e := range m.Participations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySideTxTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySideTxTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySideTxTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySideTxTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySideTxTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySideTxTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tally == nil {
+				m.Tally = &SideTxTally{}
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySideTxTalliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySideTxTalliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySideTxTalliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySideTxTalliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySideTxTalliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySideTxTalliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tallies = append(m.Tallies, SideTxTally{})
+			if err := m.Tallies[len(m.Tallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Participation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participations = append(m.Participations, SideTxParticipation{})
+			if err := m.Participations[len(m.Participations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
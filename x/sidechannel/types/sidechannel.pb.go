@@ -7,7 +7,9 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types2 "github.com/maticnetwork/heimdall/types"
 	types "github.com/tendermint/tendermint/abci/types"
+	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -76,8 +78,293 @@ func (m *PreviousValidators) GetValidators() []*types.Validator {
 	return nil
 }
 
+// SideTxVote is a validator vote on a side-tx
+type SideTxVote struct {
+	Address []byte                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Power   int64                   `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Result  types1.SideTxResultType `protobuf:"varint,3,opt,name=result,proto3,enum=tendermint.types.SideTxResultType" json:"result,omitempty"`
+	// per-msg results signed by validator for multi-msg side-tx
+	MsgResults []types1.SideTxResultType `protobuf:"varint,4,rep,packed,name=msg_results,json=msgResults,proto3,enum=tendermint.types.SideTxResultType" json:"msg_results,omitempty" yaml:"msg_results"`
+}
+
+func (m *SideTxVote) Reset()         { *m = SideTxVote{} }
+func (m *SideTxVote) String() string { return proto.CompactTextString(m) }
+func (*SideTxVote) ProtoMessage()    {}
+func (*SideTxVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687ea62bd722fafc, []int{1}
+}
+func (m *SideTxVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideTxVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SideTxVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SideTxVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideTxVote.Merge(m, src)
+}
+func (m *SideTxVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideTxVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideTxVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideTxVote proto.InternalMessageInfo
+
+func (m *SideTxVote) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *SideTxVote) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *SideTxVote) GetResult() types1.SideTxResultType {
+	if m != nil {
+		return m.Result
+	}
+	return types1.SideTxResultType_SKIP
+}
+
+func (m *SideTxVote) GetMsgResults() []types1.SideTxResultType {
+	if m != nil {
+		return m.MsgResults
+	}
+	return nil
+}
+
+// SideTxTally is the vote tally of a side-tx processed in begin side-block
+type SideTxTally struct {
+	// height at which side-tx was included
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// height at which side-tx was tallied and executed
+	ExecutedHeight uint64 `protobuf:"varint,2,opt,name=executed_height,json=executedHeight,proto3" json:"executed_height,omitempty" yaml:"executed_height"`
+	TxHash         []byte `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty" yaml:"tx_hash"`
+	TotalPower     int64  `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty" yaml:"total_power"`
+	YesPower       int64  `protobuf:"varint,5,opt,name=yes_power,json=yesPower,proto3" json:"yes_power,omitempty" yaml:"yes_power"`
+	NoPower        int64  `protobuf:"varint,6,opt,name=no_power,json=noPower,proto3" json:"no_power,omitempty" yaml:"no_power"`
+	SkipPower      int64  `protobuf:"varint,7,opt,name=skip_power,json=skipPower,proto3" json:"skip_power,omitempty" yaml:"skip_power"`
+	// result side-tx was executed with
+	Result types1.SideTxResultType `protobuf:"varint,8,opt,name=result,proto3,enum=tendermint.types.SideTxResultType" json:"result,omitempty"`
+	Votes  []SideTxVote            `protobuf:"bytes,9,rep,name=votes,proto3" json:"votes"`
+	// per-msg results each msg of multi-msg side-tx was executed with
+	MsgResults []types1.SideTxResultType `protobuf:"varint,10,rep,packed,name=msg_results,json=msgResults,proto3,enum=tendermint.types.SideTxResultType" json:"msg_results,omitempty" yaml:"msg_results"`
+}
+
+func (m *SideTxTally) Reset()         { *m = SideTxTally{} }
+func (m *SideTxTally) String() string { return proto.CompactTextString(m) }
+func (*SideTxTally) ProtoMessage()    {}
+func (*SideTxTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687ea62bd722fafc, []int{2}
+}
+func (m *SideTxTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideTxTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SideTxTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SideTxTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideTxTally.Merge(m, src)
+}
+func (m *SideTxTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideTxTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideTxTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideTxTally proto.InternalMessageInfo
+
+func (m *SideTxTally) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SideTxTally) GetExecutedHeight() uint64 {
+	if m != nil {
+		return m.ExecutedHeight
+	}
+	return 0
+}
+
+func (m *SideTxTally) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *SideTxTally) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *SideTxTally) GetYesPower() int64 {
+	if m != nil {
+		return m.YesPower
+	}
+	return 0
+}
+
+func (m *SideTxTally) GetNoPower() int64 {
+	if m != nil {
+		return m.NoPower
+	}
+	return 0
+}
+
+func (m *SideTxTally) GetSkipPower() int64 {
+	if m != nil {
+		return m.SkipPower
+	}
+	return 0
+}
+
+func (m *SideTxTally) GetResult() types1.SideTxResultType {
+	if m != nil {
+		return m.Result
+	}
+	return types1.SideTxResultType_SKIP
+}
+
+func (m *SideTxTally) GetVotes() []SideTxVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *SideTxTally) GetMsgResults() []types1.SideTxResultType {
+	if m != nil {
+		return m.MsgResults
+	}
+	return nil
+}
+
+// SideTxParticipation is the side-tx participation of a validator over the
+// latest participation window
+type SideTxParticipation struct {
+	ValID types2.ValidatorID `protobuf:"varint,1,opt,name=val_id,json=valId,proto3,enum=heimdall.types.ValidatorID" json:"val_id,omitempty" yaml:"val_id"`
+	// next position in participation window
+	IndexOffset uint64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty" yaml:"index_offset"`
+	// side-txs validator voted on
+	VotedCount uint64 `protobuf:"varint,3,opt,name=voted_count,json=votedCount,proto3" json:"voted_count,omitempty" yaml:"voted_count"`
+	// side-txs validator didn't vote on
+	MissedCount uint64 `protobuf:"varint,4,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty" yaml:"missed_count"`
+	// side-txs validator voted against the executed result
+	DisagreedCount uint64 `protobuf:"varint,5,opt,name=disagreed_count,json=disagreedCount,proto3" json:"disagreed_count,omitempty" yaml:"disagreed_count"`
+	// true while vote ratio is below min participation ratio
+	LowParticipation bool `protobuf:"varint,6,opt,name=low_participation,json=lowParticipation,proto3" json:"low_participation,omitempty" yaml:"low_participation"`
+}
+
+func (m *SideTxParticipation) Reset()         { *m = SideTxParticipation{} }
+func (m *SideTxParticipation) String() string { return proto.CompactTextString(m) }
+func (*SideTxParticipation) ProtoMessage()    {}
+func (*SideTxParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_687ea62bd722fafc, []int{3}
+}
+func (m *SideTxParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SideTxParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SideTxParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SideTxParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SideTxParticipation.Merge(m, src)
+}
+func (m *SideTxParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *SideTxParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_SideTxParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SideTxParticipation proto.InternalMessageInfo
+
+func (m *SideTxParticipation) GetValID() types2.ValidatorID {
+	if m != nil {
+		return m.ValID
+	}
+	return types2.DEFAULT
+}
+
+func (m *SideTxParticipation) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *SideTxParticipation) GetVotedCount() uint64 {
+	if m != nil {
+		return m.VotedCount
+	}
+	return 0
+}
+
+func (m *SideTxParticipation) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+func (m *SideTxParticipation) GetDisagreedCount() uint64 {
+	if m != nil {
+		return m.DisagreedCount
+	}
+	return 0
+}
+
+func (m *SideTxParticipation) GetLowParticipation() bool {
+	if m != nil {
+		return m.LowParticipation
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*PreviousValidators)(nil), "heimdall.sidechannel.v1beta1.PreviousValidators")
+	proto.RegisterType((*SideTxVote)(nil), "heimdall.sidechannel.v1beta1.SideTxVote")
+	proto.RegisterType((*SideTxTally)(nil), "heimdall.sidechannel.v1beta1.SideTxTally")
+	proto.RegisterType((*SideTxParticipation)(nil), "heimdall.sidechannel.v1beta1.SideTxParticipation")
 }
 
 func init() {
@@ -85,23 +372,54 @@ func init() {
 }
 
 var fileDescriptor_687ea62bd722fafc = []byte{
-	// 244 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcb, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x2f, 0xce, 0x4c, 0x49, 0x4d, 0xce, 0x48, 0xcc, 0xcb, 0x4b, 0xcd,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0x44, 0x16, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xa9, 0xd7, 0x43, 0x96, 0x83, 0xaa, 0x97, 0x92, 0x2e, 0x49, 0xcd, 0x4b, 0x49,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4c, 0x4a, 0xce, 0xd4, 0x2f, 0xa9, 0x2c, 0x48, 0x2d,
-	0x86, 0x68, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0x88, 0xa8, 0x52,
-	0x06, 0x97, 0x50, 0x40, 0x51, 0x6a, 0x59, 0x66, 0x7e, 0x69, 0x71, 0x58, 0x62, 0x4e, 0x66, 0x4a,
-	0x62, 0x49, 0x7e, 0x51, 0xb1, 0x90, 0x18, 0x17, 0x5b, 0x46, 0x6a, 0x66, 0x7a, 0x46, 0x89, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x94, 0x27, 0x64, 0xc5, 0xc5, 0x55, 0x06, 0x57, 0x25, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0xb0, 0x55, 0x0f, 0x64, 0xab, 0x1e, 0xdc, 0xa0,
-	0x20, 0x24, 0xd5, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65,
-	0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x9f, 0x9b, 0x58, 0x92, 0x99,
-	0x9c, 0x97, 0x5a, 0x52, 0x9e, 0x5f, 0x94, 0xad, 0x0f, 0x0f, 0x9c, 0x0a, 0x94, 0xe0, 0x01, 0xfb,
-	0x2a, 0x89, 0x0d, 0xec, 0x01, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0xc8, 0x95, 0xd0,
-	0x43, 0x01, 0x00, 0x00,
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdf, 0x6a, 0xfb, 0x36,
+	0x18, 0x8d, 0x1b, 0xe7, 0x4f, 0x95, 0x2e, 0x6d, 0xdd, 0xae, 0x33, 0x69, 0x89, 0x83, 0x60, 0x10,
+	0x18, 0x38, 0xb4, 0x2b, 0x0c, 0x72, 0x99, 0xf6, 0xa2, 0xb9, 0xe9, 0x82, 0x56, 0x7a, 0xb1, 0x5d,
+	0x18, 0x25, 0x56, 0x63, 0x51, 0xdb, 0x0a, 0x96, 0xf2, 0xef, 0x2d, 0xf6, 0x14, 0x7b, 0x96, 0x5e,
+	0xf6, 0x66, 0xb0, 0x2b, 0x33, 0xd2, 0x37, 0xf0, 0x13, 0x0c, 0x4b, 0x8e, 0xe3, 0x66, 0x6c, 0x6c,
+	0xf0, 0xbb, 0xf3, 0xd1, 0x39, 0xe7, 0xfb, 0xa4, 0xcf, 0x47, 0x36, 0xb0, 0x3d, 0x42, 0x03, 0x17,
+	0xfb, 0x7e, 0x8f, 0x53, 0x97, 0x4c, 0x3c, 0x1c, 0x86, 0xc4, 0xef, 0x2d, 0xae, 0xc7, 0x44, 0xe0,
+	0xeb, 0xe2, 0x9a, 0x3d, 0x8b, 0x98, 0x60, 0xc6, 0xd5, 0x56, 0x6f, 0x17, 0xb9, 0x4c, 0xdf, 0xba,
+	0x14, 0x24, 0x74, 0x49, 0x14, 0xd0, 0x50, 0xf4, 0xf0, 0x78, 0x42, 0x7b, 0x62, 0x3d, 0x23, 0x5c,
+	0x59, 0x5b, 0x57, 0x05, 0x52, 0xae, 0x7f, 0x62, 0xcf, 0xa7, 0x6c, 0xca, 0xe4, 0x63, 0x2f, 0x7d,
+	0xca, 0x56, 0xbf, 0xcd, 0xb7, 0x37, 0xc6, 0x9c, 0xe4, 0xfb, 0x5a, 0x60, 0x9f, 0xba, 0x58, 0xb0,
+	0x48, 0xc9, 0xa0, 0x07, 0x8c, 0x51, 0x44, 0x16, 0x94, 0xcd, 0xf9, 0xf3, 0x96, 0xe2, 0xc6, 0x05,
+	0xa8, 0x7a, 0x84, 0x4e, 0x3d, 0x61, 0x6a, 0x1d, 0xad, 0xab, 0xa3, 0x0c, 0x19, 0x7d, 0x00, 0xf2,
+	0x02, 0xdc, 0x3c, 0xe8, 0x94, 0xbb, 0x8d, 0x9b, 0x96, 0xbd, 0xdb, 0x9d, 0x9d, 0x6e, 0xdd, 0xce,
+	0x0b, 0xa1, 0x82, 0x1a, 0xfe, 0xae, 0x01, 0xf0, 0x13, 0x75, 0xc9, 0xd3, 0xea, 0x99, 0x09, 0x62,
+	0x98, 0xa0, 0x86, 0x5d, 0x37, 0x22, 0x9c, 0xcb, 0x1e, 0x47, 0x68, 0x0b, 0x8d, 0x73, 0x50, 0x99,
+	0xb1, 0x25, 0x89, 0xcc, 0x83, 0x8e, 0xd6, 0x2d, 0x23, 0x05, 0x8c, 0x3e, 0xa8, 0x46, 0x84, 0xcf,
+	0x7d, 0x61, 0x96, 0x3b, 0x5a, 0xb7, 0x79, 0x03, 0x8b, 0x6d, 0xd5, 0x38, 0x54, 0x75, 0x24, 0x55,
+	0x4f, 0xeb, 0x19, 0x41, 0x99, 0xc3, 0xf8, 0x05, 0x34, 0x02, 0x3e, 0x75, 0x14, 0xe2, 0xa6, 0xde,
+	0x29, 0xff, 0xb7, 0x02, 0x83, 0x8b, 0x24, 0xb6, 0x8c, 0x35, 0x0e, 0xfc, 0x3e, 0x2c, 0x14, 0x80,
+	0x08, 0x04, 0x7c, 0x8a, 0x32, 0xf0, 0xa6, 0x83, 0x86, 0x32, 0x3e, 0x61, 0xdf, 0x5f, 0xff, 0xe3,
+	0xec, 0xee, 0xc0, 0x31, 0x59, 0x91, 0xc9, 0x5c, 0x10, 0xd7, 0xc9, 0x04, 0xe9, 0x01, 0xf5, 0x41,
+	0x2b, 0x89, 0xad, 0x0b, 0xd5, 0x64, 0x4f, 0x00, 0x51, 0x73, 0xbb, 0xf2, 0xa0, 0x8a, 0x7c, 0x07,
+	0x6a, 0x62, 0xe5, 0x78, 0x98, 0x7b, 0x72, 0x0c, 0x47, 0x03, 0x23, 0x89, 0xad, 0xa6, 0x32, 0x67,
+	0x04, 0x44, 0x55, 0xb1, 0x7a, 0xc0, 0xdc, 0x33, 0x7e, 0x00, 0x0d, 0xc1, 0x04, 0xf6, 0x1d, 0x35,
+	0x4e, 0x3d, 0x1d, 0x67, 0xf1, 0x48, 0x05, 0x12, 0x22, 0x20, 0xd1, 0x48, 0xce, 0xfa, 0x1a, 0x1c,
+	0xae, 0x09, 0xcf, 0x6c, 0x15, 0x69, 0x3b, 0x4f, 0x62, 0xeb, 0x44, 0xd9, 0x72, 0x0a, 0xa2, 0xfa,
+	0x9a, 0x70, 0x65, 0xb1, 0x41, 0x3d, 0x64, 0x99, 0xa3, 0x2a, 0x1d, 0x67, 0x49, 0x6c, 0x1d, 0x2b,
+	0xc7, 0x96, 0x81, 0xa8, 0x16, 0x32, 0xa5, 0xbf, 0x05, 0x80, 0xbf, 0xd2, 0x59, 0xe6, 0xa8, 0x49,
+	0xc7, 0xd7, 0x49, 0x6c, 0x9d, 0x2a, 0xc7, 0x8e, 0x83, 0xe8, 0x30, 0x05, 0xa3, 0xbd, 0x10, 0xd4,
+	0xff, 0x77, 0x08, 0xee, 0x41, 0x65, 0xc1, 0x04, 0xe1, 0xe6, 0xa1, 0x8c, 0x6d, 0xd7, 0xfe, 0xb7,
+	0xfb, 0x68, 0xef, 0x92, 0x3a, 0xd0, 0xdf, 0x62, 0xab, 0x84, 0x94, 0x79, 0x3f, 0x4a, 0xe0, 0x8b,
+	0x46, 0xe9, 0xb7, 0x32, 0x38, 0x53, 0xc6, 0x11, 0x8e, 0x04, 0x9d, 0xd0, 0x19, 0x16, 0x94, 0x85,
+	0xc6, 0x23, 0xa8, 0x2e, 0xb0, 0xef, 0x50, 0x57, 0x46, 0xaa, 0x79, 0x73, 0xb9, 0xdb, 0xbb, 0xea,
+	0x96, 0xdf, 0xb8, 0xe1, 0xfd, 0xa0, 0xb5, 0x89, 0xad, 0xca, 0x33, 0xf6, 0x87, 0xf7, 0x49, 0x6c,
+	0x7d, 0xa5, 0x3a, 0x2a, 0x37, 0x44, 0x95, 0x05, 0xf6, 0x87, 0xae, 0xd1, 0x07, 0x47, 0x34, 0x74,
+	0xc9, 0xca, 0x61, 0x2f, 0x2f, 0x9c, 0x6c, 0x73, 0xf8, 0x4d, 0x12, 0x5b, 0x67, 0x4a, 0x5f, 0x64,
+	0x21, 0x6a, 0x48, 0xf8, 0xa3, 0x44, 0x69, 0xa8, 0xd2, 0x49, 0xb8, 0xce, 0x84, 0xcd, 0x43, 0x75,
+	0x19, 0xf5, 0xe2, 0xe1, 0x0a, 0x24, 0x44, 0x40, 0xa2, 0xbb, 0x14, 0xa4, 0x4d, 0x03, 0xca, 0x79,
+	0xee, 0xd4, 0xf7, 0x9b, 0x16, 0x59, 0x88, 0x1a, 0x0a, 0x2a, 0xef, 0x1d, 0x38, 0x76, 0x29, 0xc7,
+	0xd3, 0x88, 0xe4, 0xf6, 0xca, 0xfe, 0xdd, 0xd9, 0x13, 0x40, 0xd4, 0xcc, 0x57, 0x54, 0x91, 0x21,
+	0x38, 0xf5, 0xd9, 0xd2, 0x99, 0x15, 0x47, 0x2b, 0xb3, 0x5a, 0x1f, 0x5c, 0x25, 0xb1, 0x65, 0xaa,
+	0x32, 0x7f, 0x93, 0x40, 0x74, 0xe2, 0xb3, 0xe5, 0xa7, 0x17, 0x32, 0x78, 0x7c, 0xdb, 0xb4, 0xb5,
+	0xf7, 0x4d, 0x5b, 0xfb, 0x73, 0xd3, 0xd6, 0x7e, 0xfd, 0x68, 0x97, 0xde, 0x3f, 0xda, 0xa5, 0x3f,
+	0x3e, 0xda, 0xa5, 0x9f, 0x6f, 0xa7, 0x54, 0x78, 0xf3, 0xb1, 0x3d, 0x61, 0x41, 0x2f, 0xc0, 0x82,
+	0x4e, 0x42, 0x22, 0x96, 0x2c, 0x7a, 0xed, 0xe5, 0x9f, 0xe3, 0xd5, 0xa7, 0xff, 0x85, 0x7c, 0x7f,
+	0xe3, 0xaa, 0xfc, 0x18, 0x7f, 0xff, 0xd7, 0x00, 0x47, 0x64, 0x10, 0xa8, 0x54, 0x06, 0x00, 0x00,
 }
 
 func (m *PreviousValidators) Marshal() (dAtA []byte, err error) {
@@ -131,57 +449,993 @@ func (m *PreviousValidators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintSidechannel(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintSidechannel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SideTxVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SideTxVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SideTxVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResults) > 0 {
+		dAtA2 := make([]byte, len(m.MsgResults)*10)
+		var j1 int
+		for _, num := range m.MsgResults {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintSidechannel(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Result != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Power != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSidechannel(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SideTxTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SideTxTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SideTxTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResults) > 0 {
+		dAtA4 := make([]byte, len(m.MsgResults)*10)
+		var j3 int
+		for _, num := range m.MsgResults {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintSidechannel(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSidechannel(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Result != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SkipPower != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.SkipPower))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NoPower != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.NoPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.YesPower != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.YesPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSidechannel(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExecutedHeight != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.ExecutedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SideTxParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SideTxParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SideTxParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LowParticipation {
+		i--
+		if m.LowParticipation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisagreedCount != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.DisagreedCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissedCount != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotedCount != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.VotedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValID != 0 {
+		i = encodeVarintSidechannel(dAtA, i, uint64(m.ValID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSidechannel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSidechannel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PreviousValidators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSidechannel(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovSidechannel(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SideTxVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSidechannel(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovSidechannel(uint64(m.Power))
+	}
+	if m.Result != 0 {
+		n += 1 + sovSidechannel(uint64(m.Result))
+	}
+	if len(m.MsgResults) > 0 {
+		l = 0
+		for _, e := range m.MsgResults {
+			l += sovSidechannel(uint64(e))
+		}
+		n += 1 + sovSidechannel(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SideTxTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSidechannel(uint64(m.Height))
+	}
+	if m.ExecutedHeight != 0 {
+		n += 1 + sovSidechannel(uint64(m.ExecutedHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSidechannel(uint64(l))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovSidechannel(uint64(m.TotalPower))
+	}
+	if m.YesPower != 0 {
+		n += 1 + sovSidechannel(uint64(m.YesPower))
+	}
+	if m.NoPower != 0 {
+		n += 1 + sovSidechannel(uint64(m.NoPower))
+	}
+	if m.SkipPower != 0 {
+		n += 1 + sovSidechannel(uint64(m.SkipPower))
+	}
+	if m.Result != 0 {
+		n += 1 + sovSidechannel(uint64(m.Result))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovSidechannel(uint64(l))
+		}
+	}
+	if len(m.MsgResults) > 0 {
+		l = 0
+		for _, e := range m.MsgResults {
+			l += sovSidechannel(uint64(e))
+		}
+		n += 1 + sovSidechannel(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *SideTxParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValID != 0 {
+		n += 1 + sovSidechannel(uint64(m.ValID))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSidechannel(uint64(m.IndexOffset))
+	}
+	if m.VotedCount != 0 {
+		n += 1 + sovSidechannel(uint64(m.VotedCount))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovSidechannel(uint64(m.MissedCount))
+	}
+	if m.DisagreedCount != 0 {
+		n += 1 + sovSidechannel(uint64(m.DisagreedCount))
+	}
+	if m.LowParticipation {
+		n += 2
+	}
+	return n
+}
+
+func sovSidechannel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSidechannel(x uint64) (n int) {
+	return sovSidechannel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PreviousValidators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSidechannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreviousValidators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreviousValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &types.Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSidechannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SideTxVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSidechannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SideTxVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SideTxVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= types1.SideTxResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v types1.SideTxResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSidechannel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= types1.SideTxResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MsgResults = append(m.MsgResults, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSidechannel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSidechannel
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSidechannel
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.MsgResults) == 0 {
+					m.MsgResults = make([]types1.SideTxResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v types1.SideTxResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSidechannel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= types1.SideTxResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MsgResults = append(m.MsgResults, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSidechannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SideTxTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSidechannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SideTxTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SideTxTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedHeight", wireType)
+			}
+			m.ExecutedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x12
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesPower", wireType)
+			}
+			m.YesPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.YesPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoPower", wireType)
+			}
+			m.NoPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipPower", wireType)
+			}
+			m.SkipPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkipPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= types1.SideTxResultType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, SideTxVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType == 0 {
+				var v types1.SideTxResultType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSidechannel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= types1.SideTxResultType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MsgResults = append(m.MsgResults, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSidechannel
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSidechannel
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSidechannel
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.MsgResults) == 0 {
+					m.MsgResults = make([]types1.SideTxResultType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v types1.SideTxResultType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSidechannel
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= types1.SideTxResultType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MsgResults = append(m.MsgResults, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSidechannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSidechannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Height != 0 {
-		i = encodeVarintSidechannel(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintSidechannel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSidechannel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *PreviousValidators) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovSidechannel(uint64(m.Height))
-	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovSidechannel(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovSidechannel(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSidechannel(x uint64) (n int) {
-	return sovSidechannel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PreviousValidators) Unmarshal(dAtA []byte) error {
+func (m *SideTxParticipation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -204,17 +1458,17 @@ func (m *PreviousValidators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PreviousValidators: wiretype end group for non-group")
+			return fmt.Errorf("proto: SideTxParticipation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreviousValidators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SideTxParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValID", wireType)
 			}
-			m.Height = 0
+			m.ValID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSidechannel
@@ -224,16 +1478,16 @@ func (m *PreviousValidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.ValID |= types2.ValidatorID(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
 			}
-			var msglen int
+			m.IndexOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSidechannel
@@ -243,26 +1497,88 @@ func (m *PreviousValidators) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.IndexOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSidechannel
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedCount", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSidechannel
+			m.VotedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
 			}
-			m.Validators = append(m.Validators, &types.Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MissedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisagreedCount", wireType)
+			}
+			m.DisagreedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisagreedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowParticipation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSidechannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LowParticipation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSidechannel(dAtA[iNdEx:])
@@ -0,0 +1,53 @@
+package slashing
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/x/slashing/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// BeginBlocker tracks liveness of the validators which signed the last block and
+// emits slash-limit event when slashed amount in buffer first crosses the slash fraction limit
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
+	if !k.GetParams(ctx).EnableSlashing {
+		return
+	}
+
+	// iterate over all the validators which *should* have signed this block
+	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
+		k.HandleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
+	}
+
+	// tick is already in progress
+	if len(k.GetTickValSlashingInfos(ctx)) > 0 {
+		return
+	}
+
+	// slash-limit event was already emitted for buffer
+	if k.IsSlashLimitEventEmitted(ctx) || !k.IsSlashLimitReached(ctx) {
+		return
+	}
+
+	slashInfoBytes, err := types.SortAndRLPEncodeSlashInfos(k.GetBufferValSlashingInfos(ctx))
+	if err != nil {
+		k.Logger(ctx).Error("Error generating slash info bytes", "error", err)
+		return
+	}
+
+	k.Logger(ctx).Info("Slash limit reached", "totalSlashedAmount", k.GetTotalSlashedAmount(ctx))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashLimit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposer, sdk.AccAddress(req.Header.GetProposerAddress()).String()),
+			sdk.NewAttribute(types.AttributeKeySlashInfoBytes, hex.EncodeToString(slashInfoBytes)),
+		),
+	)
+
+	k.SetSlashLimitEventEmitted(ctx)
+}
@@ -0,0 +1,53 @@
+package slashing_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	checkpointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/x/slashing"
+	"github.com/maticnetwork/heimdall/x/slashing/test_helper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+func TestBeginBlockerSlashLimitEvent(t *testing.T) {
+	initApp, ctx, _ := test_helper.CreateTestApp(false)
+	keeper := initApp.SlashingKeeper
+
+	valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	slashLimitEvents := func(ctx sdk.Context) int {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		slashing.BeginBlocker(ctx, abci.RequestBeginBlock{}, keeper)
+
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeSlashLimit {
+				count++
+			}
+		}
+		return count
+	}
+
+	// slash limit not reached
+	require.NoError(t, keeper.SlashInterim(ctx, validator.ID, 1))
+	require.Equal(t, 0, slashLimitEvents(ctx))
+
+	// event is emitted once limit is crossed and not in following blocks
+	require.NoError(t, keeper.SlashInterim(ctx, validator.ID, uint64(initApp.StakingKeeper.GetTotalPower(ctx))))
+	require.Equal(t, 1, slashLimitEvents(ctx))
+	require.Equal(t, 0, slashLimitEvents(ctx))
+
+	// event is emitted again for slashing infos buffered after tick
+	require.NoError(t, keeper.CopyBufferValSlashingInfosToTickData(ctx))
+	keeper.FlushBufferValSlashingInfos(ctx)
+	keeper.FlushTotalSlashedAmount(ctx)
+	keeper.FlushTickValSlashingInfos(ctx)
+
+	require.NoError(t, keeper.SlashInterim(ctx, validator.ID, uint64(initApp.StakingKeeper.GetTotalPower(ctx))))
+	require.Equal(t, 1, slashLimitEvents(ctx))
+}
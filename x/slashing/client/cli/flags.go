@@ -0,0 +1,7 @@
+package cli
+
+const (
+	FlagValidatorID = "id"
+	FlagPage        = "page"
+	FlagLimit       = "limit"
+)
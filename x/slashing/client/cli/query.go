@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group slashing queries under a subcommand
+	slashingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	slashingQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQuerySigningInfo(),
+		GetCmdQuerySigningInfos(),
+		GetCmdQuerySlashingBuffer(),
+		GetCmdQueryTickCount(),
+	)
+
+	return slashingQueryCmd
+}
+
+// GetCmdQueryParams implements the params query command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current slashing parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as slashing parameters.
+
+Example:
+$ %s query slashing params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySigningInfo implements the signing info query command.
+func GetCmdQuerySigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info",
+		Short: "show signing info of validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			valID, err := cmd.Flags().GetUint64(FlagValidatorID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SigningInfo(context.Background(), &types.QuerySigningInfoRequest{ValId: valID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.ValSigningInfo)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	_ = cmd.MarkFlagRequired(FlagValidatorID)
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySigningInfos implements the signing infos query command.
+func GetCmdQuerySigningInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "show signing infos of all validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			page, err := cmd.Flags().GetUint64(FlagPage)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SigningInfos(context.Background(), &types.QuerySigningInfosRequest{
+				Pagination: &hmTypes.QueryPaginationParams{Page: page, Limit: limit},
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagPage, 1, "--page=<page number>")
+	cmd.Flags().Uint64(FlagLimit, 10, "--limit=<number of results per page>")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySlashingBuffer implements the slashing buffer query command.
+func GetCmdQuerySlashingBuffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buffer",
+		Args:  cobra.NoArgs,
+		Short: "show slashing infos present in buffer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SlashingBuffer(context.Background(), &types.QuerySlashingBufferRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTickCount implements the tick count query command.
+func GetCmdQueryTickCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tick-count",
+		Args:  cobra.NoArgs,
+		Short: "get tick count",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TickCount(context.Background(), &types.QueryTickCountRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,73 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// InitGenesis initializes the slashing module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState *types.GenesisState) {
+	keeper.SetParams(ctx, genState.Params)
+
+	// set signing infos
+	for _, info := range genState.SigningInfos {
+		keeper.SetValidatorSigningInfo(ctx, info.ValID, info.ValidatorSigningInfo)
+	}
+
+	// set missed blocks
+	for _, array := range genState.MissedBlocks {
+		for _, missed := range array.MissedBlocks {
+			keeper.SetValidatorMissedBlockBitArray(ctx, array.ValID, missed.Index, missed.Missed)
+		}
+	}
+
+	// set buffered slashing infos and total slashed amount
+	for _, info := range genState.BufferValSlashingInfo {
+		if err := keeper.SetBufferValSlashingInfo(ctx, info.ID, *info); err != nil {
+			keeper.Logger(ctx).Error("InitGenesis | SetBufferValSlashingInfo", "error", err)
+		}
+		keeper.UpdateTotalSlashedAmount(ctx, info.SlashedAmount)
+	}
+
+	// set slashing infos of current tick
+	for _, info := range genState.TickValSlashingInfo {
+		if err := keeper.SetTickValSlashingInfo(ctx, info.ID, *info); err != nil {
+			keeper.Logger(ctx).Error("InitGenesis | SetTickValSlashingInfo", "error", err)
+		}
+	}
+
+	// set tick count
+	keeper.UpdateTickCountWithValue(ctx, genState.TickCount)
+
+	// set processed rootchain tx sequences
+	for _, sequence := range genState.SlashingSequences {
+		keeper.SetSlashingSequence(ctx, sequence)
+	}
+}
+
+// ExportGenesis returns the slashing module's exported genesis.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	params := keeper.GetParams(ctx)
+
+	signingInfos := []types.SigningInfo{}
+	missedBlocks := []types.ValidatorMissedBlocks{}
+	keeper.IterateValidatorSigningInfos(ctx, func(valID hmTypes.ValidatorID, info hmTypes.ValidatorSigningInfo) (stop bool) {
+		signingInfos = append(signingInfos, types.NewSigningInfo(valID, info))
+		missedBlocks = append(missedBlocks, types.NewValidatorMissedBlocks(valID, keeper.GetValidatorMissedBlocks(ctx, valID)))
+		return false
+	})
+
+	return types.NewGenesisState(
+		params,
+		signingInfos,
+		missedBlocks,
+		keeper.GetBufferValSlashingInfos(ctx),
+		keeper.GetTickValSlashingInfos(ctx),
+		keeper.GetTickCount(ctx),
+		keeper.GetSlashingSequences(ctx),
+	)
+}
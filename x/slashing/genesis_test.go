@@ -0,0 +1,65 @@
+package slashing_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing"
+	"github.com/maticnetwork/heimdall/x/slashing/test_helper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+// SetupTest setup necessary things for genesis test
+func (suite *GenesisTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = test_helper.CreateTestApp(true)
+}
+
+// TestGenesisTestSuite
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestInitExportGenesis() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	params := types.DefaultParams()
+	params.EnableSlashing = true
+
+	valID := hmTypes.NewValidatorID(1)
+	signingInfos := []types.SigningInfo{
+		types.NewSigningInfo(valID, hmTypes.NewValidatorSigningInfo(valID, 1, 2, 1)),
+	}
+	missedBlocks := []types.ValidatorMissedBlocks{
+		types.NewValidatorMissedBlocks(valID, []types.MissedBlock{types.NewMissedBlock(1, true)}),
+	}
+	bufferInfo := hmTypes.NewValidatorSlashingInfo(valID, 5, false)
+	tickInfo := hmTypes.NewValidatorSlashingInfo(valID, 3, true)
+
+	genesisState := types.NewGenesisState(
+		params,
+		signingInfos,
+		missedBlocks,
+		[]*hmTypes.ValidatorSlashingInfo{&bufferInfo},
+		[]*hmTypes.ValidatorSlashingInfo{&tickInfo},
+		7,
+		[]string{"100000"},
+	)
+
+	slashing.InitGenesis(ctx, initApp.SlashingKeeper, genesisState)
+
+	exportedState := slashing.ExportGenesis(ctx, initApp.SlashingKeeper)
+	require.Equal(t, genesisState, exportedState)
+	require.Equal(t, uint64(5), initApp.SlashingKeeper.GetTotalSlashedAmount(ctx))
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	Keeper
+	contractCaller helper.IContractCaller
+}
+
+// NewQueryServerImpl returns an implementation of the slashing QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(keeper Keeper, contractCaller helper.IContractCaller) types.QueryServer {
+	return &Querier{Keeper: keeper, contractCaller: contractCaller}
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries slashing params
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// SigningInfo queries signing info of validator
+func (k Querier) SigningInfo(c context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	info, found := k.GetValidatorSigningInfo(ctx, hmTypes.NewValidatorID(req.ValId))
+	if !found {
+		return nil, types.ErrNoSigningInfoFound
+	}
+
+	return &types.QuerySigningInfoResponse{ValSigningInfo: info}, nil
+}
+
+// SigningInfos queries signing info of all validators
+func (k Querier) SigningInfos(c context.Context, req *types.QuerySigningInfosRequest) (*types.QuerySigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Pagination == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty pagination limit, page params")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	infos := k.GetValidatorSigningInfoList(ctx, req.Pagination.Page, req.Pagination.Limit)
+
+	return &types.QuerySigningInfosResponse{ValSigningInfos: infos}, nil
+}
+
+// SlashingBuffer queries slashing infos present in buffer
+func (k Querier) SlashingBuffer(c context.Context, req *types.QuerySlashingBufferRequest) (*types.QuerySlashingBufferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	infos := k.GetBufferValSlashingInfos(ctx)
+
+	return &types.QuerySlashingBufferResponse{ValSlashingInfos: infos}, nil
+}
+
+// TickCount queries tick count
+func (k Querier) TickCount(c context.Context, req *types.QueryTickCountRequest) (*types.QueryTickCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tickCount := k.GetTickCount(ctx)
+
+	return &types.QueryTickCountResponse{TickCount: tickCount}, nil
+}
+
+// LatestSlashInfoBytes queries rlp encoded slashing infos in buffer
+func (k Querier) LatestSlashInfoBytes(c context.Context, req *types.QueryLatestSlashInfoBytesRequest) (*types.QueryLatestSlashInfoBytesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	slashInfoBytes, err := types.SortAndRLPEncodeSlashInfos(k.GetBufferValSlashingInfos(ctx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLatestSlashInfoBytesResponse{SlashInfoBytes: hex.EncodeToString(slashInfoBytes)}, nil
+}
+
+// TickSlashingInfos queries slashing infos of current tick
+func (k Querier) TickSlashingInfos(c context.Context, req *types.QueryTickSlashingInfosRequest) (*types.QueryTickSlashingInfosResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	infos := k.GetTickValSlashingInfos(ctx)
+
+	return &types.QueryTickSlashingInfosResponse{ValSlashingInfos: infos}, nil
+}
+
+// IsOldTx checks if rootchain tx is already processed
+func (k Querier) IsOldTx(c context.Context, req *types.QueryIsOldTxRequest) (*types.QueryIsOldTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	chainParams := k.Ck.GetParams(ctx)
+	receipt, err := k.contractCaller.GetConfirmedTxReceipt(hmCommonTypes.HexToHeimdallHash(req.GetTxHash()).EthHash(), chainParams.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return nil, status.Errorf(codes.NotFound, "Transaction is not confirmed yet. Please wait for sometime and try again")
+	}
+
+	sequence := GetSlashingSequence(receipt.BlockNumber.Uint64(), req.GetLogIndex())
+
+	return &types.QueryIsOldTxResponse{Status: k.HasSlashingSequence(ctx, sequence)}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// RegisterInvariants registers all slashing invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "missed-blocks-counter", MissedBlocksCounterInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-slashed-amount", TotalSlashedAmountInvariant(k))
+}
+
+// MissedBlocksCounterInvariant checks that missed blocks counter of every signing info
+// equals number of missed blocks in its bit array
+func MissedBlocksCounterInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		k.IterateValidatorSigningInfos(ctx, func(valID hmTypes.ValidatorID, info hmTypes.ValidatorSigningInfo) bool {
+			var missed int64
+			k.IterateValidatorMissedBlockBitArray(ctx, valID, func(_ int64, isMissed bool) bool {
+				if isMissed {
+					missed++
+				}
+				return false
+			})
+
+			if missed != info.MissedBlocksCounter {
+				broken = true
+				msg += fmt.Sprintf("\tvalidator %d missed blocks counter %d, missed blocks in bit array %d\n",
+					valID, info.MissedBlocksCounter, missed)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "missed-blocks-counter", msg), broken
+	}
+}
+
+// TotalSlashedAmountInvariant checks that total slashed amount equals sum of buffered slashed amounts
+func TotalSlashedAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var buffered uint64
+		for _, info := range k.GetBufferValSlashingInfos(ctx) {
+			buffered += info.SlashedAmount
+		}
+
+		total := k.GetTotalSlashedAmount(ctx)
+
+		return sdk.FormatInvariant(types.ModuleName, "total-slashed-amount", fmt.Sprintf(
+			"\ttotal slashed amount: %d\n\tbuffered slashed amount sum: %d\n",
+			total, buffered,
+		)), total != buffered
+	}
+}
@@ -0,0 +1,656 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	chainKeeper "github.com/maticnetwork/heimdall/x/chainmanager/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+	stakingKeeper "github.com/maticnetwork/heimdall/x/staking/keeper"
+)
+
+var (
+	DefaultValue = []byte{0x01} // Value to store for missed blocks in bit array
+
+	ValidatorSigningInfoKey         = []byte{0x01} // Prefix for signing info
+	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
+	BufferValSlashingInfoKey        = []byte{0x03} // Prefix for Validator Slashing Info stored in buffer
+	TotalSlashedAmountKey           = []byte{0x04} // Key to store total slashed amount in buffer
+	TickValSlashingInfoKey          = []byte{0x05} // Prefix for Validator Slashing Info stored for current tick
+	TickCountKey                    = []byte{0x07} // key to store tick count
+	SlashingSequenceKey             = []byte{0x08} // prefix for each key for slashing sequence map
+	SlashLimitEventKey              = []byte{0x09} // key to store if slash-limit event was emitted for buffer
+)
+
+type (
+	Keeper struct {
+		cdc           codec.BinaryMarshaler
+		storeKey      sdk.StoreKey
+		paramSubspace paramtypes.Subspace
+		Sk            stakingKeeper.Keeper
+		Ck            chainKeeper.Keeper
+	}
+)
+
+// NewKeeper create new keeper and returns object
+func NewKeeper(
+	cdc codec.BinaryMarshaler,
+	storeKey sdk.StoreKey,
+	paramstore paramtypes.Subspace,
+	stakingKeeper stakingKeeper.Keeper,
+	chainKeeper chainKeeper.Keeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramstore,
+		Sk:            stakingKeeper,
+		Ck:            chainKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetValidatorSigningInfoKey returns signing info key for validator id
+func GetValidatorSigningInfoKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorSigningInfoKey, valID.Bytes()...)
+}
+
+// GetValidatorMissedBlockBitArrayPrefixKey returns missed block bit array prefix for validator id
+func GetValidatorMissedBlockBitArrayPrefixKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorMissedBlockBitArrayKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// GetValidatorMissedBlockBitArrayKey returns missed block bit array key for validator id and index
+func GetValidatorMissedBlockBitArrayKey(valID hmTypes.ValidatorID, index int64) []byte {
+	return append(GetValidatorMissedBlockBitArrayPrefixKey(valID), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// GetBufferValSlashingInfoKey returns buffer slashing info key for validator id
+func GetBufferValSlashingInfoKey(valID hmTypes.ValidatorID) []byte {
+	return append(BufferValSlashingInfoKey, valID.Bytes()...)
+}
+
+// GetTickValSlashingInfoKey returns tick slashing info key for validator id
+func GetTickValSlashingInfoKey(valID hmTypes.ValidatorID) []byte {
+	return append(TickValSlashingInfoKey, valID.Bytes()...)
+}
+
+// GetSlashingSequenceKey returns slashing sequence key
+func GetSlashingSequenceKey(sequence string) []byte {
+	return append(SlashingSequenceKey, []byte(sequence)...)
+}
+
+//
+// Signing info
+//
+
+// GetValidatorSigningInfo returns signing info of validator
+func (k Keeper) GetValidatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID) (info hmTypes.ValidatorSigningInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorSigningInfoKey(valID))
+	if bz == nil {
+		return info, false
+	}
+
+	info, err := hmTypes.UnmarshallValSigningInfo(k.cdc, bz)
+	if err != nil {
+		k.Logger(ctx).Error("Error unmarshalling signing info", "valID", valID, "error", err)
+		return info, false
+	}
+
+	return info, true
+}
+
+// SetValidatorSigningInfo sets signing info of validator
+func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID, info hmTypes.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := hmTypes.MarshallValSigningInfo(k.cdc, &info)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling signing info", "valID", valID, "error", err)
+		return
+	}
+
+	store.Set(GetValidatorSigningInfoKey(valID), bz)
+}
+
+// IterateValidatorSigningInfos iterates over all stored signing infos
+func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context, handler func(valID hmTypes.ValidatorID, info hmTypes.ValidatorSigningInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSigningInfoKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		info, err := hmTypes.UnmarshallValSigningInfo(k.cdc, iterator.Value())
+		if err != nil {
+			k.Logger(ctx).Error("Error unmarshalling signing info", "error", err)
+			continue
+		}
+
+		if handler(info.ValID, info) {
+			break
+		}
+	}
+}
+
+// GetValidatorSigningInfos returns all signing infos
+func (k Keeper) GetValidatorSigningInfos(ctx sdk.Context) (infos []hmTypes.ValidatorSigningInfo) {
+	k.IterateValidatorSigningInfos(ctx, func(_ hmTypes.ValidatorID, info hmTypes.ValidatorSigningInfo) bool {
+		infos = append(infos, info)
+		return false
+	})
+	return
+}
+
+// GetValidatorSigningInfoList returns signing infos with params like page and limit
+func (k Keeper) GetValidatorSigningInfoList(ctx sdk.Context, page uint64, limit uint64) []hmTypes.ValidatorSigningInfo {
+	store := ctx.KVStore(k.storeKey)
+
+	// get paginated iterator
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, ValidatorSigningInfoKey, uint(page), uint(limit))
+	defer iterator.Close()
+
+	infos := []hmTypes.ValidatorSigningInfo{}
+	for ; iterator.Valid(); iterator.Next() {
+		if info, err := hmTypes.UnmarshallValSigningInfo(k.cdc, iterator.Value()); err == nil {
+			infos = append(infos, info)
+		}
+	}
+
+	return infos
+}
+
+//
+// Missed block bit array
+//
+
+// GetValidatorMissedBlockBitArray returns true if validator missed block at index
+func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, index int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetValidatorMissedBlockBitArrayKey(valID, index))
+}
+
+// SetValidatorMissedBlockBitArray sets missed status of validator at index
+func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, index int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetValidatorMissedBlockBitArrayKey(valID, index)
+	if missed {
+		store.Set(key, DefaultValue)
+	} else {
+		store.Delete(key)
+	}
+}
+
+// IterateValidatorMissedBlockBitArray iterates over the missed blocks of validator
+func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID, handler func(index int64, missed bool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetValidatorMissedBlockBitArrayPrefixKey(valID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		index := int64(sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+		if handler(index, true) {
+			break
+		}
+	}
+}
+
+// GetValidatorMissedBlocks returns missed blocks of validator
+func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, valID hmTypes.ValidatorID) (missedBlocks []types.MissedBlock) {
+	k.IterateValidatorMissedBlockBitArray(ctx, valID, func(index int64, missed bool) bool {
+		missedBlocks = append(missedBlocks, types.NewMissedBlock(index, missed))
+		return false
+	})
+	return
+}
+
+// clearValidatorMissedBlockBitArray deletes every missed block of validator
+func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, valID hmTypes.ValidatorID) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetValidatorMissedBlockBitArrayPrefixKey(valID))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+//
+// Liveness
+//
+
+// HandleValidatorSignature tracks the signature of validator for the last block and
+// slashes it into the buffer once it misses too many blocks in the signed blocks window
+func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr []byte, power int64, signed bool) {
+	logger := k.Logger(ctx)
+	height := ctx.BlockHeight()
+	params := k.GetParams(ctx)
+
+	// fetch validator from signer address
+	validator, err := k.Sk.GetValidatorInfo(ctx, addr)
+	if err != nil {
+		logger.Error("Validator not found for signature", "address", sdk.AccAddress(addr).String(), "error", err)
+		return
+	}
+
+	// fetch signing info, create one if validator joined before slashing was enabled
+	signInfo, found := k.GetValidatorSigningInfo(ctx, validator.ID)
+	if !found {
+		signInfo = hmTypes.NewValidatorSigningInfo(validator.ID, height, 0, 0)
+	}
+
+	// this is a relative index, so it counts blocks the validator *should* have signed
+	// will use the 0-value default signing info if not present, except for start height
+	index := signInfo.IndexOffset % params.SignedBlocksWindow
+	signInfo.IndexOffset++
+
+	// update signed block bit array & counter
+	// this query is O(1) and the number of missed blocks is stored in the signing info
+	previous := k.GetValidatorMissedBlockBitArray(ctx, validator.ID, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		// array value has changed from not missed to missed, increment counter
+		k.SetValidatorMissedBlockBitArray(ctx, validator.ID, index, true)
+		signInfo.MissedBlocksCounter++
+	case previous && !missed:
+		// array value has changed from missed to not missed, decrement counter
+		k.SetValidatorMissedBlockBitArray(ctx, validator.ID, index, false)
+		signInfo.MissedBlocksCounter--
+	default:
+		// array value at this index has not changed, no need to update counter
+	}
+
+	if missed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiveness,
+				sdk.NewAttribute(types.AttributeKeyValID, validator.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyAddress, validator.Signer),
+				sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatInt(signInfo.MissedBlocksCounter, 10)),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+			),
+		)
+
+		logger.Debug("Absent validator", "height", height, "valID", validator.ID, "missed", signInfo.MissedBlocksCounter)
+	}
+
+	minHeight := signInfo.StartHeight + params.SignedBlocksWindow
+	maxMissed := params.SignedBlocksWindow - params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64()
+
+	// if we are past the minimum height and the validator has missed too many blocks, slash them
+	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
+		if !validator.Jailed {
+			slashAmount := params.SlashFractionDowntime.MulInt64(validator.VotingPower).TruncateInt64()
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeSlash,
+					sdk.NewAttribute(types.AttributeKeyValID, validator.ID.String()),
+					sdk.NewAttribute(types.AttributeKeyAddress, validator.Signer),
+					sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
+					sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatInt(slashAmount, 10)),
+				),
+			)
+
+			if err := k.SlashInterim(ctx, validator.ID, uint64(slashAmount)); err != nil {
+				logger.Error("Error while slashing validator in buffer", "valID", validator.ID, "error", err)
+			}
+
+			// we need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding
+			signInfo.MissedBlocksCounter = 0
+			signInfo.IndexOffset = 0
+			k.clearValidatorMissedBlockBitArray(ctx, validator.ID)
+
+			logger.Info("Slashing validator for downtime", "valID", validator.ID, "minHeight", minHeight, "threshold", maxMissed, "slashAmount", slashAmount)
+		} else {
+			// validator was (a) not found or (b) already jailed, don't slash
+			logger.Info("Validator would have been slashed for downtime, but was either not found in store or already jailed", "valID", validator.ID)
+		}
+	}
+
+	// set the updated signing info
+	k.SetValidatorSigningInfo(ctx, validator.ID, signInfo)
+}
+
+//
+// Slashing buffer
+//
+
+// SlashInterim adds slash amount of validator into the buffer.
+// Validator is marked to be jailed once its buffered slashed amount crosses the jail fraction limit.
+func (k Keeper) SlashInterim(ctx sdk.Context, valID hmTypes.ValidatorID, slashAmount uint64) error {
+	params := k.GetParams(ctx)
+
+	validator, found := k.Sk.GetValidatorFromValID(ctx, valID)
+	if !found {
+		return types.ErrValidatorNotFound
+	}
+
+	valSlashingInfo, found := k.GetBufferValSlashingInfo(ctx, valID)
+	if found {
+		valSlashingInfo.SlashedAmount += slashAmount
+	} else {
+		valSlashingInfo = hmTypes.NewValidatorSlashingInfo(valID, slashAmount, false)
+	}
+
+	// jail validator if slashed amount crosses the jail limit
+	jailLimit := params.JailFractionLimit.MulInt64(validator.VotingPower).TruncateInt64()
+	if valSlashingInfo.SlashedAmount >= uint64(jailLimit) {
+		valSlashingInfo.IsJailed = true
+	}
+
+	if err := k.SetBufferValSlashingInfo(ctx, valID, valSlashingInfo); err != nil {
+		return err
+	}
+
+	// update total slashed amount
+	k.UpdateTotalSlashedAmount(ctx, slashAmount)
+
+	return nil
+}
+
+// GetBufferValSlashingInfo returns buffered slashing info of validator
+func (k Keeper) GetBufferValSlashingInfo(ctx sdk.Context, valID hmTypes.ValidatorID) (info hmTypes.ValidatorSlashingInfo, found bool) {
+	return k.getValSlashingInfo(ctx, GetBufferValSlashingInfoKey(valID))
+}
+
+// SetBufferValSlashingInfo sets buffered slashing info of validator
+func (k Keeper) SetBufferValSlashingInfo(ctx sdk.Context, valID hmTypes.ValidatorID, info hmTypes.ValidatorSlashingInfo) error {
+	return k.setValSlashingInfo(ctx, GetBufferValSlashingInfoKey(valID), info)
+}
+
+// GetBufferValSlashingInfos returns all buffered slashing infos
+func (k Keeper) GetBufferValSlashingInfos(ctx sdk.Context) []*hmTypes.ValidatorSlashingInfo {
+	return k.getValSlashingInfos(ctx, BufferValSlashingInfoKey)
+}
+
+// FlushBufferValSlashingInfos removes all buffered slashing infos
+func (k Keeper) FlushBufferValSlashingInfos(ctx sdk.Context) {
+	k.flushPrefix(ctx, BufferValSlashingInfoKey)
+}
+
+// GetTotalSlashedAmount returns total slashed amount in buffer
+func (k Keeper) GetTotalSlashedAmount(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, TotalSlashedAmountKey)
+}
+
+// UpdateTotalSlashedAmount adds amount to total slashed amount in buffer
+func (k Keeper) UpdateTotalSlashedAmount(ctx sdk.Context, amount uint64) {
+	k.setUint64(ctx, TotalSlashedAmountKey, k.GetTotalSlashedAmount(ctx)+amount)
+}
+
+// FlushTotalSlashedAmount resets total slashed amount in buffer and the slash-limit event emitted for it
+func (k Keeper) FlushTotalSlashedAmount(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(TotalSlashedAmountKey)
+	store.Delete(SlashLimitEventKey)
+}
+
+// SetSlashLimitEventEmitted records that slash-limit event was emitted for buffer
+func (k Keeper) SetSlashLimitEventEmitted(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(SlashLimitEventKey, DefaultValue)
+}
+
+// IsSlashLimitEventEmitted returns true if slash-limit event was already emitted for buffer
+func (k Keeper) IsSlashLimitEventEmitted(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(SlashLimitEventKey)
+}
+
+// IsSlashLimitReached returns true if total slashed amount in buffer crossed the slash fraction limit
+func (k Keeper) IsSlashLimitReached(ctx sdk.Context) bool {
+	totalSlashedAmount := k.GetTotalSlashedAmount(ctx)
+	if totalSlashedAmount == 0 {
+		return false
+	}
+
+	params := k.GetParams(ctx)
+	slashLimit := params.SlashFractionLimit.MulInt64(k.Sk.GetTotalPower(ctx)).TruncateInt64()
+
+	return totalSlashedAmount >= uint64(slashLimit)
+}
+
+//
+// Tick data
+//
+
+// GetTickValSlashingInfo returns slashing info of validator in current tick
+func (k Keeper) GetTickValSlashingInfo(ctx sdk.Context, valID hmTypes.ValidatorID) (info hmTypes.ValidatorSlashingInfo, found bool) {
+	return k.getValSlashingInfo(ctx, GetTickValSlashingInfoKey(valID))
+}
+
+// SetTickValSlashingInfo sets slashing info of validator in current tick
+func (k Keeper) SetTickValSlashingInfo(ctx sdk.Context, valID hmTypes.ValidatorID, info hmTypes.ValidatorSlashingInfo) error {
+	return k.setValSlashingInfo(ctx, GetTickValSlashingInfoKey(valID), info)
+}
+
+// GetTickValSlashingInfos returns all slashing infos in current tick
+func (k Keeper) GetTickValSlashingInfos(ctx sdk.Context) []*hmTypes.ValidatorSlashingInfo {
+	return k.getValSlashingInfos(ctx, TickValSlashingInfoKey)
+}
+
+// FlushTickValSlashingInfos removes all slashing infos in current tick
+func (k Keeper) FlushTickValSlashingInfos(ctx sdk.Context) {
+	k.flushPrefix(ctx, TickValSlashingInfoKey)
+}
+
+// CopyBufferValSlashingInfosToTickData moves buffered slashing infos into current tick
+func (k Keeper) CopyBufferValSlashingInfosToTickData(ctx sdk.Context) error {
+	for _, info := range k.GetBufferValSlashingInfos(ctx) {
+		if err := k.SetTickValSlashingInfo(ctx, info.ID, *info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SlashAndJailTickValSlashingInfos applies slashing infos of current tick to validators
+func (k Keeper) SlashAndJailTickValSlashingInfos(ctx sdk.Context) error {
+	for _, info := range k.GetTickValSlashingInfos(ctx) {
+		if err := k.Sk.Slash(ctx, *info); err != nil {
+			k.Logger(ctx).Error("Error slashing validator", "valID", info.ID, "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Unjail unjails jailed validator and resets its signing info
+func (k Keeper) Unjail(ctx sdk.Context, valID hmTypes.ValidatorID) error {
+	validator, found := k.Sk.GetValidatorFromValID(ctx, valID)
+	if !found {
+		return types.ErrValidatorNotFound
+	}
+
+	if !validator.Jailed {
+		return types.ErrValidatorNotJailed
+	}
+
+	k.Sk.Unjail(ctx, valID)
+
+	// restart liveness tracking from current height
+	k.SetValidatorSigningInfo(ctx, valID, hmTypes.NewValidatorSigningInfo(valID, ctx.BlockHeight(), 0, 0))
+	k.clearValidatorMissedBlockBitArray(ctx, valID)
+
+	return nil
+}
+
+//
+// Tick count
+//
+
+// GetTickCount returns current tick count
+func (k Keeper) GetTickCount(ctx sdk.Context) uint64 {
+	return k.getUint64(ctx, TickCountKey)
+}
+
+// UpdateTickCountWithValue updates tick count with value
+func (k Keeper) UpdateTickCountWithValue(ctx sdk.Context, value uint64) {
+	k.setUint64(ctx, TickCountKey, value)
+}
+
+// IncrementTickCount increments tick count by 1
+func (k Keeper) IncrementTickCount(ctx sdk.Context) {
+	k.setUint64(ctx, TickCountKey, k.GetTickCount(ctx)+1)
+}
+
+//
+// Slashing sequence
+//
+
+// GetSlashingSequence returns sequence of rootchain log
+func GetSlashingSequence(blockNumber uint64, logIndex uint64) string {
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(blockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(logIndex))
+	return sequence.String()
+}
+
+// SetSlashingSequence sets slashing sequence
+func (k Keeper) SetSlashingSequence(ctx sdk.Context, sequence string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSlashingSequenceKey(sequence), DefaultValue)
+}
+
+// HasSlashingSequence checks if slashing sequence already exists
+func (k Keeper) HasSlashingSequence(ctx sdk.Context, sequence string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetSlashingSequenceKey(sequence))
+}
+
+// GetSlashingSequences returns all slashing sequences
+func (k Keeper) GetSlashingSequences(ctx sdk.Context) (sequences []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SlashingSequenceKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		sequences = append(sequences, string(iterator.Key()[len(SlashingSequenceKey):]))
+	}
+
+	return
+}
+
+//
+// Internal helpers
+//
+
+func (k Keeper) getValSlashingInfo(ctx sdk.Context, key []byte) (info hmTypes.ValidatorSlashingInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return info, false
+	}
+
+	info, err := hmTypes.UnmarshallValSlashingInfo(k.cdc, bz)
+	if err != nil {
+		k.Logger(ctx).Error("Error unmarshalling slashing info", "error", err)
+		return info, false
+	}
+
+	return info, true
+}
+
+func (k Keeper) setValSlashingInfo(ctx sdk.Context, key []byte, info hmTypes.ValidatorSlashingInfo) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := hmTypes.MarshallValSlashingInfo(k.cdc, &info)
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) getValSlashingInfos(ctx sdk.Context, prefix []byte) (infos []*hmTypes.ValidatorSlashingInfo) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		info, err := hmTypes.UnmarshallValSlashingInfo(k.cdc, iterator.Value())
+		if err != nil {
+			k.Logger(ctx).Error("Error unmarshalling slashing info", "error", err)
+			continue
+		}
+		infos = append(infos, &info)
+	}
+
+	return hmTypes.SortValidatorSlashingInfoByID(infos)
+}
+
+func (k Keeper) flushPrefix(ctx sdk.Context, prefix []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) getUint64(ctx sdk.Context, key []byte) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(key) {
+		value, err := strconv.ParseUint(string(store.Get(key)), 10, 64)
+		if err == nil {
+			return value
+		}
+		k.Logger(ctx).Error("Unable to convert key to int", "key", key)
+	}
+
+	return 0
+}
+
+func (k Keeper) setUint64(ctx sdk.Context, key []byte, value uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, []byte(strconv.FormatUint(value, 10)))
+}
+
+// -----------------------------------------------------------------------------
+// Params
+
+// SetParams sets the slashing module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the slashing module's parameters, params missing in store keep default values
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSubspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/maticnetwork/heimdall/app"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	checkpointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	slashingKeeper "github.com/maticnetwork/heimdall/x/slashing/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/test_helper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.HeimdallApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = test_helper.CreateTestApp(false)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// Tests
+
+func (suite *KeeperTestSuite) TestValidatorSigningInfo() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.SlashingKeeper
+
+	valID := hmTypes.NewValidatorID(1)
+	_, found := keeper.GetValidatorSigningInfo(ctx, valID)
+	require.False(t, found)
+
+	info := hmTypes.NewValidatorSigningInfo(valID, 10, 3, 2)
+	keeper.SetValidatorSigningInfo(ctx, valID, info)
+
+	actual, found := keeper.GetValidatorSigningInfo(ctx, valID)
+	require.True(t, found)
+	require.Equal(t, info, actual)
+	require.Len(t, keeper.GetValidatorSigningInfos(ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestHandleValidatorSignature() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.SlashingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.SignedBlocksWindow = 10
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+	signer := validator.GetSigner()
+
+	// validator signs the first window
+	height := int64(1)
+	for ; height <= params.SignedBlocksWindow; height++ {
+		keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), signer.Bytes(), validator.VotingPower, true)
+	}
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.Empty(t, keeper.GetBufferValSlashingInfos(ctx))
+
+	// validator misses blocks until it crosses min signed per window
+	maxMissed := params.SignedBlocksWindow - params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64()
+	for i := int64(0); i <= maxMissed; i++ {
+		keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), signer.Bytes(), validator.VotingPower, false)
+		height++
+	}
+
+	slashingInfo, found := keeper.GetBufferValSlashingInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, uint64(validator.VotingPower/2), slashingInfo.SlashedAmount)
+	require.True(t, slashingInfo.IsJailed)
+	require.Equal(t, slashingInfo.SlashedAmount, keeper.GetTotalSlashedAmount(ctx))
+
+	// counter and bit array are reset after slashing
+	info, _ = keeper.GetValidatorSigningInfo(ctx, validator.ID)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.Empty(t, keeper.GetValidatorMissedBlocks(ctx, validator.ID))
+}
+
+func (suite *KeeperTestSuite) TestSlashAndJailTickValSlashingInfos() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.SlashingKeeper
+
+	valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	// slash below jail limit
+	require.NoError(t, keeper.SlashInterim(ctx, validator.ID, 1))
+	slashingInfo, found := keeper.GetBufferValSlashingInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.False(t, slashingInfo.IsJailed)
+
+	// slash above jail limit
+	require.NoError(t, keeper.SlashInterim(ctx, validator.ID, 3))
+	slashingInfo, _ = keeper.GetBufferValSlashingInfo(ctx, validator.ID)
+	require.Equal(t, uint64(4), slashingInfo.SlashedAmount)
+	require.True(t, slashingInfo.IsJailed)
+	require.Equal(t, uint64(4), keeper.GetTotalSlashedAmount(ctx))
+
+	// move buffer to tick and apply
+	require.NoError(t, keeper.CopyBufferValSlashingInfosToTickData(ctx))
+	keeper.FlushBufferValSlashingInfos(ctx)
+	keeper.FlushTotalSlashedAmount(ctx)
+	require.Empty(t, keeper.GetBufferValSlashingInfos(ctx))
+	require.Len(t, keeper.GetTickValSlashingInfos(ctx), 1)
+
+	require.NoError(t, keeper.SlashAndJailTickValSlashingInfos(ctx))
+	slashedValidator, found := initApp.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, validator.VotingPower-4, slashedValidator.VotingPower)
+	require.True(t, slashedValidator.Jailed)
+
+	// unjail
+	require.NoError(t, keeper.Unjail(ctx, validator.ID))
+	unjailedValidator, _ := initApp.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+	require.False(t, unjailedValidator.Jailed)
+	require.ErrorIs(t, keeper.Unjail(ctx, validator.ID), types.ErrValidatorNotJailed)
+}
+
+func (suite *KeeperTestSuite) TestTickCount() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.SlashingKeeper
+
+	require.Equal(t, uint64(0), keeper.GetTickCount(ctx))
+	keeper.IncrementTickCount(ctx)
+	require.Equal(t, uint64(1), keeper.GetTickCount(ctx))
+	keeper.UpdateTickCountWithValue(ctx, 10)
+	require.Equal(t, uint64(10), keeper.GetTickCount(ctx))
+}
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.SlashingKeeper
+
+	invariants := app.InvariantRegistry{}
+	slashingKeeper.RegisterInvariants(&invariants, keeper)
+
+	valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	for _, signed := range []bool{false, true, false} {
+		keeper.HandleValidatorSignature(ctx, validator.GetSigner(), validator.VotingPower, signed)
+	}
+	require.NoError(t, keeper.SlashInterim(ctx, validator.ID, 1))
+
+	require.Empty(t, invariants.CheckInvariants(ctx))
+
+	// counter out of sync with bit array
+	info, _ := keeper.GetValidatorSigningInfo(ctx, validator.ID)
+	info.MissedBlocksCounter++
+	keeper.SetValidatorSigningInfo(ctx, validator.ID, info)
+
+	msg, broken := slashingKeeper.MissedBlocksCounterInvariant(keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "missed blocks counter 3, missed blocks in bit array 2")
+
+	// total out of sync with buffer
+	keeper.UpdateTotalSlashedAmount(ctx, 5)
+
+	msg, broken = slashingKeeper.TotalSlashedAmountInvariant(keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "total slashed amount: 6")
+}
+
+func (suite *KeeperTestSuite) TestGetParamsMissingKeys() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.SlashingKeeper
+
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 200
+	params.EnableSlashing = true
+	keeper.SetParams(ctx, params)
+
+	// params added by upgrade are missing in store of earlier version
+	paramsStore := prefix.NewStore(ctx.KVStore(initApp.GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+	paramsStore.Delete(types.KeyEnableSlashing)
+
+	result := keeper.GetParams(ctx)
+	require.Equal(t, int64(200), result.SignedBlocksWindow)
+	require.Equal(t, types.DefaultEnableSlashing, result.EnableSlashing)
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+type msgServer struct {
+	Keeper
+	contractCaller helper.IContractCaller
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the slashing MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper, contractCaller helper.IContractCaller) types.MsgServer {
+	return &msgServer{Keeper: keeper, contractCaller: contractCaller}
+}
+
+// Tick validates tick msg before it is voted on by validators
+func (k msgServer) Tick(goCtx context.Context, msg *types.MsgTick) (*types.MsgTickResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+
+	if !k.GetParams(ctx).EnableSlashing {
+		logger.Error("Slashing is disabled")
+		return nil, types.ErrSlashingDisabled
+	}
+
+	// check if slash limit is exceeded or not
+	if k.GetTotalSlashedAmount(ctx) == 0 {
+		logger.Error("Slashed amount is zero")
+		return nil, types.ErrNoSlashedAmount
+	}
+
+	// check if previous tick is acknowledged
+	if len(k.GetTickValSlashingInfos(ctx)) > 0 {
+		logger.Error("Tick already in progress", "tickCount", k.GetTickCount(ctx))
+		return nil, types.ErrTickInProgress
+	}
+
+	// check if tick msgs are in continuity
+	tickCount := k.GetTickCount(ctx)
+	if msg.ID != tickCount+1 {
+		logger.Error("Tick not in countinuity", "msgID", msg.ID, "expectedID", tickCount+1)
+		return nil, types.ErrTickNotInContinuity
+	}
+
+	// check proposer in message
+	validatorSet := k.Sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer == nil {
+		logger.Error("No proposer in validator set", "msgProposer", msg.Proposer)
+		return nil, types.ErrInvalidProposer
+	}
+
+	if !strings.EqualFold(msg.Proposer, validatorSet.Proposer.Signer) {
+		logger.Error(
+			"Invalid proposer in msg",
+			"proposer", validatorSet.Proposer.Signer,
+			"msgProposer", msg.Proposer,
+		)
+		return nil, types.ErrInvalidProposer
+	}
+
+	// check slashing info bytes against buffer
+	slashingInfoBytes, err := types.SortAndRLPEncodeSlashInfos(k.GetBufferValSlashingInfos(ctx))
+	if err != nil {
+		logger.Error("Error generating slashing info bytes", "error", err)
+		return nil, types.ErrInvalidSlashingInfo
+	}
+
+	if !bytes.Equal(slashingInfoBytes, msg.GetSlashingInfoBytes()) {
+		logger.Error("Slashing info bytes mismatch", "msgSlashingInfoBytes", msg.SlashingInfoBytes)
+		return nil, types.ErrInvalidSlashingInfo
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTick,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+			sdk.NewAttribute(types.AttributeKeySlashInfoBytes, msg.SlashingInfoBytes),
+		),
+	})
+
+	return &types.MsgTickResponse{}, nil
+}
+
+// TickAck validates tick ack msg before it is voted on by validators
+func (k msgServer) TickAck(goCtx context.Context, msg *types.MsgTickAck) (*types.MsgTickAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+
+	// check if incoming tx is older
+	if k.HasSlashingSequence(ctx, GetSlashingSequence(msg.BlockNumber, msg.LogIndex)) {
+		logger.Error("Older invalid tx found")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	// tick ack must acknowledge the latest tick
+	if msg.ID != k.GetTickCount(ctx) {
+		logger.Error("Tick ack not in countinuity", "msgID", msg.ID, "expectedID", k.GetTickCount(ctx))
+		return nil, types.ErrInvalidTickAck
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTickAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(msg.SlashedAmount, 10)),
+		),
+	})
+
+	return &types.MsgTickAckResponse{}, nil
+}
+
+// Unjail validates unjail msg before it is voted on by validators
+func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.MsgUnjailResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := k.Logger(ctx)
+
+	// check if incoming tx is older
+	if k.HasSlashingSequence(ctx, GetSlashingSequence(msg.BlockNumber, msg.LogIndex)) {
+		logger.Error("Older invalid tx found")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	validator, found := k.Sk.GetValidatorFromValID(ctx, hmTypes.NewValidatorID(msg.ID))
+	if !found {
+		logger.Error("Validator not found", "validatorID", msg.ID)
+		return nil, types.ErrValidatorNotFound
+	}
+
+	if !validator.Jailed {
+		logger.Error("Validator is not jailed", "validatorID", msg.ID)
+		return nil, types.ErrValidatorNotJailed
+	}
+
+	// unjail event is emitted by post handler once unjail is approved
+	return &types.MsgUnjailResponse{}, nil
+}
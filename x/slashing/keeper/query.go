@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	// this line is used by starport scaffolding # 1
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		// this line is used by starport scaffolding # 1
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
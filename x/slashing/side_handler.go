@@ -0,0 +1,371 @@
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	borCommon "github.com/maticnetwork/bor/common"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	"github.com/maticnetwork/heimdall/x/slashing/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+// NewSideTxHandler returns a side handler for "slashing" type messages.
+func NewSideTxHandler(k keeper.Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) abci.ResponseDeliverSideTx {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgTick:
+			return SideHandleMsgTick(ctx, k, *msg, contractCaller)
+		case *types.MsgTickAck:
+			return SideHandleMsgTickAck(ctx, k, *msg, contractCaller)
+		case *types.MsgUnjail:
+			return SideHandleMsgUnjail(ctx, k, *msg, contractCaller)
+		default:
+			return abci.ResponseDeliverSideTx{
+				Code: sdkerrors.ErrUnknownRequest.ABCICode(),
+			}
+		}
+	}
+}
+
+// SideHandleMsgTick handles MsgTick message for external call
+func SideHandleMsgTick(ctx sdk.Context, k keeper.Keeper, msg types.MsgTick, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	logger := k.Logger(ctx)
+
+	// check if there is anything to slash
+	if k.GetTotalSlashedAmount(ctx) == 0 {
+		logger.Error("Slashed amount is zero", "tickID", msg.ID)
+		return hmCommon.ErrorSideTx(types.ErrNoSlashedAmount)
+	}
+
+	// check if previous tick is acknowledged
+	if len(k.GetTickValSlashingInfos(ctx)) > 0 {
+		logger.Error("Tick already in progress", "tickCount", k.GetTickCount(ctx))
+		return hmCommon.ErrorSideTx(types.ErrTickInProgress)
+	}
+
+	// check if tick msgs are in continuity
+	tickCount := k.GetTickCount(ctx)
+	if msg.ID != tickCount+1 {
+		logger.Error("Tick not in countinuity", "msgID", msg.ID, "expectedID", tickCount+1)
+		return hmCommon.ErrorSideTx(types.ErrTickNotInContinuity)
+	}
+
+	// validate proposer against current validator set
+	validatorSet := k.Sk.GetValidatorSet(ctx)
+	if validatorSet.Proposer == nil || !strings.EqualFold(msg.Proposer, validatorSet.Proposer.Signer) {
+		logger.Error("Invalid proposer in msg", "msgProposer", msg.Proposer)
+		return hmCommon.ErrorSideTx(types.ErrInvalidProposer)
+	}
+
+	// validate slashing info bytes against buffer
+	slashingInfoBytes, err := types.SortAndRLPEncodeSlashInfos(k.GetBufferValSlashingInfos(ctx))
+	if err != nil {
+		logger.Error("Error generating slashing info bytes", "error", err)
+		return hmCommon.ErrorSideTx(types.ErrInvalidSlashingInfo)
+	}
+
+	if !bytes.Equal(slashingInfoBytes, msg.GetSlashingInfoBytes()) {
+		logger.Error("Slashing info bytes mismatch", "tickID", msg.ID, "msgSlashingInfoBytes", msg.SlashingInfoBytes)
+		return hmCommon.ErrorSideTx(types.ErrInvalidSlashingInfo)
+	}
+
+	logger.Debug("✅ Successfully validated slashing info bytes for tick msg", "tickID", msg.ID)
+
+	// vote `yes` if slashing info is valid
+	result.Result = tmprototypes.SideTxResultType_YES
+
+	return
+}
+
+// SideHandleMsgTickAck handles MsgTickAck message for external call
+func SideHandleMsgTickAck(ctx sdk.Context, k keeper.Keeper, msg types.MsgTickAck, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	logger := k.Logger(ctx)
+
+	logger.Debug("✅ Validating external call for tick ack msg",
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.Ck.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(hmCommonTypes.HexToHeimdallHash(msg.TxHash).EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(hmCommon.ErrWaitForConfirmation)
+	}
+
+	// decode slashed event
+	eventLog, err := contractCaller.DecodeSlashedEvent(borCommon.HexToAddress(chainParams.StakingInfoAddress), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		logger.Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(hmCommon.ErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		logger.Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	if eventLog.Nonce.Uint64() != msg.ID {
+		logger.Error("Tick ID in message doesn't match nonce in event logs", "MsgID", msg.ID, "NonceFromEvent", eventLog.Nonce)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	if !eventLog.Amount.IsUint64() || eventLog.Amount.Uint64() != msg.SlashedAmount {
+		logger.Error("Slashed amount in message doesn't match amount in event logs", "MsgAmount", msg.SlashedAmount, "AmountFromEvent", eventLog.Amount)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	logger.Debug("✅ Successfully validated external call for tick ack msg")
+	result.Result = tmprototypes.SideTxResultType_YES
+
+	return
+}
+
+// SideHandleMsgUnjail handles MsgUnjail message for external call
+func SideHandleMsgUnjail(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnjail, contractCaller helper.IContractCaller) (result abci.ResponseDeliverSideTx) {
+	logger := k.Logger(ctx)
+
+	logger.Debug("✅ Validating external call for unjail msg",
+		"txHash", msg.TxHash,
+		"logIndex", msg.LogIndex,
+		"blockNumber", msg.BlockNumber,
+	)
+
+	// chainManager params
+	params := k.Ck.GetParams(ctx)
+	chainParams := params.ChainParams
+
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(hmCommonTypes.HexToHeimdallHash(msg.TxHash).EthHash(), params.MainchainTxConfirmations)
+	if err != nil || receipt == nil {
+		return hmCommon.ErrorSideTx(hmCommon.ErrWaitForConfirmation)
+	}
+
+	// decode unjailed event
+	eventLog, err := contractCaller.DecodeUnJailedEvent(borCommon.HexToAddress(chainParams.StakingInfoAddress), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		logger.Error("Error fetching log from txhash")
+		return hmCommon.ErrorSideTx(hmCommon.ErrDecodeEvent)
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		logger.Error("BlockNumber in message doesn't match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID {
+		logger.Error("ID in message doesn't match validator id in event logs", "MsgID", msg.ID, "IDFromEvent", eventLog.ValidatorId)
+		return hmCommon.ErrorSideTx(hmCommon.ErrInvalidMsg)
+	}
+
+	logger.Debug("✅ Successfully validated external call for unjail msg")
+	result.Result = tmprototypes.SideTxResultType_YES
+
+	return
+}
+
+//
+// Tx handler
+//
+
+// NewPostTxHandler returns a post handler for "slashing" type messages.
+func NewPostTxHandler(k keeper.Keeper, contractCaller helper.IContractCaller) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgTick:
+			return PostHandleMsgTick(ctx, k, *msg, sideTxResult)
+		case *types.MsgTickAck:
+			return PostHandleMsgTickAck(ctx, k, *msg, sideTxResult)
+		case *types.MsgUnjail:
+			return PostHandleMsgUnjail(ctx, k, *msg, sideTxResult)
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+// PostHandleMsgTick moves buffered slashing info to tick data, validators are slashed once tick is acknowledged
+func PostHandleMsgTick(ctx sdk.Context, k keeper.Keeper, msg types.MsgTick, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	logger := k.Logger(ctx)
+
+	// Skip handler if tick is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		logger.Debug("Skipping new tick since side-tx didn't get yes votes", "tickID", msg.ID)
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check if tick msgs are in continuity
+	tickCount := k.GetTickCount(ctx)
+	if msg.ID != tickCount+1 {
+		logger.Error("Tick not in countinuity", "msgID", msg.ID, "expectedID", tickCount+1)
+		return nil, types.ErrTickNotInContinuity
+	}
+
+	// check if previous tick is acknowledged
+	if len(k.GetTickValSlashingInfos(ctx)) > 0 {
+		logger.Error("Tick already in progress", "tickCount", tickCount)
+		return nil, types.ErrTickInProgress
+	}
+
+	// copy slashing info from buffer to tick data
+	if err := k.CopyBufferValSlashingInfosToTickData(ctx); err != nil {
+		logger.Error("Error copying buffer slashing info to tick data", "error", err)
+		return nil, err
+	}
+
+	// flush buffer
+	k.FlushBufferValSlashingInfos(ctx)
+	k.FlushTotalSlashedAmount(ctx)
+
+	// update tick count
+	k.UpdateTickCountWithValue(ctx, msg.ID)
+	logger.Info("Valid tick received", "tickID", msg.ID)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	// Emit event for tick confirmation
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTickConfirm,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                        // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                      // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmCommonTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),                   // result
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+			sdk.NewAttribute(types.AttributeKeySlashInfoBytes, msg.SlashingInfoBytes),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+// PostHandleMsgTickAck slashes and jails validators of tick and flushes tick data once tick is acknowledged on rootchain
+func PostHandleMsgTickAck(ctx sdk.Context, k keeper.Keeper, msg types.MsgTickAck, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	logger := k.Logger(ctx)
+
+	// Skip handler if tick-ack is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		logger.Debug("Skipping new tick-ack since side-tx didn't get yes votes", "tickID", msg.ID)
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check if incoming tx is older
+	sequence := keeper.GetSlashingSequence(msg.BlockNumber, msg.LogIndex)
+	if k.HasSlashingSequence(ctx, sequence) {
+		logger.Error("Older invalid tx found")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	// tick ack must acknowledge the latest tick
+	if msg.ID != k.GetTickCount(ctx) {
+		logger.Error("Tick ack not in countinuity", "msgID", msg.ID, "expectedID", k.GetTickCount(ctx))
+		return nil, types.ErrInvalidTickAck
+	}
+
+	// slash and jail validators of this tick
+	if err := k.SlashAndJailTickValSlashingInfos(ctx); err != nil {
+		logger.Error("Error slashing and jailing validators", "error", err)
+		return nil, err
+	}
+
+	// flush tick data
+	k.FlushTickValSlashingInfos(ctx)
+	logger.Info("Valid tick ack received", "tickID", msg.ID, "slashedAmount", msg.SlashedAmount)
+
+	// save sequence
+	k.SetSlashingSequence(ctx, sequence)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTickAck,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                        // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                      // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmCommonTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),                   // result
+			sdk.NewAttribute(types.AttributeKeyTickID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashedAmount, strconv.FormatUint(msg.SlashedAmount, 10)),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
+
+// PostHandleMsgUnjail unjails validator once it is unjailed on rootchain
+func PostHandleMsgUnjail(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnjail, sideTxResult tmprototypes.SideTxResultType) (*sdk.Result, error) {
+	logger := k.Logger(ctx)
+
+	// Skip handler if unjail is not approved
+	if sideTxResult != tmprototypes.SideTxResultType_YES {
+		logger.Debug("Skipping unjail since side-tx didn't get yes votes", "validatorID", msg.ID)
+		return nil, hmCommon.ErrSideTxValidation
+	}
+
+	// check if incoming tx is older
+	sequence := keeper.GetSlashingSequence(msg.BlockNumber, msg.LogIndex)
+	if k.HasSlashingSequence(ctx, sequence) {
+		logger.Error("Older invalid tx found")
+		return nil, hmCommon.ErrOldTx
+	}
+
+	// validator may have been unjailed since unjail msg was validated
+	valID := hmTypes.NewValidatorID(msg.ID)
+	if err := k.Unjail(ctx, valID); err != nil {
+		logger.Error("Error unjailing validator", "validatorID", msg.ID, "error", err)
+		return nil, err
+	}
+	logger.Info("Validator unjailed", "validatorID", msg.ID)
+
+	// save sequence
+	k.SetSlashingSequence(ctx, sequence)
+
+	// TX bytes
+	txBytes := ctx.TxBytes()
+	hash := tmTypes.Tx(txBytes).Hash()
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),                                        // action
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),                      // module name
+			sdk.NewAttribute(hmTypes.AttributeKeyTxHash, hmCommonTypes.BytesToHeimdallHash(hash).Hex()), // tx hash
+			sdk.NewAttribute(hmTypes.AttributeKeySideTxResult, sideTxResult.String()),                   // result
+			sdk.NewAttribute(types.AttributeKeyValID, strconv.FormatUint(msg.ID, 10)),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
@@ -0,0 +1,351 @@
+package slashing_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	borCommon "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/heimdall/app"
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper/mocks"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	checkpointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	"github.com/maticnetwork/heimdall/x/slashing"
+	"github.com/maticnetwork/heimdall/x/slashing/keeper"
+	"github.com/maticnetwork/heimdall/x/slashing/test_helper"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+//
+// Create test suite
+//
+
+// SideHandlerTestSuite integrate test suite context object
+type SideHandlerTestSuite struct {
+	suite.Suite
+
+	app            *app.HeimdallApp
+	ctx            sdk.Context
+	sideHandler    hmTypes.SideTxHandler
+	postHandler    hmTypes.PostTxHandler
+	contractCaller mocks.IContractCaller
+}
+
+var (
+	SuccessCode = uint32(0)
+)
+
+func (suite *SideHandlerTestSuite) SetupTest() {
+	suite.app, suite.ctx, _ = test_helper.CreateTestApp(false)
+
+	suite.contractCaller = mocks.IContractCaller{}
+	suite.sideHandler = slashing.NewSideTxHandler(suite.app.SlashingKeeper, &suite.contractCaller)
+	suite.postHandler = slashing.NewPostTxHandler(suite.app.SlashingKeeper, &suite.contractCaller)
+}
+
+func TestSideHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(SideHandlerTestSuite))
+}
+
+//
+// Test cases
+//
+
+func (suite *SideHandlerTestSuite) TestSideHandler() {
+	t, ctx := suite.T(), suite.ctx
+
+	// side handler
+	result := suite.sideHandler(ctx, nil)
+	require.Equal(t, sdkerrors.ErrUnknownRequest.ABCICode(), result.Code)
+	require.Equal(t, abci.SideTxResultType_SKIP, result.Result)
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgTick() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	// generate proposer for validator set
+	checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	initApp.StakingKeeper.IncrementAccum(ctx, 1)
+	proposer := initApp.StakingKeeper.GetValidatorSet(ctx).Proposer.GetSigner()
+
+	info := hmTypes.NewValidatorSlashingInfo(hmTypes.NewValidatorID(1), 100, true)
+	require.NoError(t, initApp.SlashingKeeper.SetBufferValSlashingInfo(ctx, info.ID, info))
+	initApp.SlashingKeeper.UpdateTotalSlashedAmount(ctx, info.SlashedAmount)
+
+	slashingInfoBytes, err := types.SortAndRLPEncodeSlashInfos(initApp.SlashingKeeper.GetBufferValSlashingInfos(ctx))
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		msg := types.NewMsgTick(1, proposer, slashingInfoBytes)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, SuccessCode, result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_YES, result.Result, "Result should be `yes`")
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		msg := types.NewMsgTick(1, proposer, []byte("invalid"))
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, types.ErrInvalidSlashingInfo.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result, "Result should be `skip`")
+	})
+
+	t.Run("InvalidProposer", func(t *testing.T) {
+		msg := types.NewMsgTick(1, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes()), slashingInfoBytes)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, types.ErrInvalidProposer.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result, "Result should be `skip`")
+	})
+
+	t.Run("NotInContinuity", func(t *testing.T) {
+		msg := types.NewMsgTick(2, proposer, slashingInfoBytes)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, types.ErrTickNotInContinuity.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result, "Result should be `skip`")
+	})
+
+	t.Run("NoSlashedAmount", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		initApp.SlashingKeeper.FlushTotalSlashedAmount(ctx)
+		msg := types.NewMsgTick(1, proposer, slashingInfoBytes)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, types.ErrNoSlashedAmount.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result, "Result should be `skip`")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgTickAck() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	chainParams := initApp.ChainKeeper.GetParams(ctx)
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+
+	logIndex := uint64(1)
+	blockNumber := uint64(1000)
+	txHash := hmCommonTypes.HexToHeimdallHash("tick ack hash")
+	txReceipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+	stakingInfoAddress := borCommon.HexToAddress(chainParams.ChainParams.StakingInfoAddress)
+
+	t.Run("Success", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgTickAck(from, 1, 100, txHash, logIndex, blockNumber)
+
+		event := &stakinginfo.StakinginfoSlashed{
+			Nonce:  big.NewInt(1),
+			Amount: big.NewInt(100),
+		}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeSlashedEvent", stakingInfoAddress, txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, SuccessCode, result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_YES, result.Result, "Result should be `yes`")
+	})
+
+	t.Run("NoReceipt", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgTickAck(from, 1, 100, txHash, logIndex, blockNumber)
+
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(nil, nil)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, hmCommon.ErrWaitForConfirmation.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result, "Result should be `skip`")
+	})
+
+	t.Run("AmountMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgTickAck(from, 1, 100, txHash, logIndex, blockNumber)
+
+		event := &stakinginfo.StakinginfoSlashed{
+			Nonce:  big.NewInt(1),
+			Amount: big.NewInt(99),
+		}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeSlashedEvent", stakingInfoAddress, txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, hmCommon.ErrInvalidMsg.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result, "Result should be `skip`")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestSideHandleMsgUnjail() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	chainParams := initApp.ChainKeeper.GetParams(ctx)
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+
+	logIndex := uint64(2)
+	blockNumber := uint64(1001)
+	txHash := hmCommonTypes.HexToHeimdallHash("unjail hash")
+	txReceipt := &ethTypes.Receipt{BlockNumber: new(big.Int).SetUint64(blockNumber)}
+	stakingInfoAddress := borCommon.HexToAddress(chainParams.ChainParams.StakingInfoAddress)
+
+	t.Run("Success", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgUnjail(from, 3, txHash, logIndex, blockNumber)
+
+		event := &stakinginfo.StakinginfoUnJailed{ValidatorId: big.NewInt(3)}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeUnJailedEvent", stakingInfoAddress, txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, SuccessCode, result.Code, "Side tx handler should be success")
+		require.Equal(t, abci.SideTxResultType_YES, result.Result, "Result should be `yes`")
+	})
+
+	t.Run("IDMismatch", func(t *testing.T) {
+		suite.contractCaller = mocks.IContractCaller{}
+		msg := types.NewMsgUnjail(from, 3, txHash, logIndex, blockNumber)
+
+		event := &stakinginfo.StakinginfoUnJailed{ValidatorId: big.NewInt(4)}
+		suite.contractCaller.On("GetConfirmedTxReceipt", txHash.EthHash(), chainParams.MainchainTxConfirmations).Return(txReceipt, nil)
+		suite.contractCaller.On("DecodeUnJailedEvent", stakingInfoAddress, txReceipt, logIndex).Return(event, nil)
+
+		result := suite.sideHandler(ctx, &msg)
+		require.Equal(t, hmCommon.ErrInvalidMsg.ABCICode(), result.Code)
+		require.Equal(t, abci.SideTxResultType_SKIP, result.Result, "Result should be `skip`")
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandler() {
+	t, ctx := suite.T(), suite.ctx
+
+	// post handler
+	result, err := suite.postHandler(ctx, nil, abci.SideTxResultType_YES)
+	require.Nil(t, result)
+	require.Error(t, err)
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgTick() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	proposer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+
+	msg := types.NewMsgTick(1, proposer, []byte{0x01})
+
+	t.Run("NoResult", func(t *testing.T) {
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_NO)
+		require.Nil(t, result)
+		require.ErrorIs(t, err, hmCommon.ErrSideTxValidation)
+		require.Equal(t, uint64(0), initApp.SlashingKeeper.GetTickCount(ctx))
+	})
+
+	t.Run("NotInContinuity", func(t *testing.T) {
+		invalidMsg := types.NewMsgTick(2, proposer, []byte{0x01})
+
+		result, err := suite.postHandler(ctx, &invalidMsg, abci.SideTxResultType_YES)
+		require.Nil(t, result)
+		require.ErrorIs(t, err, types.ErrTickNotInContinuity)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+		validator := valSet.Validators[0]
+		require.NoError(t, initApp.SlashingKeeper.SlashInterim(ctx, validator.ID, 100))
+
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.Equal(t, uint64(1), initApp.SlashingKeeper.GetTickCount(ctx))
+		require.Equal(t, uint64(0), initApp.SlashingKeeper.GetTotalSlashedAmount(ctx))
+		require.Empty(t, initApp.SlashingKeeper.GetBufferValSlashingInfos(ctx))
+		require.Len(t, initApp.SlashingKeeper.GetTickValSlashingInfos(ctx), 1)
+
+		// validator is slashed only once tick is acknowledged
+		tickValidator, _ := initApp.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+		require.Equal(t, validator.VotingPower, tickValidator.VotingPower)
+		require.False(t, tickValidator.Jailed)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgTickAck() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+
+	logIndex := uint64(1)
+	blockNumber := uint64(1000)
+	txHash := hmCommonTypes.HexToHeimdallHash("tick ack hash")
+
+	valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+
+	initApp.SlashingKeeper.UpdateTickCountWithValue(ctx, 1)
+	info := hmTypes.NewValidatorSlashingInfo(validator.ID, 4, true)
+	require.NoError(t, initApp.SlashingKeeper.SetTickValSlashingInfo(ctx, info.ID, info))
+
+	msg := types.NewMsgTickAck(from, 1, 100, txHash, logIndex, blockNumber)
+
+	t.Run("NoResult", func(t *testing.T) {
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_NO)
+		require.Nil(t, result)
+		require.ErrorIs(t, err, hmCommon.ErrSideTxValidation)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.Empty(t, initApp.SlashingKeeper.GetTickValSlashingInfos(ctx))
+		require.True(t, initApp.SlashingKeeper.HasSlashingSequence(ctx, keeper.GetSlashingSequence(blockNumber, logIndex)))
+
+		slashedValidator, _ := initApp.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+		require.Equal(t, validator.VotingPower-4, slashedValidator.VotingPower)
+		require.True(t, slashedValidator.Jailed)
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.Nil(t, result)
+		require.ErrorIs(t, err, hmCommon.ErrOldTx)
+	})
+}
+
+func (suite *SideHandlerTestSuite) TestPostHandleMsgUnjail() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address().Bytes())
+	txHash := hmCommonTypes.HexToHeimdallHash("unjail hash")
+
+	valSet := checkpointSim.LoadValidatorSet(4, t, initApp.StakingKeeper, ctx, false, 10)
+	validator := valSet.Validators[0]
+	require.NoError(t, initApp.StakingKeeper.Slash(ctx, hmTypes.NewValidatorSlashingInfo(validator.ID, 0, true)))
+
+	msg := types.NewMsgUnjail(from, validator.ID.Uint64(), txHash, 1, 1000)
+
+	t.Run("NoResult", func(t *testing.T) {
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_NO)
+		require.Nil(t, result)
+		require.ErrorIs(t, err, hmCommon.ErrSideTxValidation)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		unjailedValidator, _ := initApp.StakingKeeper.GetValidatorFromValID(ctx, validator.ID)
+		require.False(t, unjailedValidator.Jailed)
+	})
+
+	t.Run("NotJailed", func(t *testing.T) {
+		// validator already unjailed by an earlier unjail event
+		msg := types.NewMsgUnjail(from, validator.ID.Uint64(), txHash, 2, 1000)
+
+		result, err := suite.postHandler(ctx, &msg, abci.SideTxResultType_YES)
+		require.Nil(t, result)
+		require.ErrorIs(t, err, types.ErrValidatorNotJailed)
+		require.False(t, initApp.SlashingKeeper.HasSlashingSequence(ctx, keeper.GetSlashingSequence(1000, 2)))
+	})
+}
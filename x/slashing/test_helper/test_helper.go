@@ -0,0 +1,27 @@
+package test_helper
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/x/slashing/types"
+)
+
+//
+// Create test app
+//
+
+// returns context and app with params set on slashing keeper
+func CreateTestApp(isCheckTx bool) (*app.HeimdallApp, sdk.Context, client.Context) {
+	initApp := app.Setup(false)
+	ctx := initApp.BaseApp.NewContext(isCheckTx, tmproto.Header{})
+	cliCtx := client.Context{}.WithJSONMarshaler(initApp.AppCodec())
+
+	params := types.DefaultParams()
+	params.EnableSlashing = true
+	initApp.SlashingKeeper.SetParams(ctx, params)
+
+	return initApp, ctx, cliCtx
+}
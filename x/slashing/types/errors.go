@@ -0,0 +1,21 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/slashing module sentinel errors
+var (
+	ErrNoSigningInfoFound  = sdkerrors.Register(ModuleName, 1701, "No signing info found")
+	ErrValidatorNotFound   = sdkerrors.Register(ModuleName, 1702, "Validator not found")
+	ErrInvalidSlashingInfo = sdkerrors.Register(ModuleName, 1703, "Invalid slashing info")
+	ErrSlashingDisabled    = sdkerrors.Register(ModuleName, 1704, "Slashing is disabled")
+	ErrNoSlashedAmount     = sdkerrors.Register(ModuleName, 1705, "No slashed amount in buffer")
+	ErrTickNotInContinuity = sdkerrors.Register(ModuleName, 1706, "Tick not in continuity")
+	ErrTickInProgress      = sdkerrors.Register(ModuleName, 1707, "Tick already in progress")
+	ErrInvalidProposer     = sdkerrors.Register(ModuleName, 1708, "Invalid proposer")
+	ErrInvalidTickAck      = sdkerrors.Register(ModuleName, 1709, "Invalid tick ack")
+	ErrValidatorNotJailed  = sdkerrors.Register(ModuleName, 1710, "Validator is not jailed")
+)
@@ -0,0 +1,29 @@
+package types
+
+// Slashing module event types
+var (
+	EventTypeSlash       = "slash"
+	EventTypeLiveness    = "liveness"
+	EventTypeSlashLimit  = "slash-limit"
+	EventTypeTickConfirm = "tick-confirm"
+	EventTypeTick        = "tick"
+	EventTypeTickAck     = "tick-ack"
+	EventTypeUnjail      = "unjail"
+
+	AttributeKeyAddress        = "address"
+	AttributeKeyHeight         = "height"
+	AttributeKeyPower          = "power"
+	AttributeKeyReason         = "reason"
+	AttributeKeyJailed         = "jailed"
+	AttributeKeyMissedBlocks   = "missed-blocks"
+	AttributeKeyValID          = "val-id"
+	AttributeKeySlashedAmount  = "slashed-amount"
+	AttributeKeyProposer       = "proposer"
+	AttributeKeySlashInfoBytes = "slash-info-bytes"
+	AttributeKeyTickID         = "tick-id"
+	AttributeKeyLogIndex       = "log-index"
+
+	AttributeValueDoubleSign       = "double-sign"
+	AttributeValueMissingSignature = "missing-signature"
+	AttributeValueCategory         = ModuleName
+)
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params,
+	signingInfos []SigningInfo,
+	missedBlocks []ValidatorMissedBlocks,
+	bufferValSlashingInfo []*hmTypes.ValidatorSlashingInfo,
+	tickValSlashingInfo []*hmTypes.ValidatorSlashingInfo,
+	tickCount uint64,
+	slashingSequences []string,
+) *GenesisState {
+	return &GenesisState{
+		Params:                params,
+		SigningInfos:          signingInfos,
+		MissedBlocks:          missedBlocks,
+		BufferValSlashingInfo: bufferValSlashingInfo,
+		TickValSlashingInfo:   tickValSlashingInfo,
+		TickCount:             tickCount,
+		SlashingSequences:     slashingSequences,
+	}
+}
+
+// NewSigningInfo creates a new SigningInfo instance
+func NewSigningInfo(valID hmTypes.ValidatorID, info hmTypes.ValidatorSigningInfo) SigningInfo {
+	return SigningInfo{
+		ValID:                valID,
+		ValidatorSigningInfo: info,
+	}
+}
+
+// NewValidatorMissedBlocks creates a new ValidatorMissedBlocks instance
+func NewValidatorMissedBlocks(valID hmTypes.ValidatorID, missedBlocks []MissedBlock) ValidatorMissedBlocks {
+	return ValidatorMissedBlocks{
+		ValID:        valID,
+		MissedBlocks: missedBlocks,
+	}
+}
+
+// NewMissedBlock creates a new MissedBlock instance
+func NewMissedBlock(index int64, missed bool) MissedBlock {
+	return MissedBlock{
+		Index:  index,
+		Missed: missed,
+	}
+}
+
+// DefaultGenesis returns the default slashing genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, info := range gs.SigningInfos {
+		if info.ValID != info.ValidatorSigningInfo.ValID {
+			return fmt.Errorf("signing info validator id mismatch: %d != %d", info.ValID, info.ValidatorSigningInfo.ValID)
+		}
+	}
+
+	for _, slashingInfo := range append(gs.BufferValSlashingInfo, gs.TickValSlashingInfo...) {
+		if slashingInfo == nil {
+			return ErrInvalidSlashingInfo
+		}
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns slashing GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(cdc codec.Marshaler, appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+	return genesisState
+}
+
+// SetGenesisStateToAppState sets state into app state
+func SetGenesisStateToAppState(cdc codec.Marshaler, appState map[string]json.RawMessage, valSigningInfoMap map[string]hmTypes.ValidatorSigningInfo) (map[string]json.RawMessage, error) {
+	// set signing infos to slashing state
+	slashingState := GetGenesisStateFromAppState(cdc, appState)
+	for _, info := range valSigningInfoMap {
+		slashingState.SigningInfos = append(slashingState.SigningInfos, NewSigningInfo(info.ValID, info))
+	}
+
+	// keep genesis deterministic
+	sort.Slice(slashingState.SigningInfos, func(i, j int) bool {
+		return slashingState.SigningInfos[i].ValID < slashingState.SigningInfos[j].ValID
+	})
+
+	appState[ModuleName] = cdc.MustMarshalJSON(&slashingState)
+	return appState, nil
+}
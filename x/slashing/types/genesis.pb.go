@@ -0,0 +1,1657 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdall/slashing/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/maticnetwork/heimdall/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params represents the parameters used for by the slashing module.
+type Params struct {
+	SignedBlocksWindow    int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
+	MinSignedPerWindow    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	SlashFractionDowntime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	SlashFractionLimit    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_limit,json=slashFractionLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_limit" yaml:"slash_fraction_limit"`
+	JailFractionLimit     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=jail_fraction_limit,json=jailFractionLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"jail_fraction_limit" yaml:"jail_fraction_limit"`
+	EnableSlashing        bool                                   `protobuf:"varint,6,opt,name=enable_slashing,json=enableSlashing,proto3" json:"enable_slashing,omitempty" yaml:"enable_slashing"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95bd7a59de0e3246, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// SigningInfo stores validator signing info of corresponding validator id.
+type SigningInfo struct {
+	ValID                types.ValidatorID          `protobuf:"varint,1,opt,name=val_id,json=valId,proto3,enum=heimdall.types.ValidatorID" json:"val_id,omitempty" yaml:"val_id"`
+	ValidatorSigningInfo types.ValidatorSigningInfo `protobuf:"bytes,2,opt,name=validator_signing_info,json=validatorSigningInfo,proto3" json:"validator_signing_info" yaml:"validator_signing_info"`
+}
+
+func (m *SigningInfo) Reset()         { *m = SigningInfo{} }
+func (m *SigningInfo) String() string { return proto.CompactTextString(m) }
+func (*SigningInfo) ProtoMessage()    {}
+func (*SigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95bd7a59de0e3246, []int{1}
+}
+func (m *SigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningInfo.Merge(m, src)
+}
+func (m *SigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningInfo proto.InternalMessageInfo
+
+func (m *SigningInfo) GetValID() types.ValidatorID {
+	if m != nil {
+		return m.ValID
+	}
+	return types.DEFAULT
+}
+
+func (m *SigningInfo) GetValidatorSigningInfo() types.ValidatorSigningInfo {
+	if m != nil {
+		return m.ValidatorSigningInfo
+	}
+	return types.ValidatorSigningInfo{}
+}
+
+// MissedBlock contains height and missed status as boolean.
+type MissedBlock struct {
+	Index  int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Missed bool  `protobuf:"varint,2,opt,name=missed,proto3" json:"missed,omitempty"`
+}
+
+func (m *MissedBlock) Reset()         { *m = MissedBlock{} }
+func (m *MissedBlock) String() string { return proto.CompactTextString(m) }
+func (*MissedBlock) ProtoMessage()    {}
+func (*MissedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95bd7a59de0e3246, []int{2}
+}
+func (m *MissedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedBlock.Merge(m, src)
+}
+func (m *MissedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedBlock proto.InternalMessageInfo
+
+func (m *MissedBlock) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MissedBlock) GetMissed() bool {
+	if m != nil {
+		return m.Missed
+	}
+	return false
+}
+
+// ValidatorMissedBlocks contains array of missed blocks of corresponding
+// validator id.
+type ValidatorMissedBlocks struct {
+	ValID        types.ValidatorID `protobuf:"varint,1,opt,name=val_id,json=valId,proto3,enum=heimdall.types.ValidatorID" json:"val_id,omitempty" yaml:"val_id"`
+	MissedBlocks []MissedBlock     `protobuf:"bytes,2,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+}
+
+func (m *ValidatorMissedBlocks) Reset()         { *m = ValidatorMissedBlocks{} }
+func (m *ValidatorMissedBlocks) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedBlocks) ProtoMessage()    {}
+func (*ValidatorMissedBlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95bd7a59de0e3246, []int{3}
+}
+func (m *ValidatorMissedBlocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedBlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedBlocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedBlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedBlocks.Merge(m, src)
+}
+func (m *ValidatorMissedBlocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedBlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedBlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedBlocks proto.InternalMessageInfo
+
+func (m *ValidatorMissedBlocks) GetValID() types.ValidatorID {
+	if m != nil {
+		return m.ValID
+	}
+	return types.DEFAULT
+}
+
+func (m *ValidatorMissedBlocks) GetMissedBlocks() []MissedBlock {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+// GenesisState defines the slashing module's genesis state.
+type GenesisState struct {
+	Params                Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SigningInfos          []SigningInfo                  `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos" yaml:"signing_infos"`
+	MissedBlocks          []ValidatorMissedBlocks        `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	BufferValSlashingInfo []*types.ValidatorSlashingInfo `protobuf:"bytes,4,rep,name=buffer_val_slashing_info,json=bufferValSlashingInfo,proto3" json:"buffer_val_slashing_info,omitempty" yaml:"buffer_val_slashing_info"`
+	TickValSlashingInfo   []*types.ValidatorSlashingInfo `protobuf:"bytes,5,rep,name=tick_val_slashing_info,json=tickValSlashingInfo,proto3" json:"tick_val_slashing_info,omitempty" yaml:"tick_val_slashing_info"`
+	TickCount             uint64                         `protobuf:"varint,6,opt,name=tick_count,json=tickCount,proto3" json:"tick_count,omitempty" yaml:"tick_count"`
+	SlashingSequences     []string                       `protobuf:"bytes,7,rep,name=slashing_sequences,json=slashingSequences,proto3" json:"slashing_sequences,omitempty" yaml:"slashing_sequences"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95bd7a59de0e3246, []int{4}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "heimdall.slashing.v1beta1.Params")
+	proto.RegisterType((*SigningInfo)(nil), "heimdall.slashing.v1beta1.SigningInfo")
+	proto.RegisterType((*MissedBlock)(nil), "heimdall.slashing.v1beta1.MissedBlock")
+	proto.RegisterType((*ValidatorMissedBlocks)(nil), "heimdall.slashing.v1beta1.ValidatorMissedBlocks")
+	proto.RegisterType((*GenesisState)(nil), "heimdall.slashing.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("heimdall/slashing/v1beta1/genesis.proto", fileDescriptor_95bd7a59de0e3246)
+}
+
+var fileDescriptor_95bd7a59de0e3246 = []byte{
+	// 864 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0xb6, 0xea, 0xd8, 0x4b, 0xe9, 0xb4, 0x43, 0x58, 0x3b, 0x73, 0x9d, 0xd6, 0x72, 0xb9, 0xa6,
+	0xf3, 0x65, 0xf6, 0x92, 0xed, 0xd4, 0x1d, 0x06, 0xa8, 0xc1, 0x86, 0x60, 0x69, 0x91, 0x31, 0x40,
+	0x06, 0xec, 0x22, 0xd0, 0x12, 0xad, 0x70, 0x91, 0xc8, 0x4c, 0x54, 0x9c, 0x16, 0xd8, 0x30, 0x0c,
+	0xbb, 0xf4, 0xb8, 0xe3, 0x8e, 0xfd, 0x19, 0x3b, 0xef, 0xd4, 0x63, 0xb1, 0xd3, 0xb0, 0x83, 0xb0,
+	0x25, 0xff, 0x40, 0xbf, 0x60, 0x10, 0x29, 0x29, 0x8a, 0x21, 0x17, 0x33, 0xb0, 0x93, 0xc5, 0xc7,
+	0xef, 0x7d, 0xdf, 0xa7, 0xc7, 0xc7, 0x27, 0x83, 0x0f, 0x8e, 0x29, 0x0b, 0x5c, 0xe2, 0xfb, 0x63,
+	0xe9, 0x13, 0x79, 0xcc, 0xb8, 0x37, 0x9e, 0x6d, 0x4f, 0x68, 0x44, 0xb6, 0xc7, 0x1e, 0xe5, 0x54,
+	0x32, 0x39, 0x3a, 0x0d, 0x45, 0x24, 0xe0, 0xdd, 0x1c, 0x38, 0xca, 0x81, 0xa3, 0x0c, 0xd8, 0x6b,
+	0x7b, 0xc2, 0x13, 0x0a, 0x35, 0x4e, 0x9f, 0x74, 0x42, 0xef, 0x61, 0xc1, 0x3c, 0x21, 0x92, 0x16,
+	0xac, 0x45, 0xb6, 0x46, 0x6d, 0x55, 0xa3, 0x66, 0xc4, 0x67, 0x2e, 0x89, 0x44, 0xa8, 0x61, 0xe8,
+	0xb7, 0x06, 0x68, 0x1e, 0x90, 0x90, 0x04, 0x12, 0x7e, 0x05, 0xda, 0x92, 0x79, 0x9c, 0xba, 0xf6,
+	0xc4, 0x17, 0xce, 0x89, 0xb4, 0xcf, 0x19, 0x77, 0xc5, 0x79, 0xd7, 0x18, 0x18, 0xc3, 0xba, 0x65,
+	0x26, 0xb1, 0xb9, 0xf9, 0x82, 0x04, 0xfe, 0x63, 0x54, 0x85, 0x42, 0x18, 0xea, 0xb0, 0xa5, 0xa2,
+	0x5f, 0xab, 0x20, 0xfc, 0xc9, 0x00, 0x9d, 0x80, 0x71, 0x3b, 0xcb, 0x38, 0xa5, 0x61, 0x4e, 0x7a,
+	0x63, 0x60, 0x0c, 0xd7, 0xac, 0x67, 0xaf, 0x63, 0xb3, 0xf6, 0x57, 0x6c, 0x3e, 0xf2, 0x58, 0x74,
+	0x7c, 0x36, 0x19, 0x39, 0x22, 0x18, 0x3b, 0x42, 0x06, 0x42, 0x66, 0x3f, 0x1f, 0x4a, 0xf7, 0x64,
+	0x1c, 0xbd, 0x38, 0xa5, 0x72, 0xb4, 0x4b, 0x9d, 0x24, 0x36, 0xef, 0x69, 0x0b, 0x95, 0xa4, 0x08,
+	0xc3, 0x80, 0xf1, 0x43, 0x15, 0x3e, 0xa0, 0x61, 0xe6, 0xe1, 0xa5, 0x01, 0xde, 0x53, 0xb5, 0xb1,
+	0xa7, 0x21, 0x71, 0x22, 0x26, 0xb8, 0xed, 0x8a, 0x73, 0x1e, 0xb1, 0x80, 0x76, 0xeb, 0xca, 0xc5,
+	0xc1, 0xd2, 0x2e, 0xfa, 0x59, 0x21, 0xaa, 0x69, 0x11, 0xee, 0xa8, 0x9d, 0xcf, 0xb3, 0x8d, 0xdd,
+	0x2c, 0x0e, 0x7f, 0x04, 0xed, 0xb9, 0x14, 0x9f, 0x05, 0x2c, 0xea, 0xae, 0x28, 0x1b, 0x4f, 0x97,
+	0xb6, 0xb1, 0x59, 0x69, 0x43, 0x71, 0xa6, 0xe7, 0x51, 0xf6, 0xb0, 0x9f, 0x06, 0xe1, 0xf7, 0xe0,
+	0xce, 0xb7, 0x84, 0xf9, 0xf3, 0xfa, 0x0d, 0xa5, 0xbf, 0xbf, 0xb4, 0x7e, 0x4f, 0xeb, 0x57, 0x50,
+	0x22, 0xbc, 0x9e, 0x46, 0xaf, 0xab, 0x3f, 0x01, 0xef, 0x52, 0x4e, 0x26, 0x3e, 0xb5, 0xf3, 0x5e,
+	0xed, 0x36, 0x07, 0xc6, 0x70, 0xd5, 0xea, 0x25, 0xb1, 0xb9, 0xa1, 0xb9, 0xe6, 0x00, 0x08, 0xdf,
+	0xd6, 0x91, 0xc3, 0x2c, 0xf0, 0x78, 0xf5, 0xe5, 0x2b, 0xb3, 0xf6, 0xeb, 0x2b, 0xb3, 0x86, 0xfe,
+	0x31, 0x40, 0x2b, 0x3d, 0x6c, 0xc6, 0xbd, 0x3d, 0x3e, 0x15, 0xf0, 0x19, 0x68, 0xce, 0x88, 0x6f,
+	0x33, 0x57, 0x75, 0xec, 0xed, 0x9d, 0xcd, 0x51, 0x71, 0xb3, 0xb4, 0xe9, 0xa3, 0xbc, 0xf7, 0xf7,
+	0x76, 0xad, 0xde, 0x45, 0x6c, 0x36, 0x8e, 0x88, 0xbf, 0xb7, 0x9b, 0xc4, 0xe6, 0x2d, 0xad, 0xad,
+	0xb3, 0x11, 0x6e, 0xcc, 0x88, 0xbf, 0xe7, 0xa6, 0xcd, 0xbb, 0x51, 0x5c, 0x17, 0xd5, 0x6d, 0x8c,
+	0x7b, 0x36, 0xe3, 0x53, 0xa1, 0xba, 0xb7, 0xb5, 0xf3, 0x70, 0xa1, 0x40, 0xc9, 0x96, 0xb5, 0x95,
+	0x96, 0x35, 0x89, 0xcd, 0xfb, 0x85, 0x48, 0x05, 0x23, 0xc2, 0xed, 0x59, 0x45, 0x32, 0xfa, 0x14,
+	0xb4, 0x9e, 0x32, 0x29, 0xb3, 0x6b, 0x05, 0xdb, 0xa0, 0xc1, 0xb8, 0x4b, 0x9f, 0xeb, 0x3b, 0x89,
+	0xf5, 0x02, 0x6e, 0x80, 0x66, 0xa0, 0x40, 0xca, 0xd7, 0x2a, 0xce, 0x56, 0xe8, 0x0f, 0x03, 0x74,
+	0x0a, 0x4b, 0x25, 0x1a, 0xf9, 0xbf, 0x97, 0x8a, 0x81, 0x5b, 0x5a, 0x33, 0x1b, 0x0a, 0xdd, 0x1b,
+	0x83, 0xfa, 0xb0, 0xb5, 0xf3, 0x68, 0xb4, 0x70, 0xb6, 0x8d, 0x4a, 0x7e, 0xac, 0x7b, 0x59, 0x89,
+	0xda, 0xf9, 0xe5, 0x2e, 0x51, 0x21, 0xbc, 0x16, 0x94, 0xac, 0xa3, 0xdf, 0x1b, 0x60, 0xed, 0x0b,
+	0x3d, 0x40, 0x0f, 0x23, 0x12, 0x51, 0xf8, 0x19, 0x68, 0x9e, 0xaa, 0x01, 0xa6, 0xde, 0xa5, 0xb5,
+	0xf3, 0xe0, 0x2d, 0xa2, 0x7a, 0xd2, 0x59, 0x2b, 0xa9, 0x1e, 0xce, 0xd2, 0x52, 0xf3, 0xe5, 0xa3,
+	0xf8, 0x2f, 0xe6, 0xcb, 0xe7, 0x3b, 0x67, 0xfe, 0x1a, 0x15, 0xc2, 0x6b, 0xf2, 0x0a, 0x2a, 0xa1,
+	0x9c, 0xaf, 0x53, 0x5d, 0x49, 0x7d, 0xf4, 0x16, 0xa9, 0xca, 0x03, 0x5c, 0xa6, 0x62, 0xf0, 0x67,
+	0x03, 0x74, 0x27, 0x67, 0xd3, 0x29, 0x0d, 0xed, 0xf4, 0xd8, 0x72, 0x05, 0xdd, 0xc9, 0x2b, 0xca,
+	0xc0, 0xd6, 0xe2, 0x4e, 0xce, 0xd0, 0xea, 0x55, 0xdf, 0x4f, 0x62, 0xd3, 0xd4, 0x8a, 0x8b, 0x08,
+	0x11, 0xee, 0xe8, 0xad, 0x23, 0xe2, 0x97, 0x73, 0xe1, 0x0f, 0x60, 0x23, 0x62, 0xce, 0x49, 0x85,
+	0x85, 0xc6, 0x32, 0x16, 0x1e, 0x5c, 0xdd, 0xa4, 0x6a, 0x3a, 0x84, 0xef, 0xa4, 0x1b, 0xf3, 0xf2,
+	0x9f, 0x00, 0xa0, 0xf0, 0x8e, 0x38, 0xe3, 0x91, 0x1a, 0x3b, 0x2b, 0x56, 0x27, 0x89, 0xcd, 0xf5,
+	0x12, 0x97, 0xda, 0x43, 0xf8, 0x66, 0xba, 0x78, 0x92, 0x3e, 0xc3, 0x7d, 0x00, 0x0b, 0x72, 0x49,
+	0xbf, 0x3b, 0xa3, 0xdc, 0xa1, 0xb2, 0xfb, 0xce, 0xa0, 0x3e, 0xbc, 0x69, 0xdd, 0x4f, 0x62, 0xf3,
+	0x6e, 0x69, 0x00, 0x5f, 0xc3, 0x20, 0xbc, 0x9e, 0x07, 0x0f, 0xf3, 0x58, 0x31, 0xba, 0x0c, 0xeb,
+	0xcb, 0xd7, 0x17, 0x7d, 0xe3, 0xcd, 0x45, 0xdf, 0xf8, 0xfb, 0xa2, 0x6f, 0xfc, 0x72, 0xd9, 0xaf,
+	0xbd, 0xb9, 0xec, 0xd7, 0xfe, 0xbc, 0xec, 0xd7, 0xbe, 0xd9, 0x2e, 0x0d, 0xdf, 0x80, 0x44, 0xcc,
+	0xe1, 0x34, 0x3a, 0x17, 0xe1, 0xc9, 0xb8, 0xf8, 0x9c, 0x3f, 0xbf, 0xfa, 0x43, 0xa1, 0x0a, 0x35,
+	0x69, 0xaa, 0x2f, 0xf9, 0xc7, 0xff, 0x0e, 0x00, 0x98, 0xb3, 0xf5, 0x6b, 0x72, 0x08, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnableSlashing {
+		i--
+		if m.EnableSlashing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.JailFractionLimit.Size()
+		i -= size
+		if _, err := m.JailFractionLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SlashFractionLimit.Size()
+		i -= size
+		if _, err := m.SlashFractionLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValidatorSigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ValID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Missed {
+		i--
+		if m.Missed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedBlocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ValID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingSequences) > 0 {
+		for iNdEx := len(m.SlashingSequences) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingSequences[iNdEx])
+			copy(dAtA[i:], m.SlashingSequences[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashingSequences[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TickCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TickCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TickValSlashingInfo) > 0 {
+		for iNdEx := len(m.TickValSlashingInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickValSlashingInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BufferValSlashingInfo) > 0 {
+		for iNdEx := len(m.BufferValSlashingInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BufferValSlashingInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.JailFractionLimit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EnableSlashing {
+		n += 2
+	}
+	return n
+}
+
+func (m *SigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValID != 0 {
+		n += 1 + sovGenesis(uint64(m.ValID))
+	}
+	l = m.ValidatorSigningInfo.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *MissedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	if m.Missed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ValidatorMissedBlocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValID != 0 {
+		n += 1 + sovGenesis(uint64(m.ValID))
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BufferValSlashingInfo) > 0 {
+		for _, e := range m.BufferValSlashingInfo {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TickValSlashingInfo) > 0 {
+		for _, e := range m.TickValSlashingInfo {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TickCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TickCount))
+	}
+	if len(m.SlashingSequences) > 0 {
+		for _, s := range m.SlashingSequences {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLimit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailFractionLimit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JailFractionLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSlashing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableSlashing = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValID", wireType)
+			}
+			m.ValID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValID |= types.ValidatorID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Missed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValID", wireType)
+			}
+			m.ValID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValID |= types.ValidatorID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, MissedBlock{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, SigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, ValidatorMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferValSlashingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BufferValSlashingInfo = append(m.BufferValSlashingInfo, &types.ValidatorSlashingInfo{})
+			if err := m.BufferValSlashingInfo[len(m.BufferValSlashingInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickValSlashingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickValSlashingInfo = append(m.TickValSlashingInfo, &types.ValidatorSlashingInfo{})
+			if err := m.TickValSlashingInfo[len(m.TickValSlashingInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCount", wireType)
+			}
+			m.TickCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingSequences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingSequences = append(m.SlashingSequences, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
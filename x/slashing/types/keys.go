@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "slashing"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for slashing
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultSignedBlocksWindow int64 = 100
+	DefaultEnableSlashing           = false
+)
+
+var (
+	DefaultMinSignedPerWindow    = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionDowntime = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultSlashFractionLimit    = sdk.NewDec(1).Quo(sdk.NewDec(3))
+	DefaultJailFractionLimit     = sdk.NewDec(1).Quo(sdk.NewDec(3))
+)
+
+// Parameter keys
+var (
+	KeySignedBlocksWindow    = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow    = []byte("MinSignedPerWindow")
+	KeySlashFractionDowntime = []byte("SlashFractionDowntime")
+	KeySlashFractionLimit    = []byte("SlashFractionLimit")
+	KeyJailFractionLimit     = []byte("JailFractionLimit")
+	KeyEnableSlashing        = []byte("EnableSlashing")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// NewParams creates a new Params object
+func NewParams(
+	signedBlocksWindow int64,
+	minSignedPerWindow sdk.Dec,
+	slashFractionDowntime sdk.Dec,
+	slashFractionLimit sdk.Dec,
+	jailFractionLimit sdk.Dec,
+	enableSlashing bool,
+) Params {
+	return Params{
+		SignedBlocksWindow:    signedBlocksWindow,
+		MinSignedPerWindow:    minSignedPerWindow,
+		SlashFractionDowntime: slashFractionDowntime,
+		SlashFractionLimit:    slashFractionLimit,
+		JailFractionLimit:     jailFractionLimit,
+		EnableSlashing:        enableSlashing,
+	}
+}
+
+// ParamKeyTable for slashing module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of slashing module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
+		paramtypes.NewParamSetPair(KeyMinSignedPerWindow, &p.MinSignedPerWindow, validateFraction),
+		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateFraction),
+		paramtypes.NewParamSetPair(KeySlashFractionLimit, &p.SlashFractionLimit, validateFraction),
+		paramtypes.NewParamSetPair(KeyJailFractionLimit, &p.JailFractionLimit, validateFraction),
+		paramtypes.NewParamSetPair(KeyEnableSlashing, &p.EnableSlashing, validateEnableSlashing),
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultSignedBlocksWindow,
+		DefaultMinSignedPerWindow,
+		DefaultSlashFractionDowntime,
+		DefaultSlashFractionLimit,
+		DefaultJailFractionLimit,
+		DefaultEnableSlashing,
+	)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("SignedBlocksWindow: %d\n", p.SignedBlocksWindow))
+	sb.WriteString(fmt.Sprintf("MinSignedPerWindow: %s\n", p.MinSignedPerWindow))
+	sb.WriteString(fmt.Sprintf("SlashFractionDowntime: %s\n", p.SlashFractionDowntime))
+	sb.WriteString(fmt.Sprintf("SlashFractionLimit: %s\n", p.SlashFractionLimit))
+	sb.WriteString(fmt.Sprintf("JailFractionLimit: %s\n", p.JailFractionLimit))
+	sb.WriteString(fmt.Sprintf("EnableSlashing: %t\n", p.EnableSlashing))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+
+	for _, v := range []sdk.Dec{
+		p.MinSignedPerWindow,
+		p.SlashFractionDowntime,
+		p.SlashFractionLimit,
+		p.JailFractionLimit,
+	} {
+		if err := validateFraction(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSignedBlocksWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("signed blocks window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("fraction cannot be nil or negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateEnableSlashing(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
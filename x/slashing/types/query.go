@@ -0,0 +1,10 @@
+package types
+
+// query endpoints supported by the slashing Querier
+const (
+	QueryParams         = "params"
+	QuerySigningInfo    = "signing-info"
+	QuerySigningInfos   = "signing-infos"
+	QuerySlashingBuffer = "slashing-buffer"
+	QueryTickCount      = "tick-count"
+)
@@ -0,0 +1,3103 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heimdall/slashing/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/maticnetwork/heimdall/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QuerySigningInfoRequest struct {
+	ValId uint64 `protobuf:"varint,1,opt,name=val_id,json=valId,proto3" json:"val_id,omitempty"`
+}
+
+func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest{} }
+func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoRequest) ProtoMessage()    {}
+func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{2}
+}
+func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoRequest.Merge(m, src)
+}
+func (m *QuerySigningInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfoRequest) GetValId() uint64 {
+	if m != nil {
+		return m.ValId
+	}
+	return 0
+}
+
+type QuerySigningInfoResponse struct {
+	ValSigningInfo types.ValidatorSigningInfo `protobuf:"bytes,1,opt,name=val_signing_info,json=valSigningInfo,proto3" json:"val_signing_info"`
+}
+
+func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoResponse{} }
+func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfoResponse) ProtoMessage()    {}
+func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{3}
+}
+func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfoResponse.Merge(m, src)
+}
+func (m *QuerySigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfoResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfoResponse) GetValSigningInfo() types.ValidatorSigningInfo {
+	if m != nil {
+		return m.ValSigningInfo
+	}
+	return types.ValidatorSigningInfo{}
+}
+
+type QuerySigningInfosRequest struct {
+	Pagination *types.QueryPaginationParams `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosRequest{} }
+func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosRequest) ProtoMessage()    {}
+func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{4}
+}
+func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosRequest.Merge(m, src)
+}
+func (m *QuerySigningInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosRequest proto.InternalMessageInfo
+
+func (m *QuerySigningInfosRequest) GetPagination() *types.QueryPaginationParams {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QuerySigningInfosResponse struct {
+	ValSigningInfos []types.ValidatorSigningInfo `protobuf:"bytes,1,rep,name=val_signing_infos,json=valSigningInfos,proto3" json:"val_signing_infos"`
+}
+
+func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResponse{} }
+func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySigningInfosResponse) ProtoMessage()    {}
+func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{5}
+}
+func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySigningInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySigningInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySigningInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySigningInfosResponse.Merge(m, src)
+}
+func (m *QuerySigningInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySigningInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySigningInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySigningInfosResponse proto.InternalMessageInfo
+
+func (m *QuerySigningInfosResponse) GetValSigningInfos() []types.ValidatorSigningInfo {
+	if m != nil {
+		return m.ValSigningInfos
+	}
+	return nil
+}
+
+type QuerySlashingBufferRequest struct {
+}
+
+func (m *QuerySlashingBufferRequest) Reset()         { *m = QuerySlashingBufferRequest{} }
+func (m *QuerySlashingBufferRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingBufferRequest) ProtoMessage()    {}
+func (*QuerySlashingBufferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{6}
+}
+func (m *QuerySlashingBufferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingBufferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingBufferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashingBufferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingBufferRequest.Merge(m, src)
+}
+func (m *QuerySlashingBufferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingBufferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingBufferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashingBufferRequest proto.InternalMessageInfo
+
+type QuerySlashingBufferResponse struct {
+	ValSlashingInfos []*types.ValidatorSlashingInfo `protobuf:"bytes,1,rep,name=val_slashing_infos,json=valSlashingInfos,proto3" json:"val_slashing_infos,omitempty"`
+}
+
+func (m *QuerySlashingBufferResponse) Reset()         { *m = QuerySlashingBufferResponse{} }
+func (m *QuerySlashingBufferResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingBufferResponse) ProtoMessage()    {}
+func (*QuerySlashingBufferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{7}
+}
+func (m *QuerySlashingBufferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingBufferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingBufferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashingBufferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingBufferResponse.Merge(m, src)
+}
+func (m *QuerySlashingBufferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingBufferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingBufferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashingBufferResponse proto.InternalMessageInfo
+
+func (m *QuerySlashingBufferResponse) GetValSlashingInfos() []*types.ValidatorSlashingInfo {
+	if m != nil {
+		return m.ValSlashingInfos
+	}
+	return nil
+}
+
+type QueryTickCountRequest struct {
+}
+
+func (m *QueryTickCountRequest) Reset()         { *m = QueryTickCountRequest{} }
+func (m *QueryTickCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTickCountRequest) ProtoMessage()    {}
+func (*QueryTickCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{8}
+}
+func (m *QueryTickCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTickCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTickCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTickCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTickCountRequest.Merge(m, src)
+}
+func (m *QueryTickCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTickCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTickCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTickCountRequest proto.InternalMessageInfo
+
+type QueryTickCountResponse struct {
+	TickCount uint64 `protobuf:"varint,1,opt,name=tick_count,json=tickCount,proto3" json:"tick_count,omitempty"`
+}
+
+func (m *QueryTickCountResponse) Reset()         { *m = QueryTickCountResponse{} }
+func (m *QueryTickCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTickCountResponse) ProtoMessage()    {}
+func (*QueryTickCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{9}
+}
+func (m *QueryTickCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTickCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTickCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTickCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTickCountResponse.Merge(m, src)
+}
+func (m *QueryTickCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTickCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTickCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTickCountResponse proto.InternalMessageInfo
+
+func (m *QueryTickCountResponse) GetTickCount() uint64 {
+	if m != nil {
+		return m.TickCount
+	}
+	return 0
+}
+
+type QueryLatestSlashInfoBytesRequest struct {
+}
+
+func (m *QueryLatestSlashInfoBytesRequest) Reset()         { *m = QueryLatestSlashInfoBytesRequest{} }
+func (m *QueryLatestSlashInfoBytesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestSlashInfoBytesRequest) ProtoMessage()    {}
+func (*QueryLatestSlashInfoBytesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{10}
+}
+func (m *QueryLatestSlashInfoBytesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestSlashInfoBytesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestSlashInfoBytesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestSlashInfoBytesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestSlashInfoBytesRequest.Merge(m, src)
+}
+func (m *QueryLatestSlashInfoBytesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestSlashInfoBytesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestSlashInfoBytesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestSlashInfoBytesRequest proto.InternalMessageInfo
+
+type QueryLatestSlashInfoBytesResponse struct {
+	SlashInfoBytes string `protobuf:"bytes,1,opt,name=slash_info_bytes,json=slashInfoBytes,proto3" json:"slash_info_bytes,omitempty"`
+}
+
+func (m *QueryLatestSlashInfoBytesResponse) Reset()         { *m = QueryLatestSlashInfoBytesResponse{} }
+func (m *QueryLatestSlashInfoBytesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestSlashInfoBytesResponse) ProtoMessage()    {}
+func (*QueryLatestSlashInfoBytesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{11}
+}
+func (m *QueryLatestSlashInfoBytesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestSlashInfoBytesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestSlashInfoBytesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestSlashInfoBytesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestSlashInfoBytesResponse.Merge(m, src)
+}
+func (m *QueryLatestSlashInfoBytesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestSlashInfoBytesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestSlashInfoBytesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLatestSlashInfoBytesResponse proto.InternalMessageInfo
+
+func (m *QueryLatestSlashInfoBytesResponse) GetSlashInfoBytes() string {
+	if m != nil {
+		return m.SlashInfoBytes
+	}
+	return ""
+}
+
+type QueryTickSlashingInfosRequest struct {
+}
+
+func (m *QueryTickSlashingInfosRequest) Reset()         { *m = QueryTickSlashingInfosRequest{} }
+func (m *QueryTickSlashingInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTickSlashingInfosRequest) ProtoMessage()    {}
+func (*QueryTickSlashingInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{12}
+}
+func (m *QueryTickSlashingInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTickSlashingInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTickSlashingInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTickSlashingInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTickSlashingInfosRequest.Merge(m, src)
+}
+func (m *QueryTickSlashingInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTickSlashingInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTickSlashingInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTickSlashingInfosRequest proto.InternalMessageInfo
+
+type QueryTickSlashingInfosResponse struct {
+	ValSlashingInfos []*types.ValidatorSlashingInfo `protobuf:"bytes,1,rep,name=val_slashing_infos,json=valSlashingInfos,proto3" json:"val_slashing_infos,omitempty"`
+}
+
+func (m *QueryTickSlashingInfosResponse) Reset()         { *m = QueryTickSlashingInfosResponse{} }
+func (m *QueryTickSlashingInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTickSlashingInfosResponse) ProtoMessage()    {}
+func (*QueryTickSlashingInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{13}
+}
+func (m *QueryTickSlashingInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTickSlashingInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTickSlashingInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTickSlashingInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTickSlashingInfosResponse.Merge(m, src)
+}
+func (m *QueryTickSlashingInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTickSlashingInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTickSlashingInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTickSlashingInfosResponse proto.InternalMessageInfo
+
+func (m *QueryTickSlashingInfosResponse) GetValSlashingInfos() []*types.ValidatorSlashingInfo {
+	if m != nil {
+		return m.ValSlashingInfos
+	}
+	return nil
+}
+
+type QueryIsOldTxRequest struct {
+	TxHash   string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	LogIndex uint64 `protobuf:"varint,2,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+}
+
+func (m *QueryIsOldTxRequest) Reset()         { *m = QueryIsOldTxRequest{} }
+func (m *QueryIsOldTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsOldTxRequest) ProtoMessage()    {}
+func (*QueryIsOldTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{14}
+}
+func (m *QueryIsOldTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsOldTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsOldTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsOldTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsOldTxRequest.Merge(m, src)
+}
+func (m *QueryIsOldTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsOldTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsOldTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsOldTxRequest proto.InternalMessageInfo
+
+func (m *QueryIsOldTxRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *QueryIsOldTxRequest) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+type QueryIsOldTxResponse struct {
+	Status bool `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *QueryIsOldTxResponse) Reset()         { *m = QueryIsOldTxResponse{} }
+func (m *QueryIsOldTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsOldTxResponse) ProtoMessage()    {}
+func (*QueryIsOldTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97a4c655a2cc6bb1, []int{15}
+}
+func (m *QueryIsOldTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsOldTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsOldTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsOldTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsOldTxResponse.Merge(m, src)
+}
+func (m *QueryIsOldTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsOldTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsOldTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsOldTxResponse proto.InternalMessageInfo
+
+func (m *QueryIsOldTxResponse) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.slashing.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.slashing.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QuerySigningInfoRequest)(nil), "heimdall.slashing.v1beta1.QuerySigningInfoRequest")
+	proto.RegisterType((*QuerySigningInfoResponse)(nil), "heimdall.slashing.v1beta1.QuerySigningInfoResponse")
+	proto.RegisterType((*QuerySigningInfosRequest)(nil), "heimdall.slashing.v1beta1.QuerySigningInfosRequest")
+	proto.RegisterType((*QuerySigningInfosResponse)(nil), "heimdall.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QuerySlashingBufferRequest)(nil), "heimdall.slashing.v1beta1.QuerySlashingBufferRequest")
+	proto.RegisterType((*QuerySlashingBufferResponse)(nil), "heimdall.slashing.v1beta1.QuerySlashingBufferResponse")
+	proto.RegisterType((*QueryTickCountRequest)(nil), "heimdall.slashing.v1beta1.QueryTickCountRequest")
+	proto.RegisterType((*QueryTickCountResponse)(nil), "heimdall.slashing.v1beta1.QueryTickCountResponse")
+	proto.RegisterType((*QueryLatestSlashInfoBytesRequest)(nil), "heimdall.slashing.v1beta1.QueryLatestSlashInfoBytesRequest")
+	proto.RegisterType((*QueryLatestSlashInfoBytesResponse)(nil), "heimdall.slashing.v1beta1.QueryLatestSlashInfoBytesResponse")
+	proto.RegisterType((*QueryTickSlashingInfosRequest)(nil), "heimdall.slashing.v1beta1.QueryTickSlashingInfosRequest")
+	proto.RegisterType((*QueryTickSlashingInfosResponse)(nil), "heimdall.slashing.v1beta1.QueryTickSlashingInfosResponse")
+	proto.RegisterType((*QueryIsOldTxRequest)(nil), "heimdall.slashing.v1beta1.QueryIsOldTxRequest")
+	proto.RegisterType((*QueryIsOldTxResponse)(nil), "heimdall.slashing.v1beta1.QueryIsOldTxResponse")
+}
+
+func init() {
+	proto.RegisterFile("heimdall/slashing/v1beta1/query.proto", fileDescriptor_97a4c655a2cc6bb1)
+}
+
+var fileDescriptor_97a4c655a2cc6bb1 = []byte{
+	// 883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x2b, 0x35,
+	0x14, 0xcd, 0x3c, 0xde, 0xcb, 0x7b, 0xb9, 0x45, 0xa5, 0x35, 0xe9, 0xd7, 0xb4, 0x4d, 0x9b, 0xa1,
+	0x51, 0x4b, 0x51, 0x66, 0x92, 0xb4, 0x40, 0xf9, 0x90, 0x90, 0x8a, 0x90, 0x88, 0x0a, 0x02, 0xd2,
+	0xaa, 0x0b, 0x36, 0x91, 0x93, 0x38, 0x13, 0xab, 0x93, 0x71, 0x1a, 0x3b, 0x21, 0x15, 0x62, 0xc3,
+	0x2f, 0x40, 0x20, 0xb1, 0x65, 0x0f, 0x1b, 0xfe, 0x00, 0xfb, 0x6e, 0x90, 0x2a, 0xb1, 0x61, 0x85,
+	0x50, 0xcb, 0x5f, 0x60, 0x8f, 0xc6, 0xe3, 0x49, 0x32, 0xf9, 0x4e, 0x25, 0x76, 0x19, 0xfb, 0x9e,
+	0x7b, 0xce, 0xb9, 0xb6, 0x4f, 0x0b, 0xa9, 0x1a, 0xa1, 0xf5, 0x0a, 0x76, 0x1c, 0x8b, 0x3b, 0x98,
+	0xd7, 0xa8, 0x6b, 0x5b, 0xed, 0x6c, 0x89, 0x08, 0x9c, 0xb5, 0xae, 0x5b, 0xa4, 0x79, 0x63, 0x36,
+	0x9a, 0x4c, 0x30, 0xb4, 0x11, 0x94, 0x99, 0x41, 0x99, 0xa9, 0xca, 0xf4, 0xfd, 0xf1, 0x1d, 0x6c,
+	0xe2, 0x12, 0x4e, 0xb9, 0xdf, 0x43, 0xdf, 0xb2, 0x19, 0xb3, 0x1d, 0x62, 0xe1, 0x06, 0xb5, 0xb0,
+	0xeb, 0x32, 0x81, 0x05, 0x65, 0x6e, 0xb0, 0x9b, 0xec, 0xb6, 0x29, 0x61, 0x4e, 0x46, 0x89, 0xd0,
+	0xf7, 0x46, 0x97, 0x74, 0x15, 0xf9, 0x55, 0x71, 0x9b, 0xd9, 0x4c, 0xfe, 0xb4, 0xbc, 0x5f, 0xfe,
+	0xaa, 0x11, 0x07, 0xf4, 0x85, 0xd7, 0xea, 0x73, 0xdc, 0xc4, 0x75, 0x5e, 0x20, 0xd7, 0x2d, 0xc2,
+	0x85, 0x71, 0x09, 0xaf, 0x86, 0x56, 0x79, 0x83, 0xb9, 0x9c, 0xa0, 0x0f, 0x20, 0xda, 0x90, 0x2b,
+	0xeb, 0xda, 0xae, 0x76, 0xb0, 0x90, 0x4b, 0x9a, 0x63, 0xed, 0x9b, 0x3e, 0xf4, 0xf4, 0xe9, 0xed,
+	0x5f, 0x3b, 0x91, 0x82, 0x82, 0x19, 0x19, 0x58, 0x93, 0x7d, 0xcf, 0xa9, 0xed, 0x52, 0xd7, 0xce,
+	0xbb, 0x55, 0xa6, 0x28, 0xd1, 0x0a, 0x44, 0xdb, 0xd8, 0x29, 0xd2, 0x8a, 0xec, 0xfd, 0xb4, 0xf0,
+	0xac, 0x8d, 0x9d, 0x7c, 0xc5, 0x68, 0xc0, 0xfa, 0x30, 0x42, 0xc9, 0xb9, 0x80, 0x25, 0x0f, 0xc2,
+	0xfd, 0xad, 0x22, 0x75, 0xab, 0x4c, 0x09, 0xdb, 0xeb, 0x09, 0x13, 0x37, 0x0d, 0xc2, 0xcd, 0x4b,
+	0xec, 0xd0, 0x0a, 0x16, 0xac, 0xd9, 0xd7, 0x47, 0x69, 0x5b, 0x6c, 0x63, 0xa7, 0x6f, 0xd5, 0xc0,
+	0xc3, 0x8c, 0xc1, 0x5c, 0xd0, 0x47, 0x00, 0x0d, 0x6c, 0x53, 0x57, 0x9e, 0x90, 0xe2, 0x4a, 0x0d,
+	0x72, 0xa9, 0xc9, 0x05, 0x65, 0x6a, 0x86, 0x7d, 0x40, 0x83, 0xc3, 0xc6, 0x08, 0x0a, 0xe5, 0xea,
+	0x12, 0x96, 0x07, 0x5d, 0x79, 0xf3, 0x7e, 0x69, 0x4e, 0x5b, 0xaf, 0x84, 0x6d, 0x71, 0x63, 0x0b,
+	0x74, 0x9f, 0x54, 0x9d, 0xd4, 0x69, 0xab, 0x5a, 0x25, 0xcd, 0xe0, 0xc4, 0x9b, 0xb0, 0x39, 0x72,
+	0x57, 0x89, 0x3a, 0x07, 0x24, 0x45, 0xa9, 0xdd, 0x90, 0xaa, 0xd4, 0x78, 0x55, 0xaa, 0x5c, 0x9e,
+	0x9a, 0x77, 0x56, 0xfd, 0x0b, 0xdc, 0x58, 0x83, 0x15, 0xc9, 0x79, 0x41, 0xcb, 0x57, 0x1f, 0xb2,
+	0x96, 0x2b, 0x02, 0x31, 0x6f, 0xc3, 0xea, 0xe0, 0x86, 0xd2, 0xb1, 0x0d, 0x20, 0x68, 0xf9, 0xaa,
+	0x58, 0xf6, 0x56, 0xd5, 0x4d, 0x89, 0x89, 0xa0, 0xcc, 0x30, 0x60, 0x57, 0x02, 0x3f, 0xc1, 0x82,
+	0x70, 0x21, 0xd9, 0xe4, 0x48, 0x6e, 0x04, 0xe9, 0xde, 0xed, 0x4f, 0x21, 0x39, 0xa1, 0x46, 0xf1,
+	0x1c, 0xc0, 0x92, 0xf4, 0x2a, 0x8d, 0x16, 0x4b, 0xde, 0x9e, 0x64, 0x8b, 0x15, 0x16, 0x79, 0x08,
+	0x61, 0xec, 0xc0, 0x76, 0x57, 0x6b, 0xc8, 0x5e, 0xc0, 0xd7, 0x82, 0xc4, 0xb8, 0x82, 0xff, 0x73,
+	0xb8, 0x67, 0xea, 0x09, 0xe7, 0xf9, 0x67, 0x4e, 0xe5, 0xa2, 0x13, 0xdc, 0xe0, 0x35, 0x78, 0x2e,
+	0x3a, 0xc5, 0x1a, 0xe6, 0x35, 0xe5, 0x27, 0x2a, 0x3a, 0x1f, 0x63, 0x5e, 0x43, 0x9b, 0x10, 0x73,
+	0x98, 0xc7, 0x5d, 0x21, 0x9d, 0xf5, 0x27, 0x72, 0xb0, 0x2f, 0x1c, 0x66, 0xe7, 0xbd, 0x6f, 0xc3,
+	0x84, 0x78, 0xb8, 0x99, 0x52, 0xbe, 0x0a, 0x51, 0x2e, 0xb0, 0x68, 0xf9, 0xc3, 0x79, 0x51, 0x50,
+	0x5f, 0xb9, 0x7f, 0x01, 0x9e, 0x49, 0x00, 0xfa, 0x5e, 0x83, 0xa8, 0xff, 0x02, 0x50, 0x7a, 0x42,
+	0x5a, 0x0c, 0x67, 0x90, 0x6e, 0xce, 0x5a, 0xee, 0x6b, 0x31, 0x5e, 0xff, 0xf6, 0x8f, 0x7f, 0x7e,
+	0x78, 0xf2, 0x1a, 0x4a, 0x5a, 0xe3, 0x83, 0xd7, 0x8f, 0x21, 0xf4, 0xab, 0x06, 0x0b, 0x7d, 0x6f,
+	0x03, 0xe5, 0xa6, 0x51, 0x0d, 0xe7, 0x95, 0x7e, 0x34, 0x17, 0x46, 0x69, 0x3c, 0x91, 0x1a, 0x73,
+	0x28, 0x33, 0x41, 0xa3, 0x7a, 0xf8, 0x69, 0xef, 0x16, 0x58, 0x5f, 0xfb, 0x99, 0xf8, 0x0d, 0xfa,
+	0x45, 0x83, 0x97, 0xfb, 0x9f, 0x33, 0x9a, 0x87, 0xbf, 0x3b, 0xd3, 0xe3, 0xf9, 0x40, 0x4a, 0x75,
+	0x46, 0xaa, 0x3e, 0x44, 0x07, 0x33, 0xaa, 0xe6, 0xe8, 0x67, 0x0d, 0x16, 0xc3, 0x49, 0x82, 0xde,
+	0x9c, 0x4a, 0x3d, 0x2a, 0x97, 0xf4, 0xb7, 0xe6, 0x85, 0xcd, 0x71, 0x1b, 0x4a, 0xbe, 0xb2, 0x9f,
+	0x34, 0x88, 0x75, 0x93, 0x06, 0x65, 0xa6, 0x11, 0x0e, 0xa6, 0x95, 0x9e, 0x9d, 0x03, 0xa1, 0xd4,
+	0xa5, 0xa5, 0xba, 0x7d, 0x94, 0x9a, 0xa0, 0xce, 0x4b, 0xb5, 0xb4, 0xcc, 0x39, 0xf4, 0xbb, 0x06,
+	0xf1, 0x51, 0x71, 0x85, 0xde, 0x9b, 0x46, 0x3d, 0x21, 0x08, 0xf5, 0xf7, 0x1f, 0x07, 0x56, 0x16,
+	0xde, 0x95, 0x16, 0x8e, 0x51, 0x6e, 0x82, 0x05, 0x47, 0x36, 0x48, 0xcb, 0x75, 0x79, 0x33, 0xd2,
+	0x32, 0x49, 0xd1, 0x6f, 0x1a, 0x2c, 0x0f, 0xc5, 0x21, 0x3a, 0x99, 0x65, 0x8e, 0xa3, 0x22, 0x56,
+	0x7f, 0xe7, 0x11, 0x48, 0x65, 0xe3, 0x48, 0xda, 0x48, 0xa3, 0x37, 0xa6, 0x9d, 0x44, 0xcf, 0x04,
+	0x47, 0x3f, 0x6a, 0xf0, 0x5c, 0x45, 0x21, 0x9a, 0x1a, 0x53, 0xe1, 0x00, 0xd6, 0xad, 0x99, 0xeb,
+	0x95, 0xc2, 0x43, 0xa9, 0x70, 0x0f, 0x19, 0x13, 0x14, 0x52, 0xce, 0x9c, 0x8a, 0xe8, 0x9c, 0x9e,
+	0xdd, 0xde, 0x27, 0xb4, 0xbb, 0xfb, 0x84, 0xf6, 0xf7, 0x7d, 0x42, 0xfb, 0xee, 0x21, 0x11, 0xb9,
+	0x7b, 0x48, 0x44, 0xfe, 0x7c, 0x48, 0x44, 0xbe, 0xcc, 0xda, 0x54, 0xd4, 0x5a, 0x25, 0xb3, 0xcc,
+	0xea, 0x56, 0x1d, 0x0b, 0x5a, 0x76, 0x89, 0xf8, 0x8a, 0x35, 0xaf, 0x7a, 0x4d, 0x3b, 0xbd, 0xb6,
+	0xf2, 0x2f, 0x4d, 0x29, 0x2a, 0xff, 0x43, 0x3c, 0xfa, 0x6f, 0x00, 0x12, 0x61, 0x7c, 0x4f, 0x0b,
+	0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the slashing parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SigningInfo queries the signing info of given validator id.
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries signing info of all validators.
+	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// SlashingBuffer queries the slashing info accumulated in the buffer.
+	SlashingBuffer(ctx context.Context, in *QuerySlashingBufferRequest, opts ...grpc.CallOption) (*QuerySlashingBufferResponse, error)
+	// TickCount queries the tick count.
+	TickCount(ctx context.Context, in *QueryTickCountRequest, opts ...grpc.CallOption) (*QueryTickCountResponse, error)
+	// LatestSlashInfoBytes queries the rlp encoded slashing info in buffer.
+	LatestSlashInfoBytes(ctx context.Context, in *QueryLatestSlashInfoBytesRequest, opts ...grpc.CallOption) (*QueryLatestSlashInfoBytesResponse, error)
+	// TickSlashingInfos queries the slashing info of current tick.
+	TickSlashingInfos(ctx context.Context, in *QueryTickSlashingInfosRequest, opts ...grpc.CallOption) (*QueryTickSlashingInfosResponse, error)
+	// IsOldTx checks if rootchain tx is already processed.
+	IsOldTx(ctx context.Context, in *QueryIsOldTxRequest, opts ...grpc.CallOption) (*QueryIsOldTxResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.slashing.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
+	out := new(QuerySigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.slashing.v1beta1.Query/SigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error) {
+	out := new(QuerySigningInfosResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.slashing.v1beta1.Query/SigningInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashingBuffer(ctx context.Context, in *QuerySlashingBufferRequest, opts ...grpc.CallOption) (*QuerySlashingBufferResponse, error) {
+	out := new(QuerySlashingBufferResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.slashing.v1beta1.Query/SlashingBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TickCount(ctx context.Context, in *QueryTickCountRequest, opts ...grpc.CallOption) (*QueryTickCountResponse, error) {
+	out := new(QueryTickCountResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.slashing.v1beta1.Query/TickCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestSlashInfoBytes(ctx context.Context, in *QueryLatestSlashInfoBytesRequest, opts ...grpc.CallOption) (*QueryLatestSlashInfoBytesResponse, error) {
+	out := new(QueryLatestSlashInfoBytesResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.slashing.v1beta1.Query/LatestSlashInfoBytes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TickSlashingInfos(ctx context.Context, in *QueryTickSlashingInfosRequest, opts ...grpc.CallOption) (*QueryTickSlashingInfosResponse, error) {
+	out := new(QueryTickSlashingInfosResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.slashing.v1beta1.Query/TickSlashingInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsOldTx(ctx context.Context, in *QueryIsOldTxRequest, opts ...grpc.CallOption) (*QueryIsOldTxResponse, error) {
+	out := new(QueryIsOldTxResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.slashing.v1beta1.Query/IsOldTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the slashing parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SigningInfo queries the signing info of given validator id.
+	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries signing info of all validators.
+	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// SlashingBuffer queries the slashing info accumulated in the buffer.
+	SlashingBuffer(context.Context, *QuerySlashingBufferRequest) (*QuerySlashingBufferResponse, error)
+	// TickCount queries the tick count.
+	TickCount(context.Context, *QueryTickCountRequest) (*QueryTickCountResponse, error)
+	// LatestSlashInfoBytes queries the rlp encoded slashing info in buffer.
+	LatestSlashInfoBytes(context.Context, *QueryLatestSlashInfoBytesRequest) (*QueryLatestSlashInfoBytesResponse, error)
+	// TickSlashingInfos queries the slashing info of current tick.
+	TickSlashingInfos(context.Context, *QueryTickSlashingInfosRequest) (*QueryTickSlashingInfosResponse, error)
+	// IsOldTx checks if rootchain tx is already processed.
+	IsOldTx(context.Context, *QueryIsOldTxRequest) (*QueryIsOldTxResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
+}
+func (*UnimplementedQueryServer) SlashingBuffer(ctx context.Context, req *QuerySlashingBufferRequest) (*QuerySlashingBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingBuffer not implemented")
+}
+func (*UnimplementedQueryServer) TickCount(ctx context.Context, req *QueryTickCountRequest) (*QueryTickCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickCount not implemented")
+}
+func (*UnimplementedQueryServer) LatestSlashInfoBytes(ctx context.Context, req *QueryLatestSlashInfoBytesRequest) (*QueryLatestSlashInfoBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestSlashInfoBytes not implemented")
+}
+func (*UnimplementedQueryServer) TickSlashingInfos(ctx context.Context, req *QueryTickSlashingInfosRequest) (*QueryTickSlashingInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickSlashingInfos not implemented")
+}
+func (*UnimplementedQueryServer) IsOldTx(ctx context.Context, req *QueryIsOldTxRequest) (*QueryIsOldTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsOldTx not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.slashing.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.slashing.v1beta1.Query/SigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfo(ctx, req.(*QuerySigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.slashing.v1beta1.Query/SigningInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningInfos(ctx, req.(*QuerySigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashingBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.slashing.v1beta1.Query/SlashingBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingBuffer(ctx, req.(*QuerySlashingBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TickCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTickCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TickCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.slashing.v1beta1.Query/TickCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickCount(ctx, req.(*QueryTickCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestSlashInfoBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestSlashInfoBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestSlashInfoBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.slashing.v1beta1.Query/LatestSlashInfoBytes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestSlashInfoBytes(ctx, req.(*QueryLatestSlashInfoBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TickSlashingInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTickSlashingInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TickSlashingInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.slashing.v1beta1.Query/TickSlashingInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickSlashingInfos(ctx, req.(*QueryTickSlashingInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsOldTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsOldTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsOldTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.slashing.v1beta1.Query/IsOldTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsOldTx(ctx, req.(*QueryIsOldTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.slashing.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "SigningInfos",
+			Handler:    _Query_SigningInfos_Handler,
+		},
+		{
+			MethodName: "SlashingBuffer",
+			Handler:    _Query_SlashingBuffer_Handler,
+		},
+		{
+			MethodName: "TickCount",
+			Handler:    _Query_TickCount_Handler,
+		},
+		{
+			MethodName: "LatestSlashInfoBytes",
+			Handler:    _Query_LatestSlashInfoBytes_Handler,
+		},
+		{
+			MethodName: "TickSlashingInfos",
+			Handler:    _Query_TickSlashingInfos_Handler,
+		},
+		{
+			MethodName: "IsOldTx",
+			Handler:    _Query_IsOldTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heimdall/slashing/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ValSigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySigningInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySigningInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValSigningInfos) > 0 {
+		for iNdEx := len(m.ValSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingBufferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingBufferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingBufferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingBufferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingBufferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingBufferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValSlashingInfos) > 0 {
+		for iNdEx := len(m.ValSlashingInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValSlashingInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTickCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTickCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTickCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTickCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTickCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTickCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestSlashInfoBytesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestSlashInfoBytesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestSlashInfoBytesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestSlashInfoBytesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestSlashInfoBytesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestSlashInfoBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashInfoBytes) > 0 {
+		i -= len(m.SlashInfoBytes)
+		copy(dAtA[i:], m.SlashInfoBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashInfoBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTickSlashingInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTickSlashingInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTickSlashingInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTickSlashingInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTickSlashingInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTickSlashingInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValSlashingInfos) > 0 {
+		for iNdEx := len(m.ValSlashingInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValSlashingInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsOldTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsOldTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsOldTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsOldTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsOldTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsOldTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValId != 0 {
+		n += 1 + sovQuery(uint64(m.ValId))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValSigningInfos) > 0 {
+		for _, e := range m.ValSigningInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlashingBufferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashingBufferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValSlashingInfos) > 0 {
+		for _, e := range m.ValSlashingInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTickCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTickCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickCount != 0 {
+		n += 1 + sovQuery(uint64(m.TickCount))
+	}
+	return n
+}
+
+func (m *QueryLatestSlashInfoBytesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestSlashInfoBytesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SlashInfoBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTickSlashingInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTickSlashingInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValSlashingInfos) > 0 {
+		for _, e := range m.ValSlashingInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIsOldTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func (m *QueryIsOldTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValId", wireType)
+			}
+			m.ValId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &types.QueryPaginationParams{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValSigningInfos = append(m.ValSigningInfos, types.ValidatorSigningInfo{})
+			if err := m.ValSigningInfos[len(m.ValSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingBufferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingBufferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingBufferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingBufferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingBufferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingBufferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSlashingInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValSlashingInfos = append(m.ValSlashingInfos, &types.ValidatorSlashingInfo{})
+			if err := m.ValSlashingInfos[len(m.ValSlashingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTickCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTickCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTickCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTickCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTickCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTickCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCount", wireType)
+			}
+			m.TickCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestSlashInfoBytesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestSlashInfoBytesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestSlashInfoBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestSlashInfoBytesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestSlashInfoBytesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestSlashInfoBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashInfoBytes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashInfoBytes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTickSlashingInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTickSlashingInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTickSlashingInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTickSlashingInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTickSlashingInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTickSlashingInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSlashingInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValSlashingInfos = append(m.ValSlashingInfos, &types.ValidatorSlashingInfo{})
+			if err := m.ValSlashingInfos[len(m.ValSlashingInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsOldTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsOldTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsOldTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsOldTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsOldTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsOldTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
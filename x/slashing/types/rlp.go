@@ -0,0 +1,26 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/maticnetwork/bor/rlp"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SortAndRLPEncodeSlashInfos sorts slash infos by validator id and rlp encodes them
+// in the format expected by the slash manager contract
+func SortAndRLPEncodeSlashInfos(slashInfos []*hmTypes.ValidatorSlashingInfo) ([]byte, error) {
+	sortedSlashInfos := hmTypes.SortValidatorSlashingInfoByID(slashInfos)
+
+	slashInfoList := make([][]interface{}, 0, len(sortedSlashInfos))
+	for _, slashInfo := range sortedSlashInfos {
+		slashInfoList = append(slashInfoList, []interface{}{
+			slashInfo.ID.Uint64(),
+			new(big.Int).SetUint64(slashInfo.SlashedAmount),
+			slashInfo.IsJailed,
+		})
+	}
+
+	return rlp.EncodeToBytes(slashInfoList)
+}
@@ -19,4 +19,8 @@ const (
 
 	FlagStartEpoch = "start-epoch"
 	FlagEndEpoch   = "end-epoch"
+
+	FlagStatus = "status"
+	FlagPage   = "page"
+	FlagLimit  = "limit"
 )
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/x/staking/types"
 )
 
@@ -28,6 +29,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	stakingQueryCmd.AddCommand(
 		GetValidatorInfoCmd(),
 		GetCurrentValSetCmd(),
+		GetValidatorsCmd(),
+		GetValidatorLifecycleCmd(),
+		GetPendingValidatorUpdatesCmd(),
+		GetSignerKeyHistoryCmd(),
 	)
 
 	return stakingQueryCmd
@@ -96,3 +101,182 @@ func GetCurrentValSetCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetValidatorsCmd Queries validators filtered by lifecycle status
+func GetValidatorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators",
+		Short: "show validators with lifecycle status, filtered by status (active, jailed, exiting, exited, inactive)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			status, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			page, err := cmd.Flags().GetUint64(FlagPage)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Validators(context.Background(), &types.QueryValidatorsRequest{
+				Status:     status,
+				Pagination: &hmTypes.QueryPaginationParams{Page: page, Limit: limit},
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "--status=<active|jailed|exiting|exited|inactive>")
+	cmd.Flags().Uint64(FlagPage, 1, "--page=<page number>")
+	cmd.Flags().Uint64(FlagLimit, 10, "--limit=<number of results per page>")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorLifecycleCmd Queries validator lifecycle via validator id or signer address
+func GetValidatorLifecycleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-lifecycle",
+		Short: "show validator lifecycle status and rootchain event which last updated it via validator id or signer address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			validatorID, err := cmd.Flags().GetUint64(FlagValidatorID)
+			if err != nil {
+				return err
+			}
+
+			signer, err := cmd.Flags().GetString(FlagSignerAddress)
+			if err != nil {
+				return err
+			}
+
+			if validatorID == 0 && signer == "" {
+				return fmt.Errorf("validator ID or signer address required")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var res *types.QueryValidatorLifecycleResponse
+			if validatorID != 0 {
+				res, err = queryClient.ValidatorByID(context.Background(), &types.QueryValidatorByIDRequest{ValidatorId: validatorID})
+			} else {
+				res, err = queryClient.ValidatorBySigner(context.Background(), &types.QueryValidatorBySignerRequest{Signer: signer})
+			}
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Validator)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.Flags().String(FlagSignerAddress, "", "--signer=<signer address here>")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPendingValidatorUpdatesCmd validator updates staged for activation
+func GetPendingValidatorUpdatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-validator-updates",
+		Short: "show validator updates staged for activation, optionally filtered by validator id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			validatorID, err := cmd.Flags().GetUint64(FlagValidatorID)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingValidatorUpdates(context.Background(), &types.QueryPendingValidatorUpdatesRequest{ValidatorId: validatorID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSignerKeyHistoryCmd signer keys used by validator via validator id or any of its signer addresses
+func GetSignerKeyHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-key-history",
+		Short: "show all signer keys used by validator via validator id or any current or past signer address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			validatorID, err := cmd.Flags().GetUint64(FlagValidatorID)
+			if err != nil {
+				return err
+			}
+
+			signer, err := cmd.Flags().GetString(FlagSignerAddress)
+			if err != nil {
+				return err
+			}
+
+			if validatorID == 0 && signer == "" {
+				return fmt.Errorf("validator ID or signer address required")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SignerKeyHistory(context.Background(), &types.QuerySignerKeyHistoryRequest{
+				ValidatorId: validatorID,
+				Signer:      signer,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.Flags().String(FlagSignerAddress, "", "--signer=<signer address here>")
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -11,6 +11,8 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisState) {
+	keeper.SetParams(ctx, genState.Params)
+
 	// get current val set
 	var vals []*hmTypes.Validator
 	if len(genState.CurrentValSet.Validators) == 0 {
@@ -44,14 +46,49 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 	for _, sequence := range genState.StakingSequences {
 		keeper.SetStakingSequence(ctx, sequence)
 	}
+
+	for _, update := range genState.PendingValidatorUpdates {
+		if err := keeper.StageValidatorUpdate(ctx, *update.Validator, update.ActivationEpoch); err != nil {
+			panic(err)
+		}
+	}
+
+	// signer key history is kept in order per validator
+	indices := make(map[uint64]uint64)
+	for _, record := range genState.SignerKeys {
+		if err := keeper.SetSignerKeyRecord(ctx, indices[record.ValidatorId], *record); err != nil {
+			panic(err)
+		}
+		indices[record.ValidatorId]++
+	}
+
+	// record current signer key of validators without history
+	for _, validator := range vals {
+		if _, ok := indices[validator.ID.Uint64()]; ok {
+			continue
+		}
+
+		if err := keeper.AddSignerKey(ctx, *validator); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	// return new genesis state
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		keeper.GetAllValidators(ctx),
 		keeper.GetValidatorSet(ctx),
 		keeper.GetStakingSequences(ctx),
 	)
+	genesis.Params = keeper.GetParams(ctx)
+	genesis.SignerKeys = keeper.GetAllSignerKeys(ctx)
+
+	for _, update := range keeper.GetPendingValidatorUpdates(ctx) {
+		update := update
+		genesis.PendingValidatorUpdates = append(genesis.PendingValidatorUpdates, &update)
+	}
+
+	return genesis
 }
@@ -13,6 +13,7 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/x/staking"
+	stakingSim "github.com/maticnetwork/heimdall/x/staking/simulation"
 	"github.com/maticnetwork/heimdall/x/staking/types"
 
 	"github.com/maticnetwork/heimdall/types/simulation"
@@ -75,3 +76,70 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 	require.NotNil(t, actualParams)
 	require.LessOrEqual(t, 5, len(actualParams.Validators))
 }
+
+// TestInitExportGenesisPendingValidatorUpdates test staged validator updates survive export and import
+func (suite *GenesisTestSuite) TestInitExportGenesisPendingValidatorUpdates() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.StakingKeeper
+
+	validators := stakingSim.GenRandomVal(3, 1, 10, 0, false, 1)
+	valSet := make([]*hmTypes.Validator, len(validators))
+	for i := range validators {
+		valSet[i] = &validators[i]
+	}
+	staking.InitGenesis(ctx, keeper, *types.NewGenesisState(valSet, hmTypes.NewValidatorSet(valSet), nil))
+
+	// validator joining at epoch 3 and validator exiting at epoch 5
+	joining := stakingSim.GenRandomVal(1, 3, 10, 0, false, 10)[0]
+	joining.EndEpoch = 0
+	require.NoError(t, keeper.AddValidator(ctx, joining))
+	require.NoError(t, keeper.StageValidatorUpdate(ctx, joining, 3))
+
+	exiting := validators[0]
+	exiting.EndEpoch = 5
+	require.NoError(t, keeper.AddValidator(ctx, exiting))
+	require.NoError(t, keeper.StageValidatorUpdate(ctx, exiting, 5))
+
+	exported := staking.ExportGenesis(ctx, keeper)
+	require.NoError(t, types.ValidateGenesis(*exported))
+	require.Len(t, exported.PendingValidatorUpdates, 2)
+
+	// import exported state in fresh app
+	importApp, importCtx, _ := test_helper.CreateTestApp(true)
+	staking.InitGenesis(importCtx, importApp.StakingKeeper, *exported)
+
+	require.Equal(t, keeper.GetPendingValidatorUpdates(ctx), importApp.StakingKeeper.GetPendingValidatorUpdates(importCtx))
+	require.Equal(t, exported.PendingValidatorUpdates, staking.ExportGenesis(importCtx, importApp.StakingKeeper).PendingValidatorUpdates)
+}
+
+// TestValidateGenesisSignerKeys test signer key history validation
+func (suite *GenesisTestSuite) TestValidateGenesisSignerKeys() {
+	t := suite.T()
+
+	signer := hmCommonTypes.HexToHeimdallAddress("0x01").String()
+	record := func(valID uint64, startHeight int64, endHeight int64) *types.SignerKeyRecord {
+		return &types.SignerKeyRecord{ValidatorId: valID, Signer: signer, StartHeight: startHeight, EndHeight: endHeight}
+	}
+
+	testCases := []struct {
+		name       string
+		signerKeys []*types.SignerKeyRecord
+		valid      bool
+	}{
+		{"rotated signer keys", []*types.SignerKeyRecord{record(1, 0, 10), record(1, 10, 20), record(1, 20, 0), record(2, 5, 0)}, true},
+		{"zero validator id", []*types.SignerKeyRecord{record(0, 0, 0)}, false},
+		{"multiple current signer keys", []*types.SignerKeyRecord{record(1, 0, 0), record(1, 10, 0)}, false},
+		{"unordered start heights", []*types.SignerKeyRecord{record(1, 10, 20), record(1, 5, 0)}, false},
+	}
+
+	for _, tc := range testCases {
+		genesisState := types.DefaultGenesis()
+		genesisState.SignerKeys = tc.signerKeys
+
+		if tc.valid {
+			require.NoError(t, types.ValidateGenesis(*genesisState), tc.name)
+		} else {
+			require.Error(t, types.ValidateGenesis(*genesisState), tc.name)
+		}
+	}
+}
@@ -120,3 +120,187 @@ func (k Querier) QueryProposer(c context.Context, req *types.QueryProposerReques
 		Proposers: proposers,
 	}, nil
 }
+
+// Params queries params of staking module
+func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// ValidatorSetAtHeight queries validator set snapshot in effect at heimdall height
+func (k Querier) ValidatorSetAtHeight(c context.Context, req *types.QueryValidatorSetAtHeightRequest) (*types.QueryValidatorSetAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Height < 0 || req.Height > ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height %v, current height is %v", req.Height, ctx.BlockHeight())
+	}
+
+	snapshotHeight, validatorSet, err := k.GetValidatorSetAtHeight(ctx, req.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no validator set snapshot found for height %v", req.Height)
+	}
+
+	return &types.QueryValidatorSetAtHeightResponse{
+		SnapshotHeight: snapshotHeight,
+		ValidatorSet:   validatorSet,
+	}, nil
+}
+
+// ValidatorSetForCheckpoint queries validator set snapshot referenced by acked checkpoint
+func (k Querier) ValidatorSetForCheckpoint(c context.Context, req *types.QueryValidatorSetForCheckpointRequest) (*types.QueryValidatorSetForCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	snapshotHeight, validatorSet, err := k.GetValidatorSetForCheckpoint(ctx, req.Number)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no validator set found for checkpoint %v: %v", req.Number, err)
+	}
+
+	return &types.QueryValidatorSetForCheckpointResponse{
+		CheckpointNumber: req.Number,
+		SnapshotHeight:   snapshotHeight,
+		ValidatorSet:     validatorSet,
+	}, nil
+}
+
+// Validators queries validators filtered by lifecycle status
+func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest) (*types.QueryValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Pagination == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty pagination limit, page params")
+	}
+
+	if err := types.ValidateValidatorStatus(req.Status); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ackCount := k.ModuleCommunicator.GetACKCount(ctx)
+
+	validators, total := k.GetValidatorList(ctx, req.Status, req.Pagination.Page, req.Pagination.Limit)
+
+	res := &types.QueryValidatorsResponse{Total: total}
+	for _, validator := range validators {
+		lifecycle := types.NewValidatorLifecycle(validator, ackCount, k.lastUpdatedTxHash(ctx, validator))
+		res.Validators = append(res.Validators, &lifecycle)
+	}
+
+	return res, nil
+}
+
+// ValidatorByID queries validator lifecycle for given validator id
+func (k Querier) ValidatorByID(c context.Context, req *types.QueryValidatorByIDRequest) (*types.QueryValidatorLifecycleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "validator ID cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidatorFromValID(ctx, hmTypes.NewValidatorID(req.ValidatorId))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %d not found", req.ValidatorId)
+	}
+
+	lifecycle := types.NewValidatorLifecycle(validator, k.ModuleCommunicator.GetACKCount(ctx), k.lastUpdatedTxHash(ctx, validator))
+
+	return &types.QueryValidatorLifecycleResponse{Validator: &lifecycle}, nil
+}
+
+// ValidatorBySigner queries validator lifecycle for given signer address
+func (k Querier) ValidatorBySigner(c context.Context, req *types.QueryValidatorBySignerRequest) (*types.QueryValidatorLifecycleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.Signer) {
+		return nil, status.Error(codes.InvalidArgument, "invalid signer address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, err := k.GetValidatorInfo(ctx, common.HexToAddress(req.Signer).Bytes())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator with signer %s not found", req.Signer)
+	}
+
+	lifecycle := types.NewValidatorLifecycle(validator, k.ModuleCommunicator.GetACKCount(ctx), k.lastUpdatedTxHash(ctx, validator))
+
+	return &types.QueryValidatorLifecycleResponse{Validator: &lifecycle}, nil
+}
+
+// lastUpdatedTxHash returns rootchain tx hash of staking event which last updated validator, empty if not known
+func (k Querier) lastUpdatedTxHash(ctx sdk.Context, validator hmTypes.Validator) string {
+	if txHash, ok := k.GetStakingSequenceTxHash(ctx, validator.LastUpdated); ok {
+		return txHash.String()
+	}
+
+	return ""
+}
+
+// PendingValidatorUpdates queries validator updates staged for activation
+func (k Querier) PendingValidatorUpdates(c context.Context, req *types.QueryPendingValidatorUpdatesRequest) (*types.QueryPendingValidatorUpdatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryPendingValidatorUpdatesResponse{}
+	for _, update := range k.GetPendingValidatorUpdates(ctx) {
+		if req.ValidatorId != 0 && update.Validator.ID.Uint64() != req.ValidatorId {
+			continue
+		}
+
+		update := update
+		res.Updates = append(res.Updates, &update)
+	}
+
+	return res, nil
+}
+
+// SignerKeyHistory queries all signer keys used by validator with validator id or signer address
+func (k Querier) SignerKeyHistory(c context.Context, req *types.QuerySignerKeyHistoryRequest) (*types.QuerySignerKeyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validatorID := hmTypes.NewValidatorID(req.ValidatorId)
+	if req.ValidatorId == 0 {
+		if !common.IsHexAddress(req.Signer) {
+			return nil, status.Error(codes.InvalidArgument, "validator ID or signer address required")
+		}
+
+		// past signers keep their validator record with same validator ID
+		validator, err := k.GetValidatorInfo(ctx, common.HexToAddress(req.Signer).Bytes())
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "validator with signer %s not found", req.Signer)
+		}
+		validatorID = validator.ID
+	}
+
+	res := &types.QuerySignerKeyHistoryResponse{}
+	for _, record := range k.GetSignerKeyHistory(ctx, validatorID) {
+		record := record
+		res.SignerKeys = append(res.SignerKeys, &record)
+	}
+
+	if len(res.SignerKeys) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no signer keys found for validator %d", validatorID.Uint64())
+	}
+
+	return res, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethTypes "github.com/maticnetwork/bor/core/types"
+	hmTypes2 "github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/types/simulation"
 	checkPointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
@@ -104,3 +105,168 @@ func (suite *KeeperTestSuite) TestQueryIsOlxTxStaking() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryValidatorSetSnapshots() {
+	t, app := suite.T(), suite.app
+	ctx := suite.ctx.WithBlockHeight(10)
+
+	k := keeper.Querier{
+		Keeper: app.StakingKeeper,
+	}
+
+	checkPointSim.LoadValidatorSet(4, t, k.Keeper, ctx, false, 10)
+	k.SetCheckpointValidatorSet(ctx, 1)
+
+	ctx = ctx.WithBlockHeight(20)
+	res, err := k.ValidatorSetAtHeight(sdk.WrapSDKContext(ctx), &types.QueryValidatorSetAtHeightRequest{Height: 12})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.SnapshotHeight)
+	require.Equal(t, app.StakingKeeper.GetValidatorSet(ctx), res.ValidatorSet)
+
+	_, err = k.ValidatorSetAtHeight(sdk.WrapSDKContext(ctx), &types.QueryValidatorSetAtHeightRequest{Height: 21})
+	require.Error(t, err)
+
+	checkpointRes, err := k.ValidatorSetForCheckpoint(sdk.WrapSDKContext(ctx), &types.QueryValidatorSetForCheckpointRequest{Number: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), checkpointRes.CheckpointNumber)
+	require.Equal(t, int64(10), checkpointRes.SnapshotHeight)
+
+	_, err = k.ValidatorSetForCheckpoint(sdk.WrapSDKContext(ctx), &types.QueryValidatorSetForCheckpointRequest{Number: 2})
+	require.Error(t, err)
+
+	paramsRes, err := k.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), paramsRes.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryValidators() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	k := keeper.Querier{
+		Keeper: app.StakingKeeper,
+	}
+
+	valSet := checkPointSim.LoadValidatorSet(4, t, k.Keeper, ctx, false, 0)
+
+	// current epoch is 1 before first checkpoint ack
+	updates := []func(validator *hmTypes2.Validator){
+		func(validator *hmTypes2.Validator) { validator.Jailed = true },
+		func(validator *hmTypes2.Validator) { validator.EndEpoch = 10 },
+		func(validator *hmTypes2.Validator) { validator.EndEpoch = 1 },
+		func(validator *hmTypes2.Validator) { validator.LastUpdated = "1200005" },
+	}
+	for i, update := range updates {
+		validator := *valSet.Validators[i]
+		update(&validator)
+		require.NoError(t, app.StakingKeeper.AddValidator(ctx, validator))
+	}
+
+	for status, signer := range map[string]string{
+		types.ValidatorStatusJailed:  valSet.Validators[0].Signer,
+		types.ValidatorStatusExiting: valSet.Validators[1].Signer,
+		types.ValidatorStatusExited:  valSet.Validators[2].Signer,
+		types.ValidatorStatusActive:  valSet.Validators[3].Signer,
+	} {
+		res, err := k.Validators(sdk.WrapSDKContext(ctx), &types.QueryValidatorsRequest{
+			Status:     status,
+			Pagination: &hmTypes2.QueryPaginationParams{Page: 1, Limit: 10},
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), res.Total, status)
+		require.Len(t, res.Validators, 1)
+		require.Equal(t, status, res.Validators[0].Status)
+		require.Equal(t, signer, res.Validators[0].Validator.Signer)
+	}
+
+	// pagination over all validators
+	var signers []string
+	for page := uint64(1); page <= 3; page++ {
+		res, err := k.Validators(sdk.WrapSDKContext(ctx), &types.QueryValidatorsRequest{
+			Pagination: &hmTypes2.QueryPaginationParams{Page: page, Limit: 3},
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), res.Total)
+		for _, validator := range res.Validators {
+			signers = append(signers, validator.Validator.Signer)
+		}
+	}
+	require.Len(t, signers, 4)
+
+	_, err := k.Validators(sdk.WrapSDKContext(ctx), &types.QueryValidatorsRequest{Status: "unknown", Pagination: &hmTypes2.QueryPaginationParams{Page: 1, Limit: 3}})
+	require.Error(t, err)
+
+	// lookup by validator id and signer
+	res, err := k.ValidatorByID(sdk.WrapSDKContext(ctx), &types.QueryValidatorByIDRequest{ValidatorId: valSet.Validators[3].ID.Uint64()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), res.Validator.LastUpdatedBlockNumber)
+	require.Equal(t, uint64(5), res.Validator.LastUpdatedLogIndex)
+	require.Empty(t, res.Validator.LastUpdatedTxHash)
+
+	txHash := hmTypes.HexToHeimdallHash("0x1234")
+	app.StakingKeeper.SetStakingSequenceTxHash(ctx, "1200005", txHash)
+	res, err = k.ValidatorByID(sdk.WrapSDKContext(ctx), &types.QueryValidatorByIDRequest{ValidatorId: valSet.Validators[3].ID.Uint64()})
+	require.NoError(t, err)
+	require.Equal(t, txHash.String(), res.Validator.LastUpdatedTxHash)
+
+	res, err = k.ValidatorBySigner(sdk.WrapSDKContext(ctx), &types.QueryValidatorBySignerRequest{Signer: valSet.Validators[0].Signer})
+	require.NoError(t, err)
+	require.Equal(t, valSet.Validators[0].ID, res.Validator.Validator.ID)
+	require.Equal(t, types.ValidatorStatusJailed, res.Validator.Status)
+
+	_, err = k.ValidatorBySigner(sdk.WrapSDKContext(ctx), &types.QueryValidatorBySignerRequest{Signer: "0x0000000000000000000000000000000000000001"})
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingValidatorUpdates() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	k := keeper.Querier{
+		Keeper: app.StakingKeeper,
+	}
+
+	valSet := checkPointSim.LoadValidatorSet(2, t, k.Keeper, ctx, false, 0)
+
+	for i, validator := range valSet.Validators {
+		require.NoError(t, k.StageValidatorUpdate(ctx, *validator, uint64(5+i)))
+	}
+
+	res, err := k.PendingValidatorUpdates(sdk.WrapSDKContext(ctx), &types.QueryPendingValidatorUpdatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Updates, 2)
+
+	res, err = k.PendingValidatorUpdates(sdk.WrapSDKContext(ctx), &types.QueryPendingValidatorUpdatesRequest{ValidatorId: valSet.Validators[1].ID.Uint64()})
+	require.NoError(t, err)
+	require.Len(t, res.Updates, 1)
+	require.Equal(t, uint64(6), res.Updates[0].ActivationEpoch)
+	require.Equal(t, valSet.Validators[1].Signer, res.Updates[0].Validator.Signer)
+}
+
+func (suite *KeeperTestSuite) TestQuerySignerKeyHistory() {
+	t, app, ctx := suite.T(), suite.app, suite.ctx
+
+	k := keeper.Querier{
+		Keeper: app.StakingKeeper,
+	}
+
+	validator := *checkPointSim.LoadValidatorSet(1, t, k.Keeper, ctx, false, 0).Validators[0]
+
+	_, err := k.SignerKeyHistory(sdk.WrapSDKContext(ctx), &types.QuerySignerKeyHistoryRequest{ValidatorId: validator.ID.Uint64()})
+	require.Error(t, err, "validator without recorded signer keys")
+
+	rotateSigner(t, k.Keeper, ctx, validator)
+	_, err = k.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	res, err := k.SignerKeyHistory(sdk.WrapSDKContext(ctx), &types.QuerySignerKeyHistoryRequest{ValidatorId: validator.ID.Uint64()})
+	require.NoError(t, err)
+	require.Len(t, res.SignerKeys, 2)
+
+	// past signer resolves to same history
+	res, err = k.SignerKeyHistory(sdk.WrapSDKContext(ctx), &types.QuerySignerKeyHistoryRequest{Signer: validator.Signer})
+	require.NoError(t, err)
+	require.Len(t, res.SignerKeys, 2)
+	require.Equal(t, validator.GetSigner().String(), res.SignerKeys[0].Signer)
+
+	_, err = k.SignerKeyHistory(sdk.WrapSDKContext(ctx), &types.QuerySignerKeyHistoryRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/staking/types"
+)
+
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-power", TotalPowerInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-signer-map", ValidatorSignerMapInvariant(k))
+}
+
+// TotalPowerInvariant checks that total voting power of validator set equals sum of power of active validators
+func TotalPowerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		validatorSet := k.GetValidatorSet(ctx)
+
+		var activePower int64
+		for _, validator := range k.GetCurrentValidators(ctx) {
+			activePower += validator.VotingPower
+		}
+
+		var setPower int64
+		for _, validator := range validatorSet.Validators {
+			setPower += validator.VotingPower
+		}
+
+		broken := validatorSet.TotalVotingPower != activePower || setPower != activePower
+
+		return sdk.FormatInvariant(types.ModuleName, "total-power", fmt.Sprintf(
+			"\tvalidator set total power: %d\n\tvalidator set power sum: %d\n\tactive validators power sum: %d\n",
+			validatorSet.TotalVotingPower, setPower, activePower,
+		)), broken
+	}
+}
+
+// ValidatorSignerMapInvariant checks that every validator ID maps to exactly one signer holding that ID.
+// Validators left behind under previous signer on signer update have no power.
+func ValidatorSignerMapInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		store := ctx.KVStore(k.storeKey)
+
+		// validator ID => signer map
+		var mapped []hmTypes.ValidatorID
+		signers := make(map[hmTypes.ValidatorID][]byte)
+		ids := make(map[string]hmTypes.ValidatorID)
+
+		iterator := sdk.KVStorePrefixIterator(store, ValidatorMapKey)
+		for ; iterator.Valid(); iterator.Next() {
+			idStr := string(iterator.Key()[len(ValidatorMapKey):])
+			signer := iterator.Value()
+
+			parsed, err := strconv.ParseUint(idStr, 10, 64)
+			if err != nil {
+				msg += fmt.Sprintf("\tinvalid validator id %q in signer map\n", idStr)
+				broken = true
+				continue
+			}
+			id := hmTypes.NewValidatorID(parsed)
+
+			if other, ok := ids[string(signer)]; ok {
+				msg += fmt.Sprintf("\tsigner %X is mapped to validators %d and %d\n", signer, other, id)
+				broken = true
+			}
+			ids[string(signer)] = id
+			signers[id] = signer
+			mapped = append(mapped, id)
+		}
+		iterator.Close()
+
+		// mapped signers hold their validator ID
+		for _, id := range mapped {
+			signer := signers[id]
+			validator, err := k.GetValidatorInfo(ctx, signer)
+			if err != nil {
+				msg += fmt.Sprintf("\tvalidator %d is mapped to unknown signer %X\n", id, signer)
+				broken = true
+			} else if validator.ID != id {
+				msg += fmt.Sprintf("\tvalidator %d is mapped to signer %X of validator %d\n", id, signer, validator.ID)
+				broken = true
+			}
+		}
+
+		// validators with power are mapped from their ID
+		k.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
+			signer, ok := signers[validator.ID]
+			if !ok {
+				msg += fmt.Sprintf("\tvalidator %d has no signer mapped\n", validator.ID)
+				broken = true
+			} else if validator.VotingPower > 0 && !bytes.Equal(signer, validator.GetSigner().Bytes()) {
+				msg += fmt.Sprintf("\tvalidator %d has power under unmapped signer %s\n", validator.ID, validator.Signer)
+				broken = true
+			}
+			return nil
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "validator-signer-map", msg), broken
+	}
+}
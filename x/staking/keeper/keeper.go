@@ -26,8 +26,19 @@ var (
 	ValidatorMapKey        = []byte{0x22} // prefix for each key for validator map
 	CurrentValidatorSetKey = []byte{0x23} // Key to store current validator set
 	StakingSequenceKey     = []byte{0x24} // prefix for each key for staking sequence map
+
+	ValidatorSetSnapshotKey   = []byte{0x25} // prefix for each key to a validator set snapshot by height
+	CheckpointValidatorSetKey = []byte{0x26} // prefix for each key to a checkpoint's validator set snapshot height
+	PendingValidatorUpdateKey = []byte{0x27} // prefix for each key to a validator update staged by activation epoch
+	SignerKeyHistoryKey       = []byte{0x28} // prefix for each key to a validator's signer key record
+
+	BufferedCheckpointValidatorSetKey = []byte{0x29} // key to store buffered checkpoint's validator set snapshot height
+	StakingSequenceTxHashKey          = []byte{0x2a} // prefix for each key to rootchain tx hash of staking sequence
 )
 
+// MaxValidatorsPerPage caps number of validators returned in single page
+const MaxValidatorsPerPage = 100
+
 // ModuleCommunicator manages different module interaction
 type ModuleCommunicator interface {
 	GetACKCount(ctx sdk.Context) uint64
@@ -224,6 +235,37 @@ func (k *Keeper) GetAllValidators(ctx sdk.Context) (validators []*hmTypes.Valida
 	return
 }
 
+// GetValidatorList returns page of validators with lifecycle status, empty status matches all,
+// along with total number of validators matching status
+func (k *Keeper) GetValidatorList(ctx sdk.Context, status string, page uint64, limit uint64) (validators []hmTypes.Validator, total uint64) {
+	// have max limit
+	if limit > MaxValidatorsPerPage {
+		limit = MaxValidatorsPerPage
+	}
+
+	if page == 0 {
+		return nil, 0
+	}
+
+	ackCount := k.ModuleCommunicator.GetACKCount(ctx)
+	skip := (page - 1) * limit
+
+	k.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
+		if status != "" && types.GetValidatorStatus(validator, ackCount) != status {
+			return nil
+		}
+
+		if total >= skip && uint64(len(validators)) < limit {
+			validators = append(validators, validator)
+		}
+		total++
+
+		return nil
+	})
+
+	return
+}
+
 // IterateValidatorsAndApplyFn interate validators and apply the given function.
 func (k *Keeper) IterateValidatorsAndApplyFn(ctx sdk.Context, f func(validator hmTypes.Validator) error) {
 	store := ctx.KVStore(k.storeKey)
@@ -243,7 +285,8 @@ func (k *Keeper) IterateValidatorsAndApplyFn(ctx sdk.Context, f func(validator h
 	}
 }
 
-// UpdateSigner updates validator with signer and pubkey + validator => signer map
+// UpdateSigner updates validator with signer and pubkey + validator => signer map.
+// Validator set is not updated, signer swap is staged by signer update post handler.
 func (k *Keeper) UpdateSigner(ctx sdk.Context, newSigner sdk.AccAddress, newPubkey hmCommon.PubKey, prevSigner sdk.AccAddress) error {
 	// get old validator from state and make power 0
 	validator, err := k.GetValidatorInfo(ctx, prevSigner.Bytes())
@@ -286,6 +329,9 @@ func (k *Keeper) UpdateValidatorSetInStore(ctx sdk.Context, newValidatorSet *hmT
 
 	// set validator set with CurrentValidatorSetKey as key in store
 	store.Set(CurrentValidatorSetKey, bz)
+
+	// snapshot changed validator set at current height
+	k.snapshotValidatorSet(ctx, bz)
 	return nil
 }
 
@@ -405,6 +451,29 @@ func (k *Keeper) SetStakingSequence(ctx sdk.Context, sequence string) {
 	store.Set(GetStakingSequenceKey(sequence), DefaultValue)
 }
 
+// GetStakingSequenceTxHashKey appends prefix to staking sequence
+func GetStakingSequenceTxHashKey(sequence string) []byte {
+	return append(StakingSequenceTxHashKey, []byte(sequence)...)
+}
+
+// SetStakingSequenceTxHash sets rootchain tx hash of staking event at sequence
+func (k *Keeper) SetStakingSequenceTxHash(ctx sdk.Context, sequence string, txHash hmCommon.HeimdallHash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetStakingSequenceTxHashKey(sequence), txHash.Bytes())
+}
+
+// GetStakingSequenceTxHash returns rootchain tx hash of staking event at sequence,
+// not known for events processed before tx hashes were recorded
+func (k *Keeper) GetStakingSequenceTxHash(ctx sdk.Context, sequence string) (hmCommon.HeimdallHash, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetStakingSequenceTxHashKey(sequence))
+	if bz == nil {
+		return hmCommon.HeimdallHash{}, false
+	}
+
+	return hmCommon.BytesToHeimdallHash(bz), true
+}
+
 // HasStakingSequence checks if staking sequence already exists
 func (k *Keeper) HasStakingSequence(ctx sdk.Context, sequence string) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -474,7 +543,9 @@ func (k *Keeper) Slash(ctx sdk.Context, valSlashingInfo hmTypes.ValidatorSlashin
 		return err
 	}
 	k.Logger(ctx).Debug("updated validator with slashed voting power and jail status", "validator", validator)
-	return nil
+
+	// slashing applies to validator set in current epoch
+	return k.StageValidatorUpdate(ctx, validator, k.ModuleCommunicator.GetACKCount(ctx)+1)
 }
 
 // Unjail a validator
@@ -496,9 +567,30 @@ func (k *Keeper) Unjail(ctx sdk.Context, valID hmTypes.ValidatorID) {
 	// add updated validator to store with new key
 	if err := k.AddValidator(ctx, validator); err != nil {
 		k.Logger(ctx).Error("Error calling AddValidator")
+		return
+	}
+
+	// unjailing applies to validator set in current epoch
+	if err := k.StageValidatorUpdate(ctx, validator, k.ModuleCommunicator.GetACKCount(ctx)+1); err != nil {
+		k.Logger(ctx).Error("Unjail | StageValidatorUpdate", "error", err)
 	}
 }
 
+// SetParams sets the staking module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the staking module's parameters, params missing in store keep default values
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSubspace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return
+}
+
 // BondDenom - Bondable coin denomination
 func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	k.paramSubspace.Get(ctx, types.KeyBondDenom, &res)
@@ -25,6 +25,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/types/simulation"
 	checkPointSim "github.com/maticnetwork/heimdall/x/checkpoint/simulation"
+	stakingKeeper "github.com/maticnetwork/heimdall/x/staking/keeper"
 	stakingSim "github.com/maticnetwork/heimdall/x/staking/simulation"
 )
 
@@ -157,6 +158,9 @@ func (suite *KeeperTestSuite) TestUpdateSigner() {
 		t.Error("Error while updating Signer Address -", err)
 	}
 
+	// signer swap is staged by signer update post handler only
+	require.Empty(t, initApp.StakingKeeper.GetPendingValidatorUpdates(ctx))
+
 	// Check Validator Info of Prev Signer
 	prevSginerValInfo, err := initApp.StakingKeeper.GetValidatorInfo(ctx, singerAddress)
 	if err != nil {
@@ -407,3 +411,293 @@ func (suite *KeeperTestSuite) TestGetSpanEligibleValidators() {
 	validators := keeper.GetSpanEligibleValidators(ctx)
 	require.LessOrEqual(t, len(validators), 4)
 }
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.StakingKeeper
+
+	invariants := app.InvariantRegistry{}
+	stakingKeeper.RegisterInvariants(&invariants, keeper)
+
+	valSet := checkPointSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	require.Empty(t, invariants.CheckInvariants(ctx))
+
+	// previous signer is left without power on signer update
+	newPrivKey := secp256k1.GenPrivKey()
+	newSigner := sdk.AccAddress(newPrivKey.PubKey().Address().Bytes())
+	prevSigner, err := sdk.AccAddressFromHex(valSet.Validators[0].Signer)
+	require.NoError(t, err)
+	require.NoError(t, keeper.UpdateSigner(ctx, newSigner, hmCommonTypes.NewPubKey(newPrivKey.PubKey().Bytes()), prevSigner))
+	require.Empty(t, invariants.CheckInvariants(ctx))
+
+	// validator ID mapped to signer of another validator
+	otherSigner, err := sdk.AccAddressFromHex(valSet.Validators[1].Signer)
+	require.NoError(t, err)
+	keeper.SetValidatorIDToSignerAddr(ctx, valSet.Validators[2].ID, otherSigner)
+	msg, broken := stakingKeeper.ValidatorSignerMapInvariant(keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "is mapped to validators")
+
+	// validator power changed without validator set update
+	validator, err := keeper.GetValidatorInfo(ctx, otherSigner)
+	require.NoError(t, err)
+	validator.VotingPower++
+	require.NoError(t, keeper.AddValidator(ctx, validator))
+	_, broken = stakingKeeper.TotalPowerInvariant(keeper)(ctx)
+	require.True(t, broken)
+}
+
+func (suite *KeeperTestSuite) TestValidatorSetSnapshots() {
+	t, initApp := suite.T(), suite.app
+	keeper := initApp.StakingKeeper
+
+	ctx := suite.ctx.WithBlockHeight(10)
+	checkPointSim.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	setAt10 := keeper.GetValidatorSet(ctx)
+
+	// validator set changed
+	ctx = ctx.WithBlockHeight(20)
+	changedSet := keeper.GetValidatorSet(ctx)
+	changedSet.Validators[0].VotingPower++
+	changedSet.TotalVotingPower++
+	require.NoError(t, keeper.UpdateValidatorSetInStore(ctx, changedSet))
+
+	// unchanged validator set is not snapshotted again
+	ctx = ctx.WithBlockHeight(30)
+	require.NoError(t, keeper.UpdateValidatorSetInStore(ctx, keeper.GetValidatorSet(ctx)))
+
+	height, validatorSet, err := keeper.GetValidatorSetAtHeight(ctx, 15)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+	require.Equal(t, setAt10, validatorSet)
+
+	height, validatorSet, err = keeper.GetValidatorSetAtHeight(ctx, 30)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), height)
+	require.Equal(t, changedSet, validatorSet)
+
+	_, _, err = keeper.GetValidatorSetAtHeight(ctx, 5)
+	require.Error(t, err)
+
+	// checkpoint buffered at height 15 references snapshot in effect then, though acked after validator set changed
+	keeper.SetBufferedCheckpointValidatorSet(ctx, 15)
+	keeper.SetCheckpointValidatorSet(ctx, 1)
+	height, validatorSet, err = keeper.GetValidatorSetForCheckpoint(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+	require.Equal(t, setAt10, validatorSet)
+
+	_, _, err = keeper.GetValidatorSetForCheckpoint(ctx, 2)
+	require.Error(t, err)
+
+	// snapshot in effect at retention boundary is kept
+	params := keeper.GetParams(ctx)
+	params.SnapshotRetention = 5
+	keeper.SetParams(ctx, params)
+
+	keeper.SetBufferedCheckpointValidatorSet(ctx, 30)
+
+	ctx = ctx.WithBlockHeight(40)
+	keeper.IncrementAccum(ctx, 1)
+
+	_, _, err = keeper.GetValidatorSetAtHeight(ctx, 15)
+	require.Error(t, err)
+	height, _, err = keeper.GetValidatorSetAtHeight(ctx, 35)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), height)
+	_, _, err = keeper.GetValidatorSetForCheckpoint(ctx, 1)
+	require.Error(t, err)
+
+	// snapshot of buffered checkpoint is kept past retention until ack
+	ctx = ctx.WithBlockHeight(60)
+	keeper.IncrementAccum(ctx, 1)
+
+	height, _, err = keeper.GetValidatorSetAtHeight(ctx, 30)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), height)
+
+	keeper.SetCheckpointValidatorSet(ctx, 2)
+	height, validatorSet, err = keeper.GetValidatorSetForCheckpoint(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), height)
+	require.Equal(t, changedSet, validatorSet)
+
+	ctx = ctx.WithBlockHeight(70)
+	keeper.IncrementAccum(ctx, 1)
+
+	height, _, err = keeper.GetValidatorSetAtHeight(ctx, 65)
+	require.NoError(t, err)
+	require.Equal(t, int64(60), height)
+	_, _, err = keeper.GetValidatorSetForCheckpoint(ctx, 2)
+	require.Error(t, err)
+}
+
+func (suite *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdates() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.StakingKeeper
+
+	checkPointSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	initValSet := keeper.GetValidatorSet(ctx)
+
+	// nothing staged
+	updates, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// stake update in current epoch
+	validator := *initValSet.Validators[0]
+	validator.VotingPower += 5
+	require.NoError(t, keeper.AddValidator(ctx, validator))
+	require.NoError(t, keeper.StageValidatorUpdate(ctx, validator, 1))
+
+	// validator joining at epoch 3
+	newValidator := stakingSim.GenRandomVal(1, 3, 10, 0, false, 10)[0]
+	newValidator.EndEpoch = 0
+	require.NoError(t, keeper.AddValidator(ctx, newValidator))
+	require.NoError(t, keeper.StageValidatorUpdate(ctx, newValidator, 3))
+
+	pending := keeper.GetPendingValidatorUpdates(ctx)
+	require.Len(t, pending, 2)
+	require.Equal(t, uint64(1), pending[0].ActivationEpoch)
+	require.Equal(t, uint64(3), pending[1].ActivationEpoch)
+
+	updates, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, validator.VotingPower, updates[0].Power)
+	require.Equal(t, initValSet.GetTotalVotingPower()+5, keeper.GetValidatorSet(ctx).GetTotalVotingPower())
+	require.Len(t, keeper.GetPendingValidatorUpdates(ctx), 1)
+
+	// join is not applied before activation epoch
+	updates, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	require.False(t, keeper.GetValidatorSet(ctx).HasAddress(newValidator.GetSigner().Bytes()))
+
+	// current epoch reaches 3 after second checkpoint ack
+	initApp.CheckpointKeeper.UpdateACKCountWithValue(ctx, 2)
+
+	updates, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, newValidator.VotingPower, updates[0].Power)
+	require.True(t, keeper.GetValidatorSet(ctx).HasAddress(newValidator.GetSigner().Bytes()))
+	require.Empty(t, keeper.GetPendingValidatorUpdates(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMigratePendingValidatorUpdates() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.StakingKeeper
+
+	checkPointSim.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	initValSet := keeper.GetValidatorSet(ctx)
+
+	// validator in set exiting at epoch 5
+	exiting := *initValSet.Validators[0]
+	exiting.EndEpoch = 5
+	require.NoError(t, keeper.AddValidator(ctx, exiting))
+
+	// validator joining at epoch 3
+	joining := stakingSim.GenRandomVal(1, 3, 10, 0, false, 10)[0]
+	joining.EndEpoch = 0
+	require.NoError(t, keeper.AddValidator(ctx, joining))
+
+	// validator which already left validator set
+	exited := stakingSim.GenRandomVal(1, 0, 10, 1, false, 20)[0]
+	require.NoError(t, keeper.AddValidator(ctx, exited))
+
+	require.NoError(t, keeper.MigratePendingValidatorUpdates(ctx))
+
+	pending := keeper.GetPendingValidatorUpdates(ctx)
+	require.Len(t, pending, 2)
+	require.Equal(t, joining.ID, pending[0].Validator.ID)
+	require.Equal(t, uint64(3), pending[0].ActivationEpoch)
+	require.Equal(t, exiting.ID, pending[1].Validator.ID)
+	require.Equal(t, uint64(5), pending[1].ActivationEpoch)
+
+	// current epoch reaches 5 after fourth checkpoint ack
+	initApp.CheckpointKeeper.UpdateACKCountWithValue(ctx, 4)
+
+	_, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.True(t, keeper.GetValidatorSet(ctx).HasAddress(joining.GetSigner().Bytes()))
+	require.False(t, keeper.GetValidatorSet(ctx).HasAddress(exiting.GetSigner().Bytes()))
+}
+
+func (suite *KeeperTestSuite) TestSignerKeyRotation() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	keeper := initApp.StakingKeeper
+
+	params := keeper.GetParams(ctx)
+	params.SignerOverlapBlocks = 5
+	keeper.SetParams(ctx, params)
+
+	validator := *checkPointSim.LoadValidatorSet(1, t, keeper, ctx, false, 0).Validators[0]
+	ctx = ctx.WithBlockHeight(10)
+	newValidator := rotateSigner(t, keeper, ctx, validator)
+
+	// old signer stays current signer key until signer swap is applied
+	history := keeper.GetSignerKeyHistory(ctx, validator.ID)
+	require.Len(t, history, 1)
+	require.Equal(t, validator.GetSigner().String(), history[0].Signer)
+	require.Equal(t, int64(0), history[0].EndHeight)
+
+	for _, signer := range []sdk.AccAddress{validator.GetSigner(), newValidator.GetSigner()} {
+		valID, ok := keeper.GetAcceptedSignerValidatorID(ctx.WithBlockHeight(20), signer)
+		require.True(t, ok)
+		require.Equal(t, validator.ID, valID)
+	}
+
+	// signer swap is applied at later height
+	ctx = ctx.WithBlockHeight(12)
+	_, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	history = keeper.GetSignerKeyHistory(ctx, validator.ID)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(12), history[0].EndHeight)
+	require.Equal(t, int64(17), history[0].OverlapEndHeight)
+	require.Equal(t, newValidator.GetSigner().String(), history[1].Signer)
+	require.Equal(t, int64(12), history[1].StartHeight)
+	require.Equal(t, int64(0), history[1].EndHeight)
+
+	// current signer is recorded once
+	require.NoError(t, keeper.AddSignerKey(ctx, newValidator))
+	require.Len(t, keeper.GetSignerKeyHistory(ctx, validator.ID), 2)
+
+	// both signers are accepted within overlap window
+	for _, signer := range []sdk.AccAddress{validator.GetSigner(), newValidator.GetSigner()} {
+		valID, ok := keeper.GetAcceptedSignerValidatorID(ctx.WithBlockHeight(16), signer)
+		require.True(t, ok)
+		require.Equal(t, validator.ID, valID)
+	}
+
+	// old signer is rejected once overlap window ends
+	_, ok := keeper.GetAcceptedSignerValidatorID(ctx.WithBlockHeight(17), validator.GetSigner())
+	require.False(t, ok)
+
+	_, ok = keeper.GetAcceptedSignerValidatorID(ctx, stakingSim.GenRandomVal(1, 0, 10, 0, false, 11)[0].GetSigner())
+	require.False(t, ok)
+}
+
+// rotateSigner updates validator's signer to random key like signer update post handler,
+// signer swap is staged and returned validator has new signer
+func rotateSigner(t *testing.T, keeper stakingKeeper.Keeper, ctx sdk.Context, validator hmTypes.Validator) hmTypes.Validator {
+	t.Helper()
+
+	randomVal := stakingSim.GenRandomVal(1, 0, 10, 0, false, 10)[0]
+	require.NoError(t, keeper.RotateSignerKey(ctx, validator))
+	require.NoError(t, keeper.UpdateSigner(ctx, randomVal.GetSigner(), hmCommonTypes.NewPubKeyFromHex(randomVal.PubKey), validator.GetSigner()))
+
+	oldValidator, err := keeper.GetValidatorInfo(ctx, validator.GetSigner())
+	require.NoError(t, err)
+	newValidator, err := keeper.GetValidatorInfo(ctx, randomVal.GetSigner())
+	require.NoError(t, err)
+
+	// stage signer swap in current epoch as signer update post handler does
+	currentEpoch := keeper.ModuleCommunicator.GetACKCount(ctx) + 1
+	require.NoError(t, keeper.StageValidatorUpdate(ctx, oldValidator, currentEpoch))
+	require.NoError(t, keeper.StageValidatorUpdate(ctx, newValidator, currentEpoch))
+
+	return newValidator
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/x/staking/types"
+)
+
+// GetSignerKeyHistoryKey appends prefix to validator id and record index
+func GetSignerKeyHistoryKey(valID hmTypes.ValidatorID, index uint64) []byte {
+	return append(getSignerKeyHistoryPrefix(valID), sdk.Uint64ToBigEndian(index)...)
+}
+
+func getSignerKeyHistoryPrefix(valID hmTypes.ValidatorID) []byte {
+	return append(SignerKeyHistoryKey, sdk.Uint64ToBigEndian(valID.Uint64())...)
+}
+
+// SetSignerKeyRecord stores signer key record at index of validator's history
+func (k *Keeper) SetSignerKeyRecord(ctx sdk.Context, index uint64, record types.SignerKeyRecord) error {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := k.cdc.MarshalBinaryBare(&record)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetSignerKeyHistoryKey(hmTypes.NewValidatorID(record.ValidatorId), index), bz)
+
+	return nil
+}
+
+// GetSignerKeyHistory returns signer keys used by validator, oldest first
+func (k *Keeper) GetSignerKeyHistory(ctx sdk.Context, valID hmTypes.ValidatorID) (records []types.SignerKeyRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, getSignerKeyHistoryPrefix(valID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SignerKeyRecord
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &record); err != nil {
+			k.Logger(ctx).Error("GetSignerKeyHistory | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllSignerKeys returns signer key history of all validators
+func (k *Keeper) GetAllSignerKeys(ctx sdk.Context) (records []*types.SignerKeyRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, SignerKeyHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SignerKeyRecord
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &record); err != nil {
+			k.Logger(ctx).Error("GetAllSignerKeys | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		records = append(records, &record)
+	}
+
+	return records
+}
+
+// AddSignerKey records validator's signer as its current signer key.
+// Previous signer key is closed at current height and stays accepted for side-tx voting
+// for signer overlap blocks.
+func (k *Keeper) AddSignerKey(ctx sdk.Context, validator hmTypes.Validator) error {
+	history := k.GetSignerKeyHistory(ctx, validator.ID)
+	index := uint64(len(history))
+
+	if index > 0 && history[index-1].EndHeight == 0 {
+		last := history[index-1]
+		if last.Signer == validator.GetSigner().String() {
+			return nil
+		}
+
+		last.EndHeight = ctx.BlockHeight()
+		last.OverlapEndHeight = last.EndHeight + int64(k.GetParams(ctx).SignerOverlapBlocks)
+		if err := k.SetSignerKeyRecord(ctx, index-1, last); err != nil {
+			return err
+		}
+	}
+
+	return k.SetSignerKeyRecord(ctx, index, types.SignerKeyRecord{
+		ValidatorId: validator.ID.Uint64(),
+		Signer:      validator.GetSigner().String(),
+		PubKey:      validator.PubKey,
+		StartHeight: ctx.BlockHeight(),
+	})
+}
+
+// RotateSignerKey prepares rotation of old validator signer out of signer key history.
+// Old signer is recorded first if validator has no signer key history yet, it stays current signer key
+// until staged signer swap is applied to validator set, see ApplyAndReturnValidatorSetUpdates.
+func (k *Keeper) RotateSignerKey(ctx sdk.Context, oldValidator hmTypes.Validator) error {
+	if len(k.GetSignerKeyHistory(ctx, oldValidator.ID)) != 0 {
+		return nil
+	}
+
+	return k.SetSignerKeyRecord(ctx, 0, types.SignerKeyRecord{
+		ValidatorId: oldValidator.ID.Uint64(),
+		Signer:      oldValidator.GetSigner().String(),
+		PubKey:      oldValidator.PubKey,
+	})
+}
+
+// GetAcceptedSignerValidatorID returns validator id signer address votes for on side-txs.
+// Address is accepted if it is validator's current signer, its signer key not yet swapped out of validator set,
+// or its rotated out signer within overlap window.
+func (k *Keeper) GetAcceptedSignerValidatorID(ctx sdk.Context, address []byte) (hmTypes.ValidatorID, bool) {
+	validator, err := k.GetValidatorInfo(ctx, address)
+	if err != nil {
+		return 0, false
+	}
+
+	if signer, ok := k.GetSignerFromValidatorID(ctx, validator.ID); ok && bytes.Equal(signer.Bytes(), address) {
+		return validator.ID, true
+	}
+
+	for _, record := range k.GetSignerKeyHistory(ctx, validator.ID) {
+		if (record.EndHeight == 0 || ctx.BlockHeight() < record.OverlapEndHeight) &&
+			bytes.Equal(common.HexToAddress(record.Signer).Bytes(), address) {
+			return validator.ID, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// MaxSnapshotsPrunedPerUpdate caps number of validator set snapshots pruned on single validator set update
+const MaxSnapshotsPrunedPerUpdate = 100
+
+// GetValidatorSetSnapshotKey appends prefix to heimdall height
+func GetValidatorSetSnapshotKey(height int64) []byte {
+	return append(ValidatorSetSnapshotKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetCheckpointValidatorSetKey appends prefix to checkpoint number
+func GetCheckpointValidatorSetKey(checkpointNumber uint64) []byte {
+	return append(CheckpointValidatorSetKey, sdk.Uint64ToBigEndian(checkpointNumber)...)
+}
+
+// snapshotValidatorSet stores marshalled validator set at current height if it differs from latest snapshot
+func (k *Keeper) snapshotValidatorSet(ctx sdk.Context, bz []byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	height, latest, found := k.getLatestSnapshot(ctx, ctx.BlockHeight())
+	if found && bytes.Equal(latest, bz) {
+		return
+	}
+
+	store.Set(GetValidatorSetSnapshotKey(ctx.BlockHeight()), bz)
+	if !found || height != ctx.BlockHeight() {
+		k.PruneValidatorSetSnapshots(ctx)
+	}
+}
+
+// getLatestSnapshot returns height and marshalled validator set of latest snapshot taken at or before height
+func (k *Keeper) getLatestSnapshot(ctx sdk.Context, height int64) (int64, []byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.ReverseIterator(ValidatorSetSnapshotKey, GetValidatorSetSnapshotKey(height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, nil, false
+	}
+
+	return int64(sdk.BigEndianToUint64(iterator.Key()[len(ValidatorSetSnapshotKey):])), iterator.Value(), true
+}
+
+// GetValidatorSetAtHeight returns validator set in effect at end of given heimdall height
+// along with height it was snapshotted at
+func (k *Keeper) GetValidatorSetAtHeight(ctx sdk.Context, height int64) (int64, *hmTypes.ValidatorSet, error) {
+	snapshotHeight, bz, found := k.getLatestSnapshot(ctx, height)
+	if !found {
+		return 0, nil, errors.New("no validator set snapshot found for height")
+	}
+
+	var validatorSet hmTypes.ValidatorSet
+	if err := k.cdc.UnmarshalBinaryBare(bz, &validatorSet); err != nil {
+		k.Logger(ctx).Error("GetValidatorSetAtHeight | UnmarshalBinaryBare", "error", err)
+		return 0, nil, err
+	}
+
+	return snapshotHeight, &validatorSet, nil
+}
+
+// SetBufferedCheckpointValidatorSet references validator set snapshot in effect at end of given height from buffered checkpoint
+func (k *Keeper) SetBufferedCheckpointValidatorSet(ctx sdk.Context, height int64) {
+	if snapshotHeight, found := k.ensureSnapshot(ctx, height); found {
+		store := ctx.KVStore(k.storeKey)
+		store.Set(BufferedCheckpointValidatorSetKey, sdk.Uint64ToBigEndian(uint64(snapshotHeight)))
+	}
+}
+
+// ensureSnapshot returns height of snapshot in effect at end of given height.
+// Current validator set is snapshotted first if none was taken yet.
+func (k *Keeper) ensureSnapshot(ctx sdk.Context, height int64) (int64, bool) {
+	if snapshotHeight, _, found := k.getLatestSnapshot(ctx, height); found {
+		return snapshotHeight, true
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(CurrentValidatorSetKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	k.snapshotValidatorSet(ctx, bz)
+	return ctx.BlockHeight(), true
+}
+
+// getBufferedCheckpointSnapshotHeight returns height of validator set snapshot referenced by buffered checkpoint
+func (k *Keeper) getBufferedCheckpointSnapshotHeight(ctx sdk.Context) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(BufferedCheckpointValidatorSetKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// SetCheckpointValidatorSet references validator set snapshot of buffered checkpoint from acked checkpoint.
+// Latest snapshot is referenced for checkpoints buffered before their snapshot was recorded.
+func (k *Keeper) SetCheckpointValidatorSet(ctx sdk.Context, checkpointNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	height, found := k.getBufferedCheckpointSnapshotHeight(ctx)
+	if !found {
+		if height, found = k.ensureSnapshot(ctx, ctx.BlockHeight()); !found {
+			return
+		}
+	}
+
+	store.Set(GetCheckpointValidatorSetKey(checkpointNumber), sdk.Uint64ToBigEndian(uint64(height)))
+	store.Delete(BufferedCheckpointValidatorSetKey)
+}
+
+// GetValidatorSetForCheckpoint returns validator set snapshot referenced by checkpoint
+// along with height it was snapshotted at
+func (k *Keeper) GetValidatorSetForCheckpoint(ctx sdk.Context, checkpointNumber uint64) (int64, *hmTypes.ValidatorSet, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(GetCheckpointValidatorSetKey(checkpointNumber))
+	if bz == nil {
+		return 0, nil, errors.New("no validator set snapshot referenced by checkpoint")
+	}
+
+	height := int64(sdk.BigEndianToUint64(bz))
+	snapshotHeight, validatorSet, err := k.GetValidatorSetAtHeight(ctx, height)
+	if err != nil || snapshotHeight != height {
+		return 0, nil, errors.New("validator set snapshot referenced by checkpoint is pruned")
+	}
+
+	return snapshotHeight, validatorSet, nil
+}
+
+// PruneValidatorSetSnapshots removes snapshots no longer in effect within snapshot retention blocks,
+// along with checkpoint references to them. Returns number of snapshots pruned.
+func (k *Keeper) PruneValidatorSetSnapshots(ctx sdk.Context) uint64 {
+	retention := k.GetParams(ctx).SnapshotRetention
+	if retention == 0 || ctx.BlockHeight() <= int64(retention) {
+		return 0
+	}
+
+	// snapshot in effect at retention boundary is kept
+	keepHeight, _, found := k.getLatestSnapshot(ctx, ctx.BlockHeight()-int64(retention))
+	if !found {
+		return 0
+	}
+
+	// snapshot of buffered checkpoint is kept until checkpoint is acked
+	if height, ok := k.getBufferedCheckpointSnapshotHeight(ctx); ok && height < keepHeight {
+		keepHeight = height
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// collect keys first, store must not be written while iterating
+	var keys [][]byte
+	snapshots := store.Iterator(ValidatorSetSnapshotKey, GetValidatorSetSnapshotKey(keepHeight))
+	for ; snapshots.Valid() && len(keys) < MaxSnapshotsPrunedPerUpdate; snapshots.Next() {
+		keys = append(keys, snapshots.Key())
+	}
+	snapshots.Close()
+
+	count := uint64(len(keys))
+	if count == 0 {
+		return 0
+	}
+
+	prunedBelow := int64(sdk.BigEndianToUint64(keys[len(keys)-1][len(ValidatorSetSnapshotKey):])) + 1
+
+	// checkpoint snapshot heights increase with checkpoint number
+	checkpoints := sdk.KVStorePrefixIterator(store, CheckpointValidatorSetKey)
+	for ; checkpoints.Valid(); checkpoints.Next() {
+		if int64(sdk.BigEndianToUint64(checkpoints.Value())) >= prunedBelow {
+			break
+		}
+		keys = append(keys, checkpoints.Key())
+	}
+	checkpoints.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.Logger(ctx).Info("Pruned validator set snapshots from state", "count", count, "keptHeight", keepHeight)
+
+	return count
+}
@@ -1,11 +1,190 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommon "github.com/maticnetwork/heimdall/types/common"
+	"github.com/maticnetwork/heimdall/x/staking/types"
 )
 
-// ApplyAndReturnValidatorSetUpdates applys and returns validator set for genutil module
-func (k Keeper) ApplyAndReturnValidatorSetUpdates(sdk.Context) (updates []abci.ValidatorUpdate, err error) {
-	return nil, nil
+// GetPendingValidatorUpdateKey appends prefix to activation epoch and signer address
+func GetPendingValidatorUpdateKey(activationEpoch uint64, signer []byte) []byte {
+	return append(append(PendingValidatorUpdateKey, sdk.Uint64ToBigEndian(activationEpoch)...), signer...)
+}
+
+// StageValidatorUpdate stages validator to be updated in validator set once current epoch reaches activation epoch.
+// Staging validator again for same epoch replaces earlier update.
+func (k *Keeper) StageValidatorUpdate(ctx sdk.Context, validator hmTypes.Validator, activationEpoch uint64) error {
+	store := ctx.KVStore(k.storeKey)
+
+	update := types.PendingValidatorUpdate{
+		Validator:       &validator,
+		ActivationEpoch: activationEpoch,
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&update)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetPendingValidatorUpdateKey(activationEpoch, validator.GetSigner().Bytes()), bz)
+	k.Logger(ctx).Debug("Validator update staged", "validatorID", validator.ID, "signer", validator.Signer, "activationEpoch", activationEpoch)
+
+	return nil
+}
+
+// GetPendingValidatorUpdates returns staged validator updates ordered by activation epoch
+func (k *Keeper) GetPendingValidatorUpdates(ctx sdk.Context) (updates []types.PendingValidatorUpdate) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, PendingValidatorUpdateKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var update types.PendingValidatorUpdate
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &update); err != nil {
+			k.Logger(ctx).Error("GetPendingValidatorUpdates | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		updates = append(updates, update)
+	}
+
+	return updates
+}
+
+// MigratePendingValidatorUpdates stages joins and exits made before validator updates were staged.
+// Validator set used to be compared against all validators every end block,
+// so validators not yet in set with future start epoch are pending joins and
+// validators in set with end epoch are pending exits.
+func (k *Keeper) MigratePendingValidatorUpdates(ctx sdk.Context) error {
+	currentEpoch := k.ModuleCommunicator.GetACKCount(ctx) + 1
+	validatorSet := k.GetValidatorSet(ctx)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		_, val := validatorSet.GetByAddress(validator.GetSigner())
+
+		if val == nil && validator.StartEpoch > currentEpoch && validator.VotingPower > 0 && !validator.Jailed {
+			if err := k.StageValidatorUpdate(ctx, *validator, validator.StartEpoch); err != nil {
+				return err
+			}
+		} else if val != nil && validator.EndEpoch != 0 {
+			if err := k.StageValidatorUpdate(ctx, *validator, validator.EndEpoch); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// popDueValidatorUpdates removes and returns staged updates with activation epoch reached by current epoch
+func (k *Keeper) popDueValidatorUpdates(ctx sdk.Context, currentEpoch uint64) (updates []types.PendingValidatorUpdate) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect keys first, store must not be written while iterating
+	var keys [][]byte
+	iterator := store.Iterator(PendingValidatorUpdateKey, GetPendingValidatorUpdateKey(currentEpoch+1, nil))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+
+		var update types.PendingValidatorUpdate
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &update); err != nil {
+			k.Logger(ctx).Error("popDueValidatorUpdates | UnmarshalBinaryBare", "error", err)
+			continue
+		}
+
+		updates = append(updates, update)
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return updates
+}
+
+// ApplyAndReturnValidatorSetUpdates applies staged validator updates due at current epoch to
+// validator set and returns them as tendermint validator updates.
+// Updated validators are read back from store so that later changes to them are honoured.
+// Signer key history of validators with swapped signer is updated at the same height.
+func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
+	ackCount := k.ModuleCommunicator.GetACKCount(ctx)
+
+	// current epoch will be ack count + 1
+	due := k.popDueValidatorUpdates(ctx, ackCount+1)
+	if len(due) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool)
+	validators := make([]*hmTypes.Validator, 0, len(due))
+	for _, update := range due {
+		if seen[update.Validator.Signer] {
+			continue
+		}
+		seen[update.Validator.Signer] = true
+
+		validator, err := k.GetValidatorInfo(ctx, update.Validator.GetSigner())
+		if err != nil {
+			validator = *update.Validator
+		}
+
+		validators = append(validators, &validator)
+	}
+
+	// rotate signer keys once signer swap is applied, rotated out signer overlap window starts now
+	for _, validator := range validators {
+		if signer, ok := k.GetSignerFromValidatorID(ctx, validator.ID); !ok || !bytes.Equal(signer.Bytes(), validator.GetSigner().Bytes()) {
+			continue
+		}
+
+		if err := k.AddSignerKey(ctx, *validator); err != nil {
+			return nil, err
+		}
+	}
+
+	currentValidatorSet := k.GetValidatorSet(ctx)
+
+	// get validator updates
+	setUpdates := helper.GetUpdatedValidators(
+		currentValidatorSet, // pointer to current validator set -- UpdateValidators will modify it
+		validators,          // validators with due updates
+		ackCount,            // ack count
+	)
+
+	if len(setUpdates) == 0 {
+		return nil, nil
+	}
+
+	// create new validator set
+	if err := currentValidatorSet.UpdateWithChangeSet(setUpdates); err != nil {
+		return nil, err
+	}
+
+	// increment proposer priority
+	currentValidatorSet.IncrementProposerPriority(1)
+
+	// save set in store
+	if err := k.UpdateValidatorSetInStore(ctx, currentValidatorSet); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Debug("Updated current validator set", "proposer", currentValidatorSet.GetProposer())
+
+	// convert updates to tendermint validator updates
+	for _, v := range setUpdates {
+		updates = append(updates, abci.ValidatorUpdate{
+			Power:  v.VotingPower,
+			PubKey: hmCommon.NewPubKeyFromHex(v.PubKey).TMProtoCryptoPubKey(),
+		})
+	}
+
+	return updates, nil
 }
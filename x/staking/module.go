@@ -138,8 +138,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper, am.contractCaller))
 }
 
-// RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the staking module's invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // NewSideTxHandler side tx handler
 func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
@@ -173,7 +175,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
-// returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return []abci.ValidatorUpdate{}
+// applies staged validator updates due at current epoch and returns them as validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// staged updates are kept for next block if they fail to apply
+	cacheCtx, write := ctx.CacheContext()
+
+	updates, err := am.keeper.ApplyAndReturnValidatorSetUpdates(cacheCtx)
+	if err != nil {
+		// return with nothing
+		am.keeper.Logger(ctx).Error("Unable to update current validator set", "Error", err)
+		return []abci.ValidatorUpdate{}
+	}
+	write()
+
+	return updates
 }
@@ -391,6 +391,18 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k keeper.Keeper, msg types.MsgV
 		return nil, hmCommon.ErrValidatorSave
 	}
 
+	// record validator's first signer key
+	if err := k.AddSignerKey(ctx, newValidator); err != nil {
+		k.Logger(ctx).Error("Unable to add signer key", "error", err, "validator", newValidator.String())
+		return nil, hmCommon.ErrValidatorSave
+	}
+
+	// stage validator to join validator set at activation epoch
+	if err := k.StageValidatorUpdate(ctx, newValidator, msg.ActivationEpoch); err != nil {
+		k.Logger(ctx).Error("Unable to stage validator update", "error", err, "validator", newValidator.String())
+		return nil, hmCommon.ErrValidatorSave
+	}
+
 	// Add Validator signing info. It is required for slashing module
 	valSigningInfo := hmTypes.NewValidatorSigningInfo(newValidator.ID, ctx.BlockHeight(), int64(0), int64(0))
 	err = k.AddValidatorSigningInfo(ctx, newValidator.ID, valSigningInfo)
@@ -401,6 +413,7 @@ func PostHandleMsgValidatorJoin(ctx sdk.Context, k keeper.Keeper, msg types.MsgV
 
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
+	k.SetStakingSequenceTxHash(ctx, sequence.String(), hmCommonTypes.HexToHeimdallHash(msg.TxHash))
 	k.Logger(ctx).Debug("✅ New validator successfully joined", "validator", strconv.FormatUint(newValidator.ID.Uint64(), 10))
 
 	// TX bytes
@@ -474,8 +487,15 @@ func PostHandleMsgStakeUpdate(ctx sdk.Context, k keeper.Keeper, msg types.MsgSta
 		return nil, hmCommon.ErrSignerUpdateError
 	}
 
+	// stage new power to apply to validator set in current epoch
+	if err := k.StageValidatorUpdate(ctx, validator, k.ModuleCommunicator.GetACKCount(ctx)+1); err != nil {
+		k.Logger(ctx).Error("Unable to stage validator update", "error", err, "ValidatorID", validator.ID)
+		return nil, hmCommon.ErrSignerUpdateError
+	}
+
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
+	k.SetStakingSequenceTxHash(ctx, sequence.String(), hmCommonTypes.HexToHeimdallHash(msg.TxHash))
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -576,8 +596,24 @@ func PostHandleMsgSignerUpdate(ctx sdk.Context, k keeper.Keeper, msg types.MsgSi
 		return nil, hmCommon.ErrSignerUpdateError
 	}
 
+	// keep old signer in key history, it is rotated out once signer swap is applied to validator set
+	if err := k.RotateSignerKey(ctx, *oldValidator); err != nil {
+		k.Logger(ctx).Error("Unable to rotate signer key", "error", err, "ValidatorID", validator.ID)
+		return nil, hmCommon.ErrSignerUpdateError
+	}
+
+	// stage signer swap to apply to validator set in current epoch
+	currentEpoch := k.ModuleCommunicator.GetACKCount(ctx) + 1
+	for _, v := range []hmTypes.Validator{*oldValidator, validator} {
+		if err := k.StageValidatorUpdate(ctx, v, currentEpoch); err != nil {
+			k.Logger(ctx).Error("Unable to stage validator update", "error", err, "ValidatorID", v.ID)
+			return nil, hmCommon.ErrSignerUpdateError
+		}
+	}
+
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
+	k.SetStakingSequenceTxHash(ctx, sequence.String(), hmCommonTypes.HexToHeimdallHash(msg.TxHash))
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -657,8 +693,15 @@ func PostHandleMsgValidatorExit(ctx sdk.Context, k keeper.Keeper, msg types.MsgV
 		return nil, hmCommon.ErrValidatorNotDeactivated
 	}
 
+	// stage validator to leave validator set at deactivation epoch
+	if err := k.StageValidatorUpdate(ctx, validator, msg.DeactivationEpoch); err != nil {
+		k.Logger(ctx).Error("Unable to stage validator update", "error", err, "validatorID", validator.ID.String())
+		return nil, hmCommon.ErrValidatorNotDeactivated
+	}
+
 	// save staking sequence
 	k.SetStakingSequence(ctx, sequence.String())
+	k.SetStakingSequenceTxHash(ctx, sequence.String(), hmCommonTypes.HexToHeimdallHash(msg.TxHash))
 
 	// TX bytes
 	txBytes := ctx.TxBytes()
@@ -1404,6 +1404,20 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgSignerUpdate() {
 		removedVal, err := keeper.GetValidatorInfo(ctx, oldSigner.GetSigner())
 		require.Empty(t, err, "deleted validator should be found, got %v", err)
 		require.Equal(t, removedVal.VotingPower, int64(0), "removed validator VotingPower should be zero")
+
+		// old signer stays current signer key until signer swap is applied
+		history := keeper.GetSignerKeyHistory(ctx, oldSigner.ID)
+		require.Len(t, history, 1, "old signer key should be recorded")
+		require.Equal(t, int64(0), history[0].EndHeight)
+
+		_, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(t, err)
+
+		history = keeper.GetSignerKeyHistory(ctx, oldSigner.ID)
+		require.Len(t, history, 2, "old and new signer keys should be recorded")
+		require.Equal(t, oldSigner.GetSigner().String(), history[0].Signer)
+		require.Equal(t, ctx.BlockHeight(), history[0].EndHeight)
+		require.Equal(t, newSigner[0].GetSigner().String(), history[1].Signer)
 	})
 }
 
@@ -1455,9 +1469,23 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgValidatorExit() {
 		currentVals := keeper.GetCurrentValidators(ctx)
 		require.Equal(t, 4, len(currentVals), "No of current validators should exist before epoch passes")
 
+		pending := keeper.GetPendingValidatorUpdates(ctx)
+		require.Len(t, pending, 1)
+		require.Equal(t, uint64(10), pending[0].ActivationEpoch)
+
+		updates, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(t, err)
+		require.Empty(t, updates, "Validator should stay in validator set before epoch passes")
+
 		initApp.CheckpointKeeper.UpdateACKCountWithValue(ctx, 20)
 		currentVals = keeper.GetCurrentValidators(ctx)
 		require.Equal(t, 3, len(currentVals), "No of current validators should reduce after epoch passes")
+
+		updates, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		require.Equal(t, int64(0), updates[0].Power, "Validator should be removed from validator set")
+		require.Equal(t, 3, len(keeper.GetValidatorSet(ctx).Validators))
 	})
 }
 
@@ -1517,5 +1545,10 @@ func (suite *SideHandlerTestSuite) TestPostHandleMsgStakeUpdate() {
 		actualPower, err := helper.GetPowerFromAmount(new(big.Int).SetInt64(2000000000000000000))
 		require.NoError(t, err)
 		require.Equal(t, actualPower.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", newAmount.Uint64())
+
+		// rootchain tx which last updated validator is recorded
+		txHash, ok := keeper.GetStakingSequenceTxHash(ctx, updatedVal.LastUpdated)
+		require.True(t, ok)
+		require.Equal(t, msgTxHash, txHash)
 	})
 }
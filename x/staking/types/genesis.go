@@ -3,6 +3,8 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -32,12 +34,18 @@ func NewGenesisState(
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
-	return NewGenesisState(nil, &hmTypes.ValidatorSet{}, nil)
+	genesis := NewGenesisState(nil, &hmTypes.ValidatorSet{}, nil)
+	genesis.Params = DefaultParams()
+	return genesis
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, validator := range data.Validators {
 		if err := validator.ValidateBasic(); err != nil {
 			return err
@@ -48,6 +56,29 @@ func ValidateGenesis(data GenesisState) error {
 			return errors.New("Invalid Sequence")
 		}
 	}
+	// signer key history is ordered per validator with at most one current key
+	lastRecords := make(map[uint64]*SignerKeyRecord)
+	for _, record := range data.SignerKeys {
+		if record.ValidatorId == 0 || record.Signer == "" {
+			return errors.New("Invalid signer key record")
+		}
+
+		if last, ok := lastRecords[record.ValidatorId]; ok {
+			if last.EndHeight == 0 {
+				return fmt.Errorf("Multiple current signer keys of validator %d", record.ValidatorId)
+			}
+
+			if record.StartHeight < last.StartHeight {
+				return fmt.Errorf("Unordered signer key history of validator %d", record.ValidatorId)
+			}
+		}
+		lastRecords[record.ValidatorId] = record
+	}
+	for _, update := range data.PendingValidatorUpdates {
+		if err := update.Validator.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
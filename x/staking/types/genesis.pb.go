@@ -27,10 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the staking module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of related to staking
-	Params           Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Validators       []*types.Validator  `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
-	CurrentValSet    *types.ValidatorSet `protobuf:"bytes,3,opt,name=current_val_set,json=currentValSet,proto3" json:"current_val_set,omitempty" yaml:"current_val_set"`
-	StakingSequences []string            `protobuf:"bytes,4,rep,name=staking_sequences,json=stakingSequences,proto3" json:"staking_sequences,omitempty" yaml:"staking_sequences"`
+	Params                  Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Validators              []*types.Validator        `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+	CurrentValSet           *types.ValidatorSet       `protobuf:"bytes,3,opt,name=current_val_set,json=currentValSet,proto3" json:"current_val_set,omitempty" yaml:"current_val_set"`
+	StakingSequences        []string                  `protobuf:"bytes,4,rep,name=staking_sequences,json=stakingSequences,proto3" json:"staking_sequences,omitempty" yaml:"staking_sequences"`
+	SignerKeys              []*SignerKeyRecord        `protobuf:"bytes,5,rep,name=signer_keys,json=signerKeys,proto3" json:"signer_keys,omitempty" yaml:"signer_keys"`
+	PendingValidatorUpdates []*PendingValidatorUpdate `protobuf:"bytes,6,rep,name=pending_validator_updates,json=pendingValidatorUpdates,proto3" json:"pending_validator_updates,omitempty" yaml:"pending_validator_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,8 +96,165 @@ func (m *GenesisState) GetStakingSequences() []string {
 	return nil
 }
 
+func (m *GenesisState) GetSignerKeys() []*SignerKeyRecord {
+	if m != nil {
+		return m.SignerKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingValidatorUpdates() []*PendingValidatorUpdate {
+	if m != nil {
+		return m.PendingValidatorUpdates
+	}
+	return nil
+}
+
+// SignerKeyRecord is signer key used by validator between heimdall heights.
+// Rotated out signer is accepted for side-tx voting before overlap end height.
+type SignerKeyRecord struct {
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty" yaml:"validator_id"`
+	Signer      string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	PubKey      string `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty" yaml:"pub_key"`
+	StartHeight int64  `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// end_height is zero while signer key is current
+	EndHeight        int64 `protobuf:"varint,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	OverlapEndHeight int64 `protobuf:"varint,6,opt,name=overlap_end_height,json=overlapEndHeight,proto3" json:"overlap_end_height,omitempty" yaml:"overlap_end_height"`
+}
+
+func (m *SignerKeyRecord) Reset()         { *m = SignerKeyRecord{} }
+func (m *SignerKeyRecord) String() string { return proto.CompactTextString(m) }
+func (*SignerKeyRecord) ProtoMessage()    {}
+func (*SignerKeyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f5b2cce9a1deace, []int{1}
+}
+func (m *SignerKeyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerKeyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerKeyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerKeyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerKeyRecord.Merge(m, src)
+}
+func (m *SignerKeyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerKeyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerKeyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerKeyRecord proto.InternalMessageInfo
+
+func (m *SignerKeyRecord) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
+	}
+	return 0
+}
+
+func (m *SignerKeyRecord) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *SignerKeyRecord) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *SignerKeyRecord) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *SignerKeyRecord) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *SignerKeyRecord) GetOverlapEndHeight() int64 {
+	if m != nil {
+		return m.OverlapEndHeight
+	}
+	return 0
+}
+
+// PendingValidatorUpdate is validator update staged in validator set once
+// current epoch reaches activation epoch
+type PendingValidatorUpdate struct {
+	Validator       *types.Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	ActivationEpoch uint64           `protobuf:"varint,2,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty" yaml:"activation_epoch"`
+}
+
+func (m *PendingValidatorUpdate) Reset()         { *m = PendingValidatorUpdate{} }
+func (m *PendingValidatorUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingValidatorUpdate) ProtoMessage()    {}
+func (*PendingValidatorUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f5b2cce9a1deace, []int{2}
+}
+func (m *PendingValidatorUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingValidatorUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingValidatorUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingValidatorUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingValidatorUpdate.Merge(m, src)
+}
+func (m *PendingValidatorUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingValidatorUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingValidatorUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingValidatorUpdate proto.InternalMessageInfo
+
+func (m *PendingValidatorUpdate) GetValidator() *types.Validator {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *PendingValidatorUpdate) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "heimdall.staking.v1beta1.GenesisState")
+	proto.RegisterType((*SignerKeyRecord)(nil), "heimdall.staking.v1beta1.SignerKeyRecord")
+	proto.RegisterType((*PendingValidatorUpdate)(nil), "heimdall.staking.v1beta1.PendingValidatorUpdate")
 }
 
 func init() {
@@ -103,29 +262,48 @@ func init() {
 }
 
 var fileDescriptor_5f5b2cce9a1deace = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xf2, 0x50,
-	0x10, 0x85, 0x5b, 0x20, 0x24, 0x7f, 0xf9, 0x8d, 0xda, 0x18, 0x53, 0x09, 0x29, 0x4d, 0x13, 0x0d,
-	0xab, 0x5b, 0xc1, 0x95, 0x2e, 0x5c, 0x74, 0x63, 0x74, 0x65, 0xda, 0x84, 0x85, 0x1b, 0xbc, 0x2d,
-	0x93, 0xd2, 0xd0, 0xf6, 0x62, 0xef, 0x80, 0xf2, 0x16, 0x3e, 0x8d, 0xcf, 0xc0, 0x92, 0xa5, 0x2b,
-	0x62, 0xe0, 0x0d, 0x78, 0x02, 0x63, 0x7b, 0xa9, 0x04, 0xc3, 0x6e, 0x72, 0xf2, 0x9d, 0x33, 0x67,
-	0x32, 0xca, 0xc5, 0x00, 0xc2, 0xb8, 0x4f, 0xa3, 0xc8, 0xe2, 0x48, 0x87, 0x61, 0x12, 0x58, 0x93,
-	0xb6, 0x07, 0x48, 0xdb, 0x56, 0x00, 0x09, 0xf0, 0x90, 0x93, 0x51, 0xca, 0x90, 0xa9, 0xda, 0x86,
-	0x23, 0x82, 0x23, 0x82, 0xab, 0x9f, 0x17, 0x09, 0x1e, 0xe5, 0x50, 0xd8, 0x27, 0x34, 0x0a, 0xfb,
-	0x14, 0x59, 0x9a, 0x07, 0x6c, 0x61, 0xbb, 0x8b, 0x46, 0x34, 0xa5, 0xb1, 0xd8, 0x53, 0x3f, 0x09,
-	0x58, 0xc0, 0xb2, 0xd1, 0xfa, 0x99, 0x72, 0xd5, 0xfc, 0x28, 0x29, 0xff, 0xef, 0xf2, 0x3e, 0x2e,
-	0x52, 0x04, 0xf5, 0x56, 0xa9, 0xe6, 0x36, 0x4d, 0x36, 0xe4, 0x56, 0xad, 0x63, 0x90, 0x7d, 0xfd,
-	0xc8, 0x63, 0xc6, 0xd9, 0x95, 0xd9, 0xa2, 0x29, 0x39, 0xc2, 0xa5, 0x5e, 0x2b, 0x4a, 0x51, 0x90,
-	0x6b, 0x25, 0xa3, 0xdc, 0xaa, 0x75, 0xce, 0x7e, 0x33, 0x70, 0x3a, 0x02, 0x4e, 0xba, 0x1b, 0xc2,
-	0xd9, 0x82, 0xd5, 0x67, 0xe5, 0xd0, 0x1f, 0xa7, 0x29, 0x24, 0xd8, 0x9b, 0xd0, 0xa8, 0xc7, 0x01,
-	0xb5, 0x72, 0xd6, 0xa1, 0xb1, 0xd7, 0xef, 0x02, 0xda, 0xf5, 0xf5, 0xa2, 0x79, 0x3a, 0xa5, 0x71,
-	0x74, 0x63, 0xee, 0xd8, 0x4d, 0xe7, 0x40, 0x28, 0x5d, 0x1a, 0xb9, 0x80, 0xea, 0xbd, 0x72, 0x2c,
-	0x8e, 0xe8, 0x71, 0x78, 0x19, 0x43, 0xe2, 0x03, 0xd7, 0x2a, 0x46, 0xb9, 0xf5, 0xcf, 0x6e, 0xac,
-	0x17, 0x4d, 0x2d, 0x4f, 0xf9, 0x83, 0x98, 0xce, 0x91, 0xd0, 0xdc, 0x8d, 0x64, 0x3f, 0xcc, 0x96,
-	0xba, 0x3c, 0x5f, 0xea, 0xf2, 0xd7, 0x52, 0x97, 0xdf, 0x57, 0xba, 0x34, 0x5f, 0xe9, 0xd2, 0xe7,
-	0x4a, 0x97, 0x9e, 0x2e, 0x83, 0x10, 0x07, 0x63, 0x8f, 0xf8, 0x2c, 0xb6, 0x62, 0x8a, 0xa1, 0x9f,
-	0x00, 0xbe, 0xb2, 0x74, 0x68, 0x15, 0x7f, 0x7a, 0x2b, 0x3e, 0x95, 0x9d, 0xe3, 0x55, 0xb3, 0x5f,
-	0x5c, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x1c, 0xc8, 0xe2, 0x33, 0x02, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0x9b, 0x34, 0xbf, 0xb2, 0xe9, 0x4f, 0xdb, 0x05, 0x52, 0xb7, 0x94, 0xd8, 0x5a, 0x01,
+	0x0a, 0x42, 0x72, 0xda, 0x82, 0x84, 0xe8, 0x81, 0x83, 0xa5, 0x02, 0xa5, 0x17, 0xb4, 0x11, 0x3d,
+	0x70, 0x31, 0x6b, 0x7b, 0xe4, 0x58, 0x75, 0x6c, 0xe3, 0xdd, 0x04, 0xf2, 0x16, 0x88, 0x1b, 0x4f,
+	0xc1, 0x6b, 0xf4, 0x58, 0x6e, 0x9c, 0x2c, 0xd4, 0xbe, 0x81, 0x9f, 0x00, 0x65, 0xed, 0x38, 0x51,
+	0xda, 0xf4, 0xe6, 0x99, 0xf9, 0xbe, 0x6f, 0xbe, 0x19, 0xef, 0x2e, 0x7a, 0xd2, 0x07, 0x7f, 0xe0,
+	0xb2, 0x20, 0xe8, 0x72, 0xc1, 0xce, 0xfc, 0xd0, 0xeb, 0x8e, 0xf6, 0x6d, 0x10, 0x6c, 0xbf, 0xeb,
+	0x41, 0x08, 0xdc, 0xe7, 0x46, 0x9c, 0x44, 0x22, 0xc2, 0xea, 0x14, 0x67, 0x14, 0x38, 0xa3, 0xc0,
+	0xed, 0x3c, 0x2e, 0x15, 0x6c, 0xc6, 0xa1, 0xa4, 0x8f, 0x58, 0xe0, 0xbb, 0x4c, 0x44, 0x49, 0x2e,
+	0x30, 0x07, 0x5b, 0x6c, 0x14, 0xb3, 0x84, 0x0d, 0x8a, 0x3e, 0x3b, 0xf7, 0xbc, 0xc8, 0x8b, 0xe4,
+	0x67, 0x77, 0xf2, 0x95, 0x67, 0xc9, 0xaf, 0x1a, 0x5a, 0x7b, 0x9b, 0xfb, 0xe9, 0x09, 0x26, 0x00,
+	0xbf, 0x46, 0xf5, 0x9c, 0xa6, 0x2a, 0xba, 0xd2, 0x69, 0x1e, 0xe8, 0xc6, 0x32, 0x7f, 0xc6, 0x07,
+	0x89, 0x33, 0x6b, 0xe7, 0xa9, 0x56, 0xa1, 0x05, 0x0b, 0xbf, 0x42, 0xa8, 0x34, 0xc8, 0xd5, 0x15,
+	0xbd, 0xda, 0x69, 0x1e, 0x6c, 0xcf, 0x34, 0xc4, 0x38, 0x06, 0x6e, 0x9c, 0x4e, 0x11, 0x74, 0x0e,
+	0x8c, 0x3f, 0xa3, 0x75, 0x67, 0x98, 0x24, 0x10, 0x0a, 0x6b, 0xc4, 0x02, 0x8b, 0x83, 0x50, 0xab,
+	0xd2, 0xc3, 0xee, 0x52, 0x7e, 0x0f, 0x84, 0xb9, 0x93, 0xa5, 0x5a, 0x6b, 0xcc, 0x06, 0xc1, 0x21,
+	0x59, 0xa0, 0x13, 0xfa, 0x7f, 0x91, 0x39, 0x65, 0x41, 0x0f, 0x04, 0x3e, 0x46, 0x9b, 0xc5, 0x10,
+	0x16, 0x87, 0x2f, 0x43, 0x08, 0x1d, 0xe0, 0x6a, 0x4d, 0xaf, 0x76, 0x1a, 0xe6, 0x6e, 0x96, 0x6a,
+	0x6a, 0xae, 0x72, 0x0d, 0x42, 0xe8, 0x46, 0x91, 0xeb, 0x4d, 0x53, 0xd8, 0x46, 0x4d, 0xee, 0x7b,
+	0x21, 0x24, 0xd6, 0x19, 0x8c, 0xb9, 0xba, 0x2a, 0x07, 0x7d, 0xba, 0x7c, 0x59, 0x3d, 0x09, 0x3e,
+	0x81, 0x31, 0x05, 0x27, 0x4a, 0x5c, 0xb3, 0x95, 0xa5, 0x1a, 0x2e, 0xfa, 0xcd, 0x74, 0x08, 0x45,
+	0x7c, 0x0a, 0xe4, 0xf8, 0x87, 0x82, 0xb6, 0x63, 0x08, 0xdd, 0x89, 0x99, 0x72, 0x4f, 0xd6, 0x30,
+	0x76, 0x99, 0x00, 0xae, 0xd6, 0x65, 0xcb, 0xbd, 0x5b, 0xfe, 0x4f, 0x4e, 0x2d, 0x97, 0xf5, 0x51,
+	0x12, 0xcd, 0x47, 0x59, 0xaa, 0xe9, 0x79, 0xe7, 0xa5, 0xe2, 0x84, 0x6e, 0xc5, 0x37, 0xb2, 0x39,
+	0xf9, 0xbd, 0x82, 0xd6, 0x17, 0x86, 0xc1, 0x87, 0x68, 0x6d, 0x26, 0xe1, 0xbb, 0xf2, 0xe8, 0xd4,
+	0xcc, 0xad, 0x2c, 0xd5, 0xee, 0xe6, 0x8d, 0xe6, 0xab, 0x84, 0x36, 0xcb, 0xf0, 0xd8, 0xc5, 0x2d,
+	0x54, 0xcf, 0x47, 0x56, 0x57, 0x74, 0xa5, 0xd3, 0xa0, 0x45, 0x84, 0x9f, 0xa1, 0xff, 0xe2, 0xa1,
+	0x3d, 0xd9, 0x8a, 0x3c, 0x05, 0x0d, 0x13, 0x67, 0xa9, 0x76, 0xa7, 0xf0, 0x9d, 0x17, 0x08, 0xad,
+	0xc7, 0x43, 0xfb, 0x04, 0xc6, 0x13, 0x03, 0x5c, 0xb0, 0x44, 0x58, 0x7d, 0xf0, 0xbd, 0xbe, 0x50,
+	0x6b, 0xba, 0xd2, 0xa9, 0xce, 0x1b, 0x98, 0xaf, 0x12, 0xda, 0x94, 0xe1, 0x3b, 0x19, 0xe1, 0x17,
+	0x08, 0x41, 0xe8, 0x4e, 0x99, 0xab, 0x92, 0x79, 0x3f, 0x4b, 0xb5, 0xcd, 0x9c, 0x39, 0xab, 0x11,
+	0xda, 0x80, 0xd0, 0x2d, 0x58, 0x27, 0x08, 0x47, 0x23, 0x48, 0x02, 0x16, 0x5b, 0x73, 0xec, 0xba,
+	0x64, 0x3f, 0xcc, 0x52, 0x6d, 0x3b, 0x67, 0x5f, 0xc7, 0x10, 0xba, 0x51, 0x24, 0x8f, 0xa6, 0x62,
+	0xe4, 0xa7, 0x82, 0x5a, 0x37, 0xff, 0x2d, 0xfc, 0x12, 0x35, 0xca, 0x6d, 0x15, 0x57, 0xf2, 0x96,
+	0xeb, 0x34, 0xc3, 0xe2, 0x37, 0x68, 0x83, 0x39, 0xc2, 0x1f, 0x31, 0xe1, 0x47, 0xa1, 0x05, 0x71,
+	0xe4, 0xf4, 0xe5, 0x86, 0x6b, 0xe6, 0x83, 0x2c, 0xd5, 0xb6, 0x72, 0x7b, 0x8b, 0x08, 0x42, 0xd7,
+	0x67, 0xa9, 0xa3, 0x49, 0xc6, 0x7c, 0x7f, 0x7e, 0xd9, 0x56, 0x2e, 0x2e, 0xdb, 0xca, 0xdf, 0xcb,
+	0xb6, 0xf2, 0xfd, 0xaa, 0x5d, 0xb9, 0xb8, 0x6a, 0x57, 0xfe, 0x5c, 0xb5, 0x2b, 0x9f, 0xf6, 0x3c,
+	0x5f, 0xf4, 0x87, 0xb6, 0xe1, 0x44, 0x83, 0xee, 0x80, 0x09, 0xdf, 0x09, 0x41, 0x7c, 0x8d, 0x92,
+	0xb3, 0x6e, 0xf9, 0x20, 0x7d, 0x2b, 0x9f, 0x24, 0x69, 0xd4, 0xae, 0xcb, 0x47, 0xe7, 0xf9, 0xbf,
+	0x01, 0x00, 0x66, 0xc0, 0x07, 0xa9, 0x1c, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -148,6 +326,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingValidatorUpdates) > 0 {
+		for iNdEx := len(m.PendingValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SignerKeys) > 0 {
+		for iNdEx := len(m.SignerKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.StakingSequences) > 0 {
 		for iNdEx := len(m.StakingSequences) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.StakingSequences[iNdEx])
@@ -196,6 +402,103 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignerKeyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerKeyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerKeyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverlapEndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OverlapEndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValidatorId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingValidatorUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingValidatorUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingValidatorUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Validator != nil {
+		{
+			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -231,6 +534,63 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SignerKeys) > 0 {
+		for _, e := range m.SignerKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingValidatorUpdates) > 0 {
+		for _, e := range m.PendingValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SignerKeyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovGenesis(uint64(m.ValidatorId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EndHeight))
+	}
+	if m.OverlapEndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.OverlapEndHeight))
+	}
+	return n
+}
+
+func (m *PendingValidatorUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.ActivationEpoch))
+	}
 	return n
 }
 
@@ -404,6 +764,375 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.StakingSequences = append(m.StakingSequences, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignerKeys = append(m.SignerKeys, &SignerKeyRecord{})
+			if err := m.SignerKeys[len(m.SignerKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingValidatorUpdates = append(m.PendingValidatorUpdates, &PendingValidatorUpdate{})
+			if err := m.PendingValidatorUpdates[len(m.PendingValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerKeyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerKeyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerKeyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapEndHeight", wireType)
+			}
+			m.OverlapEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingValidatorUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingValidatorUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingValidatorUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validator == nil {
+				m.Validator = &types.Validator{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -18,6 +18,9 @@ const (
 
 	// FeeToken fee token name
 	FeeToken = "matic"
+
+	// ConsensusVersion is version of staking store layout, store is migrated to it by app store migrations
+	ConsensusVersion uint64 = 2
 )
 
 func KeyPrefix(p string) []byte {
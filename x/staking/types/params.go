@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -8,19 +10,61 @@ const (
 
 	// DefaultProposerBonusPercent - Proposer Signer Reward Ratio
 	DefaultProposerBonusPercent = int64(10)
+
+	// DefaultSnapshotRetention - Heimdall blocks validator set snapshots are kept for, about a week of blocks. 0 keeps all snapshots
+	DefaultSnapshotRetention = uint64(100000)
+
+	// DefaultSignerOverlapBlocks - Heimdall blocks rotated out signer is still accepted for side-tx voting, 0 disables overlap
+	DefaultSignerOverlapBlocks = uint64(0)
 )
 
 // ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
 var ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
 
+// KeySnapshotRetention - Store's Key for validator set snapshot retention
+var KeySnapshotRetention = []byte("SnapshotRetention")
+
+// KeySignerOverlapBlocks - Store's Key for rotated signer overlap window
+var KeySignerOverlapBlocks = []byte("SignerOverlapBlocks")
+
 var KeyBondDenom = []byte("BondDenom")
 
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// DefaultParams returns default staking parameters
+func DefaultParams() Params {
+	return Params{
+		ProposerBonus:       uint64(DefaultProposerBonusPercent),
+		SnapshotRetention:   DefaultSnapshotRetention,
+		SignerOverlapBlocks: DefaultSignerOverlapBlocks,
+	}
+}
+
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable(
-		paramtypes.NewParamSetPair(ParamStoreKeyProposerBonusPercent, DefaultProposerBonusPercent, validateProposerBonusPercent),
-	// ParamStoreKeyProposerBonusPercent, DefaultProposerBonusPercent,
-	)
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyProposerBonusPercent, &p.ProposerBonus, validateProposerBonusPercent),
+		paramtypes.NewParamSetPair(KeySnapshotRetention, &p.SnapshotRetention, validateSnapshotRetention),
+		paramtypes.NewParamSetPair(KeySignerOverlapBlocks, &p.SignerOverlapBlocks, validateSignerOverlapBlocks),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateProposerBonusPercent(p.ProposerBonus); err != nil {
+		return err
+	}
+
+	if err := validateSnapshotRetention(p.SnapshotRetention); err != nil {
+		return err
+	}
+
+	return validateSignerOverlapBlocks(p.SignerOverlapBlocks)
 }
 
 func validateProposerBonusPercent(i interface{}) error {
@@ -35,3 +79,21 @@ func validateProposerBonusPercent(i interface{}) error {
 
 	return nil
 }
+
+// validateSnapshotRetention accepts zero which keeps all snapshots
+func validateSnapshotRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateSignerOverlapBlocks accepts zero which disables overlap
+func validateSignerOverlapBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
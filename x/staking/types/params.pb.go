@@ -25,7 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the staking module.
 type Params struct {
-	ProposerBonus uint64 `protobuf:"varint,1,opt,name=proposer_bonus,json=proposerBonus,proto3" json:"proposer_bonus,omitempty" yaml:"proposer_bonus"`
+	ProposerBonus       uint64 `protobuf:"varint,1,opt,name=proposer_bonus,json=proposerBonus,proto3" json:"proposer_bonus,omitempty" yaml:"proposer_bonus"`
+	SnapshotRetention   uint64 `protobuf:"varint,2,opt,name=snapshot_retention,json=snapshotRetention,proto3" json:"snapshot_retention,omitempty" yaml:"snapshot_retention"`
+	SignerOverlapBlocks uint64 `protobuf:"varint,3,opt,name=signer_overlap_blocks,json=signerOverlapBlocks,proto3" json:"signer_overlap_blocks,omitempty" yaml:"signer_overlap_blocks"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,6 +70,20 @@ func (m *Params) GetProposerBonus() uint64 {
 	return 0
 }
 
+func (m *Params) GetSnapshotRetention() uint64 {
+	if m != nil {
+		return m.SnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetSignerOverlapBlocks() uint64 {
+	if m != nil {
+		return m.SignerOverlapBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "heimdall.staking.v1beta1.Params")
 }
@@ -77,21 +93,27 @@ func init() {
 }
 
 var fileDescriptor_d5e384a18e0f9210 = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x48, 0xcd, 0xcc,
-	0x4d, 0x49, 0xcc, 0xc9, 0xd1, 0x2f, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0x95, 0x42, 0xb8, 0xd8, 0x02, 0xc0, 0xfa, 0x85,
-	0x1c, 0xb8, 0xf8, 0x0a, 0x8a, 0xf2, 0x0b, 0xf2, 0x8b, 0x53, 0x8b, 0xe2, 0x93, 0xf2, 0xf3, 0x4a,
-	0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x58, 0x9c, 0x24, 0x3f, 0xdd, 0x93, 0x17, 0xad, 0x4c, 0xcc,
-	0xcd, 0xb1, 0x52, 0x42, 0x95, 0x57, 0x0a, 0xe2, 0x85, 0x09, 0x38, 0x81, 0xf8, 0x56, 0x1c, 0x33,
-	0x16, 0xc8, 0x33, 0xbe, 0x58, 0x20, 0xcf, 0xe8, 0xe4, 0x75, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0xb9, 0x89, 0x25, 0x99, 0xc9, 0x79, 0xa9, 0x25, 0xe5, 0xf9, 0x45, 0xd9, 0xfa, 0x70, 0xef, 0x55,
-	0xc0, 0x3d, 0x58, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xa8, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0xa9, 0x57, 0x78, 0x69, 0x01, 0x01, 0x00, 0x00,
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4b, 0xf3, 0x40,
+	0x1c, 0x87, 0x7b, 0xef, 0x2b, 0x45, 0x02, 0x0a, 0x46, 0x0b, 0xa9, 0xe8, 0xa5, 0x04, 0x04, 0xa7,
+	0x9c, 0xc5, 0xad, 0x93, 0x64, 0x14, 0x41, 0x09, 0x4e, 0x2e, 0xe1, 0x52, 0x8f, 0xf4, 0x68, 0x72,
+	0xff, 0xe3, 0xee, 0x5a, 0xed, 0xb7, 0x70, 0x74, 0xec, 0xc7, 0x71, 0xec, 0xe8, 0x54, 0xa4, 0x5d,
+	0x9c, 0x33, 0x39, 0x4a, 0x2f, 0x49, 0x41, 0x74, 0xbb, 0x7b, 0xee, 0xe1, 0x19, 0xee, 0xe7, 0x9c,
+	0x8d, 0x18, 0x2f, 0x1e, 0x69, 0x9e, 0x13, 0x6d, 0xe8, 0x98, 0x8b, 0x8c, 0x4c, 0xfb, 0x29, 0x33,
+	0xb4, 0x4f, 0x24, 0x55, 0xb4, 0xd0, 0xa1, 0x54, 0x60, 0xc0, 0xf5, 0x1a, 0x2d, 0xac, 0xb5, 0xb0,
+	0xd6, 0x8e, 0x8f, 0x32, 0xc8, 0xc0, 0x4a, 0x64, 0x73, 0xaa, 0xfc, 0xe0, 0x0b, 0x39, 0xed, 0x3b,
+	0x1b, 0x70, 0xaf, 0x9c, 0x7d, 0xa9, 0x40, 0x82, 0x66, 0x2a, 0x49, 0x41, 0x4c, 0xb4, 0x87, 0x7a,
+	0xe8, 0x7c, 0x27, 0xea, 0x96, 0x4b, 0xbf, 0x33, 0xa3, 0x45, 0x3e, 0x08, 0x7e, 0xbe, 0x07, 0xf1,
+	0x5e, 0x03, 0xa2, 0xcd, 0xdd, 0xbd, 0x71, 0x5c, 0x2d, 0xa8, 0xd4, 0x23, 0x30, 0x89, 0x62, 0x86,
+	0x09, 0xc3, 0x41, 0x78, 0xff, 0x6c, 0xe5, 0xb4, 0x5c, 0xfa, 0xdd, 0xaa, 0xf2, 0xdb, 0x09, 0xe2,
+	0x83, 0x06, 0xc6, 0x0d, 0x73, 0xef, 0x9d, 0x8e, 0xe6, 0x99, 0x60, 0x2a, 0x81, 0x29, 0x53, 0x39,
+	0x95, 0x49, 0x9a, 0xc3, 0x70, 0xac, 0xbd, 0xff, 0x36, 0xd8, 0x2b, 0x97, 0xfe, 0x49, 0x1d, 0xfc,
+	0x4b, 0x0b, 0xe2, 0xc3, 0x8a, 0xdf, 0x56, 0x38, 0xb2, 0x74, 0xb0, 0xfb, 0x3a, 0xf7, 0xd1, 0xe7,
+	0xdc, 0x47, 0xd1, 0xf5, 0xdb, 0x0a, 0xa3, 0xc5, 0x0a, 0xa3, 0x8f, 0x15, 0x46, 0x2f, 0x6b, 0xdc,
+	0x5a, 0xac, 0x71, 0xeb, 0x7d, 0x8d, 0x5b, 0x0f, 0x17, 0x19, 0x37, 0xa3, 0x49, 0x1a, 0x0e, 0xa1,
+	0x20, 0x05, 0x35, 0x7c, 0x28, 0x98, 0x79, 0x02, 0x35, 0x26, 0xdb, 0x0d, 0x9e, 0xb7, 0x2b, 0x98,
+	0x99, 0x64, 0x3a, 0x6d, 0xdb, 0xdf, 0xbc, 0xfc, 0x1e, 0x00, 0xfb, 0xab, 0xb1, 0x63, 0xa6, 0x01,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -116,6 +138,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ProposerBonus != that1.ProposerBonus {
 		return false
 	}
+	if this.SnapshotRetention != that1.SnapshotRetention {
+		return false
+	}
+	if this.SignerOverlapBlocks != that1.SignerOverlapBlocks {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -138,6 +166,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignerOverlapBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignerOverlapBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SnapshotRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SnapshotRetention))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ProposerBonus != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.ProposerBonus))
 		i--
@@ -166,6 +204,12 @@ func (m *Params) Size() (n int) {
 	if m.ProposerBonus != 0 {
 		n += 1 + sovParams(uint64(m.ProposerBonus))
 	}
+	if m.SnapshotRetention != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotRetention))
+	}
+	if m.SignerOverlapBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SignerOverlapBlocks))
+	}
 	return n
 }
 
@@ -223,6 +267,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
+			}
+			m.SnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerOverlapBlocks", wireType)
+			}
+			m.SignerOverlapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignerOverlapBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -305,7 +305,6 @@ func (m *QueryStakingOldTxResponse) GetStatus() bool {
 	return false
 }
 
-//
 type QueryProposerRequest struct {
 	Times uint32 `protobuf:"varint,1,opt,name=times,proto3" json:"times,omitempty"`
 }
@@ -394,666 +393,4042 @@ func (m *QueryProposerResponse) GetProposers() []*types.Validator {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryValidatorRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorRequest")
-	proto.RegisterType((*QueryValidatorResponse)(nil), "heimdall.staking.v1beta1.QueryValidatorResponse")
-	proto.RegisterType((*QueryValidatorSetRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorSetRequest")
-	proto.RegisterType((*QueryValidatorSetResponse)(nil), "heimdall.staking.v1beta1.QueryValidatorSetResponse")
-	proto.RegisterType((*QueryStakingOldTxRequest)(nil), "heimdall.staking.v1beta1.QueryStakingOldTxRequest")
-	proto.RegisterType((*QueryStakingOldTxResponse)(nil), "heimdall.staking.v1beta1.QueryStakingOldTxResponse")
-	proto.RegisterType((*QueryProposerRequest)(nil), "heimdall.staking.v1beta1.QueryProposerRequest")
-	proto.RegisterType((*QueryProposerResponse)(nil), "heimdall.staking.v1beta1.QueryProposerResponse")
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("heimdall/staking/v1beta1/query.proto", fileDescriptor_f1573e611ce5e8a5)
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{8}
 }
-
-var fileDescriptor_f1573e611ce5e8a5 = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0x5e, 0x06, 0x1d, 0xab, 0xb7, 0x21, 0x64, 0x95, 0xa9, 0x8b, 0xa6, 0x6c, 0x33, 0x20, 0x36,
-	0x18, 0xf1, 0xda, 0x22, 0x21, 0xf5, 0x46, 0x4f, 0x8c, 0x0b, 0x5b, 0x8a, 0x38, 0x70, 0xa0, 0x72,
-	0x17, 0x2b, 0x8d, 0x96, 0xc6, 0x59, 0xed, 0x8e, 0x94, 0x69, 0x17, 0x9e, 0x00, 0x89, 0x37, 0x40,
-	0x1c, 0x10, 0xbc, 0x08, 0xc7, 0x49, 0x5c, 0x38, 0x4d, 0xa8, 0xe5, 0x09, 0xf6, 0x04, 0xa8, 0x8e,
-	0xeb, 0xb4, 0x53, 0xab, 0x96, 0x9b, 0x63, 0x7f, 0xff, 0x9c, 0xdf, 0x97, 0x80, 0xfb, 0x0d, 0xea,
-	0x37, 0x5d, 0x12, 0x04, 0x98, 0x0b, 0x72, 0xec, 0x87, 0x1e, 0x3e, 0x2d, 0xd4, 0xa9, 0x20, 0x05,
-	0x7c, 0xd2, 0xa6, 0xad, 0x8e, 0x1d, 0xb5, 0x98, 0x60, 0x30, 0x3f, 0x40, 0xd9, 0x0a, 0x65, 0x2b,
-	0x94, 0xf9, 0x40, 0xf3, 0xeb, 0x84, 0x53, 0x4d, 0x3e, 0x25, 0x81, 0xef, 0x12, 0xc1, 0x5a, 0x89,
-	0x80, 0xb9, 0xee, 0x31, 0xe6, 0x05, 0x14, 0x93, 0xc8, 0xc7, 0x24, 0x0c, 0x99, 0x20, 0xc2, 0x67,
-	0x21, 0x57, 0xa7, 0x39, 0x8f, 0x79, 0x4c, 0x2e, 0x71, 0x7f, 0x95, 0xec, 0xa2, 0x32, 0xb8, 0x7b,
-	0xd8, 0xcf, 0xf0, 0x66, 0xa0, 0xe5, 0xd0, 0x93, 0x36, 0xe5, 0x02, 0x6e, 0x81, 0x65, 0xad, 0x5f,
-	0xf3, 0xdd, 0xbc, 0xb1, 0x69, 0x6c, 0x67, 0x9c, 0x25, 0xbd, 0xb7, 0xef, 0xa2, 0x43, 0xb0, 0x7a,
-	0x9d, 0xcb, 0x23, 0x16, 0x72, 0x0a, 0x9f, 0x81, 0xac, 0x06, 0x4a, 0xe6, 0x52, 0x71, 0xcd, 0xd6,
-	0xd7, 0x13, 0x9d, 0x88, 0x72, 0x3b, 0x65, 0xa5, 0x58, 0x64, 0x82, 0xfc, 0xa8, 0x64, 0x95, 0x0a,
-	0x95, 0x08, 0xbd, 0x03, 0x6b, 0x63, 0xce, 0x94, 0xe3, 0x73, 0xb0, 0x92, 0xc6, 0xe5, 0x54, 0x28,
-	0xd7, 0xf5, 0x89, 0xae, 0x7d, 0x72, 0x7a, 0xc3, 0x2a, 0x15, 0xe8, 0x83, 0xf2, 0xae, 0x26, 0x6f,
-	0xff, 0x55, 0xe0, 0xbe, 0x8e, 0x07, 0x6f, 0xe3, 0x31, 0xb8, 0x25, 0xe2, 0x5a, 0x83, 0xf0, 0x86,
-	0x14, 0xce, 0x56, 0xe0, 0xd5, 0xe5, 0xc6, 0xed, 0x0e, 0x69, 0x06, 0x65, 0xa4, 0x0e, 0x90, 0xb3,
-	0x20, 0xe2, 0x17, 0x84, 0x37, 0x60, 0x01, 0x64, 0x03, 0xe6, 0xd5, 0xfc, 0xd0, 0xa5, 0x71, 0x7e,
-	0x7e, 0xd3, 0xd8, 0xbe, 0x59, 0xc9, 0x5d, 0x5d, 0x6e, 0xdc, 0x49, 0xe0, 0xfa, 0x08, 0x39, 0x8b,
-	0x01, 0xf3, 0xf6, 0xe5, 0xb2, 0xa4, 0xee, 0x36, 0xea, 0xad, 0xee, 0xb6, 0x0a, 0x16, 0xb8, 0x20,
-	0xa2, 0xcd, 0xa5, 0xf7, 0xa2, 0xa3, 0x9e, 0xd0, 0x2e, 0xc8, 0x49, 0xd2, 0x41, 0x8b, 0x45, 0x8c,
-	0x53, 0x3d, 0xba, 0x1c, 0xc8, 0x08, 0xbf, 0x49, 0x13, 0xf8, 0x8a, 0x93, 0x3c, 0xa0, 0x03, 0x35,
-	0xe9, 0x14, 0x9d, 0x0e, 0x2b, 0x52, 0x7b, 0x7d, 0xca, 0x8d, 0x29, 0xc3, 0xd2, 0xd8, 0xe2, 0x8f,
-	0x0c, 0xc8, 0x48, 0x49, 0xf8, 0xdd, 0x00, 0x59, 0x0d, 0x81, 0xd8, 0x9e, 0xd4, 0x64, 0x7b, 0x6c,
-	0xd7, 0xcc, 0xbd, 0xd9, 0x09, 0x49, 0x66, 0x54, 0xfe, 0xf8, 0xeb, 0xef, 0xe7, 0xf9, 0xa7, 0xb0,
-	0x88, 0x27, 0x7e, 0x5a, 0x7a, 0xb6, 0xf8, 0x6c, 0xb8, 0xc8, 0xe7, 0xf0, 0x9b, 0x01, 0x96, 0x87,
-	0x6b, 0x00, 0x8b, 0xb3, 0xda, 0xa7, 0x65, 0x34, 0x4b, 0xff, 0xc5, 0x51, 0xa9, 0xb1, 0x4c, 0xbd,
-	0x03, 0x1f, 0xce, 0x90, 0xfa, 0x09, 0xa7, 0x02, 0x7e, 0x31, 0xc0, 0xf2, 0x70, 0x25, 0xa6, 0x46,
-	0x1d, 0xd3, 0xdd, 0xa9, 0x51, 0xc7, 0x75, 0x0e, 0xed, 0xc8, 0xa8, 0xf7, 0xe0, 0xd6, 0xe4, 0xa8,
-	0x3e, 0x67, 0x81, 0x2b, 0x62, 0xf8, 0xd5, 0x00, 0x2b, 0x23, 0xcd, 0x82, 0xf6, 0x14, 0xc7, 0x6b,
-	0x85, 0x35, 0xf1, 0xcc, 0x78, 0x95, 0xae, 0x28, 0xd3, 0xed, 0xc2, 0x47, 0x93, 0xd3, 0x0d, 0x6a,
-	0x8a, 0xcf, 0x64, 0xfd, 0xcf, 0x2b, 0x2f, 0x7f, 0x76, 0x2d, 0xe3, 0xa2, 0x6b, 0x19, 0x7f, 0xba,
-	0x96, 0xf1, 0xa9, 0x67, 0xcd, 0x5d, 0xf4, 0xac, 0xb9, 0xdf, 0x3d, 0x6b, 0xee, 0xed, 0x9e, 0xe7,
-	0x8b, 0x46, 0xbb, 0x6e, 0x1f, 0xb1, 0x26, 0x6e, 0x12, 0xe1, 0x1f, 0x85, 0x54, 0xbc, 0x67, 0xad,
-	0xe3, 0x54, 0x3c, 0xd6, 0xf2, 0xf2, 0x73, 0xa8, 0x2f, 0xc8, 0x9f, 0x67, 0xe9, 0x5f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x99, 0xca, 0x73, 0x10, 0xd9, 0x05, 0x00, 0x00,
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Validator queries the validator that match by validator id.
-	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
-	// ValidatorSet queries the Current Validator Set
-	ValidatorSet(ctx context.Context, in *QueryValidatorSetRequest, opts ...grpc.CallOption) (*QueryValidatorSetResponse, error)
-	//
-	StakingOldTx(ctx context.Context, in *QueryStakingOldTxRequest, opts ...grpc.CallOption) (*QueryStakingOldTxResponse, error)
-	// Proposer
-	QueryProposer(ctx context.Context, in *QueryProposerRequest, opts ...grpc.CallOption) (*QueryProposerResponse, error)
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryValidatorSetAtHeightRequest is request type for the
+// Query/ValidatorSetAtHeight RPC method
+type QueryValidatorSetAtHeightRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
-	out := new(QueryValidatorResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/Validator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorSetAtHeightRequest) Reset()         { *m = QueryValidatorSetAtHeightRequest{} }
+func (m *QueryValidatorSetAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSetAtHeightRequest) ProtoMessage()    {}
+func (*QueryValidatorSetAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{10}
+}
+func (m *QueryValidatorSetAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSetAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSetAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorSetAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSetAtHeightRequest.Merge(m, src)
+}
+func (m *QueryValidatorSetAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSetAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSetAtHeightRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValidatorSet(ctx context.Context, in *QueryValidatorSetRequest, opts ...grpc.CallOption) (*QueryValidatorSetResponse, error) {
-	out := new(QueryValidatorSetResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/ValidatorSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorSetAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorSetAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) StakingOldTx(ctx context.Context, in *QueryStakingOldTxRequest, opts ...grpc.CallOption) (*QueryStakingOldTxResponse, error) {
-	out := new(QueryStakingOldTxResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/StakingOldTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryValidatorSetAtHeightResponse is response type for the
+// Query/ValidatorSetAtHeight RPC method
+type QueryValidatorSetAtHeightResponse struct {
+	// snapshot_height defines the height validator set was snapshotted at
+	SnapshotHeight int64               `protobuf:"varint,1,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+	ValidatorSet   *types.ValidatorSet `protobuf:"bytes,2,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
+}
+
+func (m *QueryValidatorSetAtHeightResponse) Reset()         { *m = QueryValidatorSetAtHeightResponse{} }
+func (m *QueryValidatorSetAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSetAtHeightResponse) ProtoMessage()    {}
+func (*QueryValidatorSetAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{11}
+}
+func (m *QueryValidatorSetAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSetAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSetAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidatorSetAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSetAtHeightResponse.Merge(m, src)
+}
+func (m *QueryValidatorSetAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSetAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSetAtHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryProposer(ctx context.Context, in *QueryProposerRequest, opts ...grpc.CallOption) (*QueryProposerResponse, error) {
-	out := new(QueryProposerResponse)
-	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/QueryProposer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorSetAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorSetAtHeightResponse) GetSnapshotHeight() int64 {
+	if m != nil {
+		return m.SnapshotHeight
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Validator queries the validator that match by validator id.
-	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
-	// ValidatorSet queries the Current Validator Set
-	ValidatorSet(context.Context, *QueryValidatorSetRequest) (*QueryValidatorSetResponse, error)
-	//
-	StakingOldTx(context.Context, *QueryStakingOldTxRequest) (*QueryStakingOldTxResponse, error)
-	// Proposer
-	QueryProposer(context.Context, *QueryProposerRequest) (*QueryProposerResponse, error)
+func (m *QueryValidatorSetAtHeightResponse) GetValidatorSet() *types.ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryValidatorSetForCheckpointRequest is request type for the
+// Query/ValidatorSetForCheckpoint RPC method
+type QueryValidatorSetForCheckpointRequest struct {
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+func (m *QueryValidatorSetForCheckpointRequest) Reset()         { *m = QueryValidatorSetForCheckpointRequest{} }
+func (m *QueryValidatorSetForCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSetForCheckpointRequest) ProtoMessage()    {}
+func (*QueryValidatorSetForCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{12}
 }
-func (*UnimplementedQueryServer) ValidatorSet(ctx context.Context, req *QueryValidatorSetRequest) (*QueryValidatorSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
+func (m *QueryValidatorSetForCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) StakingOldTx(ctx context.Context, req *QueryStakingOldTxRequest) (*QueryStakingOldTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StakingOldTx not implemented")
+func (m *QueryValidatorSetForCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSetForCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryProposer(ctx context.Context, req *QueryProposerRequest) (*QueryProposerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryProposer not implemented")
+func (m *QueryValidatorSetForCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSetForCheckpointRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryValidatorSetForCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSetForCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSetForCheckpointRequest.DiscardUnknown(m)
 }
 
-func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.staking.v1beta1.Query/Validator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+var xxx_messageInfo_QueryValidatorSetForCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorSetForCheckpointRequest) GetNumber() uint64 {
+	if m != nil {
+		return m.Number
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorSetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.staking.v1beta1.Query/ValidatorSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorSet(ctx, req.(*QueryValidatorSetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryValidatorSetForCheckpointResponse is response type for the
+// Query/ValidatorSetForCheckpoint RPC method
+type QueryValidatorSetForCheckpointResponse struct {
+	CheckpointNumber uint64              `protobuf:"varint,1,opt,name=checkpoint_number,json=checkpointNumber,proto3" json:"checkpoint_number,omitempty"`
+	SnapshotHeight   int64               `protobuf:"varint,2,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+	ValidatorSet     *types.ValidatorSet `protobuf:"bytes,3,opt,name=validator_set,json=validatorSet,proto3" json:"validator_set,omitempty"`
 }
 
-func _Query_StakingOldTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStakingOldTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StakingOldTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.staking.v1beta1.Query/StakingOldTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StakingOldTx(ctx, req.(*QueryStakingOldTxRequest))
+func (m *QueryValidatorSetForCheckpointResponse) Reset() {
+	*m = QueryValidatorSetForCheckpointResponse{}
+}
+func (m *QueryValidatorSetForCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSetForCheckpointResponse) ProtoMessage()    {}
+func (*QueryValidatorSetForCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{13}
+}
+func (m *QueryValidatorSetForCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSetForCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSetForCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorSetForCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSetForCheckpointResponse.Merge(m, src)
+}
+func (m *QueryValidatorSetForCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSetForCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSetForCheckpointResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryProposer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/heimdall.staking.v1beta1.Query/QueryProposer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryProposer(ctx, req.(*QueryProposerRequest))
+var xxx_messageInfo_QueryValidatorSetForCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorSetForCheckpointResponse) GetCheckpointNumber() uint64 {
+	if m != nil {
+		return m.CheckpointNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "heimdall.staking.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Validator",
-			Handler:    _Query_Validator_Handler,
-		},
-		{
-			MethodName: "ValidatorSet",
-			Handler:    _Query_ValidatorSet_Handler,
-		},
-		{
-			MethodName: "StakingOldTx",
-			Handler:    _Query_StakingOldTx_Handler,
-		},
-		{
-			MethodName: "QueryProposer",
-			Handler:    _Query_QueryProposer_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "heimdall/staking/v1beta1/query.proto",
+func (m *QueryValidatorSetForCheckpointResponse) GetSnapshotHeight() int64 {
+	if m != nil {
+		return m.SnapshotHeight
+	}
+	return 0
 }
 
-func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorSetForCheckpointResponse) GetValidatorSet() *types.ValidatorSet {
+	if m != nil {
+		return m.ValidatorSet
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorLifecycle is validator along with its lifecycle status and
+// rootchain event which last updated it
+type ValidatorLifecycle struct {
+	Validator *types.Validator `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// status is one of active, jailed, exiting, exited or inactive
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// last_updated_tx_hash, last_updated_block_number and last_updated_log_index
+	// locate rootchain staking event which last updated validator, tx hash is
+	// empty for events processed before it was recorded
+	LastUpdatedBlockNumber uint64 `protobuf:"varint,3,opt,name=last_updated_block_number,json=lastUpdatedBlockNumber,proto3" json:"last_updated_block_number,omitempty" yaml:"last_updated_block_number"`
+	LastUpdatedLogIndex    uint64 `protobuf:"varint,4,opt,name=last_updated_log_index,json=lastUpdatedLogIndex,proto3" json:"last_updated_log_index,omitempty" yaml:"last_updated_log_index"`
+	LastUpdatedTxHash      string `protobuf:"bytes,5,opt,name=last_updated_tx_hash,json=lastUpdatedTxHash,proto3" json:"last_updated_tx_hash,omitempty" yaml:"last_updated_tx_hash"`
 }
 
-func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatorId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *ValidatorLifecycle) Reset()         { *m = ValidatorLifecycle{} }
+func (m *ValidatorLifecycle) String() string { return proto.CompactTextString(m) }
+func (*ValidatorLifecycle) ProtoMessage()    {}
+func (*ValidatorLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{14}
 }
-
-func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorLifecycle.Merge(m, src)
+}
+func (m *ValidatorLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorLifecycle.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Validator != nil {
-		{
-			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ValidatorLifecycle proto.InternalMessageInfo
+
+func (m *ValidatorLifecycle) GetValidator() *types.Validator {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorSetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorLifecycle) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorLifecycle) GetLastUpdatedBlockNumber() uint64 {
+	if m != nil {
+		return m.LastUpdatedBlockNumber
+	}
+	return 0
 }
 
-func (m *QueryValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ValidatorLifecycle) GetLastUpdatedLogIndex() uint64 {
+	if m != nil {
+		return m.LastUpdatedLogIndex
+	}
+	return 0
 }
 
-func (m *QueryValidatorSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorLifecycle) GetLastUpdatedTxHash() string {
+	if m != nil {
+		return m.LastUpdatedTxHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorsRequest is request type for the Query/Validators RPC method
+type QueryValidatorsRequest struct {
+	// status filters validators by lifecycle status, empty matches all
+	Status     string                       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination *types.QueryPaginationParams `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatorSet != nil {
-		{
-			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryValidatorsRequest) Reset()         { *m = QueryValidatorsRequest{} }
+func (m *QueryValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsRequest) ProtoMessage()    {}
+func (*QueryValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{15}
+}
+func (m *QueryValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryStakingOldTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsRequest.Merge(m, src)
 }
-
-func (m *QueryStakingOldTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakingOldTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LogIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LogIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryValidatorsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryStakingOldTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorsRequest) GetPagination() *types.QueryPaginationParams {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStakingOldTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorsResponse is response type for the Query/Validators RPC method
+type QueryValidatorsResponse struct {
+	Validators []*ValidatorLifecycle `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	// total defines number of validators matching status
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (m *QueryStakingOldTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status {
-		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryValidatorsResponse) Reset()         { *m = QueryValidatorsResponse{} }
+func (m *QueryValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorsResponse) ProtoMessage()    {}
+func (*QueryValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{16}
+}
+func (m *QueryValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorsResponse.Merge(m, src)
+}
+func (m *QueryValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProposerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorsResponse) GetValidators() []*ValidatorLifecycle {
+	if m != nil {
+		return m.Validators
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProposerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
 
-func (m *QueryProposerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Times != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Times))
-		i--
-		dAtA[i] = 0x8
+// QueryValidatorByIDRequest is request type for the Query/ValidatorByID RPC
+// method
+type QueryValidatorByIDRequest struct {
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+}
+
+func (m *QueryValidatorByIDRequest) Reset()         { *m = QueryValidatorByIDRequest{} }
+func (m *QueryValidatorByIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorByIDRequest) ProtoMessage()    {}
+func (*QueryValidatorByIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{17}
+}
+func (m *QueryValidatorByIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorByIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorByIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorByIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorByIDRequest.Merge(m, src)
+}
+func (m *QueryValidatorByIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorByIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorByIDRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProposerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorByIDRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorByIDRequest) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryProposerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorBySignerRequest is request type for the
+// Query/ValidatorBySigner RPC method
+type QueryValidatorBySignerRequest struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *QueryProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proposers) > 0 {
-		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorBySignerRequest) Reset()         { *m = QueryValidatorBySignerRequest{} }
+func (m *QueryValidatorBySignerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorBySignerRequest) ProtoMessage()    {}
+func (*QueryValidatorBySignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{18}
+}
+func (m *QueryValidatorBySignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorBySignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorBySignerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorBySignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorBySignerRequest.Merge(m, src)
+}
+func (m *QueryValidatorBySignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorBySignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorBySignerRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryValidatorBySignerRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorBySignerRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ValidatorId != 0 {
-		n += 1 + sovQuery(uint64(m.ValidatorId))
-	}
-	return n
+
+// QueryValidatorLifecycleResponse is response type for the
+// Query/ValidatorByID and Query/ValidatorBySigner RPC methods
+type QueryValidatorLifecycleResponse struct {
+	Validator *ValidatorLifecycle `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *QueryValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Validator != nil {
-		l = m.Validator.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorLifecycleResponse) Reset()         { *m = QueryValidatorLifecycleResponse{} }
+func (m *QueryValidatorLifecycleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorLifecycleResponse) ProtoMessage()    {}
+func (*QueryValidatorLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{19}
+}
+func (m *QueryValidatorLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorLifecycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorLifecycleResponse.Merge(m, src)
+}
+func (m *QueryValidatorLifecycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorLifecycleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryValidatorLifecycleResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorLifecycleResponse) GetValidator() *ValidatorLifecycle {
+	if m != nil {
+		return m.Validator
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryValidatorSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ValidatorSet != nil {
-		l = m.ValidatorSet.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryPendingValidatorUpdatesRequest is request type for the
+// Query/PendingValidatorUpdates RPC method
+type QueryPendingValidatorUpdatesRequest struct {
+	// validator_id filters updates by validator, zero matches all
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
 }
 
-func (m *QueryStakingOldTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.LogIndex != 0 {
-		n += 1 + sovQuery(uint64(m.LogIndex))
+func (m *QueryPendingValidatorUpdatesRequest) Reset()         { *m = QueryPendingValidatorUpdatesRequest{} }
+func (m *QueryPendingValidatorUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingValidatorUpdatesRequest) ProtoMessage()    {}
+func (*QueryPendingValidatorUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{20}
+}
+func (m *QueryPendingValidatorUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingValidatorUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingValidatorUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingValidatorUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingValidatorUpdatesRequest.Merge(m, src)
+}
+func (m *QueryPendingValidatorUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingValidatorUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingValidatorUpdatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStakingOldTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPendingValidatorUpdatesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingValidatorUpdatesRequest) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	return 0
+}
+
+// QueryPendingValidatorUpdatesResponse is response type for the
+// Query/PendingValidatorUpdates RPC method
+type QueryPendingValidatorUpdatesResponse struct {
+	Updates []*PendingValidatorUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (m *QueryPendingValidatorUpdatesResponse) Reset()         { *m = QueryPendingValidatorUpdatesResponse{} }
+func (m *QueryPendingValidatorUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingValidatorUpdatesResponse) ProtoMessage()    {}
+func (*QueryPendingValidatorUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{21}
+}
+func (m *QueryPendingValidatorUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingValidatorUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingValidatorUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPendingValidatorUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingValidatorUpdatesResponse.Merge(m, src)
+}
+func (m *QueryPendingValidatorUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingValidatorUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingValidatorUpdatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProposerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPendingValidatorUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingValidatorUpdatesResponse) GetUpdates() []*PendingValidatorUpdate {
+	if m != nil {
+		return m.Updates
 	}
-	var l int
-	_ = l
-	if m.Times != 0 {
-		n += 1 + sovQuery(uint64(m.Times))
+	return nil
+}
+
+// QuerySignerKeyHistoryRequest is request type for the Query/SignerKeyHistory
+// RPC method, signer may be any current or past signer of validator
+type QuerySignerKeyHistoryRequest struct {
+	ValidatorId uint64 `protobuf:"varint,1,opt,name=validator_id,json=validatorId,proto3" json:"validator_id,omitempty"`
+	Signer      string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *QuerySignerKeyHistoryRequest) Reset()         { *m = QuerySignerKeyHistoryRequest{} }
+func (m *QuerySignerKeyHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerKeyHistoryRequest) ProtoMessage()    {}
+func (*QuerySignerKeyHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{22}
+}
+func (m *QuerySignerKeyHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerKeyHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerKeyHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QuerySignerKeyHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerKeyHistoryRequest.Merge(m, src)
+}
+func (m *QuerySignerKeyHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerKeyHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerKeyHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProposerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QuerySignerKeyHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySignerKeyHistoryRequest) GetValidatorId() uint64 {
+	if m != nil {
+		return m.ValidatorId
 	}
-	var l int
-	_ = l
-	if len(m.Proposers) > 0 {
-		for _, e := range m.Proposers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return 0
+}
+
+func (m *QuerySignerKeyHistoryRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return n
+	return ""
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QuerySignerKeyHistoryResponse is response type for the
+// Query/SignerKeyHistory RPC method
+type QuerySignerKeyHistoryResponse struct {
+	SignerKeys []*SignerKeyRecord `protobuf:"bytes,1,rep,name=signer_keys,json=signerKeys,proto3" json:"signer_keys,omitempty"`
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySignerKeyHistoryResponse) Reset()         { *m = QuerySignerKeyHistoryResponse{} }
+func (m *QuerySignerKeyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignerKeyHistoryResponse) ProtoMessage()    {}
+func (*QuerySignerKeyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1573e611ce5e8a5, []int{23}
 }
-func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *QuerySignerKeyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignerKeyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignerKeyHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignerKeyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignerKeyHistoryResponse.Merge(m, src)
+}
+func (m *QuerySignerKeyHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignerKeyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignerKeyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignerKeyHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySignerKeyHistoryResponse) GetSignerKeys() []*SignerKeyRecord {
+	if m != nil {
+		return m.SignerKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryValidatorRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorRequest")
+	proto.RegisterType((*QueryValidatorResponse)(nil), "heimdall.staking.v1beta1.QueryValidatorResponse")
+	proto.RegisterType((*QueryValidatorSetRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorSetRequest")
+	proto.RegisterType((*QueryValidatorSetResponse)(nil), "heimdall.staking.v1beta1.QueryValidatorSetResponse")
+	proto.RegisterType((*QueryStakingOldTxRequest)(nil), "heimdall.staking.v1beta1.QueryStakingOldTxRequest")
+	proto.RegisterType((*QueryStakingOldTxResponse)(nil), "heimdall.staking.v1beta1.QueryStakingOldTxResponse")
+	proto.RegisterType((*QueryProposerRequest)(nil), "heimdall.staking.v1beta1.QueryProposerRequest")
+	proto.RegisterType((*QueryProposerResponse)(nil), "heimdall.staking.v1beta1.QueryProposerResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "heimdall.staking.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "heimdall.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryValidatorSetAtHeightRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorSetAtHeightRequest")
+	proto.RegisterType((*QueryValidatorSetAtHeightResponse)(nil), "heimdall.staking.v1beta1.QueryValidatorSetAtHeightResponse")
+	proto.RegisterType((*QueryValidatorSetForCheckpointRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorSetForCheckpointRequest")
+	proto.RegisterType((*QueryValidatorSetForCheckpointResponse)(nil), "heimdall.staking.v1beta1.QueryValidatorSetForCheckpointResponse")
+	proto.RegisterType((*ValidatorLifecycle)(nil), "heimdall.staking.v1beta1.ValidatorLifecycle")
+	proto.RegisterType((*QueryValidatorsRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorsRequest")
+	proto.RegisterType((*QueryValidatorsResponse)(nil), "heimdall.staking.v1beta1.QueryValidatorsResponse")
+	proto.RegisterType((*QueryValidatorByIDRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorByIDRequest")
+	proto.RegisterType((*QueryValidatorBySignerRequest)(nil), "heimdall.staking.v1beta1.QueryValidatorBySignerRequest")
+	proto.RegisterType((*QueryValidatorLifecycleResponse)(nil), "heimdall.staking.v1beta1.QueryValidatorLifecycleResponse")
+	proto.RegisterType((*QueryPendingValidatorUpdatesRequest)(nil), "heimdall.staking.v1beta1.QueryPendingValidatorUpdatesRequest")
+	proto.RegisterType((*QueryPendingValidatorUpdatesResponse)(nil), "heimdall.staking.v1beta1.QueryPendingValidatorUpdatesResponse")
+	proto.RegisterType((*QuerySignerKeyHistoryRequest)(nil), "heimdall.staking.v1beta1.QuerySignerKeyHistoryRequest")
+	proto.RegisterType((*QuerySignerKeyHistoryResponse)(nil), "heimdall.staking.v1beta1.QuerySignerKeyHistoryResponse")
+}
+
+func init() {
+	proto.RegisterFile("heimdall/staking/v1beta1/query.proto", fileDescriptor_f1573e611ce5e8a5)
+}
+
+var fileDescriptor_f1573e611ce5e8a5 = []byte{
+	// 1386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xdb, 0x6e, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xe6, 0xd4, 0xe6, 0x6b, 0xd3, 0x7f, 0x33, 0x75, 0xd3, 0x64, 0xff, 0x6d, 0x9c, 0x6c,
+	0x93, 0x1e, 0x68, 0xe2, 0x6d, 0x12, 0x20, 0x6a, 0x4a, 0x53, 0x9a, 0x16, 0x94, 0x96, 0x0a, 0xda,
+	0x4d, 0x5b, 0x09, 0x2e, 0xb0, 0x36, 0xf6, 0xb0, 0x5e, 0x65, 0xbd, 0xb3, 0xf5, 0x4c, 0xda, 0x98,
+	0x28, 0x37, 0xbc, 0x40, 0x91, 0x78, 0x00, 0x24, 0xc4, 0x05, 0xe2, 0x0a, 0xf1, 0x06, 0x48, 0x20,
+	0xf5, 0xb2, 0x88, 0x1b, 0xae, 0x2c, 0x94, 0xf0, 0x00, 0x28, 0x4f, 0x80, 0x3c, 0x33, 0x7b, 0xb2,
+	0x77, 0xe3, 0x75, 0xb8, 0xca, 0xce, 0xcc, 0x77, 0xf8, 0x7d, 0x87, 0xf9, 0xe6, 0xe7, 0xc0, 0x74,
+	0x05, 0xdb, 0xd5, 0xb2, 0xe9, 0x38, 0x3a, 0x65, 0xe6, 0xa6, 0xed, 0x5a, 0xfa, 0x8b, 0xf9, 0x0d,
+	0xcc, 0xcc, 0x79, 0xfd, 0xf9, 0x16, 0xae, 0xd5, 0x0b, 0x5e, 0x8d, 0x30, 0x82, 0xc6, 0x7c, 0xa9,
+	0x82, 0x94, 0x2a, 0x48, 0x29, 0x75, 0x26, 0xd0, 0xdf, 0x30, 0x29, 0x0e, 0x94, 0x5f, 0x98, 0x8e,
+	0x5d, 0x36, 0x19, 0xa9, 0x09, 0x03, 0xea, 0x54, 0xb2, 0x58, 0xc4, 0x47, 0xc4, 0x52, 0x2b, 0x12,
+	0xcf, 0xac, 0x99, 0x55, 0x2a, 0xc5, 0x2e, 0xa5, 0x8a, 0x59, 0xd8, 0xc5, 0xd4, 0xf6, 0xe5, 0xce,
+	0x5b, 0x84, 0x58, 0x0e, 0xd6, 0x4d, 0xcf, 0xd6, 0x4d, 0xd7, 0x25, 0xcc, 0x64, 0x36, 0x71, 0xfd,
+	0xd3, 0x9c, 0x45, 0x2c, 0xc2, 0x3f, 0xf5, 0xe6, 0x97, 0xd8, 0xd5, 0x96, 0xe1, 0xec, 0xe3, 0x26,
+	0xa2, 0x67, 0x3e, 0x7a, 0x03, 0x3f, 0xdf, 0xc2, 0x94, 0xa1, 0x29, 0x38, 0x19, 0x44, 0x54, 0xb4,
+	0xcb, 0x63, 0xca, 0xa4, 0x72, 0x65, 0xc0, 0x38, 0x11, 0xec, 0xdd, 0x2f, 0x6b, 0x8f, 0x61, 0xb4,
+	0x55, 0x97, 0x7a, 0xc4, 0xa5, 0x18, 0x2d, 0xc1, 0x50, 0x20, 0xc8, 0x35, 0x4f, 0x2c, 0x8c, 0x17,
+	0x82, 0x84, 0xb2, 0xba, 0x87, 0x69, 0x21, 0xd4, 0x0a, 0x65, 0x35, 0x15, 0xc6, 0xe2, 0x26, 0xd7,
+	0x31, 0x93, 0x88, 0xb4, 0xcf, 0x61, 0x3c, 0xe1, 0x4c, 0x7a, 0xbc, 0x03, 0xc3, 0x21, 0x5c, 0x8a,
+	0x99, 0xf4, 0x7a, 0x3e, 0xd5, 0x6b, 0x53, 0x39, 0x8c, 0x70, 0x1d, 0x33, 0xed, 0x4b, 0xe9, 0x7b,
+	0x5d, 0x24, 0xf9, 0x13, 0xa7, 0xfc, 0x64, 0xdb, 0xcf, 0xc6, 0x35, 0x38, 0xc6, 0xb6, 0x8b, 0x15,
+	0x93, 0x56, 0xb8, 0xe1, 0xa1, 0x55, 0x74, 0xd0, 0xc8, 0x9f, 0xaa, 0x9b, 0x55, 0x67, 0x59, 0x93,
+	0x07, 0x9a, 0x31, 0xc8, 0xb6, 0xd7, 0x4c, 0x5a, 0x41, 0xf3, 0x30, 0xe4, 0x10, 0xab, 0x68, 0xbb,
+	0x65, 0xbc, 0x3d, 0xd6, 0x3b, 0xa9, 0x5c, 0xe9, 0x5f, 0xcd, 0x1d, 0x34, 0xf2, 0xa7, 0x85, 0x78,
+	0x70, 0xa4, 0x19, 0xc7, 0x1d, 0x62, 0xdd, 0xe7, 0x9f, 0x8b, 0x30, 0x9e, 0xe0, 0x5b, 0xc6, 0x36,
+	0x0a, 0x83, 0x94, 0x99, 0x6c, 0x8b, 0x72, 0xdf, 0xc7, 0x0d, 0xb9, 0xd2, 0x66, 0x21, 0xc7, 0x95,
+	0x1e, 0xd5, 0x88, 0x47, 0x28, 0x0e, 0x4a, 0x97, 0x83, 0x01, 0x66, 0x57, 0xb1, 0x10, 0x1f, 0x36,
+	0xc4, 0x42, 0x7b, 0x04, 0x67, 0x5b, 0xa4, 0xc3, 0x62, 0x79, 0x72, 0xaf, 0xa9, 0xd2, 0xd7, 0xa1,
+	0x58, 0x81, 0xac, 0x96, 0x03, 0x24, 0x2c, 0xf2, 0x66, 0xf5, 0xcb, 0xf4, 0x14, 0xce, 0xc4, 0x76,
+	0xa5, 0x97, 0x15, 0x18, 0x14, 0x4d, 0x2d, 0x2b, 0x33, 0x59, 0x48, 0xbb, 0x60, 0x05, 0xa1, 0xb9,
+	0xda, 0xff, 0xba, 0x91, 0xef, 0x31, 0xa4, 0x96, 0xb6, 0x0c, 0x93, 0x6d, 0xd5, 0xbf, 0xc3, 0xd6,
+	0xb0, 0x6d, 0x55, 0xfc, 0x0e, 0x69, 0x26, 0xaa, 0xc2, 0x37, 0xb8, 0x8f, 0x3e, 0x43, 0xae, 0xb4,
+	0x57, 0x0a, 0x4c, 0x1d, 0xa2, 0x2c, 0x11, 0x5e, 0x86, 0xff, 0x51, 0xd7, 0xf4, 0x68, 0x85, 0xb0,
+	0x62, 0xcc, 0xcc, 0x29, 0x7f, 0x5b, 0x28, 0xb4, 0xf7, 0x5a, 0x6f, 0xd7, 0xbd, 0x76, 0x1b, 0x66,
+	0xda, 0x00, 0x7d, 0x48, 0x6a, 0x77, 0x2b, 0xb8, 0xb4, 0xe9, 0x11, 0xdb, 0x8d, 0x86, 0xe4, 0x6e,
+	0x55, 0x37, 0xb0, 0xb8, 0x46, 0xfd, 0x86, 0x5c, 0x69, 0xbf, 0x28, 0x70, 0xa9, 0x93, 0x05, 0x19,
+	0xd7, 0x35, 0x18, 0x29, 0x05, 0xbb, 0xc5, 0x98, 0xb5, 0xd3, 0xe1, 0xc1, 0xc7, 0x7c, 0x3f, 0x29,
+	0x09, 0xbd, 0xd9, 0x92, 0xd0, 0xd7, 0x75, 0x12, 0xfe, 0xe9, 0x05, 0x14, 0x1c, 0x3f, 0xb4, 0xbf,
+	0xc0, 0xa5, 0x7a, 0xc9, 0x39, 0xfa, 0xf0, 0x88, 0xdc, 0x93, 0x26, 0xe4, 0x21, 0xff, 0x9e, 0xa0,
+	0x22, 0x8c, 0x3b, 0x26, 0x65, 0xc5, 0x2d, 0xaf, 0x6c, 0x32, 0x5c, 0x2e, 0x6e, 0x38, 0xa4, 0xb4,
+	0xe9, 0x27, 0xa2, 0x8f, 0xdf, 0xcf, 0xe9, 0x83, 0x46, 0x7e, 0x52, 0xde, 0xcf, 0x34, 0x51, 0xcd,
+	0x18, 0x6d, 0x9e, 0x3d, 0x15, 0x47, 0xab, 0xcd, 0x13, 0x99, 0xb4, 0x67, 0x30, 0x1a, 0xd3, 0x0a,
+	0x6f, 0x7f, 0x3f, 0xb7, 0x3e, 0x75, 0xd0, 0xc8, 0x5f, 0x48, 0xb0, 0x1e, 0x19, 0x05, 0x67, 0x22,
+	0xa6, 0x1f, 0xca, 0xa9, 0x80, 0x1e, 0x41, 0x2e, 0x26, 0xef, 0x8f, 0xa0, 0x01, 0x3e, 0x82, 0xf2,
+	0x07, 0x8d, 0xfc, 0xff, 0x13, 0xac, 0x06, 0xf3, 0x68, 0x24, 0x62, 0xf3, 0x09, 0x1f, 0x4d, 0xda,
+	0xcb, 0xd6, 0x91, 0x4d, 0x23, 0x8d, 0x16, 0x19, 0x32, 0x61, 0xf2, 0x3e, 0x00, 0xf0, 0x4c, 0xcb,
+	0x76, 0xf9, 0x5b, 0x22, 0x3b, 0x7d, 0xa6, 0xb5, 0x1c, 0xf2, 0xc2, 0xfb, 0x62, 0xf2, 0xea, 0x47,
+	0x14, 0xb5, 0x5d, 0x38, 0xd7, 0xe6, 0x58, 0xf6, 0xe7, 0x43, 0x80, 0xa0, 0x86, 0xfe, 0x00, 0x9a,
+	0x4d, 0x9f, 0x0e, 0xed, 0x1d, 0x63, 0x44, 0xf4, 0xf9, 0xf0, 0x23, 0xcc, 0x74, 0xc4, 0xe0, 0x35,
+	0xc4, 0x42, 0x5b, 0x69, 0x7d, 0x3b, 0x56, 0xeb, 0xf7, 0xef, 0x1d, 0xf6, 0xd4, 0xf5, 0xc7, 0x9f,
+	0xba, 0x25, 0xb8, 0xd0, 0xaa, 0xbf, 0x6e, 0x5b, 0x2e, 0xae, 0x45, 0xd3, 0xc7, 0x37, 0x82, 0xf4,
+	0xf1, 0x95, 0x56, 0x85, 0x7c, 0x5c, 0x31, 0x44, 0xed, 0xc7, 0xff, 0xa0, 0xbd, 0xdf, 0xbb, 0x0b,
+	0x3f, 0xf2, 0x7e, 0xae, 0xc1, 0x45, 0x51, 0x0b, 0xec, 0x96, 0x6d, 0xd7, 0x0a, 0x84, 0x45, 0x17,
+	0xd0, 0x2e, 0x22, 0xae, 0xc1, 0xf4, 0xe1, 0x96, 0x02, 0xf4, 0xc7, 0x44, 0xe3, 0xf9, 0xa5, 0xbb,
+	0x7e, 0xc8, 0x60, 0x4f, 0xb4, 0x65, 0xf8, 0x06, 0xb4, 0x4f, 0xe1, 0xbc, 0x78, 0x05, 0x79, 0xee,
+	0x3e, 0xc2, 0xf5, 0x35, 0x9b, 0x32, 0x52, 0xab, 0x67, 0x87, 0x1d, 0xa9, 0x43, 0x6f, 0xac, 0x0e,
+	0x9b, 0x70, 0x21, 0xc5, 0x74, 0x10, 0xc7, 0x09, 0x21, 0x5a, 0xdc, 0xc4, 0x75, 0x3f, 0x96, 0xab,
+	0xe9, 0xb1, 0x04, 0x86, 0x0c, 0x5c, 0x22, 0xb5, 0xb2, 0x01, 0xd4, 0xdf, 0xa0, 0x0b, 0x3f, 0x8d,
+	0xc0, 0x00, 0xf7, 0x86, 0x7e, 0x54, 0x60, 0x28, 0x08, 0x17, 0xe9, 0xe9, 0xe6, 0x12, 0x49, 0x98,
+	0x7a, 0x3d, 0xbb, 0x82, 0x08, 0x43, 0x5b, 0xfe, 0xea, 0x8f, 0xbf, 0xbf, 0xe9, 0x7d, 0x1b, 0x2d,
+	0xe8, 0xa9, 0xa4, 0x31, 0x48, 0x97, 0xbe, 0x13, 0xcd, 0xe6, 0x2e, 0xfa, 0x41, 0x81, 0x93, 0xd1,
+	0x71, 0x8d, 0x16, 0xb2, 0xba, 0x0f, 0x59, 0x9a, 0xba, 0xd8, 0x95, 0x8e, 0x44, 0xad, 0x73, 0xd4,
+	0x57, 0xd1, 0xe5, 0x0c, 0xa8, 0xe7, 0x28, 0x66, 0xe8, 0x3b, 0x05, 0x4e, 0x46, 0xb9, 0x52, 0x47,
+	0xa8, 0x09, 0xa4, 0x4e, 0x5d, 0xec, 0x4a, 0x47, 0x42, 0xbd, 0xca, 0xa1, 0x5e, 0x44, 0x53, 0xe9,
+	0x50, 0x6d, 0x4a, 0x9c, 0x32, 0xdb, 0x46, 0xdf, 0x2b, 0x30, 0x1c, 0xa3, 0x5c, 0xa8, 0xd0, 0xc1,
+	0x63, 0x0b, 0x93, 0x53, 0xf5, 0xcc, 0xf2, 0x12, 0xdd, 0x02, 0x47, 0x37, 0x8b, 0xde, 0x4a, 0x47,
+	0xe7, 0xf3, 0x37, 0x7d, 0x87, 0xf3, 0xc2, 0x5d, 0xf4, 0x4a, 0x81, 0x41, 0x31, 0xb1, 0xd1, 0x6c,
+	0x27, 0x7f, 0x51, 0xa6, 0xa7, 0xce, 0x65, 0x94, 0x96, 0xd8, 0xae, 0x70, 0x6c, 0x1a, 0x9a, 0xd4,
+	0x3b, 0xfc, 0xec, 0x41, 0x6f, 0x14, 0xc8, 0x25, 0x51, 0x35, 0xb4, 0xdc, 0x45, 0x73, 0xb5, 0x90,
+	0x43, 0xf5, 0xe6, 0x91, 0x74, 0x25, 0xf6, 0xdb, 0x1c, 0xfb, 0x0d, 0xb4, 0x94, 0xb1, 0x41, 0x75,
+	0xc1, 0x9d, 0xf4, 0x1d, 0xf1, 0x77, 0x17, 0xed, 0x2b, 0x30, 0x9e, 0x4a, 0xd5, 0xd0, 0xed, 0x2e,
+	0xb0, 0x25, 0xd1, 0x44, 0xf5, 0xfd, 0xa3, 0x1b, 0x90, 0x11, 0xde, 0xe5, 0x11, 0xde, 0x42, 0x37,
+	0xb3, 0x46, 0x18, 0x52, 0x47, 0x7d, 0x47, 0x10, 0xa4, 0x5d, 0xf4, 0xad, 0x02, 0xf0, 0x2c, 0x7c,
+	0x8b, 0x33, 0x8f, 0xaf, 0xa0, 0xa5, 0xe6, 0xbb, 0xd0, 0x90, 0xc0, 0x67, 0x39, 0xf0, 0x4b, 0x68,
+	0x3a, 0x03, 0x70, 0x8a, 0x7e, 0x53, 0x60, 0x38, 0x46, 0x02, 0x50, 0xe6, 0x81, 0x15, 0xa1, 0x0c,
+	0xea, 0x8d, 0xac, 0x4a, 0x6d, 0xcf, 0xbd, 0x76, 0x8f, 0xe3, 0x5d, 0x41, 0xef, 0x75, 0x3f, 0xa1,
+	0x75, 0x27, 0x20, 0xc9, 0xbf, 0x2a, 0x30, 0xd2, 0x46, 0x46, 0xd0, 0x52, 0xf6, 0x58, 0x62, 0xf4,
+	0xe5, 0xbf, 0xc4, 0x73, 0x93, 0xc7, 0xf3, 0x0e, 0x5a, 0xcc, 0x12, 0x8f, 0x78, 0x24, 0xf5, 0x1d,
+	0xf1, 0x77, 0x17, 0xfd, 0xae, 0xc0, 0xb9, 0x14, 0x86, 0x81, 0x6e, 0x75, 0x1a, 0x2f, 0x87, 0x72,
+	0x1c, 0x75, 0xe5, 0xa8, 0xea, 0x32, 0xae, 0x25, 0x1e, 0xd7, 0x3c, 0xd2, 0xb3, 0x5e, 0x08, 0x4f,
+	0x18, 0x44, 0x3f, 0x2b, 0x70, 0xba, 0x95, 0x66, 0xa0, 0x77, 0x3b, 0xbd, 0x35, 0xc9, 0x94, 0x47,
+	0x5d, 0xea, 0x5a, 0x4f, 0xc2, 0x9f, 0xe3, 0xf0, 0x2f, 0xa3, 0x99, 0x74, 0xf8, 0xa2, 0x08, 0x73,
+	0x4d, 0xbe, 0xb3, 0xfa, 0xe0, 0xf5, 0xde, 0x84, 0xf2, 0x66, 0x6f, 0x42, 0xf9, 0x6b, 0x6f, 0x42,
+	0xf9, 0x7a, 0x7f, 0xa2, 0xe7, 0xcd, 0xfe, 0x44, 0xcf, 0x9f, 0xfb, 0x13, 0x3d, 0x9f, 0x5d, 0xb7,
+	0x6c, 0x56, 0xd9, 0xda, 0x28, 0x94, 0x48, 0x55, 0xaf, 0x9a, 0xcc, 0x2e, 0xb9, 0x98, 0xbd, 0x24,
+	0xb5, 0xcd, 0xd0, 0xee, 0x76, 0x60, 0x99, 0xff, 0x1a, 0xd8, 0x18, 0xe4, 0xff, 0x5a, 0x5a, 0xfc,
+	0x77, 0x00, 0xc4, 0x72, 0x28, 0x3a, 0x69, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Validator queries the validator that match by validator id.
+	Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error)
+	// ValidatorSet queries the Current Validator Set
+	ValidatorSet(ctx context.Context, in *QueryValidatorSetRequest, opts ...grpc.CallOption) (*QueryValidatorSetResponse, error)
+	//
+	StakingOldTx(ctx context.Context, in *QueryStakingOldTxRequest, opts ...grpc.CallOption) (*QueryStakingOldTxResponse, error)
+	// Proposer
+	QueryProposer(ctx context.Context, in *QueryProposerRequest, opts ...grpc.CallOption) (*QueryProposerResponse, error)
+	// Params queries the parameters of staking module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorSetAtHeight queries the validator set snapshot in effect at a
+	// heimdall height
+	ValidatorSetAtHeight(ctx context.Context, in *QueryValidatorSetAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorSetAtHeightResponse, error)
+	// ValidatorSetForCheckpoint queries the validator set snapshot referenced
+	// by an acked checkpoint
+	ValidatorSetForCheckpoint(ctx context.Context, in *QueryValidatorSetForCheckpointRequest, opts ...grpc.CallOption) (*QueryValidatorSetForCheckpointResponse, error)
+	// Validators queries all validators filtered by lifecycle status
+	Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error)
+	// ValidatorByID queries lifecycle of the validator with validator id
+	ValidatorByID(ctx context.Context, in *QueryValidatorByIDRequest, opts ...grpc.CallOption) (*QueryValidatorLifecycleResponse, error)
+	// ValidatorBySigner queries lifecycle of the validator with signer address
+	ValidatorBySigner(ctx context.Context, in *QueryValidatorBySignerRequest, opts ...grpc.CallOption) (*QueryValidatorLifecycleResponse, error)
+	// PendingValidatorUpdates queries validator updates staged for activation
+	PendingValidatorUpdates(ctx context.Context, in *QueryPendingValidatorUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingValidatorUpdatesResponse, error)
+	// SignerKeyHistory queries all signer keys used by validator with
+	// validator id or signer address
+	SignerKeyHistory(ctx context.Context, in *QuerySignerKeyHistoryRequest, opts ...grpc.CallOption) (*QuerySignerKeyHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Validator(ctx context.Context, in *QueryValidatorRequest, opts ...grpc.CallOption) (*QueryValidatorResponse, error) {
+	out := new(QueryValidatorResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/Validator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSet(ctx context.Context, in *QueryValidatorSetRequest, opts ...grpc.CallOption) (*QueryValidatorSetResponse, error) {
+	out := new(QueryValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/ValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingOldTx(ctx context.Context, in *QueryStakingOldTxRequest, opts ...grpc.CallOption) (*QueryStakingOldTxResponse, error) {
+	out := new(QueryStakingOldTxResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/StakingOldTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryProposer(ctx context.Context, in *QueryProposerRequest, opts ...grpc.CallOption) (*QueryProposerResponse, error) {
+	out := new(QueryProposerResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/QueryProposer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSetAtHeight(ctx context.Context, in *QueryValidatorSetAtHeightRequest, opts ...grpc.CallOption) (*QueryValidatorSetAtHeightResponse, error) {
+	out := new(QueryValidatorSetAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/ValidatorSetAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSetForCheckpoint(ctx context.Context, in *QueryValidatorSetForCheckpointRequest, opts ...grpc.CallOption) (*QueryValidatorSetForCheckpointResponse, error) {
+	out := new(QueryValidatorSetForCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/ValidatorSetForCheckpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Validators(ctx context.Context, in *QueryValidatorsRequest, opts ...grpc.CallOption) (*QueryValidatorsResponse, error) {
+	out := new(QueryValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorByID(ctx context.Context, in *QueryValidatorByIDRequest, opts ...grpc.CallOption) (*QueryValidatorLifecycleResponse, error) {
+	out := new(QueryValidatorLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/ValidatorByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorBySigner(ctx context.Context, in *QueryValidatorBySignerRequest, opts ...grpc.CallOption) (*QueryValidatorLifecycleResponse, error) {
+	out := new(QueryValidatorLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/ValidatorBySigner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingValidatorUpdates(ctx context.Context, in *QueryPendingValidatorUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingValidatorUpdatesResponse, error) {
+	out := new(QueryPendingValidatorUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/PendingValidatorUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignerKeyHistory(ctx context.Context, in *QuerySignerKeyHistoryRequest, opts ...grpc.CallOption) (*QuerySignerKeyHistoryResponse, error) {
+	out := new(QuerySignerKeyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.staking.v1beta1.Query/SignerKeyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Validator queries the validator that match by validator id.
+	Validator(context.Context, *QueryValidatorRequest) (*QueryValidatorResponse, error)
+	// ValidatorSet queries the Current Validator Set
+	ValidatorSet(context.Context, *QueryValidatorSetRequest) (*QueryValidatorSetResponse, error)
+	//
+	StakingOldTx(context.Context, *QueryStakingOldTxRequest) (*QueryStakingOldTxResponse, error)
+	// Proposer
+	QueryProposer(context.Context, *QueryProposerRequest) (*QueryProposerResponse, error)
+	// Params queries the parameters of staking module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorSetAtHeight queries the validator set snapshot in effect at a
+	// heimdall height
+	ValidatorSetAtHeight(context.Context, *QueryValidatorSetAtHeightRequest) (*QueryValidatorSetAtHeightResponse, error)
+	// ValidatorSetForCheckpoint queries the validator set snapshot referenced
+	// by an acked checkpoint
+	ValidatorSetForCheckpoint(context.Context, *QueryValidatorSetForCheckpointRequest) (*QueryValidatorSetForCheckpointResponse, error)
+	// Validators queries all validators filtered by lifecycle status
+	Validators(context.Context, *QueryValidatorsRequest) (*QueryValidatorsResponse, error)
+	// ValidatorByID queries lifecycle of the validator with validator id
+	ValidatorByID(context.Context, *QueryValidatorByIDRequest) (*QueryValidatorLifecycleResponse, error)
+	// ValidatorBySigner queries lifecycle of the validator with signer address
+	ValidatorBySigner(context.Context, *QueryValidatorBySignerRequest) (*QueryValidatorLifecycleResponse, error)
+	// PendingValidatorUpdates queries validator updates staged for activation
+	PendingValidatorUpdates(context.Context, *QueryPendingValidatorUpdatesRequest) (*QueryPendingValidatorUpdatesResponse, error)
+	// SignerKeyHistory queries all signer keys used by validator with
+	// validator id or signer address
+	SignerKeyHistory(context.Context, *QuerySignerKeyHistoryRequest) (*QuerySignerKeyHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Validator(ctx context.Context, req *QueryValidatorRequest) (*QueryValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validator not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSet(ctx context.Context, req *QueryValidatorSetRequest) (*QueryValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSet not implemented")
+}
+func (*UnimplementedQueryServer) StakingOldTx(ctx context.Context, req *QueryStakingOldTxRequest) (*QueryStakingOldTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingOldTx not implemented")
+}
+func (*UnimplementedQueryServer) QueryProposer(ctx context.Context, req *QueryProposerRequest) (*QueryProposerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProposer not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSetAtHeight(ctx context.Context, req *QueryValidatorSetAtHeightRequest) (*QueryValidatorSetAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSetForCheckpoint(ctx context.Context, req *QueryValidatorSetForCheckpointRequest) (*QueryValidatorSetForCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSetForCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryValidatorsRequest) (*QueryValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorByID(ctx context.Context, req *QueryValidatorByIDRequest) (*QueryValidatorLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorByID not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorBySigner(ctx context.Context, req *QueryValidatorBySignerRequest) (*QueryValidatorLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorBySigner not implemented")
+}
+func (*UnimplementedQueryServer) PendingValidatorUpdates(ctx context.Context, req *QueryPendingValidatorUpdatesRequest) (*QueryPendingValidatorUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingValidatorUpdates not implemented")
+}
+func (*UnimplementedQueryServer) SignerKeyHistory(ctx context.Context, req *QuerySignerKeyHistoryRequest) (*QuerySignerKeyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignerKeyHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Validator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/Validator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validator(ctx, req.(*QueryValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/ValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSet(ctx, req.(*QueryValidatorSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingOldTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingOldTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingOldTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/StakingOldTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingOldTx(ctx, req.(*QueryStakingOldTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/QueryProposer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProposer(ctx, req.(*QueryProposerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSetAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/ValidatorSetAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetAtHeight(ctx, req.(*QueryValidatorSetAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSetForCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSetForCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSetForCheckpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/ValidatorSetForCheckpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSetForCheckpoint(ctx, req.(*QueryValidatorSetForCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Validators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validators(ctx, req.(*QueryValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/ValidatorByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorByID(ctx, req.(*QueryValidatorByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorBySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorBySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/ValidatorBySigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorBySigner(ctx, req.(*QueryValidatorBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingValidatorUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingValidatorUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingValidatorUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/PendingValidatorUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingValidatorUpdates(ctx, req.(*QueryPendingValidatorUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignerKeyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignerKeyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignerKeyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.staking.v1beta1.Query/SignerKeyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignerKeyHistory(ctx, req.(*QuerySignerKeyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "heimdall.staking.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "ValidatorSet",
+			Handler:    _Query_ValidatorSet_Handler,
+		},
+		{
+			MethodName: "StakingOldTx",
+			Handler:    _Query_StakingOldTx_Handler,
+		},
+		{
+			MethodName: "QueryProposer",
+			Handler:    _Query_QueryProposer_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorSetAtHeight",
+			Handler:    _Query_ValidatorSetAtHeight_Handler,
+		},
+		{
+			MethodName: "ValidatorSetForCheckpoint",
+			Handler:    _Query_ValidatorSetForCheckpoint_Handler,
+		},
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "ValidatorByID",
+			Handler:    _Query_ValidatorByID_Handler,
+		},
+		{
+			MethodName: "ValidatorBySigner",
+			Handler:    _Query_ValidatorBySigner_Handler,
+		},
+		{
+			MethodName: "PendingValidatorUpdates",
+			Handler:    _Query_PendingValidatorUpdates_Handler,
+		},
+		{
+			MethodName: "SignerKeyHistory",
+			Handler:    _Query_SignerKeyHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "heimdall/staking/v1beta1/query.proto",
+}
+
+func (m *QueryValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Validator != nil {
+		{
+			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingOldTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingOldTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingOldTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingOldTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingOldTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingOldTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Times != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Times))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposers) > 0 {
+		for iNdEx := len(m.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSetAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSetAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSetAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSetAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSetAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSetAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SnapshotHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSetForCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSetForCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSetForCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSetForCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSetForCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSetForCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		{
+			size, err := m.ValidatorSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SnapshotHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CheckpointNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastUpdatedTxHash) > 0 {
+		i -= len(m.LastUpdatedTxHash)
+		copy(dAtA[i:], m.LastUpdatedTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastUpdatedTxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastUpdatedLogIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdatedLogIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastUpdatedBlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdatedBlockNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Validator != nil {
+		{
+			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorBySignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorBySignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorBySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Validator != nil {
+		{
+			size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingValidatorUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingValidatorUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingValidatorUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingValidatorUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingValidatorUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingValidatorUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerKeyHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerKeyHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerKeyHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ValidatorId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignerKeyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignerKeyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignerKeyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignerKeys) > 0 {
+		for iNdEx := len(m.SignerKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignerKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	return n
+}
+
+func (m *QueryValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStakingOldTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func (m *QueryStakingOldTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryProposerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Times != 0 {
+		n += 1 + sovQuery(uint64(m.Times))
+	}
+	return n
+}
+
+func (m *QueryProposerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposers) > 0 {
+		for _, e := range m.Proposers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorSetAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryValidatorSetAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotHeight))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSetForCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	return n
+}
+
+func (m *QueryValidatorSetForCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckpointNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointNumber))
+	}
+	if m.SnapshotHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotHeight))
+	}
+	if m.ValidatorSet != nil {
+		l = m.ValidatorSet.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastUpdatedBlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdatedBlockNumber))
+	}
+	if m.LastUpdatedLogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdatedLogIndex))
+	}
+	l = len(m.LastUpdatedTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *QueryValidatorByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	return n
+}
+
+func (m *QueryValidatorBySignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorLifecycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingValidatorUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	return n
+}
+
+func (m *QueryPendingValidatorUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySignerKeyHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignerKeyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignerKeys) > 0 {
+		for _, e := range m.SignerKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validator == nil {
+				m.Validator = &types.Validator{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &types.ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingOldTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingOldTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingOldTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStakingOldTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStakingOldTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStakingOldTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Status = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Times", wireType)
+			}
+			m.Times = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Times |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, &types.Validator{})
+			if err := m.Proposers[len(m.Proposers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSetAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSetAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSetAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSetAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSetAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSetAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHeight", wireType)
+			}
+			m.SnapshotHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &types.ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSetForCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSetForCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSetForCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSetForCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSetForCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSetForCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointNumber", wireType)
+			}
+			m.CheckpointNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHeight", wireType)
+			}
+			m.SnapshotHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidatorSet == nil {
+				m.ValidatorSet = &types.ValidatorSet{}
+			}
+			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validator == nil {
+				m.Validator = &types.Validator{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
+			}
+			m.LastUpdatedBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedLogIndex", wireType)
+			}
+			m.LastUpdatedLogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedLogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastUpdatedTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ValidatorId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1063,11 +4438,28 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorId |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &types.QueryPaginationParams{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1092,7 +4484,7 @@ func (m *QueryValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1115,15 +4507,15 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1150,13 +4542,30 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Validator == nil {
-				m.Validator = &types.Validator{}
-			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, &ValidatorLifecycle{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1181,7 +4590,7 @@ func (m *QueryValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1204,12 +4613,31 @@ func (m *QueryValidatorSetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorByIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
+			}
+			m.ValidatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1234,7 +4662,7 @@ func (m *QueryValidatorSetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorBySignerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1257,17 +4685,17 @@ func (m *QueryValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorBySignerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorBySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1277,27 +4705,23 @@ func (m *QueryValidatorSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidatorSet == nil {
-				m.ValidatorSet = &types.ValidatorSet{}
-			}
-			if err := m.ValidatorSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1323,7 +4747,7 @@ func (m *QueryValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingOldTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorLifecycleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1346,17 +4770,17 @@ func (m *QueryStakingOldTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingOldTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorLifecycleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingOldTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1366,29 +4790,86 @@ func (m *QueryStakingOldTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			if m.Validator == nil {
+				m.Validator = &ValidatorLifecycle{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingValidatorUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingValidatorUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingValidatorUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
 			}
-			m.LogIndex = 0
+			m.ValidatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1398,7 +4879,7 @@ func (m *QueryStakingOldTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LogIndex |= uint64(b&0x7F) << shift
+				m.ValidatorId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1427,7 +4908,7 @@ func (m *QueryStakingOldTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStakingOldTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingValidatorUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1450,17 +4931,17 @@ func (m *QueryStakingOldTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStakingOldTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingValidatorUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStakingOldTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingValidatorUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1470,12 +4951,26 @@ func (m *QueryStakingOldTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &PendingValidatorUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1500,7 +4995,7 @@ func (m *QueryStakingOldTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposerRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerKeyHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1523,17 +5018,17 @@ func (m *QueryProposerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerKeyHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerKeyHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Times", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorId", wireType)
 			}
-			m.Times = 0
+			m.ValidatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1543,11 +5038,43 @@ func (m *QueryProposerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Times |= uint32(b&0x7F) << shift
+				m.ValidatorId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1572,7 +5099,7 @@ func (m *QueryProposerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposerResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySignerKeyHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,15 +5122,15 @@ func (m *QueryProposerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySignerKeyHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySignerKeyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1630,8 +5157,8 @@ func (m *QueryProposerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, &types.Validator{})
-			if err := m.Proposers[len(m.Proposers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SignerKeys = append(m.SignerKeys, &SignerKeyRecord{})
+			if err := m.SignerKeys[len(m.SignerKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
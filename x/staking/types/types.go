@@ -1 +1,70 @@
 package types
+
+import (
+	"fmt"
+	"math/big"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommon "github.com/maticnetwork/heimdall/types/common"
+)
+
+// Validator lifecycle statuses
+const (
+	// ValidatorStatusActive validator is in current validator set without end epoch set
+	ValidatorStatusActive = "active"
+	// ValidatorStatusJailed validator is jailed
+	ValidatorStatusJailed = "jailed"
+	// ValidatorStatusExiting validator is in current validator set until its end epoch
+	ValidatorStatusExiting = "exiting"
+	// ValidatorStatusExited validator reached its end epoch
+	ValidatorStatusExited = "exited"
+	// ValidatorStatusInactive validator not started yet or left without power
+	ValidatorStatusInactive = "inactive"
+)
+
+// ValidateValidatorStatus checks that status filter is empty or a known validator status
+func ValidateValidatorStatus(status string) error {
+	switch status {
+	case "", ValidatorStatusActive, ValidatorStatusJailed, ValidatorStatusExiting, ValidatorStatusExited, ValidatorStatusInactive:
+		return nil
+	default:
+		return fmt.Errorf("invalid validator status: %s", status)
+	}
+}
+
+// GetValidatorStatus returns lifecycle status of validator at ack count
+func GetValidatorStatus(validator hmTypes.Validator, ackCount uint64) string {
+	// current epoch will be ack count + 1
+	currentEpoch := ackCount + 1
+
+	switch {
+	case validator.EndEpoch != 0 && validator.EndEpoch <= currentEpoch:
+		return ValidatorStatusExited
+	case validator.Jailed:
+		return ValidatorStatusJailed
+	case !validator.IsCurrentValidator(ackCount):
+		return ValidatorStatusInactive
+	case validator.EndEpoch != 0:
+		return ValidatorStatusExiting
+	default:
+		return ValidatorStatusActive
+	}
+}
+
+// NewValidatorLifecycle creates validator lifecycle with status at ack count and rootchain tx hash which last updated it,
+// decoding rootchain block number and log index from validator's last updated sequence
+func NewValidatorLifecycle(validator hmTypes.Validator, ackCount uint64, lastUpdatedTxHash string) ValidatorLifecycle {
+	lifecycle := ValidatorLifecycle{
+		Validator:         &validator,
+		Status:            GetValidatorStatus(validator, ackCount),
+		LastUpdatedTxHash: lastUpdatedTxHash,
+	}
+
+	if sequence, ok := new(big.Int).SetString(validator.LastUpdated, 10); ok {
+		blockNumber, logIndex := new(big.Int).QuoRem(sequence, big.NewInt(hmCommon.DefaultLogIndexUnit), new(big.Int))
+		lifecycle.LastUpdatedBlockNumber = blockNumber.Uint64()
+		lifecycle.LastUpdatedLogIndex = logIndex.Uint64()
+	}
+
+	return lifecycle
+}
@@ -3,9 +3,11 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/maticnetwork/heimdall/x/topup/types"
@@ -24,6 +26,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(
 		GetSequenceCmd(),
+		GetAccountProofCmd(),
+		GetVerifyAccountProofCmd(),
 	)
 
 	return cmd
@@ -77,3 +81,99 @@ func GetSequenceCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetAccountProofCmd queries dividend account proof against account root hash of checkpoint
+func GetAccountProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-proof [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "get dividend account proof against account root hash of checkpoint",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query merkle proof of dividend account against account root hash of checkpoint, latest acked checkpoint by default.
+Proof is computed from dividend accounts at heimdall height account root hash of checkpoint refers to.
+
+Example:
+$ %s query topup account-proof 0x6c468cf8c9879006e22ec4029696e005c2319c9d --checkpoint 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			checkpointNumber, err := cmd.Flags().GetUint64(FlagCheckpoint)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryDividendAccountProof(context.Background(), &types.QueryDividendAccountProofRequest{
+				Address:          args[0],
+				CheckpointNumber: checkpointNumber,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagCheckpoint, 0, "--checkpoint=<checkpoint-number>")
+
+	return cmd
+}
+
+// GetVerifyAccountProofCmd verifies dividend account proof against account root hash of checkpoint
+func GetVerifyAccountProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-account-proof [address] [proof]",
+		Args:  cobra.ExactArgs(2),
+		Short: "verify dividend account proof against account root hash of checkpoint",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify merkle proof of dividend account against account root hash of checkpoint, latest acked checkpoint by default.
+
+Example:
+$ %s query topup verify-account-proof 0x6c468cf8c9879006e22ec4029696e005c2319c9d 0x3a1f... --checkpoint 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			checkpointNumber, err := cmd.Flags().GetUint64(FlagCheckpoint)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VerifyDividendAccountProof(context.Background(), &types.VerifyDividendAccountProofRequest{
+				Address:          args[0],
+				Proof:            args[1],
+				CheckpointNumber: checkpointNumber,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagCheckpoint, 0, "--checkpoint=<checkpoint-number>")
+
+	return cmd
+}
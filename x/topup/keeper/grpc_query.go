@@ -8,8 +8,10 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/common/hexutil"
 	"github.com/maticnetwork/heimdall/helper"
-	hmTypes "github.com/maticnetwork/heimdall/types/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/x/topup/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -40,13 +42,13 @@ func (k Querier) Sequence(c context.Context, req *types.QuerySequenceRequest) (*
 	ctx := sdk.UnwrapSDKContext(c)
 
 	chainParams := k.ChainKeeper.GetParams(ctx)
-	receipt, err := k.contractCaller.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txHash).EthHash(), chainParams.MainchainTxConfirmations)
+	receipt, err := k.contractCaller.GetConfirmedTxReceipt(hmCommonTypes.HexToHeimdallHash(txHash).EthHash(), chainParams.MainchainTxConfirmations)
 
 	if err != nil || receipt == nil {
 		return nil, status.Errorf(codes.NotFound, "Transaction is not confirmed yet. Please wait for sometime and try again")
 	}
 
-	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmCommonTypes.DefaultLogIndexUnit))
 	sequence.Add(sequence, new(big.Int).SetUint64(logIndex))
 
 	if !k.HasTopupSequence(ctx, sequence.String()) {
@@ -88,7 +90,7 @@ func (k Querier) QueryDividendAccountRoot(c context.Context, req *types.QueryDiv
 	}
 
 	return &types.QueryDividendAccountRootResponse{
-		AccountRootHash: hmTypes.BytesToHeimdallHash(accountRoot).String(),
+		AccountRootHash: hmCommonTypes.BytesToHeimdallHash(accountRoot).String(),
 	}, nil
 }
 
@@ -124,3 +126,126 @@ func (k Querier) QueryDividendAccount(c context.Context, req *types.QueryDividen
 		DividendAccount: &dividendAccount,
 	}, nil
 }
+
+// QueryDividendAccountProof will return merkle proof of dividend account against account root hash of checkpoint
+func (k Querier) QueryDividendAccountProof(c context.Context, req *types.QueryDividendAccountProofRequest) (*types.QueryDividendAccountProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromHex(req.GetAddress())
+	if err != nil || addr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid address format")
+	}
+
+	checkpointNumber, accountRootHash, dividendAccounts, err := k.checkpointDividendAccounts(ctx, req.GetCheckpointNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	dividendAccount, _, found := findDividendAccount(dividendAccounts, addr)
+	if !found {
+		return nil, status.Error(codes.NotFound, "dividend account not found")
+	}
+
+	proof, index, err := checkpointTypes.GetAccountProof(dividendAccounts, addr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not generate dividend account proof. Error:%v", err)
+	}
+
+	return &types.QueryDividendAccountProofResponse{
+		DividendAccount:  dividendAccount,
+		CheckpointNumber: checkpointNumber,
+		AccountRootHash:  accountRootHash,
+		Index:            index,
+		Proof:            hexutil.Encode(proof),
+	}, nil
+}
+
+// VerifyDividendAccountProof will return if dividend account proof leads to account root hash of checkpoint
+func (k Querier) VerifyDividendAccountProof(c context.Context, req *types.VerifyDividendAccountProofRequest) (*types.VerifyDividendAccountProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromHex(req.GetAddress())
+	if err != nil || addr.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "invalid address format")
+	}
+
+	_, accountRootHash, dividendAccounts, err := k.checkpointDividendAccounts(ctx, req.GetCheckpointNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	dividendAccount, index, found := findDividendAccount(dividendAccounts, addr)
+	if !found {
+		return nil, status.Error(codes.NotFound, "dividend account not found")
+	}
+
+	proof, err := hexutil.Decode(req.GetProof())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid proof format")
+	}
+
+	isVerified, err := checkpointTypes.VerifyAccountProofWithRoot(dividendAccount, index, proof, hmCommonTypes.HexToHeimdallHash(accountRootHash).Bytes())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not verify dividend account proof. Error:%v", err)
+	}
+
+	return &types.VerifyDividendAccountProofResponse{IsVerified: isVerified}, nil
+}
+
+// findDividendAccount returns dividend account of address along with its index in account tree
+func findDividendAccount(dividendAccounts []*hmTypes.DividendAccount, addr sdk.AccAddress) (*hmTypes.DividendAccount, uint64, bool) {
+	for i, dividendAccount := range hmTypes.SortDividendAccountByAddress(dividendAccounts) {
+		if accAddr, err := sdk.AccAddressFromHex(dividendAccount.User); err == nil && accAddr.Equals(addr) {
+			return dividendAccount, uint64(i), true
+		}
+	}
+
+	return nil, 0, false
+}
+
+// checkpointDividendAccounts returns dividend accounts at height of checkpoint if their root matches its account root hash,
+// checkpoint number 0 selects latest acked checkpoint. Current dividend accounts are used for checkpoints without recorded height.
+func (k Querier) checkpointDividendAccounts(ctx sdk.Context, checkpointNumber uint64) (uint64, string, []*hmTypes.DividendAccount, error) {
+	if checkpointNumber == 0 {
+		checkpointNumber = k.checkpointKeeper.GetACKCount(ctx)
+		if checkpointNumber == 0 {
+			return 0, "", nil, status.Error(codes.NotFound, "no checkpoint acked yet")
+		}
+	}
+
+	checkpoint, err := k.checkpointKeeper.GetCheckpointByNumber(ctx, checkpointNumber)
+	if err != nil {
+		return 0, "", nil, status.Errorf(codes.NotFound, "checkpoint %v not found", checkpointNumber)
+	}
+
+	if checkpoint.AccountRootHash == "" {
+		return 0, "", nil, status.Errorf(codes.FailedPrecondition, "checkpoint %v has no account root hash", checkpointNumber)
+	}
+
+	height, found := k.checkpointKeeper.GetCheckpointHeight(ctx, checkpointNumber)
+	if !found {
+		height = ctx.BlockHeight()
+	}
+
+	dividendAccounts := k.GetDividendAccountsAtHeight(ctx, height)
+	accountRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts)
+	if err != nil {
+		return 0, "", nil, status.Errorf(codes.Internal, "could not fetch accountroothash")
+	}
+
+	if hmCommonTypes.BytesToHeimdallHash(accountRoot).String() != checkpoint.AccountRootHash {
+		return 0, "", nil, status.Errorf(
+			codes.FailedPrecondition,
+			"dividend accounts at height %v do not match account root hash of checkpoint %v",
+			height, checkpointNumber,
+		)
+	}
+
+	return checkpointNumber, checkpoint.AccountRootHash, dividendAccounts, nil
+}
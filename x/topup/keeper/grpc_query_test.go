@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -8,7 +9,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	hmTypes2 "github.com/maticnetwork/heimdall/types"
 	hmTypes "github.com/maticnetwork/heimdall/types/common"
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
 
 	"github.com/maticnetwork/heimdall/x/topup/types"
 
@@ -57,3 +63,106 @@ func (suite *KeeperTestSuite) TestSequence() {
 		require.Equal(t, resp.Sequence, sequence.Uint64())
 	})
 }
+
+func (suite *KeeperTestSuite) TestQueryDividendAccountProof() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	k := keeper.NewQueryServerImpl(initApp.TopupKeeper, &suite.contractCaller)
+
+	users := []sdk.AccAddress{sdk.AccAddress("user-1"), sdk.AccAddress("user-2"), sdk.AccAddress("user-3")}
+	for i, user := range users {
+		require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx, user, big.NewInt(int64(i+1)*1000)))
+	}
+
+	_, err := k.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: users[0].String()})
+	require.Error(t, err, "no checkpoint acked yet")
+
+	accountRoot, err := checkpointTypes.GetAccountRootHash(initApp.TopupKeeper.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+	accountRootHash := hmTypes.BytesToHeimdallHash(accountRoot).String()
+
+	require.NoError(t, initApp.CheckpointKeeper.AddCheckpoint(ctx, 1, &hmTypes2.Checkpoint{StartBlock: 0, EndBlock: 255}))
+	require.NoError(t, initApp.CheckpointKeeper.AddCheckpoint(ctx, 2, &hmTypes2.Checkpoint{StartBlock: 256, EndBlock: 511, AccountRootHash: accountRootHash}))
+	initApp.CheckpointKeeper.UpdateACKCountWithValue(ctx, 2)
+
+	t.Run("Checkpoint without account root", func(t *testing.T) {
+		_, err := k.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: users[0].String(), CheckpointNumber: 1})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("Unknown account", func(t *testing.T) {
+		_, err := k.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: sdk.AccAddress("user-4").String()})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	for _, user := range users {
+		res, err := k.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: user.String()})
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), res.CheckpointNumber)
+		require.Equal(t, accountRootHash, res.AccountRootHash)
+		require.Equal(t, user.String(), res.DividendAccount.User)
+
+		verified, err := k.VerifyDividendAccountProof(sdk.WrapSDKContext(ctx), &types.VerifyDividendAccountProofRequest{Address: user.String(), Proof: res.Proof, CheckpointNumber: 2})
+		require.NoError(t, err)
+		require.True(t, verified.IsVerified)
+	}
+
+	res, err := k.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: users[0].String()})
+	require.NoError(t, err)
+	verified, err := k.VerifyDividendAccountProof(sdk.WrapSDKContext(ctx), &types.VerifyDividendAccountProofRequest{Address: users[1].String(), Proof: res.Proof})
+	require.NoError(t, err)
+	require.False(t, verified.IsVerified)
+
+	// malformed proof
+	_, err = k.VerifyDividendAccountProof(sdk.WrapSDKContext(ctx), &types.VerifyDividendAccountProofRequest{Address: users[0].String(), Proof: "0x0102"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// fees added after checkpoint without recorded height no longer match its account root
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx, users[0], big.NewInt(1)))
+	_, err = k.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: users[0].String()})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// proofs are computed from dividend accounts at recorded height of checkpoint
+	initApp.CheckpointKeeper.SetCheckpointHeight(ctx, 2, ctx.BlockHeight()-1)
+	res, err = k.QueryDividendAccountProof(sdk.WrapSDKContext(ctx), &types.QueryDividendAccountProofRequest{Address: users[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, "1000", res.DividendAccount.FeeAmount)
+	require.Equal(t, accountRootHash, res.AccountRootHash)
+
+	verified, err = k.VerifyDividendAccountProof(sdk.WrapSDKContext(ctx), &types.VerifyDividendAccountProofRequest{Address: users[0].String(), Proof: res.Proof})
+	require.NoError(t, err)
+	require.True(t, verified.IsVerified)
+}
+
+func (suite *KeeperTestSuite) TestVerifyAccountProofWithRoot() {
+	t := suite.T()
+
+	// odd and even sized account trees
+	for count := 1; count <= 6; count++ {
+		var dividendAccounts []*hmTypes2.DividendAccount
+		for i := 0; i < count; i++ {
+			dividendAccount := hmTypes2.NewDividendAccount(sdk.AccAddress(fmt.Sprintf("user-%d", i)), big.NewInt(int64(i+1)).String())
+			dividendAccounts = append(dividendAccounts, &dividendAccount)
+		}
+
+		accountRoot, err := checkpointTypes.GetAccountRootHash(dividendAccounts)
+		require.NoError(t, err)
+
+		for _, dividendAccount := range dividendAccounts {
+			user, err := sdk.AccAddressFromHex(dividendAccount.User)
+			require.NoError(t, err)
+			proof, index, err := checkpointTypes.GetAccountProof(dividendAccounts, user)
+			require.NoError(t, err)
+
+			verified, err := checkpointTypes.VerifyAccountProofWithRoot(dividendAccount, index, proof, accountRoot)
+			require.NoError(t, err)
+			require.True(t, verified, "account %d of %d", index, count)
+
+			if count > 1 {
+				verified, err = checkpointTypes.VerifyAccountProofWithRoot(dividendAccount, (index+1)%uint64(count), proof, accountRoot)
+				require.NoError(t, err)
+				require.False(t, verified, "account %d of %d at wrong index", index, count)
+			}
+		}
+	}
+}
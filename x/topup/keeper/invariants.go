@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
+	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+	"github.com/maticnetwork/heimdall/x/topup/types"
+)
+
+// RegisterInvariants registers all topup invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "dividend-account-root", DividendAccountRootInvariant(k))
+}
+
+// DividendAccountRootInvariant checks that dividend accounts are stored under their user address with
+// well formed fee totals, and that fee totals at height of last acked checkpoint hash into its account root
+func DividendAccountRootInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		store := ctx.KVStore(k.key)
+
+		iterator := sdk.KVStorePrefixIterator(store, DividendAccountMapKey)
+		for ; iterator.Valid(); iterator.Next() {
+			dividendAccount, err := hmTypes.UnMarshallDividendAccount(k.cdc, iterator.Value())
+			if err != nil {
+				msg += fmt.Sprintf("\tunable to decode dividend account %X: %v\n", iterator.Key(), err)
+				broken = true
+				continue
+			}
+
+			if !bytes.Equal(iterator.Key(), GetDividendAccountMapKey([]byte(dividendAccount.User))) {
+				msg += fmt.Sprintf("\tdividend account of %s is stored under key %X\n", dividendAccount.User, iterator.Key())
+				broken = true
+			}
+
+			fee, ok := big.NewInt(0).SetString(dividendAccount.FeeAmount, 10)
+			if !ok || fee.Sign() < 0 {
+				msg += fmt.Sprintf("\tdividend account of %s has invalid fee total %q\n", dividendAccount.User, dividendAccount.FeeAmount)
+				broken = true
+			}
+		}
+		iterator.Close()
+
+		// fee totals at height of last acked checkpoint hash into its account root
+		if !broken {
+			if res, stop := checkLastCheckpointAccountRoot(ctx, k); stop {
+				msg += res
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "dividend-account-root", msg), broken
+	}
+}
+
+// checkLastCheckpointAccountRoot compares account root of dividend accounts at height of last acked checkpoint
+// with account root hash of checkpoint. Checkpoints without recorded height are skipped.
+func checkLastCheckpointAccountRoot(ctx sdk.Context, k Keeper) (string, bool) {
+	ackCount := k.checkpointKeeper.GetACKCount(ctx)
+	if ackCount == 0 {
+		return "", false
+	}
+
+	height, found := k.checkpointKeeper.GetCheckpointHeight(ctx, ackCount)
+	if !found {
+		return "", false
+	}
+
+	checkpoint, err := k.checkpointKeeper.GetCheckpointByNumber(ctx, ackCount)
+	if err != nil {
+		return fmt.Sprintf("\tlast acked checkpoint %d not found: %v\n", ackCount, err), true
+	}
+
+	accountRoot, err := checkpointTypes.GetAccountRootHash(k.GetDividendAccountsAtHeight(ctx, height))
+	if err != nil {
+		return fmt.Sprintf("\tunable to compute dividend account root at height %d: %v\n", height, err), true
+	}
+
+	if hmCommonTypes.BytesToHeimdallHash(accountRoot).String() != checkpoint.AccountRootHash {
+		return fmt.Sprintf("\tdividend account root at height %d is %s, checkpoint %d has %s\n",
+			height, hmCommonTypes.BytesToHeimdallHash(accountRoot).String(), ackCount, checkpoint.AccountRootHash), true
+	}
+
+	return "", false
+}
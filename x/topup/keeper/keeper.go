@@ -12,6 +12,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	chainKeeper "github.com/maticnetwork/heimdall/x/chainmanager/keeper"
+	checkpointKeeper "github.com/maticnetwork/heimdall/x/checkpoint/keeper"
 	stakingKeeper "github.com/maticnetwork/heimdall/x/staking/keeper"
 	"github.com/maticnetwork/heimdall/x/topup/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -24,6 +25,8 @@ var (
 	TopupSequencePrefixKey = []byte{0x81}
 
 	DividendAccountMapKey = []byte{0x82} // prefix for each key for Dividend Account Map
+
+	DividendAccountHistoryKey = []byte{0x83} // prefix for each key to dividend account as stored at heimdall height
 )
 
 // Keeper stores all related data
@@ -42,6 +45,8 @@ type Keeper struct {
 	Bk bankKeeper.Keeper
 	// staking keeper
 	sk stakingKeeper.Keeper
+	// checkpoint keeper
+	checkpointKeeper checkpointKeeper.Keeper
 }
 
 // NewKeeper create new keeper
@@ -52,14 +57,16 @@ func NewKeeper(
 	chainKeeper chainKeeper.Keeper,
 	bankKeeper bankKeeper.Keeper,
 	stakingKeeper stakingKeeper.Keeper,
+	checkpointKeeper checkpointKeeper.Keeper,
 ) Keeper {
 	return Keeper{
-		cdc:         cdc,
-		key:         storeKey,
-		paramSpace:  paramSpace,
-		ChainKeeper: chainKeeper,
-		Bk:          bankKeeper,
-		sk:          stakingKeeper,
+		cdc:              cdc,
+		key:              storeKey,
+		paramSpace:       paramSpace,
+		ChainKeeper:      chainKeeper,
+		Bk:               bankKeeper,
+		sk:               stakingKeeper,
+		checkpointKeeper: checkpointKeeper,
 	}
 }
 
@@ -131,10 +138,94 @@ func (k *Keeper) AddDividendAccount(ctx sdk.Context, dividendAccount hmTypes.Div
 	}
 
 	store.Set(GetDividendAccountMapKey([]byte(dividendAccount.User)), bz)
+	store.Set(GetDividendAccountHistoryKey([]byte(dividendAccount.User), ctx.BlockHeight()), bz)
 	k.Logger(ctx).Debug("DividendAccount Stored", "key", hex.EncodeToString(GetDividendAccountMapKey([]byte(dividendAccount.User))), "dividendAccount", dividendAccount.String())
 	return nil
 }
 
+// GetDividendAccountHistoryKey appends prefix and heimdall height to address
+func GetDividendAccountHistoryKey(address []byte, height int64) []byte {
+	key := append(append([]byte{}, DividendAccountHistoryKey...), address...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetDividendAccountsAtHeight returns dividend accounts as they were at end of given heimdall height
+func (k *Keeper) GetDividendAccountsAtHeight(ctx sdk.Context, height int64) (dividendAccounts []*hmTypes.DividendAccount) {
+	store := ctx.KVStore(k.key)
+
+	// dividend accounts are never removed, so every account at height is still stored
+	iterator := sdk.KVStorePrefixIterator(store, DividendAccountMapKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := iterator.Key()[len(DividendAccountMapKey):]
+
+		history := store.ReverseIterator(GetDividendAccountHistoryKey(address, 0), GetDividendAccountHistoryKey(address, height+1))
+		if history.Valid() {
+			dividendAccount, err := hmTypes.UnMarshallDividendAccount(k.cdc, history.Value())
+			if err == nil {
+				dividendAccounts = append(dividendAccounts, &dividendAccount)
+			}
+		}
+		history.Close()
+	}
+
+	return
+}
+
+// PruneDividendAccountHistory removes dividend account history older than given heimdall height.
+// Newest record of each account at or before height is kept, so accounts at height can still be read.
+func (k *Keeper) PruneDividendAccountHistory(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.key)
+
+	// collect keys first, store must not be written while iterating
+	var keys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, DividendAccountMapKey)
+	for ; iterator.Valid(); iterator.Next() {
+		address := iterator.Key()[len(DividendAccountMapKey):]
+
+		history := store.ReverseIterator(GetDividendAccountHistoryKey(address, 0), GetDividendAccountHistoryKey(address, height+1))
+		if history.Valid() {
+			history.Next()
+		}
+		for ; history.Valid(); history.Next() {
+			keys = append(keys, append([]byte{}, history.Key()...))
+		}
+		history.Close()
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if len(keys) > 0 {
+		k.Logger(ctx).Debug("Pruned dividend account history", "count", len(keys), "height", height)
+	}
+}
+
+// MigrateDividendAccountHistory records dividend accounts stored before history was kept at end of previous height,
+// store migrations run before txs of block so accounts didn't change since then
+func (k *Keeper) MigrateDividendAccountHistory(ctx sdk.Context) error {
+	store := ctx.KVStore(k.key)
+
+	// collect accounts first, store must not be written while iterating
+	var keys, values [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, DividendAccountMapKey)
+	for ; iterator.Valid(); iterator.Next() {
+		address := iterator.Key()[len(DividendAccountMapKey):]
+		keys = append(keys, GetDividendAccountHistoryKey(address, ctx.BlockHeight()-1))
+		values = append(values, iterator.Value())
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Set(key, values[i])
+	}
+
+	return nil
+}
+
 // GetDividendAccountByAddress will return DividendAccount of user
 func (k *Keeper) GetDividendAccountByAddress(ctx sdk.Context, address sdk.AccAddress) (dividendAccount hmTypes.DividendAccount, err error) {
 
@@ -14,8 +14,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/app"
 	hmTypes "github.com/maticnetwork/heimdall/types"
+	hmCommonTypes "github.com/maticnetwork/heimdall/types/common"
 	"github.com/maticnetwork/heimdall/types/simulation"
 	checkpointTypes "github.com/maticnetwork/heimdall/x/checkpoint/types"
+	topupKeeper "github.com/maticnetwork/heimdall/x/topup/keeper"
+	"github.com/maticnetwork/heimdall/x/topup/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -83,6 +86,124 @@ func (suite *KeeperTestSuite) TestAddFeeToDividendAccount() {
 	require.Equal(t, amount, actualResult)
 }
 
+func (suite *KeeperTestSuite) TestDividendAccountRootInvariant() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	invariant := topupKeeper.DividendAccountRootInvariant(initApp.TopupKeeper)
+
+	for i := 1; i <= 3; i++ {
+		err := initApp.TopupKeeper.AddFeeToDividendAccount(ctx, sdk.AccAddress(strconv.Itoa(i)), big.NewInt(int64(i*100)))
+		require.NoError(t, err)
+	}
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	err := initApp.TopupKeeper.AddDividendAccount(ctx, hmTypes.NewDividendAccount(sdk.AccAddress("4"), "abc"))
+	require.NoError(t, err)
+
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "invalid fee total")
+}
+
+func (suite *KeeperTestSuite) TestDividendAccountRootInvariantCheckpoint() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx.WithBlockHeight(10)
+	invariant := topupKeeper.DividendAccountRootInvariant(initApp.TopupKeeper)
+
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx, sdk.AccAddress("1"), big.NewInt(100)))
+	accountRoot, err := checkpointTypes.GetAccountRootHash(initApp.TopupKeeper.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+
+	checkpoint := hmTypes.CreateBlock(0, 255, hmCommonTypes.HexToHeimdallHash("123"), hmCommonTypes.HexToHeimdallAddress("123"), "1234", 1)
+	checkpoint.AccountRootHash = hmCommonTypes.BytesToHeimdallHash(accountRoot).String()
+	require.NoError(t, initApp.CheckpointKeeper.AddCheckpoint(ctx, 1, checkpoint))
+	initApp.CheckpointKeeper.UpdateACKCount(ctx)
+	initApp.CheckpointKeeper.SetCheckpointHeight(ctx, 1, 10)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// fees added after checkpoint don't change dividend accounts at its height
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx, sdk.AccAddress("1"), big.NewInt(100)))
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx, sdk.AccAddress("2"), big.NewInt(100)))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// checkpoint account root hash not matching fee totals at its height
+	initApp.CheckpointKeeper.SetCheckpointHeight(ctx, 1, 11)
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "checkpoint 1")
+}
+
+func (suite *KeeperTestSuite) TestGetDividendAccountsAtHeight() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx.WithBlockHeight(5), sdk.AccAddress("1"), big.NewInt(100)))
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx.WithBlockHeight(7), sdk.AccAddress("1"), big.NewInt(50)))
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx.WithBlockHeight(7), sdk.AccAddress("2"), big.NewInt(10)))
+
+	require.Empty(t, initApp.TopupKeeper.GetDividendAccountsAtHeight(ctx, 4))
+
+	accounts := initApp.TopupKeeper.GetDividendAccountsAtHeight(ctx, 6)
+	require.Len(t, accounts, 1)
+	require.Equal(t, "100", accounts[0].FeeAmount)
+
+	accounts = initApp.TopupKeeper.GetDividendAccountsAtHeight(ctx, 7)
+	require.Len(t, accounts, 2)
+	require.ElementsMatch(t, initApp.TopupKeeper.GetAllDividendAccounts(ctx), accounts)
+}
+
+func (suite *KeeperTestSuite) TestPruneDividendAccountHistory() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx
+	store := ctx.KVStore(initApp.GetKey(types.StoreKey))
+	user := []byte(hmTypes.NewDividendAccount(sdk.AccAddress("1"), "0").User)
+
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx.WithBlockHeight(5), sdk.AccAddress("1"), big.NewInt(100)))
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx.WithBlockHeight(7), sdk.AccAddress("1"), big.NewInt(50)))
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx.WithBlockHeight(9), sdk.AccAddress("1"), big.NewInt(10)))
+	require.NoError(t, initApp.TopupKeeper.AddFeeToDividendAccount(ctx.WithBlockHeight(7), sdk.AccAddress("2"), big.NewInt(10)))
+
+	// history is kept until a checkpoint refers to its height
+	initApp.CheckpointKeeper.PruneDividendAccountHistory(ctx)
+	require.True(t, store.Has(topupKeeper.GetDividendAccountHistoryKey(user, 5)))
+
+	// oldest checkpoint in state refers to height 8, newest record before it is kept
+	initApp.CheckpointKeeper.SetCheckpointHeight(ctx, 2, 8)
+	initApp.CheckpointKeeper.SetCheckpointHeight(ctx, 3, 9)
+	initApp.CheckpointKeeper.PruneDividendAccountHistory(ctx)
+
+	require.False(t, store.Has(topupKeeper.GetDividendAccountHistoryKey(user, 5)))
+	require.True(t, store.Has(topupKeeper.GetDividendAccountHistoryKey(user, 7)))
+	require.True(t, store.Has(topupKeeper.GetDividendAccountHistoryKey(user, 9)))
+
+	accounts := initApp.TopupKeeper.GetDividendAccountsAtHeight(ctx, 8)
+	require.Len(t, accounts, 2)
+	require.ElementsMatch(t, []string{"150", "10"}, []string{accounts[0].FeeAmount, accounts[1].FeeAmount})
+
+	// record at pruning height is kept
+	initApp.TopupKeeper.PruneDividendAccountHistory(ctx, 9)
+	require.False(t, store.Has(topupKeeper.GetDividendAccountHistoryKey(user, 7)))
+	require.True(t, store.Has(topupKeeper.GetDividendAccountHistoryKey(user, 9)))
+	require.Len(t, initApp.TopupKeeper.GetDividendAccountsAtHeight(ctx, 9), 2)
+}
+
+func (suite *KeeperTestSuite) TestMigrateDividendAccountHistory() {
+	t, initApp, ctx := suite.T(), suite.app, suite.ctx.WithBlockHeight(8)
+	store := ctx.KVStore(initApp.GetKey(types.StoreKey))
+
+	// dividend account stored before history was kept
+	dividendAccount := hmTypes.NewDividendAccount(sdk.AccAddress("1"), "100")
+	bz, err := hmTypes.MarshallDividendAccount(initApp.AppCodec(), &dividendAccount)
+	require.NoError(t, err)
+	store.Set(topupKeeper.GetDividendAccountMapKey([]byte(dividendAccount.User)), bz)
+	require.Empty(t, initApp.TopupKeeper.GetDividendAccountsAtHeight(ctx, 7))
+
+	require.NoError(t, initApp.TopupKeeper.MigrateDividendAccountHistory(ctx))
+	require.Equal(t, []*hmTypes.DividendAccount{&dividendAccount}, initApp.TopupKeeper.GetDividendAccountsAtHeight(ctx, 7))
+}
+
 func (suite *KeeperTestSuite) TestDividendAccountTree() {
 	t := suite.T()
 
@@ -475,6 +475,242 @@ func (m *QueryDividendAccountResponse) GetDividendAccount() *types.DividendAccou
 	return nil
 }
 
+// QueryDividendAccountProofRequest is request for dividend account proof,
+// checkpoint number 0 selects the latest acked checkpoint
+type QueryDividendAccountProofRequest struct {
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CheckpointNumber uint64 `protobuf:"varint,2,opt,name=checkpoint_number,json=checkpointNumber,proto3" json:"checkpoint_number,omitempty"`
+}
+
+func (m *QueryDividendAccountProofRequest) Reset()         { *m = QueryDividendAccountProofRequest{} }
+func (m *QueryDividendAccountProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDividendAccountProofRequest) ProtoMessage()    {}
+func (*QueryDividendAccountProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{10}
+}
+func (m *QueryDividendAccountProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDividendAccountProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDividendAccountProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDividendAccountProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDividendAccountProofRequest.Merge(m, src)
+}
+func (m *QueryDividendAccountProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDividendAccountProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDividendAccountProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDividendAccountProofRequest proto.InternalMessageInfo
+
+func (m *QueryDividendAccountProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryDividendAccountProofRequest) GetCheckpointNumber() uint64 {
+	if m != nil {
+		return m.CheckpointNumber
+	}
+	return 0
+}
+
+type QueryDividendAccountProofResponse struct {
+	DividendAccount  *types.DividendAccount `protobuf:"bytes,1,opt,name=dividend_account,json=dividendAccount,proto3" json:"dividend_account,omitempty"`
+	CheckpointNumber uint64                 `protobuf:"varint,2,opt,name=checkpoint_number,json=checkpointNumber,proto3" json:"checkpoint_number,omitempty"`
+	AccountRootHash  string                 `protobuf:"bytes,3,opt,name=account_root_hash,json=accountRootHash,proto3" json:"account_root_hash,omitempty"`
+	Index            uint64                 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Proof            string                 `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *QueryDividendAccountProofResponse) Reset()         { *m = QueryDividendAccountProofResponse{} }
+func (m *QueryDividendAccountProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDividendAccountProofResponse) ProtoMessage()    {}
+func (*QueryDividendAccountProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{11}
+}
+func (m *QueryDividendAccountProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDividendAccountProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDividendAccountProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDividendAccountProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDividendAccountProofResponse.Merge(m, src)
+}
+func (m *QueryDividendAccountProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDividendAccountProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDividendAccountProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDividendAccountProofResponse proto.InternalMessageInfo
+
+func (m *QueryDividendAccountProofResponse) GetDividendAccount() *types.DividendAccount {
+	if m != nil {
+		return m.DividendAccount
+	}
+	return nil
+}
+
+func (m *QueryDividendAccountProofResponse) GetCheckpointNumber() uint64 {
+	if m != nil {
+		return m.CheckpointNumber
+	}
+	return 0
+}
+
+func (m *QueryDividendAccountProofResponse) GetAccountRootHash() string {
+	if m != nil {
+		return m.AccountRootHash
+	}
+	return ""
+}
+
+func (m *QueryDividendAccountProofResponse) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *QueryDividendAccountProofResponse) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+// VerifyDividendAccountProofRequest is request for dividend account proof
+// verification, checkpoint number 0 selects the latest acked checkpoint
+type VerifyDividendAccountProofRequest struct {
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Proof            string `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	CheckpointNumber uint64 `protobuf:"varint,3,opt,name=checkpoint_number,json=checkpointNumber,proto3" json:"checkpoint_number,omitempty"`
+}
+
+func (m *VerifyDividendAccountProofRequest) Reset()         { *m = VerifyDividendAccountProofRequest{} }
+func (m *VerifyDividendAccountProofRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyDividendAccountProofRequest) ProtoMessage()    {}
+func (*VerifyDividendAccountProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{12}
+}
+func (m *VerifyDividendAccountProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyDividendAccountProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyDividendAccountProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyDividendAccountProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDividendAccountProofRequest.Merge(m, src)
+}
+func (m *VerifyDividendAccountProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyDividendAccountProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDividendAccountProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDividendAccountProofRequest proto.InternalMessageInfo
+
+func (m *VerifyDividendAccountProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerifyDividendAccountProofRequest) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+func (m *VerifyDividendAccountProofRequest) GetCheckpointNumber() uint64 {
+	if m != nil {
+		return m.CheckpointNumber
+	}
+	return 0
+}
+
+type VerifyDividendAccountProofResponse struct {
+	IsVerified bool `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+}
+
+func (m *VerifyDividendAccountProofResponse) Reset()         { *m = VerifyDividendAccountProofResponse{} }
+func (m *VerifyDividendAccountProofResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyDividendAccountProofResponse) ProtoMessage()    {}
+func (*VerifyDividendAccountProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fc062043e57c0b9, []int{13}
+}
+func (m *VerifyDividendAccountProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyDividendAccountProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyDividendAccountProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyDividendAccountProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDividendAccountProofResponse.Merge(m, src)
+}
+func (m *VerifyDividendAccountProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyDividendAccountProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDividendAccountProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDividendAccountProofResponse proto.InternalMessageInfo
+
+func (m *VerifyDividendAccountProofResponse) GetIsVerified() bool {
+	if m != nil {
+		return m.IsVerified
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QuerySequenceRequest)(nil), "heimdall.topup.v1beta1.QuerySequenceRequest")
 	proto.RegisterType((*QuerySequenceResponse)(nil), "heimdall.topup.v1beta1.QuerySequenceResponse")
@@ -486,6 +722,10 @@ func init() {
 	proto.RegisterType((*QueryDividendAccountsResponse)(nil), "heimdall.topup.v1beta1.QueryDividendAccountsResponse")
 	proto.RegisterType((*QueryDividendAccountRequest)(nil), "heimdall.topup.v1beta1.QueryDividendAccountRequest")
 	proto.RegisterType((*QueryDividendAccountResponse)(nil), "heimdall.topup.v1beta1.QueryDividendAccountResponse")
+	proto.RegisterType((*QueryDividendAccountProofRequest)(nil), "heimdall.topup.v1beta1.QueryDividendAccountProofRequest")
+	proto.RegisterType((*QueryDividendAccountProofResponse)(nil), "heimdall.topup.v1beta1.QueryDividendAccountProofResponse")
+	proto.RegisterType((*VerifyDividendAccountProofRequest)(nil), "heimdall.topup.v1beta1.VerifyDividendAccountProofRequest")
+	proto.RegisterType((*VerifyDividendAccountProofResponse)(nil), "heimdall.topup.v1beta1.VerifyDividendAccountProofResponse")
 }
 
 func init() {
@@ -493,47 +733,59 @@ func init() {
 }
 
 var fileDescriptor_4fc062043e57c0b9 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0xeb, 0xfe, 0xfa, 0x4b, 0xd2, 0x63, 0x68, 0x7b, 0x2a, 0x25, 0x72, 0x8b, 0x93, 0x5a,
-	0x48, 0x84, 0x3f, 0xb1, 0x95, 0x3f, 0xa5, 0x88, 0x0d, 0xc4, 0xd0, 0xa2, 0x0a, 0x84, 0xcb, 0xc4,
-	0x12, 0x5d, 0xe2, 0x93, 0x63, 0x70, 0x7c, 0x6e, 0xee, 0x5c, 0x5c, 0x21, 0x16, 0x5e, 0x01, 0x88,
-	0x99, 0xd7, 0xc1, 0xc4, 0xc6, 0xc0, 0x58, 0x89, 0x85, 0x11, 0x25, 0xbc, 0x0c, 0x06, 0xe4, 0xcb,
-	0xe3, 0x04, 0x59, 0x76, 0x49, 0x24, 0xa6, 0xf8, 0x2e, 0xcf, 0xf3, 0xfd, 0x7e, 0x2e, 0xf7, 0x7c,
-	0x1d, 0xa4, 0xf7, 0xa9, 0x3b, 0xb0, 0x89, 0xe7, 0x99, 0x82, 0x05, 0x61, 0x60, 0x9e, 0x36, 0xba,
-	0x54, 0x90, 0x86, 0x79, 0x12, 0xd2, 0xe1, 0x99, 0x11, 0x0c, 0x99, 0x60, 0x78, 0x2b, 0xa9, 0x31,
-	0x64, 0x8d, 0x01, 0x35, 0xea, 0x8e, 0xc3, 0x98, 0xe3, 0x51, 0x93, 0x04, 0xae, 0x49, 0x7c, 0x9f,
-	0x09, 0x22, 0x5c, 0xe6, 0xf3, 0x49, 0x97, 0xba, 0xe9, 0x30, 0x87, 0xc9, 0x47, 0x33, 0x7e, 0x82,
-	0xdd, 0x6b, 0x53, 0xbf, 0x2e, 0xe1, 0x74, 0x6a, 0x67, 0xbb, 0xa7, 0xae, 0x4d, 0x7d, 0x31, 0xa9,
-	0xd2, 0x8f, 0xd0, 0xe6, 0xd3, 0x18, 0xe0, 0x98, 0x9e, 0x84, 0xd4, 0xef, 0x51, 0x2b, 0xfe, 0xe4,
-	0x02, 0x5f, 0x41, 0x45, 0x11, 0x75, 0xfa, 0x84, 0xf7, 0xcb, 0x4a, 0x55, 0xa9, 0xad, 0x5a, 0x05,
-	0x11, 0x1d, 0x10, 0xde, 0xc7, 0xdb, 0x68, 0xd5, 0x63, 0x4e, 0xc7, 0xf5, 0x6d, 0x1a, 0x95, 0x97,
-	0xab, 0x4a, 0x6d, 0xc5, 0x2a, 0x79, 0xcc, 0x39, 0x8c, 0xd7, 0x7a, 0x0b, 0x5d, 0x4e, 0xa9, 0xf1,
-	0x80, 0xf9, 0x9c, 0x62, 0x15, 0x95, 0x38, 0xec, 0x49, 0xbd, 0x15, 0x6b, 0xba, 0xd6, 0x8f, 0xd1,
-	0xb6, 0x6c, 0x3a, 0xe4, 0x4f, 0x3c, 0xfb, 0x59, 0xf4, 0x6f, 0x48, 0xee, 0xa0, 0x9d, 0x6c, 0x51,
-	0x00, 0xda, 0x42, 0x05, 0x2e, 0x88, 0x08, 0xb9, 0x14, 0x2d, 0x59, 0xb0, 0xd2, 0x77, 0x51, 0x45,
-	0xf6, 0x3d, 0x9c, 0xfc, 0x4c, 0xf6, 0xfd, 0x5e, 0x8f, 0x85, 0xbe, 0xb0, 0x18, 0x13, 0x00, 0xa4,
-	0x3f, 0x46, 0xd5, 0xfc, 0x12, 0x90, 0xbf, 0x89, 0x36, 0xc8, 0x64, 0xbb, 0x33, 0x64, 0x4c, 0xfc,
-	0x89, 0xbf, 0x46, 0x66, 0xf5, 0xf1, 0x39, 0x74, 0x0d, 0x50, 0x53, 0x7a, 0x3c, 0xf1, 0x1b, 0xa0,
-	0xab, 0x39, 0xdf, 0x83, 0xd9, 0x11, 0xda, 0x80, 0x5b, 0xb5, 0x3b, 0x20, 0x1e, 0x1f, 0xeb, 0xbf,
-	0xda, 0xa5, 0x66, 0xc5, 0x98, 0x4d, 0xd4, 0x59, 0x40, 0xb9, 0x91, 0x86, 0x5e, 0xb7, 0x53, 0xaa,
-	0xfa, 0x3e, 0x5c, 0x47, 0xba, 0x12, 0xae, 0xa3, 0x8c, 0x8a, 0xc4, 0xb6, 0x87, 0x94, 0x73, 0x38,
-	0x4f, 0xb2, 0xd4, 0x5f, 0x64, 0x9f, 0x63, 0x8a, 0xf9, 0x08, 0xad, 0xa7, 0x31, 0xa5, 0xc4, 0x1c,
-	0x94, 0x6b, 0x29, 0xca, 0xe6, 0xaf, 0x02, 0xfa, 0x5f, 0x9a, 0xe1, 0xf7, 0x0a, 0x2a, 0x25, 0xb7,
-	0x8b, 0x6f, 0x1b, 0xd9, 0x01, 0x32, 0xb2, 0x66, 0x5c, 0xad, 0xcf, 0x59, 0x3d, 0xe1, 0xd7, 0x6b,
-	0x6f, 0xbf, 0xfd, 0xfc, 0xb0, 0xac, 0xe3, 0xaa, 0x99, 0x93, 0xe4, 0x64, 0xa2, 0xf1, 0x47, 0x05,
-	0x15, 0x61, 0xf0, 0x70, 0xeb, 0x42, 0x93, 0xec, 0x99, 0x57, 0xdb, 0x8b, 0x35, 0x01, 0xe0, 0x75,
-	0x09, 0xb8, 0x8b, 0x2b, 0x79, 0x80, 0x2e, 0x67, 0x9e, 0x2d, 0x22, 0xfc, 0x45, 0x41, 0xe5, 0xbc,
-	0x11, 0xc6, 0xfb, 0x17, 0x7a, 0xe7, 0xe7, 0x42, 0xbd, 0xbb, 0x78, 0x23, 0x80, 0xef, 0x49, 0x70,
-	0x13, 0xd7, 0xf3, 0xc0, 0x93, 0xeb, 0xaf, 0xc3, 0xdc, 0xd4, 0xe3, 0x50, 0xe1, 0x4f, 0x0a, 0xbc,
-	0x6e, 0xd2, 0xc9, 0xc0, 0xed, 0x45, 0x50, 0x92, 0xa0, 0xa9, 0x7b, 0x0b, 0x76, 0x01, 0x7d, 0x43,
-	0xd2, 0xdf, 0xc2, 0x37, 0xe6, 0xa5, 0xe7, 0xf8, 0xb3, 0x02, 0xaf, 0xdd, 0x94, 0xe8, 0x5f, 0xa6,
-	0x25, 0x3b, 0x92, 0x6a, 0x7b, 0xb1, 0x26, 0xc0, 0xbe, 0x27, 0xb1, 0xdb, 0xb8, 0x39, 0x2f, 0xb6,
-	0xf9, 0x1a, 0x92, 0xfe, 0xe6, 0xc1, 0xc1, 0xd7, 0x91, 0xa6, 0x9c, 0x8f, 0x34, 0xe5, 0xc7, 0x48,
-	0x53, 0xde, 0x8d, 0xb5, 0xa5, 0xf3, 0xb1, 0xb6, 0xf4, 0x7d, 0xac, 0x2d, 0x3d, 0x37, 0x1c, 0x57,
-	0xf4, 0xc3, 0xae, 0xd1, 0x63, 0x03, 0x73, 0x40, 0x84, 0xdb, 0xf3, 0xa9, 0x78, 0xc5, 0x86, 0x2f,
-	0x67, 0x26, 0x11, 0xd8, 0xc8, 0xa4, 0x77, 0x0b, 0xf2, 0x6f, 0xa8, 0xf5, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0xd8, 0x9c, 0xd9, 0xf3, 0x1e, 0x07, 0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x4f, 0xdb, 0x48,
+	0x18, 0xc7, 0x31, 0xaf, 0xe1, 0xe1, 0x00, 0x8c, 0x02, 0x9b, 0x35, 0x6c, 0x5e, 0xac, 0x95, 0x36,
+	0xbb, 0x6c, 0x6c, 0x01, 0x61, 0x61, 0xb9, 0xb4, 0x54, 0xad, 0x0a, 0x15, 0xa2, 0x6d, 0xa8, 0x38,
+	0xf4, 0x12, 0x39, 0xf1, 0x90, 0x4c, 0x49, 0x3c, 0xc6, 0x33, 0xa1, 0x41, 0x55, 0xa5, 0xaa, 0x9f,
+	0xa0, 0x55, 0xcf, 0xfd, 0x0e, 0xbd, 0xf5, 0xd4, 0x5b, 0x0f, 0x3d, 0xa2, 0xf6, 0xd2, 0x43, 0x0f,
+	0x15, 0xf4, 0x23, 0xf4, 0x03, 0x54, 0x1e, 0x4f, 0x12, 0x14, 0xd9, 0x21, 0x8e, 0x38, 0x91, 0x99,
+	0x3c, 0x2f, 0xbf, 0xff, 0xcc, 0x33, 0x7f, 0x02, 0x5a, 0x15, 0x93, 0xba, 0x65, 0xd6, 0x6a, 0x06,
+	0xa7, 0x4e, 0xc3, 0x31, 0x4e, 0x96, 0x4b, 0x98, 0x9b, 0xcb, 0xc6, 0x71, 0x03, 0xbb, 0xa7, 0xba,
+	0xe3, 0x52, 0x4e, 0xd1, 0x7c, 0x2b, 0x46, 0x17, 0x31, 0xba, 0x8c, 0x51, 0x17, 0x2b, 0x94, 0x56,
+	0x6a, 0xd8, 0x30, 0x1d, 0x62, 0x98, 0xb6, 0x4d, 0xb9, 0xc9, 0x09, 0xb5, 0x99, 0x9f, 0xa5, 0xc6,
+	0x2b, 0xb4, 0x42, 0xc5, 0x47, 0xc3, 0xfb, 0x24, 0x77, 0xff, 0x6c, 0xf7, 0x2b, 0x99, 0x0c, 0xb7,
+	0xdb, 0x59, 0xe4, 0x84, 0x58, 0xd8, 0xe6, 0x7e, 0x94, 0xb6, 0x0b, 0xf1, 0x87, 0x1e, 0xc0, 0x3e,
+	0x3e, 0x6e, 0x60, 0xbb, 0x8c, 0x0b, 0xde, 0x5f, 0xc6, 0xd1, 0x6f, 0x30, 0xc1, 0x9b, 0xc5, 0xaa,
+	0xc9, 0xaa, 0x09, 0x25, 0xad, 0x64, 0x27, 0x0b, 0xe3, 0xbc, 0xb9, 0x6d, 0xb2, 0x2a, 0x5a, 0x80,
+	0xc9, 0x1a, 0xad, 0x14, 0x89, 0x6d, 0xe1, 0x66, 0x62, 0x38, 0xad, 0x64, 0x47, 0x0b, 0xb1, 0x1a,
+	0xad, 0xec, 0x78, 0x6b, 0x6d, 0x15, 0xe6, 0xba, 0xaa, 0x31, 0x87, 0xda, 0x0c, 0x23, 0x15, 0x62,
+	0x4c, 0xee, 0x89, 0x7a, 0xa3, 0x85, 0xf6, 0x5a, 0xdb, 0x87, 0x05, 0x91, 0xb4, 0xc3, 0xee, 0xd7,
+	0xac, 0x47, 0xcd, 0xeb, 0x21, 0xf9, 0x0f, 0x16, 0x83, 0x8b, 0x4a, 0xa0, 0x79, 0x18, 0x67, 0xdc,
+	0xe4, 0x0d, 0x26, 0x8a, 0xc6, 0x0a, 0x72, 0xa5, 0x65, 0x20, 0x25, 0xf2, 0x6e, 0xfb, 0xc7, 0x64,
+	0x6d, 0x95, 0xcb, 0xb4, 0x61, 0xf3, 0x02, 0xa5, 0x5c, 0x02, 0x69, 0x7b, 0x90, 0x0e, 0x0f, 0x91,
+	0xe5, 0xff, 0x81, 0x59, 0xd3, 0xdf, 0x2e, 0xba, 0x94, 0xf2, 0xcb, 0xf8, 0xd3, 0x66, 0x27, 0xde,
+	0xd3, 0xa1, 0x25, 0x61, 0x31, 0xa8, 0x1e, 0x6b, 0xf5, 0xab, 0xc3, 0x1f, 0x21, 0xdf, 0xcb, 0x66,
+	0xbb, 0x30, 0x2b, 0x6f, 0xd5, 0x2a, 0xca, 0xe2, 0x9e, 0xac, 0x91, 0xec, 0xd4, 0x4a, 0x4a, 0xef,
+	0x4c, 0xd4, 0xa9, 0x83, 0x99, 0xde, 0x0d, 0x3d, 0x63, 0x75, 0x55, 0xd5, 0xd6, 0xe5, 0x75, 0x74,
+	0x47, 0xca, 0xeb, 0x48, 0xc0, 0x84, 0x69, 0x59, 0x2e, 0x66, 0x4c, 0xea, 0x69, 0x2d, 0xb5, 0x27,
+	0xc1, 0x3a, 0xda, 0x98, 0xf7, 0x60, 0xa6, 0x1b, 0x53, 0x94, 0xe8, 0x83, 0x72, 0xba, 0x8b, 0x52,
+	0x23, 0xc1, 0x77, 0xf0, 0xc0, 0xa5, 0xf4, 0xf0, 0x4a, 0x52, 0xb4, 0x04, 0xb3, 0xe5, 0x2a, 0x2e,
+	0x1f, 0x39, 0x94, 0xd8, 0xbc, 0x68, 0x37, 0xea, 0x25, 0xec, 0xca, 0x09, 0x9a, 0xe9, 0x7c, 0xb1,
+	0x27, 0xf6, 0xb5, 0x9f, 0x0a, 0x64, 0x7a, 0xf4, 0xba, 0x7e, 0x71, 0x91, 0xf0, 0x82, 0x27, 0x6d,
+	0x24, 0x70, 0xd2, 0x50, 0x1c, 0xc6, 0xfc, 0xd7, 0x32, 0x2a, 0x8a, 0xf9, 0x0b, 0x6f, 0xd7, 0xf1,
+	0xb4, 0x24, 0xc6, 0x44, 0x96, 0xbf, 0xd0, 0x5e, 0x28, 0x90, 0x39, 0xc0, 0x2e, 0x39, 0x1c, 0xf0,
+	0x8c, 0xdb, 0x55, 0x87, 0x2f, 0x55, 0x0d, 0x96, 0x36, 0x12, 0x72, 0xf2, 0x77, 0x40, 0xeb, 0x45,
+	0x20, 0x4f, 0x3e, 0x05, 0x53, 0x84, 0x15, 0x4f, 0xbc, 0x40, 0x82, 0x2d, 0xf9, 0x9c, 0x81, 0xb0,
+	0x03, 0xb9, 0xb3, 0xf2, 0x0e, 0x60, 0x4c, 0x5c, 0x20, 0x7a, 0xad, 0x40, 0xac, 0xe5, 0x04, 0xe8,
+	0x5f, 0x3d, 0xd8, 0x6c, 0xf5, 0x20, 0x3f, 0x54, 0x73, 0x7d, 0x46, 0xfb, 0x50, 0x5a, 0xf6, 0xe5,
+	0x97, 0x1f, 0x6f, 0x86, 0x35, 0x94, 0x36, 0x42, 0x5c, 0xbf, 0xe5, 0x7e, 0xe8, 0xad, 0x02, 0x13,
+	0xd2, 0xa4, 0xd0, 0x6a, 0xcf, 0x26, 0xc1, 0xfe, 0xa8, 0xe6, 0xa3, 0x25, 0x49, 0xc0, 0xbf, 0x04,
+	0x60, 0x06, 0xa5, 0xc2, 0x00, 0x09, 0xa3, 0x35, 0x8b, 0x37, 0xd1, 0x47, 0x05, 0x12, 0x61, 0x76,
+	0x87, 0xd6, 0x7b, 0xf6, 0x0e, 0xf7, 0x50, 0x75, 0x23, 0x7a, 0xa2, 0x04, 0x5f, 0x13, 0xe0, 0x06,
+	0xca, 0x85, 0x81, 0xb7, 0x5e, 0x53, 0x4e, 0x4e, 0x7f, 0xce, 0x7b, 0x16, 0xe8, 0xbd, 0x02, 0x73,
+	0x41, 0xb5, 0x19, 0xca, 0x47, 0x41, 0x69, 0x99, 0xb2, 0xba, 0x16, 0x31, 0x4b, 0xd2, 0x2f, 0x0b,
+	0xfa, 0x25, 0xf4, 0x77, 0xbf, 0xf4, 0x0c, 0x7d, 0x50, 0x20, 0x1e, 0x54, 0xf4, 0x8a, 0x69, 0x09,
+	0xb6, 0x6f, 0x35, 0x1f, 0x2d, 0x49, 0x62, 0x6f, 0x0a, 0xec, 0x3c, 0x5a, 0xe9, 0x17, 0xdb, 0x78,
+	0x26, 0x7d, 0xe0, 0x39, 0xfa, 0xac, 0xc0, 0xef, 0xa1, 0xfe, 0x89, 0x22, 0x0d, 0xc2, 0x65, 0xeb,
+	0x51, 0xff, 0x1f, 0x20, 0x53, 0xca, 0xb9, 0x29, 0xe4, 0x6c, 0xa2, 0x8d, 0xe8, 0x72, 0x0c, 0xdf,
+	0xc7, 0xbe, 0x29, 0xa0, 0x86, 0x7b, 0x13, 0x0a, 0x65, 0xbb, 0xd2, 0x51, 0xd5, 0xcd, 0x41, 0x52,
+	0xa5, 0xae, 0xbb, 0x42, 0xd7, 0x16, 0xba, 0x31, 0x80, 0x2e, 0x61, 0xa0, 0xa7, 0x39, 0x21, 0xef,
+	0xd6, 0xf6, 0xa7, 0xf3, 0xa4, 0x72, 0x76, 0x9e, 0x54, 0xbe, 0x9f, 0x27, 0x95, 0x57, 0x17, 0xc9,
+	0xa1, 0xb3, 0x8b, 0xe4, 0xd0, 0xd7, 0x8b, 0xe4, 0xd0, 0x63, 0xbd, 0x42, 0x78, 0xb5, 0x51, 0xd2,
+	0xcb, 0xb4, 0x6e, 0xd4, 0x4d, 0x4e, 0xca, 0x36, 0xe6, 0x4f, 0xa9, 0x7b, 0xd4, 0xe9, 0xd8, 0x94,
+	0x3d, 0xc5, 0x3f, 0xbb, 0xd2, 0xb8, 0xf8, 0x99, 0xb9, 0xfa, 0x6b, 0x00, 0xda, 0xda, 0x79, 0x63,
+	0xfe, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -557,6 +809,12 @@ type QueryClient interface {
 	//
 	QueryDividendAccounts(ctx context.Context, in *QueryDividendAccountsRequest, opts ...grpc.CallOption) (*QueryDividendAccountsResponse, error)
 	QueryDividendAccount(ctx context.Context, in *QueryDividendAccountRequest, opts ...grpc.CallOption) (*QueryDividendAccountResponse, error)
+	// QueryDividendAccountProof queries the merkle proof of a dividend account
+	// against the account root hash of a checkpoint.
+	QueryDividendAccountProof(ctx context.Context, in *QueryDividendAccountProofRequest, opts ...grpc.CallOption) (*QueryDividendAccountProofResponse, error)
+	// VerifyDividendAccountProof verifies a dividend account merkle proof
+	// against the account root hash of a checkpoint.
+	VerifyDividendAccountProof(ctx context.Context, in *VerifyDividendAccountProofRequest, opts ...grpc.CallOption) (*VerifyDividendAccountProofResponse, error)
 }
 
 type queryClient struct {
@@ -612,6 +870,24 @@ func (c *queryClient) QueryDividendAccount(ctx context.Context, in *QueryDividen
 	return out, nil
 }
 
+func (c *queryClient) QueryDividendAccountProof(ctx context.Context, in *QueryDividendAccountProofRequest, opts ...grpc.CallOption) (*QueryDividendAccountProofResponse, error) {
+	out := new(QueryDividendAccountProofResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.topup.v1beta1.Query/QueryDividendAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyDividendAccountProof(ctx context.Context, in *VerifyDividendAccountProofRequest, opts ...grpc.CallOption) (*VerifyDividendAccountProofResponse, error) {
+	out := new(VerifyDividendAccountProofResponse)
+	err := c.cc.Invoke(ctx, "/heimdall.topup.v1beta1.Query/VerifyDividendAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Sequence query sequence no
@@ -623,6 +899,12 @@ type QueryServer interface {
 	//
 	QueryDividendAccounts(context.Context, *QueryDividendAccountsRequest) (*QueryDividendAccountsResponse, error)
 	QueryDividendAccount(context.Context, *QueryDividendAccountRequest) (*QueryDividendAccountResponse, error)
+	// QueryDividendAccountProof queries the merkle proof of a dividend account
+	// against the account root hash of a checkpoint.
+	QueryDividendAccountProof(context.Context, *QueryDividendAccountProofRequest) (*QueryDividendAccountProofResponse, error)
+	// VerifyDividendAccountProof verifies a dividend account merkle proof
+	// against the account root hash of a checkpoint.
+	VerifyDividendAccountProof(context.Context, *VerifyDividendAccountProofRequest) (*VerifyDividendAccountProofResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -644,6 +926,12 @@ func (*UnimplementedQueryServer) QueryDividendAccounts(ctx context.Context, req
 func (*UnimplementedQueryServer) QueryDividendAccount(ctx context.Context, req *QueryDividendAccountRequest) (*QueryDividendAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryDividendAccount not implemented")
 }
+func (*UnimplementedQueryServer) QueryDividendAccountProof(ctx context.Context, req *QueryDividendAccountProofRequest) (*QueryDividendAccountProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDividendAccountProof not implemented")
+}
+func (*UnimplementedQueryServer) VerifyDividendAccountProof(ctx context.Context, req *VerifyDividendAccountProofRequest) (*VerifyDividendAccountProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDividendAccountProof not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -739,6 +1027,42 @@ func _Query_QueryDividendAccount_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryDividendAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDividendAccountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryDividendAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.topup.v1beta1.Query/QueryDividendAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryDividendAccountProof(ctx, req.(*QueryDividendAccountProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyDividendAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDividendAccountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyDividendAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/heimdall.topup.v1beta1.Query/VerifyDividendAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyDividendAccountProof(ctx, req.(*VerifyDividendAccountProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "heimdall.topup.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -763,6 +1087,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryDividendAccount",
 			Handler:    _Query_QueryDividendAccount_Handler,
 		},
+		{
+			MethodName: "QueryDividendAccountProof",
+			Handler:    _Query_QueryDividendAccountProof_Handler,
+		},
+		{
+			MethodName: "VerifyDividendAccountProof",
+			Handler:    _Query_VerifyDividendAccountProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "heimdall/topup/v1beta1/query.proto",
@@ -1077,54 +1409,223 @@ func (m *QueryDividendAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDividendAccountProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuerySequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryDividendAccountProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDividendAccountProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.CheckpointNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.LogIndex != 0 {
-		n += 1 + sovQuery(uint64(m.LogIndex))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+func (m *QueryDividendAccountProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIsOldTxSequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDividendAccountProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDividendAccountProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AccountRootHash) > 0 {
+		i -= len(m.AccountRootHash)
+		copy(dAtA[i:], m.AccountRootHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountRootHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CheckpointNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DividendAccount != nil {
+		{
+			size, err := m.DividendAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyDividendAccountProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyDividendAccountProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyDividendAccountProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckpointNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyDividendAccountProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyDividendAccountProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyDividendAccountProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsVerified {
+		i--
+		if m.IsVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func (m *QuerySequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryIsOldTxSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.LogIndex != 0 {
 		n += 1 + sovQuery(uint64(m.LogIndex))
@@ -1216,6 +1717,81 @@ func (m *QueryDividendAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDividendAccountProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CheckpointNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointNumber))
+	}
+	return n
+}
+
+func (m *QueryDividendAccountProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DividendAccount != nil {
+		l = m.DividendAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CheckpointNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointNumber))
+	}
+	l = len(m.AccountRootHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VerifyDividendAccountProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CheckpointNumber != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointNumber))
+	}
+	return n
+}
+
+func (m *VerifyDividendAccountProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsVerified {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2027,6 +2603,510 @@ func (m *QueryDividendAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDividendAccountProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDividendAccountProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDividendAccountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointNumber", wireType)
+			}
+			m.CheckpointNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDividendAccountProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDividendAccountProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDividendAccountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DividendAccount == nil {
+				m.DividendAccount = &types.DividendAccount{}
+			}
+			if err := m.DividendAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointNumber", wireType)
+			}
+			m.CheckpointNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountRootHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountRootHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDividendAccountProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDividendAccountProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDividendAccountProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointNumber", wireType)
+			}
+			m.CheckpointNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyDividendAccountProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyDividendAccountProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyDividendAccountProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsVerified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0